@@ -0,0 +1,1116 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package lockup
+
+import (
+	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_LockAuthorization_3_list)(nil)
+
+type _LockAuthorization_3_list struct {
+	list *[]string
+}
+
+func (x *_LockAuthorization_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LockAuthorization_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_LockAuthorization_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LockAuthorization_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LockAuthorization_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message LockAuthorization at list field AllowedValidators as it is not of Message kind"))
+}
+
+func (x *_LockAuthorization_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LockAuthorization_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_LockAuthorization_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LockAuthorization_5_list)(nil)
+
+type _LockAuthorization_5_list struct {
+	list *[]string
+}
+
+func (x *_LockAuthorization_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LockAuthorization_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_LockAuthorization_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LockAuthorization_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LockAuthorization_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message LockAuthorization at list field AllowedRecipients as it is not of Message kind"))
+}
+
+func (x *_LockAuthorization_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LockAuthorization_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_LockAuthorization_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_LockAuthorization                    protoreflect.MessageDescriptor
+	fd_LockAuthorization_spend_limit        protoreflect.FieldDescriptor
+	fd_LockAuthorization_max_unlock_date    protoreflect.FieldDescriptor
+	fd_LockAuthorization_allowed_validators protoreflect.FieldDescriptor
+	fd_LockAuthorization_authorization_type protoreflect.FieldDescriptor
+	fd_LockAuthorization_allowed_recipients protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_authz_proto_init()
+	md_LockAuthorization = File_optio_lockup_authz_proto.Messages().ByName("LockAuthorization")
+	fd_LockAuthorization_spend_limit = md_LockAuthorization.Fields().ByName("spend_limit")
+	fd_LockAuthorization_max_unlock_date = md_LockAuthorization.Fields().ByName("max_unlock_date")
+	fd_LockAuthorization_allowed_validators = md_LockAuthorization.Fields().ByName("allowed_validators")
+	fd_LockAuthorization_authorization_type = md_LockAuthorization.Fields().ByName("authorization_type")
+	fd_LockAuthorization_allowed_recipients = md_LockAuthorization.Fields().ByName("allowed_recipients")
+}
+
+var _ protoreflect.Message = (*fastReflection_LockAuthorization)(nil)
+
+type fastReflection_LockAuthorization LockAuthorization
+
+func (x *LockAuthorization) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LockAuthorization)(x)
+}
+
+func (x *LockAuthorization) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_authz_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LockAuthorization_messageType fastReflection_LockAuthorization_messageType
+var _ protoreflect.MessageType = fastReflection_LockAuthorization_messageType{}
+
+type fastReflection_LockAuthorization_messageType struct{}
+
+func (x fastReflection_LockAuthorization_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LockAuthorization)(nil)
+}
+func (x fastReflection_LockAuthorization_messageType) New() protoreflect.Message {
+	return new(fastReflection_LockAuthorization)
+}
+func (x fastReflection_LockAuthorization_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockAuthorization
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LockAuthorization) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockAuthorization
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LockAuthorization) Type() protoreflect.MessageType {
+	return _fastReflection_LockAuthorization_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LockAuthorization) New() protoreflect.Message {
+	return new(fastReflection_LockAuthorization)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LockAuthorization) Interface() protoreflect.ProtoMessage {
+	return (*LockAuthorization)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LockAuthorization) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SpendLimit != nil {
+		value := protoreflect.ValueOfMessage(x.SpendLimit.ProtoReflect())
+		if !f(fd_LockAuthorization_spend_limit, value) {
+			return
+		}
+	}
+	if x.MaxUnlockDate != "" {
+		value := protoreflect.ValueOfString(x.MaxUnlockDate)
+		if !f(fd_LockAuthorization_max_unlock_date, value) {
+			return
+		}
+	}
+	if len(x.AllowedValidators) != 0 {
+		value := protoreflect.ValueOfList(&_LockAuthorization_3_list{list: &x.AllowedValidators})
+		if !f(fd_LockAuthorization_allowed_validators, value) {
+			return
+		}
+	}
+	if x.AuthorizationType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AuthorizationType))
+		if !f(fd_LockAuthorization_authorization_type, value) {
+			return
+		}
+	}
+	if len(x.AllowedRecipients) != 0 {
+		value := protoreflect.ValueOfList(&_LockAuthorization_5_list{list: &x.AllowedRecipients})
+		if !f(fd_LockAuthorization_allowed_recipients, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LockAuthorization) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.LockAuthorization.spend_limit":
+		return x.SpendLimit != nil
+	case "optio.lockup.LockAuthorization.max_unlock_date":
+		return x.MaxUnlockDate != ""
+	case "optio.lockup.LockAuthorization.allowed_validators":
+		return len(x.AllowedValidators) != 0
+	case "optio.lockup.LockAuthorization.authorization_type":
+		return x.AuthorizationType != 0
+	case "optio.lockup.LockAuthorization.allowed_recipients":
+		return len(x.AllowedRecipients) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockAuthorization"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockAuthorization) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.LockAuthorization.spend_limit":
+		x.SpendLimit = nil
+	case "optio.lockup.LockAuthorization.max_unlock_date":
+		x.MaxUnlockDate = ""
+	case "optio.lockup.LockAuthorization.allowed_validators":
+		x.AllowedValidators = nil
+	case "optio.lockup.LockAuthorization.authorization_type":
+		x.AuthorizationType = 0
+	case "optio.lockup.LockAuthorization.allowed_recipients":
+		x.AllowedRecipients = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockAuthorization"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LockAuthorization) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.LockAuthorization.spend_limit":
+		value := x.SpendLimit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.LockAuthorization.max_unlock_date":
+		value := x.MaxUnlockDate
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.LockAuthorization.allowed_validators":
+		if len(x.AllowedValidators) == 0 {
+			return protoreflect.ValueOfList(&_LockAuthorization_3_list{})
+		}
+		listValue := &_LockAuthorization_3_list{list: &x.AllowedValidators}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.lockup.LockAuthorization.authorization_type":
+		value := x.AuthorizationType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "optio.lockup.LockAuthorization.allowed_recipients":
+		if len(x.AllowedRecipients) == 0 {
+			return protoreflect.ValueOfList(&_LockAuthorization_5_list{})
+		}
+		listValue := &_LockAuthorization_5_list{list: &x.AllowedRecipients}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockAuthorization"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockAuthorization does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockAuthorization) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.LockAuthorization.spend_limit":
+		x.SpendLimit = value.Message().Interface().(*v1beta1.Coin)
+	case "optio.lockup.LockAuthorization.max_unlock_date":
+		x.MaxUnlockDate = value.Interface().(string)
+	case "optio.lockup.LockAuthorization.allowed_validators":
+		lv := value.List()
+		clv := lv.(*_LockAuthorization_3_list)
+		x.AllowedValidators = *clv.list
+	case "optio.lockup.LockAuthorization.authorization_type":
+		x.AuthorizationType = (LockAuthorizationType)(value.Enum())
+	case "optio.lockup.LockAuthorization.allowed_recipients":
+		lv := value.List()
+		clv := lv.(*_LockAuthorization_5_list)
+		x.AllowedRecipients = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockAuthorization"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockAuthorization) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.LockAuthorization.spend_limit":
+		if x.SpendLimit == nil {
+			x.SpendLimit = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.SpendLimit.ProtoReflect())
+	case "optio.lockup.LockAuthorization.allowed_validators":
+		if x.AllowedValidators == nil {
+			x.AllowedValidators = []string{}
+		}
+		value := &_LockAuthorization_3_list{list: &x.AllowedValidators}
+		return protoreflect.ValueOfList(value)
+	case "optio.lockup.LockAuthorization.allowed_recipients":
+		if x.AllowedRecipients == nil {
+			x.AllowedRecipients = []string{}
+		}
+		value := &_LockAuthorization_5_list{list: &x.AllowedRecipients}
+		return protoreflect.ValueOfList(value)
+	case "optio.lockup.LockAuthorization.max_unlock_date":
+		panic(fmt.Errorf("field max_unlock_date of message optio.lockup.LockAuthorization is not mutable"))
+	case "optio.lockup.LockAuthorization.authorization_type":
+		panic(fmt.Errorf("field authorization_type of message optio.lockup.LockAuthorization is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockAuthorization"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LockAuthorization) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.LockAuthorization.spend_limit":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.LockAuthorization.max_unlock_date":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.LockAuthorization.allowed_validators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_LockAuthorization_3_list{list: &list})
+	case "optio.lockup.LockAuthorization.authorization_type":
+		return protoreflect.ValueOfEnum(0)
+	case "optio.lockup.LockAuthorization.allowed_recipients":
+		list := []string{}
+		return protoreflect.ValueOfList(&_LockAuthorization_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockAuthorization"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LockAuthorization) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.LockAuthorization", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LockAuthorization) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockAuthorization) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LockAuthorization) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LockAuthorization) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LockAuthorization)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SpendLimit != nil {
+			l = options.Size(x.SpendLimit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxUnlockDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AllowedValidators) > 0 {
+			for _, s := range x.AllowedValidators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AuthorizationType != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuthorizationType))
+		}
+		if len(x.AllowedRecipients) > 0 {
+			for _, s := range x.AllowedRecipients {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LockAuthorization)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AllowedRecipients) > 0 {
+			for iNdEx := len(x.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedRecipients[iNdEx])
+				copy(dAtA[i:], x.AllowedRecipients[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedRecipients[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.AuthorizationType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuthorizationType))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.AllowedValidators) > 0 {
+			for iNdEx := len(x.AllowedValidators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedValidators[iNdEx])
+				copy(dAtA[i:], x.AllowedValidators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedValidators[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.MaxUnlockDate) > 0 {
+			i -= len(x.MaxUnlockDate)
+			copy(dAtA[i:], x.MaxUnlockDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxUnlockDate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.SpendLimit != nil {
+			encoded, err := options.Marshal(x.SpendLimit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LockAuthorization)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockAuthorization: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SpendLimit == nil {
+					x.SpendLimit = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpendLimit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxUnlockDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxUnlockDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedValidators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedValidators = append(x.AllowedValidators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthorizationType", wireType)
+				}
+				x.AuthorizationType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuthorizationType |= LockAuthorizationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedRecipients = append(x.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: optio/lockup/authz.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LockAuthorizationType defines the lockup message a LockAuthorization grants.
+type LockAuthorizationType int32
+
+const (
+	// LOCK_AUTHORIZATION_TYPE_UNSPECIFIED is not a valid authorization type.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_UNSPECIFIED LockAuthorizationType = 0
+	// LOCK_AUTHORIZATION_TYPE_LOCK grants MsgLock.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_LOCK LockAuthorizationType = 1
+	// LOCK_AUTHORIZATION_TYPE_EXTEND grants MsgExtend.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_EXTEND LockAuthorizationType = 2
+	// LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK grants MsgSendDelegateAndLock.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK LockAuthorizationType = 3
+	// LOCK_AUTHORIZATION_TYPE_LOCK_SCHEDULE grants MsgLockSchedule.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_LOCK_SCHEDULE LockAuthorizationType = 4
+	// LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK_SCHEDULE grants MsgSendDelegateAndLockSchedule.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK_SCHEDULE LockAuthorizationType = 5
+	// LOCK_AUTHORIZATION_TYPE_DELEGATE_AND_LOCK grants MsgDelegateAndLock.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_DELEGATE_AND_LOCK LockAuthorizationType = 6
+)
+
+// Enum value maps for LockAuthorizationType.
+var (
+	LockAuthorizationType_name = map[int32]string{
+		0: "LOCK_AUTHORIZATION_TYPE_UNSPECIFIED",
+		1: "LOCK_AUTHORIZATION_TYPE_LOCK",
+		2: "LOCK_AUTHORIZATION_TYPE_EXTEND",
+		3: "LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK",
+		4: "LOCK_AUTHORIZATION_TYPE_LOCK_SCHEDULE",
+		5: "LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK_SCHEDULE",
+		6: "LOCK_AUTHORIZATION_TYPE_DELEGATE_AND_LOCK",
+	}
+	LockAuthorizationType_value = map[string]int32{
+		"LOCK_AUTHORIZATION_TYPE_UNSPECIFIED":                     0,
+		"LOCK_AUTHORIZATION_TYPE_LOCK":                            1,
+		"LOCK_AUTHORIZATION_TYPE_EXTEND":                          2,
+		"LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK":          3,
+		"LOCK_AUTHORIZATION_TYPE_LOCK_SCHEDULE":                   4,
+		"LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK_SCHEDULE": 5,
+		"LOCK_AUTHORIZATION_TYPE_DELEGATE_AND_LOCK":               6,
+	}
+)
+
+func (x LockAuthorizationType) Enum() *LockAuthorizationType {
+	p := new(LockAuthorizationType)
+	*p = x
+	return p
+}
+
+func (x LockAuthorizationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LockAuthorizationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_optio_lockup_authz_proto_enumTypes[0].Descriptor()
+}
+
+func (LockAuthorizationType) Type() protoreflect.EnumType {
+	return &file_optio_lockup_authz_proto_enumTypes[0]
+}
+
+func (x LockAuthorizationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LockAuthorizationType.Descriptor instead.
+func (LockAuthorizationType) EnumDescriptor() ([]byte, []int) {
+	return file_optio_lockup_authz_proto_rawDescGZIP(), []int{0}
+}
+
+// LockAuthorization allows the grantee to lock, extend, delegate and lock, or
+// send, delegate and lock the granter's tokens, at once or on a schedule,
+// within the given limits.
+type LockAuthorization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// spend_limit is the amount the grantee can still lock or extend. It is
+	// decremented by every accepted message, and the grant is removed once it is
+	// spent. There is no limit when it is not set.
+	SpendLimit *v1beta1.Coin `protobuf:"bytes,1,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	// max_unlock_date is the latest unlock date the grantee can lock or extend
+	// to. There is no limit when it is empty.
+	MaxUnlockDate string `protobuf:"bytes,2,opt,name=max_unlock_date,json=maxUnlockDate,proto3" json:"max_unlock_date,omitempty"`
+	// allowed_validators are the validators the grantee can delegate to with
+	// MsgSendDelegateAndLock, MsgSendDelegateAndLockSchedule and
+	// MsgDelegateAndLock. Any validator is allowed when it is empty.
+	AllowedValidators []string `protobuf:"bytes,3,rep,name=allowed_validators,json=allowedValidators,proto3" json:"allowed_validators,omitempty"`
+	// authorization_type is the message the grantee is allowed to execute.
+	AuthorizationType LockAuthorizationType `protobuf:"varint,4,opt,name=authorization_type,json=authorizationType,proto3,enum=optio.lockup.LockAuthorizationType" json:"authorization_type,omitempty"`
+	// allowed_recipients are the addresses the grantee can send, delegate and
+	// lock the granter's tokens for with MsgSendDelegateAndLock and
+	// MsgSendDelegateAndLockSchedule. Only the granter is allowed when it is empty.
+	AllowedRecipients []string `protobuf:"bytes,5,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+}
+
+func (x *LockAuthorization) Reset() {
+	*x = LockAuthorization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_authz_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockAuthorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockAuthorization) ProtoMessage() {}
+
+// Deprecated: Use LockAuthorization.ProtoReflect.Descriptor instead.
+func (*LockAuthorization) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_authz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LockAuthorization) GetSpendLimit() *v1beta1.Coin {
+	if x != nil {
+		return x.SpendLimit
+	}
+	return nil
+}
+
+func (x *LockAuthorization) GetMaxUnlockDate() string {
+	if x != nil {
+		return x.MaxUnlockDate
+	}
+	return ""
+}
+
+func (x *LockAuthorization) GetAllowedValidators() []string {
+	if x != nil {
+		return x.AllowedValidators
+	}
+	return nil
+}
+
+func (x *LockAuthorization) GetAuthorizationType() LockAuthorizationType {
+	if x != nil {
+		return x.AuthorizationType
+	}
+	return LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_UNSPECIFIED
+}
+
+func (x *LockAuthorization) GetAllowedRecipients() []string {
+	if x != nil {
+		return x.AllowedRecipients
+	}
+	return nil
+}
+
+var File_optio_lockup_authz_proto protoreflect.FileDescriptor
+
+var file_optio_lockup_authz_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x61,
+	0x75, 0x74, 0x68, 0x7a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f,
+	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62,
+	0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb1, 0x03, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0b,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0a, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f,
+	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x50, 0x0a, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4,
+	0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x11, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x3a,
+	0x49, 0xca, 0xb4, 0x2d, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x4c, 0x6f, 0x63, 0x6b, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0xd1, 0x02, 0x0a, 0x15, 0x4c,
+	0x6f, 0x63, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54,
+	0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a,
+	0x1c, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12,
+	0x22, 0x0a, 0x1e, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e,
+	0x44, 0x10, 0x02, 0x12, 0x32, 0x0a, 0x2e, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x48,
+	0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
+	0x45, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x4e, 0x44,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x29, 0x0a, 0x25, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45,
+	0x10, 0x04, 0x12, 0x3b, 0x0a, 0x37, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f,
+	0x52, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45,
+	0x4e, 0x44, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x4e, 0x44, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x05, 0x12,
+	0x2d, 0x0a, 0x29, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x47,
+	0x41, 0x54, 0x45, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x06, 0x42, 0x9f,
+	0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x42, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75,
+	0x70, 0xa2, 0x02, 0x03, 0x4f, 0x4c, 0x58, 0xaa, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x2e,
+	0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xca, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c, 0x4c,
+	0x6f, 0x63, 0x6b, 0x75, 0x70, 0xe2, 0x02, 0x18, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c, 0x4c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x3a, 0x3a, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_optio_lockup_authz_proto_rawDescOnce sync.Once
+	file_optio_lockup_authz_proto_rawDescData = file_optio_lockup_authz_proto_rawDesc
+)
+
+func file_optio_lockup_authz_proto_rawDescGZIP() []byte {
+	file_optio_lockup_authz_proto_rawDescOnce.Do(func() {
+		file_optio_lockup_authz_proto_rawDescData = protoimpl.X.CompressGZIP(file_optio_lockup_authz_proto_rawDescData)
+	})
+	return file_optio_lockup_authz_proto_rawDescData
+}
+
+var file_optio_lockup_authz_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_optio_lockup_authz_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_optio_lockup_authz_proto_goTypes = []interface{}{
+	(LockAuthorizationType)(0), // 0: optio.lockup.LockAuthorizationType
+	(*LockAuthorization)(nil),  // 1: optio.lockup.LockAuthorization
+	(*v1beta1.Coin)(nil),       // 2: cosmos.base.v1beta1.Coin
+}
+var file_optio_lockup_authz_proto_depIdxs = []int32{
+	2, // 0: optio.lockup.LockAuthorization.spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	0, // 1: optio.lockup.LockAuthorization.authorization_type:type_name -> optio.lockup.LockAuthorizationType
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_optio_lockup_authz_proto_init() }
+func file_optio_lockup_authz_proto_init() {
+	if File_optio_lockup_authz_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_optio_lockup_authz_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockAuthorization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_optio_lockup_authz_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_optio_lockup_authz_proto_goTypes,
+		DependencyIndexes: file_optio_lockup_authz_proto_depIdxs,
+		EnumInfos:         file_optio_lockup_authz_proto_enumTypes,
+		MessageInfos:      file_optio_lockup_authz_proto_msgTypes,
+	}.Build()
+	File_optio_lockup_authz_proto = out.File
+	file_optio_lockup_authz_proto_rawDesc = nil
+	file_optio_lockup_authz_proto_goTypes = nil
+	file_optio_lockup_authz_proto_depIdxs = nil
+}
@@ -0,0 +1,1381 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package lockup
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_LockHistoryEntry                 protoreflect.MessageDescriptor
+	fd_LockHistoryEntry_id              protoreflect.FieldDescriptor
+	fd_LockHistoryEntry_address         protoreflect.FieldDescriptor
+	fd_LockHistoryEntry_action          protoreflect.FieldDescriptor
+	fd_LockHistoryEntry_unlock_date     protoreflect.FieldDescriptor
+	fd_LockHistoryEntry_old_unlock_date protoreflect.FieldDescriptor
+	fd_LockHistoryEntry_amount          protoreflect.FieldDescriptor
+	fd_LockHistoryEntry_auto_renew      protoreflect.FieldDescriptor
+	fd_LockHistoryEntry_counterparty    protoreflect.FieldDescriptor
+	fd_LockHistoryEntry_height          protoreflect.FieldDescriptor
+	fd_LockHistoryEntry_time            protoreflect.FieldDescriptor
+	fd_LockHistoryEntry_denom           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_history_proto_init()
+	md_LockHistoryEntry = File_optio_lockup_history_proto.Messages().ByName("LockHistoryEntry")
+	fd_LockHistoryEntry_id = md_LockHistoryEntry.Fields().ByName("id")
+	fd_LockHistoryEntry_address = md_LockHistoryEntry.Fields().ByName("address")
+	fd_LockHistoryEntry_action = md_LockHistoryEntry.Fields().ByName("action")
+	fd_LockHistoryEntry_unlock_date = md_LockHistoryEntry.Fields().ByName("unlock_date")
+	fd_LockHistoryEntry_old_unlock_date = md_LockHistoryEntry.Fields().ByName("old_unlock_date")
+	fd_LockHistoryEntry_amount = md_LockHistoryEntry.Fields().ByName("amount")
+	fd_LockHistoryEntry_auto_renew = md_LockHistoryEntry.Fields().ByName("auto_renew")
+	fd_LockHistoryEntry_counterparty = md_LockHistoryEntry.Fields().ByName("counterparty")
+	fd_LockHistoryEntry_height = md_LockHistoryEntry.Fields().ByName("height")
+	fd_LockHistoryEntry_time = md_LockHistoryEntry.Fields().ByName("time")
+	fd_LockHistoryEntry_denom = md_LockHistoryEntry.Fields().ByName("denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_LockHistoryEntry)(nil)
+
+type fastReflection_LockHistoryEntry LockHistoryEntry
+
+func (x *LockHistoryEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LockHistoryEntry)(x)
+}
+
+func (x *LockHistoryEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_history_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LockHistoryEntry_messageType fastReflection_LockHistoryEntry_messageType
+var _ protoreflect.MessageType = fastReflection_LockHistoryEntry_messageType{}
+
+type fastReflection_LockHistoryEntry_messageType struct{}
+
+func (x fastReflection_LockHistoryEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LockHistoryEntry)(nil)
+}
+func (x fastReflection_LockHistoryEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_LockHistoryEntry)
+}
+func (x fastReflection_LockHistoryEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockHistoryEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LockHistoryEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockHistoryEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LockHistoryEntry) Type() protoreflect.MessageType {
+	return _fastReflection_LockHistoryEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LockHistoryEntry) New() protoreflect.Message {
+	return new(fastReflection_LockHistoryEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LockHistoryEntry) Interface() protoreflect.ProtoMessage {
+	return (*LockHistoryEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LockHistoryEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_LockHistoryEntry_id, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_LockHistoryEntry_address, value) {
+			return
+		}
+	}
+	if x.Action != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Action))
+		if !f(fd_LockHistoryEntry_action, value) {
+			return
+		}
+	}
+	if x.UnlockDate != "" {
+		value := protoreflect.ValueOfString(x.UnlockDate)
+		if !f(fd_LockHistoryEntry_unlock_date, value) {
+			return
+		}
+	}
+	if x.OldUnlockDate != "" {
+		value := protoreflect.ValueOfString(x.OldUnlockDate)
+		if !f(fd_LockHistoryEntry_old_unlock_date, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_LockHistoryEntry_amount, value) {
+			return
+		}
+	}
+	if x.AutoRenew != false {
+		value := protoreflect.ValueOfBool(x.AutoRenew)
+		if !f(fd_LockHistoryEntry_auto_renew, value) {
+			return
+		}
+	}
+	if x.Counterparty != "" {
+		value := protoreflect.ValueOfString(x.Counterparty)
+		if !f(fd_LockHistoryEntry_counterparty, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_LockHistoryEntry_height, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_LockHistoryEntry_time, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_LockHistoryEntry_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LockHistoryEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.LockHistoryEntry.id":
+		return x.Id != uint64(0)
+	case "optio.lockup.LockHistoryEntry.address":
+		return x.Address != ""
+	case "optio.lockup.LockHistoryEntry.action":
+		return x.Action != 0
+	case "optio.lockup.LockHistoryEntry.unlock_date":
+		return x.UnlockDate != ""
+	case "optio.lockup.LockHistoryEntry.old_unlock_date":
+		return x.OldUnlockDate != ""
+	case "optio.lockup.LockHistoryEntry.amount":
+		return x.Amount != ""
+	case "optio.lockup.LockHistoryEntry.auto_renew":
+		return x.AutoRenew != false
+	case "optio.lockup.LockHistoryEntry.counterparty":
+		return x.Counterparty != ""
+	case "optio.lockup.LockHistoryEntry.height":
+		return x.Height != int64(0)
+	case "optio.lockup.LockHistoryEntry.time":
+		return x.Time != nil
+	case "optio.lockup.LockHistoryEntry.denom":
+		return x.Denom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockHistoryEntry"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockHistoryEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.LockHistoryEntry.id":
+		x.Id = uint64(0)
+	case "optio.lockup.LockHistoryEntry.address":
+		x.Address = ""
+	case "optio.lockup.LockHistoryEntry.action":
+		x.Action = 0
+	case "optio.lockup.LockHistoryEntry.unlock_date":
+		x.UnlockDate = ""
+	case "optio.lockup.LockHistoryEntry.old_unlock_date":
+		x.OldUnlockDate = ""
+	case "optio.lockup.LockHistoryEntry.amount":
+		x.Amount = ""
+	case "optio.lockup.LockHistoryEntry.auto_renew":
+		x.AutoRenew = false
+	case "optio.lockup.LockHistoryEntry.counterparty":
+		x.Counterparty = ""
+	case "optio.lockup.LockHistoryEntry.height":
+		x.Height = int64(0)
+	case "optio.lockup.LockHistoryEntry.time":
+		x.Time = nil
+	case "optio.lockup.LockHistoryEntry.denom":
+		x.Denom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockHistoryEntry"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LockHistoryEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.LockHistoryEntry.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "optio.lockup.LockHistoryEntry.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.LockHistoryEntry.action":
+		value := x.Action
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "optio.lockup.LockHistoryEntry.unlock_date":
+		value := x.UnlockDate
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.LockHistoryEntry.old_unlock_date":
+		value := x.OldUnlockDate
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.LockHistoryEntry.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.LockHistoryEntry.auto_renew":
+		value := x.AutoRenew
+		return protoreflect.ValueOfBool(value)
+	case "optio.lockup.LockHistoryEntry.counterparty":
+		value := x.Counterparty
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.LockHistoryEntry.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "optio.lockup.LockHistoryEntry.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.LockHistoryEntry.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockHistoryEntry"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockHistoryEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockHistoryEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.LockHistoryEntry.id":
+		x.Id = value.Uint()
+	case "optio.lockup.LockHistoryEntry.address":
+		x.Address = value.Interface().(string)
+	case "optio.lockup.LockHistoryEntry.action":
+		x.Action = (LockHistoryAction)(value.Enum())
+	case "optio.lockup.LockHistoryEntry.unlock_date":
+		x.UnlockDate = value.Interface().(string)
+	case "optio.lockup.LockHistoryEntry.old_unlock_date":
+		x.OldUnlockDate = value.Interface().(string)
+	case "optio.lockup.LockHistoryEntry.amount":
+		x.Amount = value.Interface().(string)
+	case "optio.lockup.LockHistoryEntry.auto_renew":
+		x.AutoRenew = value.Bool()
+	case "optio.lockup.LockHistoryEntry.counterparty":
+		x.Counterparty = value.Interface().(string)
+	case "optio.lockup.LockHistoryEntry.height":
+		x.Height = value.Int()
+	case "optio.lockup.LockHistoryEntry.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "optio.lockup.LockHistoryEntry.denom":
+		x.Denom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockHistoryEntry"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockHistoryEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.LockHistoryEntry.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "optio.lockup.LockHistoryEntry.id":
+		panic(fmt.Errorf("field id of message optio.lockup.LockHistoryEntry is not mutable"))
+	case "optio.lockup.LockHistoryEntry.address":
+		panic(fmt.Errorf("field address of message optio.lockup.LockHistoryEntry is not mutable"))
+	case "optio.lockup.LockHistoryEntry.action":
+		panic(fmt.Errorf("field action of message optio.lockup.LockHistoryEntry is not mutable"))
+	case "optio.lockup.LockHistoryEntry.unlock_date":
+		panic(fmt.Errorf("field unlock_date of message optio.lockup.LockHistoryEntry is not mutable"))
+	case "optio.lockup.LockHistoryEntry.old_unlock_date":
+		panic(fmt.Errorf("field old_unlock_date of message optio.lockup.LockHistoryEntry is not mutable"))
+	case "optio.lockup.LockHistoryEntry.amount":
+		panic(fmt.Errorf("field amount of message optio.lockup.LockHistoryEntry is not mutable"))
+	case "optio.lockup.LockHistoryEntry.auto_renew":
+		panic(fmt.Errorf("field auto_renew of message optio.lockup.LockHistoryEntry is not mutable"))
+	case "optio.lockup.LockHistoryEntry.counterparty":
+		panic(fmt.Errorf("field counterparty of message optio.lockup.LockHistoryEntry is not mutable"))
+	case "optio.lockup.LockHistoryEntry.height":
+		panic(fmt.Errorf("field height of message optio.lockup.LockHistoryEntry is not mutable"))
+	case "optio.lockup.LockHistoryEntry.denom":
+		panic(fmt.Errorf("field denom of message optio.lockup.LockHistoryEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockHistoryEntry"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LockHistoryEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.LockHistoryEntry.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "optio.lockup.LockHistoryEntry.address":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.LockHistoryEntry.action":
+		return protoreflect.ValueOfEnum(0)
+	case "optio.lockup.LockHistoryEntry.unlock_date":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.LockHistoryEntry.old_unlock_date":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.LockHistoryEntry.amount":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.LockHistoryEntry.auto_renew":
+		return protoreflect.ValueOfBool(false)
+	case "optio.lockup.LockHistoryEntry.counterparty":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.LockHistoryEntry.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "optio.lockup.LockHistoryEntry.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.LockHistoryEntry.denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockHistoryEntry"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LockHistoryEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.LockHistoryEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LockHistoryEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockHistoryEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LockHistoryEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LockHistoryEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LockHistoryEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Action != 0 {
+			n += 1 + runtime.Sov(uint64(x.Action))
+		}
+		l = len(x.UnlockDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OldUnlockDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoRenew {
+			n += 2
+		}
+		l = len(x.Counterparty)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LockHistoryEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.Counterparty) > 0 {
+			i -= len(x.Counterparty)
+			copy(dAtA[i:], x.Counterparty)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Counterparty)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.AutoRenew {
+			i--
+			if x.AutoRenew {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.OldUnlockDate) > 0 {
+			i -= len(x.OldUnlockDate)
+			copy(dAtA[i:], x.OldUnlockDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldUnlockDate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.UnlockDate) > 0 {
+			i -= len(x.UnlockDate)
+			copy(dAtA[i:], x.UnlockDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UnlockDate)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Action != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Action))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LockHistoryEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockHistoryEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				}
+				x.Action = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Action |= LockHistoryAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnlockDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldUnlockDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldUnlockDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoRenew = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Counterparty = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: optio/lockup/history.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LockHistoryAction defines the change to a lock recorded by a lock history entry.
+type LockHistoryAction int32
+
+const (
+	// LOCK_HISTORY_ACTION_UNSPECIFIED is not a valid action.
+	LockHistoryAction_LOCK_HISTORY_ACTION_UNSPECIFIED LockHistoryAction = 0
+	// LOCK_HISTORY_ACTION_LOCK adds to a lock.
+	LockHistoryAction_LOCK_HISTORY_ACTION_LOCK LockHistoryAction = 1
+	// LOCK_HISTORY_ACTION_EXTEND moves an amount from old_unlock_date to unlock_date.
+	LockHistoryAction_LOCK_HISTORY_ACTION_EXTEND LockHistoryAction = 2
+	// LOCK_HISTORY_ACTION_TRANSFER_IN receives a lock from counterparty.
+	LockHistoryAction_LOCK_HISTORY_ACTION_TRANSFER_IN LockHistoryAction = 3
+	// LOCK_HISTORY_ACTION_TRANSFER_OUT sends a lock to counterparty.
+	LockHistoryAction_LOCK_HISTORY_ACTION_TRANSFER_OUT LockHistoryAction = 4
+	// LOCK_HISTORY_ACTION_EXPIRE removes a lock that reached its unlock date.
+	LockHistoryAction_LOCK_HISTORY_ACTION_EXPIRE LockHistoryAction = 5
+	// LOCK_HISTORY_ACTION_EARLY_UNLOCK removes an amount from a lock before its unlock date.
+	LockHistoryAction_LOCK_HISTORY_ACTION_EARLY_UNLOCK LockHistoryAction = 6
+	// LOCK_HISTORY_ACTION_SLASH removes an amount from a lock that slashing left above the delegations.
+	LockHistoryAction_LOCK_HISTORY_ACTION_SLASH LockHistoryAction = 7
+)
+
+// Enum value maps for LockHistoryAction.
+var (
+	LockHistoryAction_name = map[int32]string{
+		0: "LOCK_HISTORY_ACTION_UNSPECIFIED",
+		1: "LOCK_HISTORY_ACTION_LOCK",
+		2: "LOCK_HISTORY_ACTION_EXTEND",
+		3: "LOCK_HISTORY_ACTION_TRANSFER_IN",
+		4: "LOCK_HISTORY_ACTION_TRANSFER_OUT",
+		5: "LOCK_HISTORY_ACTION_EXPIRE",
+		6: "LOCK_HISTORY_ACTION_EARLY_UNLOCK",
+		7: "LOCK_HISTORY_ACTION_SLASH",
+	}
+	LockHistoryAction_value = map[string]int32{
+		"LOCK_HISTORY_ACTION_UNSPECIFIED":  0,
+		"LOCK_HISTORY_ACTION_LOCK":         1,
+		"LOCK_HISTORY_ACTION_EXTEND":       2,
+		"LOCK_HISTORY_ACTION_TRANSFER_IN":  3,
+		"LOCK_HISTORY_ACTION_TRANSFER_OUT": 4,
+		"LOCK_HISTORY_ACTION_EXPIRE":       5,
+		"LOCK_HISTORY_ACTION_EARLY_UNLOCK": 6,
+		"LOCK_HISTORY_ACTION_SLASH":        7,
+	}
+)
+
+func (x LockHistoryAction) Enum() *LockHistoryAction {
+	p := new(LockHistoryAction)
+	*p = x
+	return p
+}
+
+func (x LockHistoryAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LockHistoryAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_optio_lockup_history_proto_enumTypes[0].Descriptor()
+}
+
+func (LockHistoryAction) Type() protoreflect.EnumType {
+	return &file_optio_lockup_history_proto_enumTypes[0]
+}
+
+func (x LockHistoryAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LockHistoryAction.Descriptor instead.
+func (LockHistoryAction) EnumDescriptor() ([]byte, []int) {
+	return file_optio_lockup_history_proto_rawDescGZIP(), []int{0}
+}
+
+// LockHistoryEntry records a single change to the locks of an address.
+type LockHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id orders the entries of every address.
+	Id      uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string            `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Action  LockHistoryAction `protobuf:"varint,3,opt,name=action,proto3,enum=optio.lockup.LockHistoryAction" json:"action,omitempty"`
+	// unlock_date is the unlock date of the changed lock. For auto-renewing locks it is the unlock date as of the block.
+	UnlockDate string `protobuf:"bytes,4,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	// old_unlock_date is the unlock date an extension moved the amount from.
+	OldUnlockDate string `protobuf:"bytes,5,opt,name=old_unlock_date,json=oldUnlockDate,proto3" json:"old_unlock_date,omitempty"`
+	Amount        string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	AutoRenew     bool   `protobuf:"varint,7,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// counterparty is the other address of a transfer.
+	Counterparty string                 `protobuf:"bytes,8,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	Height       int64                  `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	Time         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=time,proto3" json:"time,omitempty"`
+	// denom is the denom of an escrowed lock, empty for delegated locks.
+	Denom string `protobuf:"bytes,11,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (x *LockHistoryEntry) Reset() {
+	*x = LockHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_history_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockHistoryEntry) ProtoMessage() {}
+
+// Deprecated: Use LockHistoryEntry.ProtoReflect.Descriptor instead.
+func (*LockHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LockHistoryEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LockHistoryEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetAction() LockHistoryAction {
+	if x != nil {
+		return x.Action
+	}
+	return LockHistoryAction_LOCK_HISTORY_ACTION_UNSPECIFIED
+}
+
+func (x *LockHistoryEntry) GetUnlockDate() string {
+	if x != nil {
+		return x.UnlockDate
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetOldUnlockDate() string {
+	if x != nil {
+		return x.OldUnlockDate
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+func (x *LockHistoryEntry) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *LockHistoryEntry) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *LockHistoryEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *LockHistoryEntry) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+var File_optio_lockup_history_proto protoreflect.FileDescriptor
+
+var file_optio_lockup_history_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e,
+	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xb3, 0x03, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x5f,
+	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75,
+	0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2a, 0xa6, 0x02, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x1f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x1e,
+	0x0a, 0x1a, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x23,
+	0x0a, 0x1f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x49,
+	0x4e, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x49, 0x53, 0x54,
+	0x4f, 0x52, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x45, 0x52, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x10, 0x05, 0x12, 0x24, 0x0a, 0x20, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x45, 0x41, 0x52, 0x4c, 0x59, 0x5f, 0x55, 0x4e, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x06, 0x12,
+	0x1d, 0x0a, 0x19, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x41, 0x53, 0x48, 0x10, 0x07, 0x42, 0xa1,
+	0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x42, 0x0c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0xa2, 0x02, 0x03, 0x4f, 0x4c, 0x58, 0xaa, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xca, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xe2, 0x02, 0x18, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c,
+	0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x3a, 0x3a, 0x4c, 0x6f, 0x63, 0x6b,
+	0x75, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_optio_lockup_history_proto_rawDescOnce sync.Once
+	file_optio_lockup_history_proto_rawDescData = file_optio_lockup_history_proto_rawDesc
+)
+
+func file_optio_lockup_history_proto_rawDescGZIP() []byte {
+	file_optio_lockup_history_proto_rawDescOnce.Do(func() {
+		file_optio_lockup_history_proto_rawDescData = protoimpl.X.CompressGZIP(file_optio_lockup_history_proto_rawDescData)
+	})
+	return file_optio_lockup_history_proto_rawDescData
+}
+
+var file_optio_lockup_history_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_optio_lockup_history_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_optio_lockup_history_proto_goTypes = []interface{}{
+	(LockHistoryAction)(0),        // 0: optio.lockup.LockHistoryAction
+	(*LockHistoryEntry)(nil),      // 1: optio.lockup.LockHistoryEntry
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_optio_lockup_history_proto_depIdxs = []int32{
+	0, // 0: optio.lockup.LockHistoryEntry.action:type_name -> optio.lockup.LockHistoryAction
+	2, // 1: optio.lockup.LockHistoryEntry.time:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_optio_lockup_history_proto_init() }
+func file_optio_lockup_history_proto_init() {
+	if File_optio_lockup_history_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_optio_lockup_history_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockHistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_optio_lockup_history_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_optio_lockup_history_proto_goTypes,
+		DependencyIndexes: file_optio_lockup_history_proto_depIdxs,
+		EnumInfos:         file_optio_lockup_history_proto_enumTypes,
+		MessageInfos:      file_optio_lockup_history_proto_msgTypes,
+	}.Build()
+	File_optio_lockup_history_proto = out.File
+	file_optio_lockup_history_proto_rawDesc = nil
+	file_optio_lockup_history_proto_goTypes = nil
+	file_optio_lockup_history_proto_depIdxs = nil
+}
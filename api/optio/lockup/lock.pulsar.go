@@ -20,6 +20,8 @@ var (
 	md_Lock             protoreflect.MessageDescriptor
 	fd_Lock_unlock_date protoreflect.FieldDescriptor
 	fd_Lock_amount      protoreflect.FieldDescriptor
+	fd_Lock_denom       protoreflect.FieldDescriptor
+	fd_Lock_kind        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -27,6 +29,8 @@ func init() {
 	md_Lock = File_optio_lockup_lock_proto.Messages().ByName("Lock")
 	fd_Lock_unlock_date = md_Lock.Fields().ByName("unlock_date")
 	fd_Lock_amount = md_Lock.Fields().ByName("amount")
+	fd_Lock_denom = md_Lock.Fields().ByName("denom")
+	fd_Lock_kind = md_Lock.Fields().ByName("kind")
 }
 
 var _ protoreflect.Message = (*fastReflection_Lock)(nil)
@@ -106,6 +110,18 @@ func (x *fastReflection_Lock) Range(f func(protoreflect.FieldDescriptor, protore
 			return
 		}
 	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_Lock_denom, value) {
+			return
+		}
+	}
+	if x.Kind != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Kind))
+		if !f(fd_Lock_kind, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -125,6 +141,10 @@ func (x *fastReflection_Lock) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.UnlockDate != ""
 	case "optio.lockup.Lock.amount":
 		return x.Amount != ""
+	case "optio.lockup.Lock.denom":
+		return x.Denom != ""
+	case "optio.lockup.Lock.kind":
+		return x.Kind != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.Lock"))
@@ -145,6 +165,10 @@ func (x *fastReflection_Lock) Clear(fd protoreflect.FieldDescriptor) {
 		x.UnlockDate = ""
 	case "optio.lockup.Lock.amount":
 		x.Amount = ""
+	case "optio.lockup.Lock.denom":
+		x.Denom = ""
+	case "optio.lockup.Lock.kind":
+		x.Kind = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.Lock"))
@@ -167,6 +191,12 @@ func (x *fastReflection_Lock) Get(descriptor protoreflect.FieldDescriptor) proto
 	case "optio.lockup.Lock.amount":
 		value := x.Amount
 		return protoreflect.ValueOfString(value)
+	case "optio.lockup.Lock.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.Lock.kind":
+		value := x.Kind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.Lock"))
@@ -191,6 +221,10 @@ func (x *fastReflection_Lock) Set(fd protoreflect.FieldDescriptor, value protore
 		x.UnlockDate = value.Interface().(string)
 	case "optio.lockup.Lock.amount":
 		x.Amount = value.Interface().(string)
+	case "optio.lockup.Lock.denom":
+		x.Denom = value.Interface().(string)
+	case "optio.lockup.Lock.kind":
+		x.Kind = (LockKind)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.Lock"))
@@ -215,6 +249,10 @@ func (x *fastReflection_Lock) Mutable(fd protoreflect.FieldDescriptor) protorefl
 		panic(fmt.Errorf("field unlock_date of message optio.lockup.Lock is not mutable"))
 	case "optio.lockup.Lock.amount":
 		panic(fmt.Errorf("field amount of message optio.lockup.Lock is not mutable"))
+	case "optio.lockup.Lock.denom":
+		panic(fmt.Errorf("field denom of message optio.lockup.Lock is not mutable"))
+	case "optio.lockup.Lock.kind":
+		panic(fmt.Errorf("field kind of message optio.lockup.Lock is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.Lock"))
@@ -232,6 +270,10 @@ func (x *fastReflection_Lock) NewField(fd protoreflect.FieldDescriptor) protoref
 		return protoreflect.ValueOfString("")
 	case "optio.lockup.Lock.amount":
 		return protoreflect.ValueOfString("")
+	case "optio.lockup.Lock.denom":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.Lock.kind":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.Lock"))
@@ -309,6 +351,13 @@ func (x *fastReflection_Lock) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Kind != 0 {
+			n += 1 + runtime.Sov(uint64(x.Kind))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -338,6 +387,18 @@ func (x *fastReflection_Lock) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Kind != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Kind))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Amount) > 0 {
 			i -= len(x.Amount)
 			copy(dAtA[i:], x.Amount)
@@ -465,6 +526,57 @@ func (x *fastReflection_Lock) ProtoMethods() *protoiface.Methods {
 				}
 				x.Amount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				x.Kind = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Kind |= LockKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -994,162 +1106,1471 @@ func (x *fastReflection_Locks) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: optio/lockup/lock.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_RollingLock               protoreflect.MessageDescriptor
+	fd_RollingLock_duration_days protoreflect.FieldDescriptor
+	fd_RollingLock_amount        protoreflect.FieldDescriptor
 )
 
-type Lock struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_optio_lockup_lock_proto_init()
+	md_RollingLock = File_optio_lockup_lock_proto.Messages().ByName("RollingLock")
+	fd_RollingLock_duration_days = md_RollingLock.Fields().ByName("duration_days")
+	fd_RollingLock_amount = md_RollingLock.Fields().ByName("amount")
+}
 
-	UnlockDate string `protobuf:"bytes,1,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
-	Amount     string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+var _ protoreflect.Message = (*fastReflection_RollingLock)(nil)
+
+type fastReflection_RollingLock RollingLock
+
+func (x *RollingLock) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RollingLock)(x)
 }
 
-func (x *Lock) Reset() {
-	*x = Lock{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_optio_lockup_lock_proto_msgTypes[0]
+func (x *RollingLock) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_lock_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Lock) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_RollingLock_messageType fastReflection_RollingLock_messageType
+var _ protoreflect.MessageType = fastReflection_RollingLock_messageType{}
 
-func (*Lock) ProtoMessage() {}
+type fastReflection_RollingLock_messageType struct{}
 
-// Deprecated: Use Lock.ProtoReflect.Descriptor instead.
-func (*Lock) Descriptor() ([]byte, []int) {
-	return file_optio_lockup_lock_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_RollingLock_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RollingLock)(nil)
 }
-
-func (x *Lock) GetUnlockDate() string {
-	if x != nil {
-		return x.UnlockDate
-	}
-	return ""
+func (x fastReflection_RollingLock_messageType) New() protoreflect.Message {
+	return new(fastReflection_RollingLock)
 }
-
-func (x *Lock) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+func (x fastReflection_RollingLock_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RollingLock
 }
 
-type Locks struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Locks []*Lock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RollingLock) Descriptor() protoreflect.MessageDescriptor {
+	return md_RollingLock
 }
 
-func (x *Locks) Reset() {
-	*x = Locks{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_optio_lockup_lock_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RollingLock) Type() protoreflect.MessageType {
+	return _fastReflection_RollingLock_messageType
 }
 
-func (x *Locks) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RollingLock) New() protoreflect.Message {
+	return new(fastReflection_RollingLock)
 }
 
-func (*Locks) ProtoMessage() {}
-
-// Deprecated: Use Locks.ProtoReflect.Descriptor instead.
-func (*Locks) Descriptor() ([]byte, []int) {
-	return file_optio_lockup_lock_proto_rawDescGZIP(), []int{1}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RollingLock) Interface() protoreflect.ProtoMessage {
+	return (*RollingLock)(x)
 }
 
-func (x *Locks) GetLocks() []*Lock {
-	if x != nil {
-		return x.Locks
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RollingLock) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DurationDays != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DurationDays)
+		if !f(fd_RollingLock_duration_days, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_RollingLock_amount, value) {
+			return
+		}
 	}
-	return nil
 }
 
-var File_optio_lockup_lock_proto protoreflect.FileDescriptor
-
-var file_optio_lockup_lock_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x6c,
-	0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
-	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f,
-	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x04, 0x4c,
-	0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x31,
-	0x0a, 0x05, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c,
-	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x42, 0x9e, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e,
-	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x42, 0x09, 0x4c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63,
-	0x6b, 0x75, 0x70, 0xa2, 0x02, 0x03, 0x4f, 0x4c, 0x58, 0xaa, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xca, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xe2, 0x02, 0x18, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c,
-	0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x0d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x3a, 0x3a, 0x4c, 0x6f, 0x63, 0x6b,
-	0x75, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RollingLock) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.RollingLock.duration_days":
+		return x.DurationDays != uint32(0)
+	case "optio.lockup.RollingLock.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.RollingLock"))
+		}
+		panic(fmt.Errorf("message optio.lockup.RollingLock does not contain field %s", fd.FullName()))
+	}
 }
 
-var (
-	file_optio_lockup_lock_proto_rawDescOnce sync.Once
-	file_optio_lockup_lock_proto_rawDescData = file_optio_lockup_lock_proto_rawDesc
-)
-
-func file_optio_lockup_lock_proto_rawDescGZIP() []byte {
-	file_optio_lockup_lock_proto_rawDescOnce.Do(func() {
-		file_optio_lockup_lock_proto_rawDescData = protoimpl.X.CompressGZIP(file_optio_lockup_lock_proto_rawDescData)
-	})
-	return file_optio_lockup_lock_proto_rawDescData
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RollingLock) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.RollingLock.duration_days":
+		x.DurationDays = uint32(0)
+	case "optio.lockup.RollingLock.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.RollingLock"))
+		}
+		panic(fmt.Errorf("message optio.lockup.RollingLock does not contain field %s", fd.FullName()))
+	}
 }
 
-var file_optio_lockup_lock_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_optio_lockup_lock_proto_goTypes = []interface{}{
-	(*Lock)(nil),  // 0: optio.lockup.Lock
-	(*Locks)(nil), // 1: optio.lockup.Locks
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RollingLock) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.RollingLock.duration_days":
+		value := x.DurationDays
+		return protoreflect.ValueOfUint32(value)
+	case "optio.lockup.RollingLock.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.RollingLock"))
+		}
+		panic(fmt.Errorf("message optio.lockup.RollingLock does not contain field %s", descriptor.FullName()))
+	}
 }
-var file_optio_lockup_lock_proto_depIdxs = []int32{
-	0, // 0: optio.lockup.Locks.locks:type_name -> optio.lockup.Lock
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RollingLock) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.RollingLock.duration_days":
+		x.DurationDays = uint32(value.Uint())
+	case "optio.lockup.RollingLock.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.RollingLock"))
+		}
+		panic(fmt.Errorf("message optio.lockup.RollingLock does not contain field %s", fd.FullName()))
+	}
 }
 
-func init() { file_optio_lockup_lock_proto_init() }
-func file_optio_lockup_lock_proto_init() {
-	if File_optio_lockup_lock_proto != nil {
-		return
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RollingLock) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.RollingLock.duration_days":
+		panic(fmt.Errorf("field duration_days of message optio.lockup.RollingLock is not mutable"))
+	case "optio.lockup.RollingLock.amount":
+		panic(fmt.Errorf("field amount of message optio.lockup.RollingLock is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.RollingLock"))
+		}
+		panic(fmt.Errorf("message optio.lockup.RollingLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RollingLock) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.RollingLock.duration_days":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "optio.lockup.RollingLock.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.RollingLock"))
+		}
+		panic(fmt.Errorf("message optio.lockup.RollingLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RollingLock) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.RollingLock", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RollingLock) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RollingLock) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RollingLock) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RollingLock) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RollingLock)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DurationDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.DurationDays))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RollingLock)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DurationDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DurationDays))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RollingLock)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RollingLock: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RollingLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DurationDays", wireType)
+				}
+				x.DurationDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DurationDays |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LockSchedule               protoreflect.MessageDescriptor
+	fd_LockSchedule_start_date    protoreflect.FieldDescriptor
+	fd_LockSchedule_period        protoreflect.FieldDescriptor
+	fd_LockSchedule_count         protoreflect.FieldDescriptor
+	fd_LockSchedule_cliff_periods protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_lock_proto_init()
+	md_LockSchedule = File_optio_lockup_lock_proto.Messages().ByName("LockSchedule")
+	fd_LockSchedule_start_date = md_LockSchedule.Fields().ByName("start_date")
+	fd_LockSchedule_period = md_LockSchedule.Fields().ByName("period")
+	fd_LockSchedule_count = md_LockSchedule.Fields().ByName("count")
+	fd_LockSchedule_cliff_periods = md_LockSchedule.Fields().ByName("cliff_periods")
+}
+
+var _ protoreflect.Message = (*fastReflection_LockSchedule)(nil)
+
+type fastReflection_LockSchedule LockSchedule
+
+func (x *LockSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LockSchedule)(x)
+}
+
+func (x *LockSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_lock_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LockSchedule_messageType fastReflection_LockSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_LockSchedule_messageType{}
+
+type fastReflection_LockSchedule_messageType struct{}
+
+func (x fastReflection_LockSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LockSchedule)(nil)
+}
+func (x fastReflection_LockSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_LockSchedule)
+}
+func (x fastReflection_LockSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LockSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LockSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_LockSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LockSchedule) New() protoreflect.Message {
+	return new(fastReflection_LockSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LockSchedule) Interface() protoreflect.ProtoMessage {
+	return (*LockSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LockSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartDate != "" {
+		value := protoreflect.ValueOfString(x.StartDate)
+		if !f(fd_LockSchedule_start_date, value) {
+			return
+		}
+	}
+	if x.Period != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Period))
+		if !f(fd_LockSchedule_period, value) {
+			return
+		}
+	}
+	if x.Count != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Count)
+		if !f(fd_LockSchedule_count, value) {
+			return
+		}
+	}
+	if x.CliffPeriods != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.CliffPeriods)
+		if !f(fd_LockSchedule_cliff_periods, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LockSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.LockSchedule.start_date":
+		return x.StartDate != ""
+	case "optio.lockup.LockSchedule.period":
+		return x.Period != 0
+	case "optio.lockup.LockSchedule.count":
+		return x.Count != uint32(0)
+	case "optio.lockup.LockSchedule.cliff_periods":
+		return x.CliffPeriods != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockSchedule"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.LockSchedule.start_date":
+		x.StartDate = ""
+	case "optio.lockup.LockSchedule.period":
+		x.Period = 0
+	case "optio.lockup.LockSchedule.count":
+		x.Count = uint32(0)
+	case "optio.lockup.LockSchedule.cliff_periods":
+		x.CliffPeriods = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockSchedule"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LockSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.LockSchedule.start_date":
+		value := x.StartDate
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.LockSchedule.period":
+		value := x.Period
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "optio.lockup.LockSchedule.count":
+		value := x.Count
+		return protoreflect.ValueOfUint32(value)
+	case "optio.lockup.LockSchedule.cliff_periods":
+		value := x.CliffPeriods
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockSchedule"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.LockSchedule.start_date":
+		x.StartDate = value.Interface().(string)
+	case "optio.lockup.LockSchedule.period":
+		x.Period = (LockSchedulePeriod)(value.Enum())
+	case "optio.lockup.LockSchedule.count":
+		x.Count = uint32(value.Uint())
+	case "optio.lockup.LockSchedule.cliff_periods":
+		x.CliffPeriods = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockSchedule"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.LockSchedule.start_date":
+		panic(fmt.Errorf("field start_date of message optio.lockup.LockSchedule is not mutable"))
+	case "optio.lockup.LockSchedule.period":
+		panic(fmt.Errorf("field period of message optio.lockup.LockSchedule is not mutable"))
+	case "optio.lockup.LockSchedule.count":
+		panic(fmt.Errorf("field count of message optio.lockup.LockSchedule is not mutable"))
+	case "optio.lockup.LockSchedule.cliff_periods":
+		panic(fmt.Errorf("field cliff_periods of message optio.lockup.LockSchedule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockSchedule"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LockSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.LockSchedule.start_date":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.LockSchedule.period":
+		return protoreflect.ValueOfEnum(0)
+	case "optio.lockup.LockSchedule.count":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "optio.lockup.LockSchedule.cliff_periods":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockSchedule"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LockSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.LockSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LockSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LockSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LockSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LockSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.StartDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Period != 0 {
+			n += 1 + runtime.Sov(uint64(x.Period))
+		}
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		if x.CliffPeriods != 0 {
+			n += 1 + runtime.Sov(uint64(x.CliffPeriods))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LockSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CliffPeriods != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CliffPeriods))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Period != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Period))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.StartDate) > 0 {
+			i -= len(x.StartDate)
+			copy(dAtA[i:], x.StartDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StartDate)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LockSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StartDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				}
+				x.Period = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Period |= LockSchedulePeriod(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CliffPeriods", wireType)
+				}
+				x.CliffPeriods = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CliffPeriods |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: optio/lockup/lock.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LockKind is the kind of a lock.
+type LockKind int32
+
+const (
+	// LOCK_KIND_DELEGATED is a lock of the bond denom backed by the delegations of its owner.
+	LockKind_LOCK_KIND_DELEGATED LockKind = 0
+	// LOCK_KIND_ESCROWED is a lock whose tokens are held by the lockup module account and returned at expiry.
+	LockKind_LOCK_KIND_ESCROWED LockKind = 1
+)
+
+// Enum value maps for LockKind.
+var (
+	LockKind_name = map[int32]string{
+		0: "LOCK_KIND_DELEGATED",
+		1: "LOCK_KIND_ESCROWED",
+	}
+	LockKind_value = map[string]int32{
+		"LOCK_KIND_DELEGATED": 0,
+		"LOCK_KIND_ESCROWED":  1,
+	}
+)
+
+func (x LockKind) Enum() *LockKind {
+	p := new(LockKind)
+	*p = x
+	return p
+}
+
+func (x LockKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LockKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_optio_lockup_lock_proto_enumTypes[0].Descriptor()
+}
+
+func (LockKind) Type() protoreflect.EnumType {
+	return &file_optio_lockup_lock_proto_enumTypes[0]
+}
+
+func (x LockKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LockKind.Descriptor instead.
+func (LockKind) EnumDescriptor() ([]byte, []int) {
+	return file_optio_lockup_lock_proto_rawDescGZIP(), []int{0}
+}
+
+// LockSchedulePeriod is the interval between the unlock dates of a lock schedule.
+type LockSchedulePeriod int32
+
+const (
+	// LOCK_SCHEDULE_PERIOD_UNSPECIFIED is not a valid period.
+	LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_UNSPECIFIED LockSchedulePeriod = 0
+	// LOCK_SCHEDULE_PERIOD_DAILY unlocks every day.
+	LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_DAILY LockSchedulePeriod = 1
+	// LOCK_SCHEDULE_PERIOD_WEEKLY unlocks every 7 days.
+	LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_WEEKLY LockSchedulePeriod = 2
+	// LOCK_SCHEDULE_PERIOD_MONTHLY unlocks on the same day of every month, or on the last day of shorter months.
+	LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_MONTHLY LockSchedulePeriod = 3
+)
+
+// Enum value maps for LockSchedulePeriod.
+var (
+	LockSchedulePeriod_name = map[int32]string{
+		0: "LOCK_SCHEDULE_PERIOD_UNSPECIFIED",
+		1: "LOCK_SCHEDULE_PERIOD_DAILY",
+		2: "LOCK_SCHEDULE_PERIOD_WEEKLY",
+		3: "LOCK_SCHEDULE_PERIOD_MONTHLY",
+	}
+	LockSchedulePeriod_value = map[string]int32{
+		"LOCK_SCHEDULE_PERIOD_UNSPECIFIED": 0,
+		"LOCK_SCHEDULE_PERIOD_DAILY":       1,
+		"LOCK_SCHEDULE_PERIOD_WEEKLY":      2,
+		"LOCK_SCHEDULE_PERIOD_MONTHLY":     3,
+	}
+)
+
+func (x LockSchedulePeriod) Enum() *LockSchedulePeriod {
+	p := new(LockSchedulePeriod)
+	*p = x
+	return p
+}
+
+func (x LockSchedulePeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LockSchedulePeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_optio_lockup_lock_proto_enumTypes[1].Descriptor()
+}
+
+func (LockSchedulePeriod) Type() protoreflect.EnumType {
+	return &file_optio_lockup_lock_proto_enumTypes[1]
+}
+
+func (x LockSchedulePeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LockSchedulePeriod.Descriptor instead.
+func (LockSchedulePeriod) EnumDescriptor() ([]byte, []int) {
+	return file_optio_lockup_lock_proto_rawDescGZIP(), []int{1}
+}
+
+type Lock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unlock_date is either a date such as 2026-03-01, which unlocks at the start of that UTC day, or an RFC3339
+	// timestamp in UTC such as 2026-03-01T14:30:00Z, which unlocks at the first block at or after that time.
+	UnlockDate string `protobuf:"bytes,1,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	Amount     string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// denom is the denom of an escrowed lock, which may be the bond denom. It is empty for delegated locks.
+	Denom string   `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Kind  LockKind `protobuf:"varint,4,opt,name=kind,proto3,enum=optio.lockup.LockKind" json:"kind,omitempty"`
+}
+
+func (x *Lock) Reset() {
+	*x = Lock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_lock_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lock) ProtoMessage() {}
+
+// Deprecated: Use Lock.ProtoReflect.Descriptor instead.
+func (*Lock) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_lock_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Lock) GetUnlockDate() string {
+	if x != nil {
+		return x.UnlockDate
+	}
+	return ""
+}
+
+func (x *Lock) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Lock) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *Lock) GetKind() LockKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LockKind_LOCK_KIND_DELEGATED
+}
+
+type Locks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locks []*Lock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+}
+
+func (x *Locks) Reset() {
+	*x = Locks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_lock_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Locks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Locks) ProtoMessage() {}
+
+// Deprecated: Use Locks.ProtoReflect.Descriptor instead.
+func (*Locks) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_lock_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Locks) GetLocks() []*Lock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+// RollingLock is an auto-renewing lock. Its effective unlock date is always
+// duration_days after the current block day.
+type RollingLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DurationDays uint32 `protobuf:"varint,1,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	Amount       string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *RollingLock) Reset() {
+	*x = RollingLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_lock_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollingLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollingLock) ProtoMessage() {}
+
+// Deprecated: Use RollingLock.ProtoReflect.Descriptor instead.
+func (*RollingLock) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_lock_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RollingLock) GetDurationDays() uint32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+func (x *RollingLock) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// LockSchedule splits an amount into count equal locks that unlock one period apart, the first one period after
+// start_date. Any remainder is added to the last lock. The locks of the first cliff_periods periods all unlock at the
+// end of the cliff instead.
+type LockSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start_date is a date such as 2026-03-01.
+	StartDate string             `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	Period    LockSchedulePeriod `protobuf:"varint,2,opt,name=period,proto3,enum=optio.lockup.LockSchedulePeriod" json:"period,omitempty"`
+	Count     uint32             `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// cliff_periods is at most count. A cliff of count periods unlocks everything at once.
+	CliffPeriods uint32 `protobuf:"varint,4,opt,name=cliff_periods,json=cliffPeriods,proto3" json:"cliff_periods,omitempty"`
+}
+
+func (x *LockSchedule) Reset() {
+	*x = LockSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_lock_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockSchedule) ProtoMessage() {}
+
+// Deprecated: Use LockSchedule.ProtoReflect.Descriptor instead.
+func (*LockSchedule) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_lock_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LockSchedule) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *LockSchedule) GetPeriod() LockSchedulePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_UNSPECIFIED
+}
+
+func (x *LockSchedule) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *LockSchedule) GetCliffPeriods() uint32 {
+	if x != nil {
+		return x.CliffPeriods
+	}
+	return 0
+}
+
+var File_optio_lockup_lock_proto protoreflect.FileDescriptor
+
+var file_optio_lockup_lock_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
+	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f,
+	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x01, 0x0a, 0x04,
+	0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x44, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x75, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x22, 0x31, 0x0a, 0x05, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x22, 0x7c, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x4c,
+	0x6f, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75,
+	0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x66, 0x66,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2a, 0x3b, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x6b, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x45, 0x53, 0x43, 0x52, 0x4f, 0x57,
+	0x45, 0x44, 0x10, 0x01, 0x2a, 0x9d, 0x01, 0x0a, 0x12, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x24, 0x0a, 0x20, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x50, 0x45, 0x52,
+	0x49, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55,
+	0x4c, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10,
+	0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55,
+	0x4c, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59,
+	0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44,
+	0x55, 0x4c, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48,
+	0x4c, 0x59, 0x10, 0x03, 0x42, 0x9e, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x42, 0x09, 0x4c, 0x6f, 0x63, 0x6b, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f,
+	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xa2, 0x02, 0x03, 0x4f, 0x4c, 0x58, 0xaa, 0x02, 0x0c, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xca, 0x02, 0x0c, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xe2, 0x02, 0x18, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x3a, 0x3a, 0x4c,
+	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_optio_lockup_lock_proto_rawDescOnce sync.Once
+	file_optio_lockup_lock_proto_rawDescData = file_optio_lockup_lock_proto_rawDesc
+)
+
+func file_optio_lockup_lock_proto_rawDescGZIP() []byte {
+	file_optio_lockup_lock_proto_rawDescOnce.Do(func() {
+		file_optio_lockup_lock_proto_rawDescData = protoimpl.X.CompressGZIP(file_optio_lockup_lock_proto_rawDescData)
+	})
+	return file_optio_lockup_lock_proto_rawDescData
+}
+
+var file_optio_lockup_lock_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_optio_lockup_lock_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_optio_lockup_lock_proto_goTypes = []interface{}{
+	(LockKind)(0),           // 0: optio.lockup.LockKind
+	(LockSchedulePeriod)(0), // 1: optio.lockup.LockSchedulePeriod
+	(*Lock)(nil),            // 2: optio.lockup.Lock
+	(*Locks)(nil),           // 3: optio.lockup.Locks
+	(*RollingLock)(nil),     // 4: optio.lockup.RollingLock
+	(*LockSchedule)(nil),    // 5: optio.lockup.LockSchedule
+}
+var file_optio_lockup_lock_proto_depIdxs = []int32{
+	0, // 0: optio.lockup.Lock.kind:type_name -> optio.lockup.LockKind
+	2, // 1: optio.lockup.Locks.locks:type_name -> optio.lockup.Lock
+	1, // 2: optio.lockup.LockSchedule.period:type_name -> optio.lockup.LockSchedulePeriod
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_optio_lockup_lock_proto_init() }
+func file_optio_lockup_lock_proto_init() {
+	if File_optio_lockup_lock_proto != nil {
+		return
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_optio_lockup_lock_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -1176,19 +2597,44 @@ func file_optio_lockup_lock_proto_init() {
 				return nil
 			}
 		}
+		file_optio_lockup_lock_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollingLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_lock_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_optio_lockup_lock_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_optio_lockup_lock_proto_goTypes,
 		DependencyIndexes: file_optio_lockup_lock_proto_depIdxs,
+		EnumInfos:         file_optio_lockup_lock_proto_enumTypes,
 		MessageInfos:      file_optio_lockup_lock_proto_msgTypes,
 	}.Build()
 	File_optio_lockup_lock_proto = out.File
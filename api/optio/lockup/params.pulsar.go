@@ -0,0 +1,1922 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package lockup
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_Params_4_list)(nil)
+
+type _Params_4_list struct {
+	list *[]string
+}
+
+func (x *_Params_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedDenoms as it is not of Message kind"))
+}
+
+func (x *_Params_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_7_list)(nil)
+
+type _Params_7_list struct {
+	list *[]*VotingPowerMultiplier
+}
+
+func (x *_Params_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VotingPowerMultiplier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VotingPowerMultiplier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_7_list) AppendMutable() protoreflect.Value {
+	v := new(VotingPowerMultiplier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_7_list) NewElement() protoreflect.Value {
+	v := new(VotingPowerMultiplier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                             protoreflect.MessageDescriptor
+	fd_Params_max_lock_months             protoreflect.FieldDescriptor
+	fd_Params_min_lock_amount             protoreflect.FieldDescriptor
+	fd_Params_max_locks_per_address       protoreflect.FieldDescriptor
+	fd_Params_allowed_denoms              protoreflect.FieldDescriptor
+	fd_Params_early_unlock_penalty_rate   protoreflect.FieldDescriptor
+	fd_Params_penalty_destination         protoreflect.FieldDescriptor
+	fd_Params_voting_power_multipliers    protoreflect.FieldDescriptor
+	fd_Params_reward_epoch_days           protoreflect.FieldDescriptor
+	fd_Params_lock_history_retention_days protoreflect.FieldDescriptor
+	fd_Params_max_multi_send_outputs      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_params_proto_init()
+	md_Params = File_optio_lockup_params_proto.Messages().ByName("Params")
+	fd_Params_max_lock_months = md_Params.Fields().ByName("max_lock_months")
+	fd_Params_min_lock_amount = md_Params.Fields().ByName("min_lock_amount")
+	fd_Params_max_locks_per_address = md_Params.Fields().ByName("max_locks_per_address")
+	fd_Params_allowed_denoms = md_Params.Fields().ByName("allowed_denoms")
+	fd_Params_early_unlock_penalty_rate = md_Params.Fields().ByName("early_unlock_penalty_rate")
+	fd_Params_penalty_destination = md_Params.Fields().ByName("penalty_destination")
+	fd_Params_voting_power_multipliers = md_Params.Fields().ByName("voting_power_multipliers")
+	fd_Params_reward_epoch_days = md_Params.Fields().ByName("reward_epoch_days")
+	fd_Params_lock_history_retention_days = md_Params.Fields().ByName("lock_history_retention_days")
+	fd_Params_max_multi_send_outputs = md_Params.Fields().ByName("max_multi_send_outputs")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxLockMonths != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxLockMonths)
+		if !f(fd_Params_max_lock_months, value) {
+			return
+		}
+	}
+	if x.MinLockAmount != "" {
+		value := protoreflect.ValueOfString(x.MinLockAmount)
+		if !f(fd_Params_min_lock_amount, value) {
+			return
+		}
+	}
+	if x.MaxLocksPerAddress != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxLocksPerAddress)
+		if !f(fd_Params_max_locks_per_address, value) {
+			return
+		}
+	}
+	if len(x.AllowedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_4_list{list: &x.AllowedDenoms})
+		if !f(fd_Params_allowed_denoms, value) {
+			return
+		}
+	}
+	if x.EarlyUnlockPenaltyRate != "" {
+		value := protoreflect.ValueOfString(x.EarlyUnlockPenaltyRate)
+		if !f(fd_Params_early_unlock_penalty_rate, value) {
+			return
+		}
+	}
+	if x.PenaltyDestination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PenaltyDestination))
+		if !f(fd_Params_penalty_destination, value) {
+			return
+		}
+	}
+	if len(x.VotingPowerMultipliers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_7_list{list: &x.VotingPowerMultipliers})
+		if !f(fd_Params_voting_power_multipliers, value) {
+			return
+		}
+	}
+	if x.RewardEpochDays != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RewardEpochDays)
+		if !f(fd_Params_reward_epoch_days, value) {
+			return
+		}
+	}
+	if x.LockHistoryRetentionDays != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.LockHistoryRetentionDays)
+		if !f(fd_Params_lock_history_retention_days, value) {
+			return
+		}
+	}
+	if x.MaxMultiSendOutputs != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxMultiSendOutputs)
+		if !f(fd_Params_max_multi_send_outputs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.Params.max_lock_months":
+		return x.MaxLockMonths != uint32(0)
+	case "optio.lockup.Params.min_lock_amount":
+		return x.MinLockAmount != ""
+	case "optio.lockup.Params.max_locks_per_address":
+		return x.MaxLocksPerAddress != uint32(0)
+	case "optio.lockup.Params.allowed_denoms":
+		return len(x.AllowedDenoms) != 0
+	case "optio.lockup.Params.early_unlock_penalty_rate":
+		return x.EarlyUnlockPenaltyRate != ""
+	case "optio.lockup.Params.penalty_destination":
+		return x.PenaltyDestination != 0
+	case "optio.lockup.Params.voting_power_multipliers":
+		return len(x.VotingPowerMultipliers) != 0
+	case "optio.lockup.Params.reward_epoch_days":
+		return x.RewardEpochDays != uint32(0)
+	case "optio.lockup.Params.lock_history_retention_days":
+		return x.LockHistoryRetentionDays != uint32(0)
+	case "optio.lockup.Params.max_multi_send_outputs":
+		return x.MaxMultiSendOutputs != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.Params"))
+		}
+		panic(fmt.Errorf("message optio.lockup.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.Params.max_lock_months":
+		x.MaxLockMonths = uint32(0)
+	case "optio.lockup.Params.min_lock_amount":
+		x.MinLockAmount = ""
+	case "optio.lockup.Params.max_locks_per_address":
+		x.MaxLocksPerAddress = uint32(0)
+	case "optio.lockup.Params.allowed_denoms":
+		x.AllowedDenoms = nil
+	case "optio.lockup.Params.early_unlock_penalty_rate":
+		x.EarlyUnlockPenaltyRate = ""
+	case "optio.lockup.Params.penalty_destination":
+		x.PenaltyDestination = 0
+	case "optio.lockup.Params.voting_power_multipliers":
+		x.VotingPowerMultipliers = nil
+	case "optio.lockup.Params.reward_epoch_days":
+		x.RewardEpochDays = uint32(0)
+	case "optio.lockup.Params.lock_history_retention_days":
+		x.LockHistoryRetentionDays = uint32(0)
+	case "optio.lockup.Params.max_multi_send_outputs":
+		x.MaxMultiSendOutputs = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.Params"))
+		}
+		panic(fmt.Errorf("message optio.lockup.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.Params.max_lock_months":
+		value := x.MaxLockMonths
+		return protoreflect.ValueOfUint32(value)
+	case "optio.lockup.Params.min_lock_amount":
+		value := x.MinLockAmount
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.Params.max_locks_per_address":
+		value := x.MaxLocksPerAddress
+		return protoreflect.ValueOfUint32(value)
+	case "optio.lockup.Params.allowed_denoms":
+		if len(x.AllowedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_4_list{})
+		}
+		listValue := &_Params_4_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.lockup.Params.early_unlock_penalty_rate":
+		value := x.EarlyUnlockPenaltyRate
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.Params.penalty_destination":
+		value := x.PenaltyDestination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "optio.lockup.Params.voting_power_multipliers":
+		if len(x.VotingPowerMultipliers) == 0 {
+			return protoreflect.ValueOfList(&_Params_7_list{})
+		}
+		listValue := &_Params_7_list{list: &x.VotingPowerMultipliers}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.lockup.Params.reward_epoch_days":
+		value := x.RewardEpochDays
+		return protoreflect.ValueOfUint32(value)
+	case "optio.lockup.Params.lock_history_retention_days":
+		value := x.LockHistoryRetentionDays
+		return protoreflect.ValueOfUint32(value)
+	case "optio.lockup.Params.max_multi_send_outputs":
+		value := x.MaxMultiSendOutputs
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.Params"))
+		}
+		panic(fmt.Errorf("message optio.lockup.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.Params.max_lock_months":
+		x.MaxLockMonths = uint32(value.Uint())
+	case "optio.lockup.Params.min_lock_amount":
+		x.MinLockAmount = value.Interface().(string)
+	case "optio.lockup.Params.max_locks_per_address":
+		x.MaxLocksPerAddress = uint32(value.Uint())
+	case "optio.lockup.Params.allowed_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_4_list)
+		x.AllowedDenoms = *clv.list
+	case "optio.lockup.Params.early_unlock_penalty_rate":
+		x.EarlyUnlockPenaltyRate = value.Interface().(string)
+	case "optio.lockup.Params.penalty_destination":
+		x.PenaltyDestination = (PenaltyDestination)(value.Enum())
+	case "optio.lockup.Params.voting_power_multipliers":
+		lv := value.List()
+		clv := lv.(*_Params_7_list)
+		x.VotingPowerMultipliers = *clv.list
+	case "optio.lockup.Params.reward_epoch_days":
+		x.RewardEpochDays = uint32(value.Uint())
+	case "optio.lockup.Params.lock_history_retention_days":
+		x.LockHistoryRetentionDays = uint32(value.Uint())
+	case "optio.lockup.Params.max_multi_send_outputs":
+		x.MaxMultiSendOutputs = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.Params"))
+		}
+		panic(fmt.Errorf("message optio.lockup.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.Params.allowed_denoms":
+		if x.AllowedDenoms == nil {
+			x.AllowedDenoms = []string{}
+		}
+		value := &_Params_4_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "optio.lockup.Params.voting_power_multipliers":
+		if x.VotingPowerMultipliers == nil {
+			x.VotingPowerMultipliers = []*VotingPowerMultiplier{}
+		}
+		value := &_Params_7_list{list: &x.VotingPowerMultipliers}
+		return protoreflect.ValueOfList(value)
+	case "optio.lockup.Params.max_lock_months":
+		panic(fmt.Errorf("field max_lock_months of message optio.lockup.Params is not mutable"))
+	case "optio.lockup.Params.min_lock_amount":
+		panic(fmt.Errorf("field min_lock_amount of message optio.lockup.Params is not mutable"))
+	case "optio.lockup.Params.max_locks_per_address":
+		panic(fmt.Errorf("field max_locks_per_address of message optio.lockup.Params is not mutable"))
+	case "optio.lockup.Params.early_unlock_penalty_rate":
+		panic(fmt.Errorf("field early_unlock_penalty_rate of message optio.lockup.Params is not mutable"))
+	case "optio.lockup.Params.penalty_destination":
+		panic(fmt.Errorf("field penalty_destination of message optio.lockup.Params is not mutable"))
+	case "optio.lockup.Params.reward_epoch_days":
+		panic(fmt.Errorf("field reward_epoch_days of message optio.lockup.Params is not mutable"))
+	case "optio.lockup.Params.lock_history_retention_days":
+		panic(fmt.Errorf("field lock_history_retention_days of message optio.lockup.Params is not mutable"))
+	case "optio.lockup.Params.max_multi_send_outputs":
+		panic(fmt.Errorf("field max_multi_send_outputs of message optio.lockup.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.Params"))
+		}
+		panic(fmt.Errorf("message optio.lockup.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.Params.max_lock_months":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "optio.lockup.Params.min_lock_amount":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.Params.max_locks_per_address":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "optio.lockup.Params.allowed_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_4_list{list: &list})
+	case "optio.lockup.Params.early_unlock_penalty_rate":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.Params.penalty_destination":
+		return protoreflect.ValueOfEnum(0)
+	case "optio.lockup.Params.voting_power_multipliers":
+		list := []*VotingPowerMultiplier{}
+		return protoreflect.ValueOfList(&_Params_7_list{list: &list})
+	case "optio.lockup.Params.reward_epoch_days":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "optio.lockup.Params.lock_history_retention_days":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "optio.lockup.Params.max_multi_send_outputs":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.Params"))
+		}
+		panic(fmt.Errorf("message optio.lockup.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MaxLockMonths != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxLockMonths))
+		}
+		l = len(x.MinLockAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxLocksPerAddress != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxLocksPerAddress))
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for _, s := range x.AllowedDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.EarlyUnlockPenaltyRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PenaltyDestination != 0 {
+			n += 1 + runtime.Sov(uint64(x.PenaltyDestination))
+		}
+		if len(x.VotingPowerMultipliers) > 0 {
+			for _, e := range x.VotingPowerMultipliers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RewardEpochDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.RewardEpochDays))
+		}
+		if x.LockHistoryRetentionDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.LockHistoryRetentionDays))
+		}
+		if x.MaxMultiSendOutputs != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxMultiSendOutputs))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxMultiSendOutputs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMultiSendOutputs))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.LockHistoryRetentionDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockHistoryRetentionDays))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.RewardEpochDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RewardEpochDays))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.VotingPowerMultipliers) > 0 {
+			for iNdEx := len(x.VotingPowerMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VotingPowerMultipliers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.PenaltyDestination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PenaltyDestination))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.EarlyUnlockPenaltyRate) > 0 {
+			i -= len(x.EarlyUnlockPenaltyRate)
+			copy(dAtA[i:], x.EarlyUnlockPenaltyRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EarlyUnlockPenaltyRate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for iNdEx := len(x.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.MaxLocksPerAddress != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxLocksPerAddress))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MinLockAmount) > 0 {
+			i -= len(x.MinLockAmount)
+			copy(dAtA[i:], x.MinLockAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinLockAmount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MaxLockMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxLockMonths))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLockMonths", wireType)
+				}
+				x.MaxLockMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxLockMonths |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinLockAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinLockAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLocksPerAddress", wireType)
+				}
+				x.MaxLocksPerAddress = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxLocksPerAddress |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedDenoms = append(x.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EarlyUnlockPenaltyRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EarlyUnlockPenaltyRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PenaltyDestination", wireType)
+				}
+				x.PenaltyDestination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PenaltyDestination |= PenaltyDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowerMultipliers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VotingPowerMultipliers = append(x.VotingPowerMultipliers, &VotingPowerMultiplier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPowerMultipliers[len(x.VotingPowerMultipliers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardEpochDays", wireType)
+				}
+				x.RewardEpochDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RewardEpochDays |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockHistoryRetentionDays", wireType)
+				}
+				x.LockHistoryRetentionDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LockHistoryRetentionDays |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMultiSendOutputs", wireType)
+				}
+				x.MaxMultiSendOutputs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMultiSendOutputs |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VotingPowerMultiplier                    protoreflect.MessageDescriptor
+	fd_VotingPowerMultiplier_min_remaining_days protoreflect.FieldDescriptor
+	fd_VotingPowerMultiplier_multiplier         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_params_proto_init()
+	md_VotingPowerMultiplier = File_optio_lockup_params_proto.Messages().ByName("VotingPowerMultiplier")
+	fd_VotingPowerMultiplier_min_remaining_days = md_VotingPowerMultiplier.Fields().ByName("min_remaining_days")
+	fd_VotingPowerMultiplier_multiplier = md_VotingPowerMultiplier.Fields().ByName("multiplier")
+}
+
+var _ protoreflect.Message = (*fastReflection_VotingPowerMultiplier)(nil)
+
+type fastReflection_VotingPowerMultiplier VotingPowerMultiplier
+
+func (x *VotingPowerMultiplier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VotingPowerMultiplier)(x)
+}
+
+func (x *VotingPowerMultiplier) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VotingPowerMultiplier_messageType fastReflection_VotingPowerMultiplier_messageType
+var _ protoreflect.MessageType = fastReflection_VotingPowerMultiplier_messageType{}
+
+type fastReflection_VotingPowerMultiplier_messageType struct{}
+
+func (x fastReflection_VotingPowerMultiplier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VotingPowerMultiplier)(nil)
+}
+func (x fastReflection_VotingPowerMultiplier_messageType) New() protoreflect.Message {
+	return new(fastReflection_VotingPowerMultiplier)
+}
+func (x fastReflection_VotingPowerMultiplier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingPowerMultiplier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VotingPowerMultiplier) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingPowerMultiplier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VotingPowerMultiplier) Type() protoreflect.MessageType {
+	return _fastReflection_VotingPowerMultiplier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VotingPowerMultiplier) New() protoreflect.Message {
+	return new(fastReflection_VotingPowerMultiplier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VotingPowerMultiplier) Interface() protoreflect.ProtoMessage {
+	return (*VotingPowerMultiplier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VotingPowerMultiplier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinRemainingDays != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MinRemainingDays)
+		if !f(fd_VotingPowerMultiplier_min_remaining_days, value) {
+			return
+		}
+	}
+	if x.Multiplier != "" {
+		value := protoreflect.ValueOfString(x.Multiplier)
+		if !f(fd_VotingPowerMultiplier_multiplier, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VotingPowerMultiplier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.VotingPowerMultiplier.min_remaining_days":
+		return x.MinRemainingDays != uint32(0)
+	case "optio.lockup.VotingPowerMultiplier.multiplier":
+		return x.Multiplier != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.VotingPowerMultiplier"))
+		}
+		panic(fmt.Errorf("message optio.lockup.VotingPowerMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerMultiplier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.VotingPowerMultiplier.min_remaining_days":
+		x.MinRemainingDays = uint32(0)
+	case "optio.lockup.VotingPowerMultiplier.multiplier":
+		x.Multiplier = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.VotingPowerMultiplier"))
+		}
+		panic(fmt.Errorf("message optio.lockup.VotingPowerMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VotingPowerMultiplier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.VotingPowerMultiplier.min_remaining_days":
+		value := x.MinRemainingDays
+		return protoreflect.ValueOfUint32(value)
+	case "optio.lockup.VotingPowerMultiplier.multiplier":
+		value := x.Multiplier
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.VotingPowerMultiplier"))
+		}
+		panic(fmt.Errorf("message optio.lockup.VotingPowerMultiplier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerMultiplier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.VotingPowerMultiplier.min_remaining_days":
+		x.MinRemainingDays = uint32(value.Uint())
+	case "optio.lockup.VotingPowerMultiplier.multiplier":
+		x.Multiplier = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.VotingPowerMultiplier"))
+		}
+		panic(fmt.Errorf("message optio.lockup.VotingPowerMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerMultiplier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.VotingPowerMultiplier.min_remaining_days":
+		panic(fmt.Errorf("field min_remaining_days of message optio.lockup.VotingPowerMultiplier is not mutable"))
+	case "optio.lockup.VotingPowerMultiplier.multiplier":
+		panic(fmt.Errorf("field multiplier of message optio.lockup.VotingPowerMultiplier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.VotingPowerMultiplier"))
+		}
+		panic(fmt.Errorf("message optio.lockup.VotingPowerMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VotingPowerMultiplier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.VotingPowerMultiplier.min_remaining_days":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "optio.lockup.VotingPowerMultiplier.multiplier":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.VotingPowerMultiplier"))
+		}
+		panic(fmt.Errorf("message optio.lockup.VotingPowerMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VotingPowerMultiplier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.VotingPowerMultiplier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VotingPowerMultiplier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerMultiplier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VotingPowerMultiplier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VotingPowerMultiplier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VotingPowerMultiplier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MinRemainingDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinRemainingDays))
+		}
+		l = len(x.Multiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VotingPowerMultiplier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Multiplier) > 0 {
+			i -= len(x.Multiplier)
+			copy(dAtA[i:], x.Multiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Multiplier)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MinRemainingDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinRemainingDays))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VotingPowerMultiplier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingPowerMultiplier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingPowerMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinRemainingDays", wireType)
+				}
+				x.MinRemainingDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinRemainingDays |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Multiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: optio/lockup/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PenaltyDestination defines where early unlock penalties are sent.
+type PenaltyDestination int32
+
+const (
+	// PENALTY_DESTINATION_BURN burns the penalty.
+	PenaltyDestination_PENALTY_DESTINATION_BURN PenaltyDestination = 0
+	// PENALTY_DESTINATION_COMMUNITY_POOL sends the penalty to the community pool.
+	PenaltyDestination_PENALTY_DESTINATION_COMMUNITY_POOL PenaltyDestination = 1
+	// PENALTY_DESTINATION_MODULE_ACCOUNT sends the penalty to the lockup_penalties module account, which is kept apart
+	// from the escrowed tokens held by the lockup module account.
+	PenaltyDestination_PENALTY_DESTINATION_MODULE_ACCOUNT PenaltyDestination = 2
+)
+
+// Enum value maps for PenaltyDestination.
+var (
+	PenaltyDestination_name = map[int32]string{
+		0: "PENALTY_DESTINATION_BURN",
+		1: "PENALTY_DESTINATION_COMMUNITY_POOL",
+		2: "PENALTY_DESTINATION_MODULE_ACCOUNT",
+	}
+	PenaltyDestination_value = map[string]int32{
+		"PENALTY_DESTINATION_BURN":           0,
+		"PENALTY_DESTINATION_COMMUNITY_POOL": 1,
+		"PENALTY_DESTINATION_MODULE_ACCOUNT": 2,
+	}
+)
+
+func (x PenaltyDestination) Enum() *PenaltyDestination {
+	p := new(PenaltyDestination)
+	*p = x
+	return p
+}
+
+func (x PenaltyDestination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PenaltyDestination) Descriptor() protoreflect.EnumDescriptor {
+	return file_optio_lockup_params_proto_enumTypes[0].Descriptor()
+}
+
+func (PenaltyDestination) Type() protoreflect.EnumType {
+	return &file_optio_lockup_params_proto_enumTypes[0]
+}
+
+func (x PenaltyDestination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PenaltyDestination.Descriptor instead.
+func (PenaltyDestination) EnumDescriptor() ([]byte, []int) {
+	return file_optio_lockup_params_proto_rawDescGZIP(), []int{0}
+}
+
+// Params defines the parameters for the module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_lock_months is the number of months after the current block day that an unlock date may be set to.
+	MaxLockMonths uint32 `protobuf:"varint,1,opt,name=max_lock_months,json=maxLockMonths,proto3" json:"max_lock_months,omitempty"`
+	// min_lock_amount is the smallest amount a single lock message can lock.
+	MinLockAmount string `protobuf:"bytes,2,opt,name=min_lock_amount,json=minLockAmount,proto3" json:"min_lock_amount,omitempty"`
+	// max_locks_per_address is the maximum number of distinct unlock dates an address can hold. Zero disables the limit.
+	MaxLocksPerAddress uint32 `protobuf:"varint,3,opt,name=max_locks_per_address,json=maxLocksPerAddress,proto3" json:"max_locks_per_address,omitempty"`
+	// allowed_denoms lists the denoms that can be locked. Locks of the bond denom are backed by delegations unless they
+	// are escrowed, locks of any other denom are always escrowed in the lockup module account until they expire.
+	AllowedDenoms []string `protobuf:"bytes,4,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// early_unlock_penalty_rate is the fraction of the unlocked amount charged when a lock with the maximum remaining
+	// duration is unlocked early. The penalty scales linearly with the time remaining until the unlock date.
+	EarlyUnlockPenaltyRate string `protobuf:"bytes,5,opt,name=early_unlock_penalty_rate,json=earlyUnlockPenaltyRate,proto3" json:"early_unlock_penalty_rate,omitempty"`
+	// penalty_destination defines where early unlock penalties are sent.
+	PenaltyDestination PenaltyDestination `protobuf:"varint,6,opt,name=penalty_destination,json=penaltyDestination,proto3,enum=optio.lockup.PenaltyDestination" json:"penalty_destination,omitempty"`
+	// voting_power_multipliers is the duration curve used by the informational LockWeightedTally query. Each step applies
+	// its multiplier to locks with at least min_remaining_days left until unlock. An empty curve disables the lock bonus.
+	VotingPowerMultipliers []*VotingPowerMultiplier `protobuf:"bytes,7,rep,name=voting_power_multipliers,json=votingPowerMultipliers,proto3" json:"voting_power_multipliers,omitempty"`
+	// reward_epoch_days is the number of days between rewards pool payouts. Zero disables payouts.
+	RewardEpochDays uint32 `protobuf:"varint,8,opt,name=reward_epoch_days,json=rewardEpochDays,proto3" json:"reward_epoch_days,omitempty"`
+	// lock_history_retention_days is the number of days lock history entries are kept. Zero disables recording new
+	// entries; entries already recorded are kept until they are pruned.
+	LockHistoryRetentionDays uint32 `protobuf:"varint,9,opt,name=lock_history_retention_days,json=lockHistoryRetentionDays,proto3" json:"lock_history_retention_days,omitempty"`
+	// max_multi_send_outputs is the maximum number of outputs of a single MsgMultiSendDelegateAndLock. It must be between
+	// one and 10000.
+	MaxMultiSendOutputs uint32 `protobuf:"varint,10,opt,name=max_multi_send_outputs,json=maxMultiSendOutputs,proto3" json:"max_multi_send_outputs,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetMaxLockMonths() uint32 {
+	if x != nil {
+		return x.MaxLockMonths
+	}
+	return 0
+}
+
+func (x *Params) GetMinLockAmount() string {
+	if x != nil {
+		return x.MinLockAmount
+	}
+	return ""
+}
+
+func (x *Params) GetMaxLocksPerAddress() uint32 {
+	if x != nil {
+		return x.MaxLocksPerAddress
+	}
+	return 0
+}
+
+func (x *Params) GetAllowedDenoms() []string {
+	if x != nil {
+		return x.AllowedDenoms
+	}
+	return nil
+}
+
+func (x *Params) GetEarlyUnlockPenaltyRate() string {
+	if x != nil {
+		return x.EarlyUnlockPenaltyRate
+	}
+	return ""
+}
+
+func (x *Params) GetPenaltyDestination() PenaltyDestination {
+	if x != nil {
+		return x.PenaltyDestination
+	}
+	return PenaltyDestination_PENALTY_DESTINATION_BURN
+}
+
+func (x *Params) GetVotingPowerMultipliers() []*VotingPowerMultiplier {
+	if x != nil {
+		return x.VotingPowerMultipliers
+	}
+	return nil
+}
+
+func (x *Params) GetRewardEpochDays() uint32 {
+	if x != nil {
+		return x.RewardEpochDays
+	}
+	return 0
+}
+
+func (x *Params) GetLockHistoryRetentionDays() uint32 {
+	if x != nil {
+		return x.LockHistoryRetentionDays
+	}
+	return 0
+}
+
+func (x *Params) GetMaxMultiSendOutputs() uint32 {
+	if x != nil {
+		return x.MaxMultiSendOutputs
+	}
+	return 0
+}
+
+// VotingPowerMultiplier is a step of the lock-weighted tally curve.
+type VotingPowerMultiplier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// min_remaining_days is the minimum number of days left on a lock for the step to apply.
+	MinRemainingDays uint32 `protobuf:"varint,1,opt,name=min_remaining_days,json=minRemainingDays,proto3" json:"min_remaining_days,omitempty"`
+	// multiplier is the fraction of the locked amount added to the holder's voting power.
+	Multiplier string `protobuf:"bytes,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (x *VotingPowerMultiplier) Reset() {
+	*x = VotingPowerMultiplier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VotingPowerMultiplier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotingPowerMultiplier) ProtoMessage() {}
+
+// Deprecated: Use VotingPowerMultiplier.ProtoReflect.Descriptor instead.
+func (*VotingPowerMultiplier) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VotingPowerMultiplier) GetMinRemainingDays() uint32 {
+	if x != nil {
+		return x.MinRemainingDays
+	}
+	return 0
+}
+
+func (x *VotingPowerMultiplier) GetMultiplier() string {
+	if x != nil {
+		return x.Multiplier
+	}
+	return ""
+}
+
+var File_optio_lockup_params_proto protoreflect.FileDescriptor
+
+var file_optio_lockup_params_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
+	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x05,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x63, 0x6b, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73,
+	0x12, 0x58, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e,
+	0x4c, 0x6f, 0x63, 0x6b, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61,
+	0x78, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x4c, 0x6f,
+	0x63, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x71, 0x0a, 0x19, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x75, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x16, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x2e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x68, 0x0a, 0x18, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x56, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x79, 0x73,
+	0x12, 0x3d, 0x0a, 0x1b, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x13, 0x6d, 0x61, 0x78, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x3a, 0x1e, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x15, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x2f, 0x78, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x15, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x79, 0x73, 0x12, 0x56, 0x0a, 0x0a,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x2a, 0x82, 0x01, 0x0a, 0x12, 0x50,
+	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x44, 0x45, 0x53,
+	0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x00, 0x12,
+	0x26, 0x0a, 0x22, 0x50, 0x45, 0x4e, 0x41, 0x4c, 0x54, 0x59, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49,
+	0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54, 0x59,
+	0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x45, 0x4e, 0x41, 0x4c,
+	0x54, 0x59, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x4f, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02, 0x42,
+	0xa0, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0xa2, 0x02, 0x03, 0x4f, 0x4c, 0x58, 0xaa, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xca, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xe2, 0x02, 0x18, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c,
+	0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x3a, 0x3a, 0x4c, 0x6f, 0x63, 0x6b,
+	0x75, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_optio_lockup_params_proto_rawDescOnce sync.Once
+	file_optio_lockup_params_proto_rawDescData = file_optio_lockup_params_proto_rawDesc
+)
+
+func file_optio_lockup_params_proto_rawDescGZIP() []byte {
+	file_optio_lockup_params_proto_rawDescOnce.Do(func() {
+		file_optio_lockup_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_optio_lockup_params_proto_rawDescData)
+	})
+	return file_optio_lockup_params_proto_rawDescData
+}
+
+var file_optio_lockup_params_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_optio_lockup_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_optio_lockup_params_proto_goTypes = []interface{}{
+	(PenaltyDestination)(0),       // 0: optio.lockup.PenaltyDestination
+	(*Params)(nil),                // 1: optio.lockup.Params
+	(*VotingPowerMultiplier)(nil), // 2: optio.lockup.VotingPowerMultiplier
+}
+var file_optio_lockup_params_proto_depIdxs = []int32{
+	0, // 0: optio.lockup.Params.penalty_destination:type_name -> optio.lockup.PenaltyDestination
+	2, // 1: optio.lockup.Params.voting_power_multipliers:type_name -> optio.lockup.VotingPowerMultiplier
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_optio_lockup_params_proto_init() }
+func file_optio_lockup_params_proto_init() {
+	if File_optio_lockup_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_optio_lockup_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotingPowerMultiplier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_optio_lockup_params_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_optio_lockup_params_proto_goTypes,
+		DependencyIndexes: file_optio_lockup_params_proto_depIdxs,
+		EnumInfos:         file_optio_lockup_params_proto_enumTypes,
+		MessageInfos:      file_optio_lockup_params_proto_msgTypes,
+	}.Build()
+	File_optio_lockup_params_proto = out.File
+	file_optio_lockup_params_proto_rawDesc = nil
+	file_optio_lockup_params_proto_goTypes = nil
+	file_optio_lockup_params_proto_depIdxs = nil
+}
@@ -2,9 +2,12 @@
 package lockup
 
 import (
+	_ "cosmossdk.io/api/amino"
 	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
 	v1beta11 "cosmossdk.io/api/cosmos/base/v1beta1"
+	v1 "cosmossdk.io/api/cosmos/gov/v1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -17,25 +20,23 @@ import (
 )
 
 var (
-	md_QueryActiveLocksRequest            protoreflect.MessageDescriptor
-	fd_QueryActiveLocksRequest_pagination protoreflect.FieldDescriptor
+	md_QueryParamsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_optio_lockup_query_proto_init()
-	md_QueryActiveLocksRequest = File_optio_lockup_query_proto.Messages().ByName("QueryActiveLocksRequest")
-	fd_QueryActiveLocksRequest_pagination = md_QueryActiveLocksRequest.Fields().ByName("pagination")
+	md_QueryParamsRequest = File_optio_lockup_query_proto.Messages().ByName("QueryParamsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActiveLocksRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
 
-type fastReflection_QueryActiveLocksRequest QueryActiveLocksRequest
+type fastReflection_QueryParamsRequest QueryParamsRequest
 
-func (x *QueryActiveLocksRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActiveLocksRequest)(x)
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(x)
 }
 
-func (x *QueryActiveLocksRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_optio_lockup_query_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -47,43 +48,43 @@ func (x *QueryActiveLocksRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActiveLocksRequest_messageType fastReflection_QueryActiveLocksRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActiveLocksRequest_messageType{}
+var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
 
-type fastReflection_QueryActiveLocksRequest_messageType struct{}
+type fastReflection_QueryParamsRequest_messageType struct{}
 
-func (x fastReflection_QueryActiveLocksRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActiveLocksRequest)(nil)
+func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(nil)
 }
-func (x fastReflection_QueryActiveLocksRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveLocksRequest)
+func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
-func (x fastReflection_QueryActiveLocksRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveLocksRequest
+func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActiveLocksRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveLocksRequest
+func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActiveLocksRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActiveLocksRequest_messageType
+func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActiveLocksRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveLocksRequest)
+func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActiveLocksRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryActiveLocksRequest)(x)
+func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -91,13 +92,7 @@ func (x *fastReflection_QueryActiveLocksRequest) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActiveLocksRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryActiveLocksRequest_pagination, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -111,15 +106,13 @@ func (x *fastReflection_QueryActiveLocksRequest) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActiveLocksRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "optio.lockup.QueryActiveLocksRequest.pagination":
-		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -129,15 +122,13 @@ func (x *fastReflection_QueryActiveLocksRequest) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveLocksRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryActiveLocksRequest.pagination":
-		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -147,16 +138,13 @@ func (x *fastReflection_QueryActiveLocksRequest) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActiveLocksRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "optio.lockup.QueryActiveLocksRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryParamsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -170,15 +158,13 @@ func (x *fastReflection_QueryActiveLocksRequest) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveLocksRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryActiveLocksRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -192,44 +178,36 @@ func (x *fastReflection_QueryActiveLocksRequest) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveLocksRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryActiveLocksRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActiveLocksRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryActiveLocksRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActiveLocksRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryActiveLocksRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryParamsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -237,7 +215,7 @@ func (x *fastReflection_QueryActiveLocksRequest) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActiveLocksRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -248,7 +226,7 @@ func (x *fastReflection_QueryActiveLocksRequest) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveLocksRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -260,7 +238,7 @@ func (x *fastReflection_QueryActiveLocksRequest) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActiveLocksRequest) IsValid() bool {
+func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -270,9 +248,9 @@ func (x *fastReflection_QueryActiveLocksRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActiveLocksRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActiveLocksRequest)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -284,10 +262,6 @@ func (x *fastReflection_QueryActiveLocksRequest) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -298,7 +272,7 @@ func (x *fastReflection_QueryActiveLocksRequest) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveLocksRequest)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -317,20 +291,6 @@ func (x *fastReflection_QueryActiveLocksRequest) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -342,7 +302,7 @@ func (x *fastReflection_QueryActiveLocksRequest) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveLocksRequest)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -374,48 +334,12 @@ func (x *fastReflection_QueryActiveLocksRequest) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveLocksRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -451,79 +375,26 @@ func (x *fastReflection_QueryActiveLocksRequest) ProtoMethods() *protoiface.Meth
 	}
 }
 
-var _ protoreflect.List = (*_QueryActiveLocksResponse_1_list)(nil)
-
-type _QueryActiveLocksResponse_1_list struct {
-	list *[]*ActiveLockResource
-}
-
-func (x *_QueryActiveLocksResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryActiveLocksResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryActiveLocksResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ActiveLockResource)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryActiveLocksResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ActiveLockResource)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryActiveLocksResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(ActiveLockResource)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryActiveLocksResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryActiveLocksResponse_1_list) NewElement() protoreflect.Value {
-	v := new(ActiveLockResource)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryActiveLocksResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryActiveLocksResponse            protoreflect.MessageDescriptor
-	fd_QueryActiveLocksResponse_locks      protoreflect.FieldDescriptor
-	fd_QueryActiveLocksResponse_pagination protoreflect.FieldDescriptor
+	md_QueryParamsResponse        protoreflect.MessageDescriptor
+	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_optio_lockup_query_proto_init()
-	md_QueryActiveLocksResponse = File_optio_lockup_query_proto.Messages().ByName("QueryActiveLocksResponse")
-	fd_QueryActiveLocksResponse_locks = md_QueryActiveLocksResponse.Fields().ByName("locks")
-	fd_QueryActiveLocksResponse_pagination = md_QueryActiveLocksResponse.Fields().ByName("pagination")
+	md_QueryParamsResponse = File_optio_lockup_query_proto.Messages().ByName("QueryParamsResponse")
+	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActiveLocksResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
 
-type fastReflection_QueryActiveLocksResponse QueryActiveLocksResponse
+type fastReflection_QueryParamsResponse QueryParamsResponse
 
-func (x *QueryActiveLocksResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActiveLocksResponse)(x)
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(x)
 }
 
-func (x *QueryActiveLocksResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_optio_lockup_query_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -535,43 +406,43 @@ func (x *QueryActiveLocksResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActiveLocksResponse_messageType fastReflection_QueryActiveLocksResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActiveLocksResponse_messageType{}
+var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
 
-type fastReflection_QueryActiveLocksResponse_messageType struct{}
+type fastReflection_QueryParamsResponse_messageType struct{}
 
-func (x fastReflection_QueryActiveLocksResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActiveLocksResponse)(nil)
+func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(nil)
 }
-func (x fastReflection_QueryActiveLocksResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveLocksResponse)
+func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
-func (x fastReflection_QueryActiveLocksResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveLocksResponse
+func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActiveLocksResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveLocksResponse
+func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActiveLocksResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActiveLocksResponse_messageType
+func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActiveLocksResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveLocksResponse)
+func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActiveLocksResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryActiveLocksResponse)(x)
+func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -579,16 +450,10 @@ func (x *fastReflection_QueryActiveLocksResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActiveLocksResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Locks) != 0 {
-		value := protoreflect.ValueOfList(&_QueryActiveLocksResponse_1_list{list: &x.Locks})
-		if !f(fd_QueryActiveLocksResponse_locks, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryActiveLocksResponse_pagination, value) {
+func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryParamsResponse_params, value) {
 			return
 		}
 	}
@@ -605,17 +470,15 @@ func (x *fastReflection_QueryActiveLocksResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActiveLocksResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "optio.lockup.QueryActiveLocksResponse.locks":
-		return len(x.Locks) != 0
-	case "optio.lockup.QueryActiveLocksResponse.pagination":
-		return x.Pagination != nil
+	case "optio.lockup.QueryParamsResponse.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -625,17 +488,15 @@ func (x *fastReflection_QueryActiveLocksResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveLocksResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryActiveLocksResponse.locks":
-		x.Locks = nil
-	case "optio.lockup.QueryActiveLocksResponse.pagination":
-		x.Pagination = nil
+	case "optio.lockup.QueryParamsResponse.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -645,22 +506,16 @@ func (x *fastReflection_QueryActiveLocksResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActiveLocksResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "optio.lockup.QueryActiveLocksResponse.locks":
-		if len(x.Locks) == 0 {
-			return protoreflect.ValueOfList(&_QueryActiveLocksResponse_1_list{})
-		}
-		listValue := &_QueryActiveLocksResponse_1_list{list: &x.Locks}
-		return protoreflect.ValueOfList(listValue)
-	case "optio.lockup.QueryActiveLocksResponse.pagination":
-		value := x.Pagination
+	case "optio.lockup.QueryParamsResponse.params":
+		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -674,19 +529,15 @@ func (x *fastReflection_QueryActiveLocksResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveLocksResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryActiveLocksResponse.locks":
-		lv := value.List()
-		clv := lv.(*_QueryActiveLocksResponse_1_list)
-		x.Locks = *clv.list
-	case "optio.lockup.QueryActiveLocksResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "optio.lockup.QueryParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -700,53 +551,44 @@ func (x *fastReflection_QueryActiveLocksResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveLocksResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryActiveLocksResponse.locks":
-		if x.Locks == nil {
-			x.Locks = []*ActiveLockResource{}
-		}
-		value := &_QueryActiveLocksResponse_1_list{list: &x.Locks}
-		return protoreflect.ValueOfList(value)
-	case "optio.lockup.QueryActiveLocksResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "optio.lockup.QueryParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActiveLocksResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryActiveLocksResponse.locks":
-		list := []*ActiveLockResource{}
-		return protoreflect.ValueOfList(&_QueryActiveLocksResponse_1_list{list: &list})
-	case "optio.lockup.QueryActiveLocksResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "optio.lockup.QueryParamsResponse.params":
+		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActiveLocksResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryActiveLocksResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -754,7 +596,7 @@ func (x *fastReflection_QueryActiveLocksResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActiveLocksResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -765,7 +607,7 @@ func (x *fastReflection_QueryActiveLocksResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveLocksResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -777,7 +619,7 @@ func (x *fastReflection_QueryActiveLocksResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActiveLocksResponse) IsValid() bool {
+func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -787,9 +629,9 @@ func (x *fastReflection_QueryActiveLocksResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActiveLocksResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActiveLocksResponse)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -801,14 +643,8 @@ func (x *fastReflection_QueryActiveLocksResponse) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if len(x.Locks) > 0 {
-			for _, e := range x.Locks {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Params != nil {
+			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -821,7 +657,7 @@ func (x *fastReflection_QueryActiveLocksResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveLocksResponse)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -840,8 +676,8 @@ func (x *fastReflection_QueryActiveLocksResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -852,23 +688,7 @@ func (x *fastReflection_QueryActiveLocksResponse) ProtoMethods() *protoiface.Met
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Locks) > 0 {
-			for iNdEx := len(x.Locks) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Locks[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -881,7 +701,7 @@ func (x *fastReflection_QueryActiveLocksResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveLocksResponse)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -913,49 +733,15 @@ func (x *fastReflection_QueryActiveLocksResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveLocksResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Locks = append(x.Locks, &ActiveLockResource{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Locks[len(x.Locks)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -982,10 +768,10 @@ func (x *fastReflection_QueryActiveLocksResponse) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.Params == nil {
+					x.Params = &Params{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1025,29 +811,29 @@ func (x *fastReflection_QueryActiveLocksResponse) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_ActiveLockResource             protoreflect.MessageDescriptor
-	fd_ActiveLockResource_address     protoreflect.FieldDescriptor
-	fd_ActiveLockResource_unlock_date protoreflect.FieldDescriptor
-	fd_ActiveLockResource_amount      protoreflect.FieldDescriptor
+	md_QueryActiveLocksRequest            protoreflect.MessageDescriptor
+	fd_QueryActiveLocksRequest_pagination protoreflect.FieldDescriptor
+	fd_QueryActiveLocksRequest_address    protoreflect.FieldDescriptor
+	fd_QueryActiveLocksRequest_filter     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_optio_lockup_query_proto_init()
-	md_ActiveLockResource = File_optio_lockup_query_proto.Messages().ByName("ActiveLockResource")
-	fd_ActiveLockResource_address = md_ActiveLockResource.Fields().ByName("address")
-	fd_ActiveLockResource_unlock_date = md_ActiveLockResource.Fields().ByName("unlock_date")
-	fd_ActiveLockResource_amount = md_ActiveLockResource.Fields().ByName("amount")
+	md_QueryActiveLocksRequest = File_optio_lockup_query_proto.Messages().ByName("QueryActiveLocksRequest")
+	fd_QueryActiveLocksRequest_pagination = md_QueryActiveLocksRequest.Fields().ByName("pagination")
+	fd_QueryActiveLocksRequest_address = md_QueryActiveLocksRequest.Fields().ByName("address")
+	fd_QueryActiveLocksRequest_filter = md_QueryActiveLocksRequest.Fields().ByName("filter")
 }
 
-var _ protoreflect.Message = (*fastReflection_ActiveLockResource)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryActiveLocksRequest)(nil)
 
-type fastReflection_ActiveLockResource ActiveLockResource
+type fastReflection_QueryActiveLocksRequest QueryActiveLocksRequest
 
-func (x *ActiveLockResource) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ActiveLockResource)(x)
+func (x *QueryActiveLocksRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActiveLocksRequest)(x)
 }
 
-func (x *ActiveLockResource) slowProtoReflect() protoreflect.Message {
+func (x *QueryActiveLocksRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_optio_lockup_query_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1059,43 +845,43 @@ func (x *ActiveLockResource) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ActiveLockResource_messageType fastReflection_ActiveLockResource_messageType
-var _ protoreflect.MessageType = fastReflection_ActiveLockResource_messageType{}
+var _fastReflection_QueryActiveLocksRequest_messageType fastReflection_QueryActiveLocksRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActiveLocksRequest_messageType{}
 
-type fastReflection_ActiveLockResource_messageType struct{}
+type fastReflection_QueryActiveLocksRequest_messageType struct{}
 
-func (x fastReflection_ActiveLockResource_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ActiveLockResource)(nil)
+func (x fastReflection_QueryActiveLocksRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActiveLocksRequest)(nil)
 }
-func (x fastReflection_ActiveLockResource_messageType) New() protoreflect.Message {
-	return new(fastReflection_ActiveLockResource)
+func (x fastReflection_QueryActiveLocksRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveLocksRequest)
 }
-func (x fastReflection_ActiveLockResource_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ActiveLockResource
+func (x fastReflection_QueryActiveLocksRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveLocksRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ActiveLockResource) Descriptor() protoreflect.MessageDescriptor {
-	return md_ActiveLockResource
+func (x *fastReflection_QueryActiveLocksRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveLocksRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ActiveLockResource) Type() protoreflect.MessageType {
-	return _fastReflection_ActiveLockResource_messageType
+func (x *fastReflection_QueryActiveLocksRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActiveLocksRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ActiveLockResource) New() protoreflect.Message {
-	return new(fastReflection_ActiveLockResource)
+func (x *fastReflection_QueryActiveLocksRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveLocksRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ActiveLockResource) Interface() protoreflect.ProtoMessage {
-	return (*ActiveLockResource)(x)
+func (x *fastReflection_QueryActiveLocksRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryActiveLocksRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1103,22 +889,22 @@ func (x *fastReflection_ActiveLockResource) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ActiveLockResource) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_ActiveLockResource_address, value) {
+func (x *fastReflection_QueryActiveLocksRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryActiveLocksRequest_pagination, value) {
 			return
 		}
 	}
-	if x.UnlockDate != "" {
-		value := protoreflect.ValueOfString(x.UnlockDate)
-		if !f(fd_ActiveLockResource_unlock_date, value) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryActiveLocksRequest_address, value) {
 			return
 		}
 	}
-	if x.Amount != nil {
-		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
-		if !f(fd_ActiveLockResource_amount, value) {
+	if x.Filter != nil {
+		value := protoreflect.ValueOfMessage(x.Filter.ProtoReflect())
+		if !f(fd_QueryActiveLocksRequest_filter, value) {
 			return
 		}
 	}
@@ -1135,19 +921,19 @@ func (x *fastReflection_ActiveLockResource) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ActiveLockResource) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryActiveLocksRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "optio.lockup.ActiveLockResource.address":
+	case "optio.lockup.QueryActiveLocksRequest.pagination":
+		return x.Pagination != nil
+	case "optio.lockup.QueryActiveLocksRequest.address":
 		return x.Address != ""
-	case "optio.lockup.ActiveLockResource.unlock_date":
-		return x.UnlockDate != ""
-	case "optio.lockup.ActiveLockResource.amount":
-		return x.Amount != nil
+	case "optio.lockup.QueryActiveLocksRequest.filter":
+		return x.Filter != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.ActiveLockResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.ActiveLockResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1157,19 +943,19 @@ func (x *fastReflection_ActiveLockResource) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveLockResource) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryActiveLocksRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "optio.lockup.ActiveLockResource.address":
+	case "optio.lockup.QueryActiveLocksRequest.pagination":
+		x.Pagination = nil
+	case "optio.lockup.QueryActiveLocksRequest.address":
 		x.Address = ""
-	case "optio.lockup.ActiveLockResource.unlock_date":
-		x.UnlockDate = ""
-	case "optio.lockup.ActiveLockResource.amount":
-		x.Amount = nil
+	case "optio.lockup.QueryActiveLocksRequest.filter":
+		x.Filter = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.ActiveLockResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.ActiveLockResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1179,22 +965,22 @@ func (x *fastReflection_ActiveLockResource) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ActiveLockResource) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActiveLocksRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "optio.lockup.ActiveLockResource.address":
+	case "optio.lockup.QueryActiveLocksRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.QueryActiveLocksRequest.address":
 		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "optio.lockup.ActiveLockResource.unlock_date":
-		value := x.UnlockDate
-		return protoreflect.ValueOfString(value)
-	case "optio.lockup.ActiveLockResource.amount":
-		value := x.Amount
+	case "optio.lockup.QueryActiveLocksRequest.filter":
+		value := x.Filter
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.ActiveLockResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.ActiveLockResource does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1208,19 +994,19 @@ func (x *fastReflection_ActiveLockResource) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveLockResource) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryActiveLocksRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "optio.lockup.ActiveLockResource.address":
+	case "optio.lockup.QueryActiveLocksRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "optio.lockup.QueryActiveLocksRequest.address":
 		x.Address = value.Interface().(string)
-	case "optio.lockup.ActiveLockResource.unlock_date":
-		x.UnlockDate = value.Interface().(string)
-	case "optio.lockup.ActiveLockResource.amount":
-		x.Amount = value.Message().Interface().(*v1beta11.Coin)
+	case "optio.lockup.QueryActiveLocksRequest.filter":
+		x.Filter = value.Message().Interface().(*LockFilter)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.ActiveLockResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.ActiveLockResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1234,52 +1020,56 @@ func (x *fastReflection_ActiveLockResource) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveLockResource) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActiveLocksRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.ActiveLockResource.amount":
-		if x.Amount == nil {
-			x.Amount = new(v1beta11.Coin)
+	case "optio.lockup.QueryActiveLocksRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
 		}
-		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
-	case "optio.lockup.ActiveLockResource.address":
-		panic(fmt.Errorf("field address of message optio.lockup.ActiveLockResource is not mutable"))
-	case "optio.lockup.ActiveLockResource.unlock_date":
-		panic(fmt.Errorf("field unlock_date of message optio.lockup.ActiveLockResource is not mutable"))
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "optio.lockup.QueryActiveLocksRequest.filter":
+		if x.Filter == nil {
+			x.Filter = new(LockFilter)
+		}
+		return protoreflect.ValueOfMessage(x.Filter.ProtoReflect())
+	case "optio.lockup.QueryActiveLocksRequest.address":
+		panic(fmt.Errorf("field address of message optio.lockup.QueryActiveLocksRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.ActiveLockResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.ActiveLockResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ActiveLockResource) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActiveLocksRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.ActiveLockResource.address":
-		return protoreflect.ValueOfString("")
-	case "optio.lockup.ActiveLockResource.unlock_date":
+	case "optio.lockup.QueryActiveLocksRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.QueryActiveLocksRequest.address":
 		return protoreflect.ValueOfString("")
-	case "optio.lockup.ActiveLockResource.amount":
-		m := new(v1beta11.Coin)
+	case "optio.lockup.QueryActiveLocksRequest.filter":
+		m := new(LockFilter)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.ActiveLockResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.ActiveLockResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ActiveLockResource) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryActiveLocksRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.ActiveLockResource", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryActiveLocksRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1287,7 +1077,7 @@ func (x *fastReflection_ActiveLockResource) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ActiveLockResource) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryActiveLocksRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1298,7 +1088,7 @@ func (x *fastReflection_ActiveLockResource) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ActiveLockResource) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryActiveLocksRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1310,7 +1100,7 @@ func (x *fastReflection_ActiveLockResource) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ActiveLockResource) IsValid() bool {
+func (x *fastReflection_QueryActiveLocksRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1320,9 +1110,9 @@ func (x *fastReflection_ActiveLockResource) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ActiveLockResource) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryActiveLocksRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ActiveLockResource)
+		x := input.Message.Interface().(*QueryActiveLocksRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1334,16 +1124,16 @@ func (x *fastReflection_ActiveLockResource) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
-		if l > 0 {
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.UnlockDate)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Amount != nil {
-			l = options.Size(x.Amount)
+		if x.Filter != nil {
+			l = options.Size(x.Filter)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1356,7 +1146,7 @@ func (x *fastReflection_ActiveLockResource) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ActiveLockResource)
+		x := input.Message.Interface().(*QueryActiveLocksRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1375,8 +1165,8 @@ func (x *fastReflection_ActiveLockResource) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Amount != nil {
-			encoded, err := options.Marshal(x.Amount)
+		if x.Filter != nil {
+			encoded, err := options.Marshal(x.Filter)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1389,18 +1179,25 @@ func (x *fastReflection_ActiveLockResource) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.UnlockDate) > 0 {
-			i -= len(x.UnlockDate)
-			copy(dAtA[i:], x.UnlockDate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UnlockDate)))
-			i--
-			dAtA[i] = 0x12
-		}
 		if len(x.Address) > 0 {
 			i -= len(x.Address)
 			copy(dAtA[i:], x.Address)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -1414,7 +1211,7 @@ func (x *fastReflection_ActiveLockResource) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ActiveLockResource)
+		x := input.Message.Interface().(*QueryActiveLocksRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1446,17 +1243,17 @@ func (x *fastReflection_ActiveLockResource) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveLockResource: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveLocksRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveLockResource: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1466,27 +1263,31 @@ func (x *fastReflection_ActiveLockResource) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1514,11 +1315,11 @@ func (x *fastReflection_ActiveLockResource) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.UnlockDate = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1545,10 +1346,10 @@ func (x *fastReflection_ActiveLockResource) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Amount == nil {
-					x.Amount = &v1beta11.Coin{}
+				if x.Filter == nil {
+					x.Filter = &LockFilter{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Filter); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1588,23 +1389,29 @@ func (x *fastReflection_ActiveLockResource) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryTotalLockedAmountRequest protoreflect.MessageDescriptor
+	md_LockFilter            protoreflect.MessageDescriptor
+	fd_LockFilter_start_date protoreflect.FieldDescriptor
+	fd_LockFilter_end_date   protoreflect.FieldDescriptor
+	fd_LockFilter_min_amount protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_optio_lockup_query_proto_init()
-	md_QueryTotalLockedAmountRequest = File_optio_lockup_query_proto.Messages().ByName("QueryTotalLockedAmountRequest")
+	md_LockFilter = File_optio_lockup_query_proto.Messages().ByName("LockFilter")
+	fd_LockFilter_start_date = md_LockFilter.Fields().ByName("start_date")
+	fd_LockFilter_end_date = md_LockFilter.Fields().ByName("end_date")
+	fd_LockFilter_min_amount = md_LockFilter.Fields().ByName("min_amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTotalLockedAmountRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_LockFilter)(nil)
 
-type fastReflection_QueryTotalLockedAmountRequest QueryTotalLockedAmountRequest
+type fastReflection_LockFilter LockFilter
 
-func (x *QueryTotalLockedAmountRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTotalLockedAmountRequest)(x)
+func (x *LockFilter) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LockFilter)(x)
 }
 
-func (x *QueryTotalLockedAmountRequest) slowProtoReflect() protoreflect.Message {
+func (x *LockFilter) slowProtoReflect() protoreflect.Message {
 	mi := &file_optio_lockup_query_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1616,43 +1423,43 @@ func (x *QueryTotalLockedAmountRequest) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTotalLockedAmountRequest_messageType fastReflection_QueryTotalLockedAmountRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTotalLockedAmountRequest_messageType{}
+var _fastReflection_LockFilter_messageType fastReflection_LockFilter_messageType
+var _ protoreflect.MessageType = fastReflection_LockFilter_messageType{}
 
-type fastReflection_QueryTotalLockedAmountRequest_messageType struct{}
+type fastReflection_LockFilter_messageType struct{}
 
-func (x fastReflection_QueryTotalLockedAmountRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTotalLockedAmountRequest)(nil)
+func (x fastReflection_LockFilter_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LockFilter)(nil)
 }
-func (x fastReflection_QueryTotalLockedAmountRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalLockedAmountRequest)
+func (x fastReflection_LockFilter_messageType) New() protoreflect.Message {
+	return new(fastReflection_LockFilter)
 }
-func (x fastReflection_QueryTotalLockedAmountRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalLockedAmountRequest
+func (x fastReflection_LockFilter_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockFilter
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTotalLockedAmountRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalLockedAmountRequest
+func (x *fastReflection_LockFilter) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockFilter
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTotalLockedAmountRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTotalLockedAmountRequest_messageType
+func (x *fastReflection_LockFilter) Type() protoreflect.MessageType {
+	return _fastReflection_LockFilter_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTotalLockedAmountRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalLockedAmountRequest)
+func (x *fastReflection_LockFilter) New() protoreflect.Message {
+	return new(fastReflection_LockFilter)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTotalLockedAmountRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTotalLockedAmountRequest)(x)
+func (x *fastReflection_LockFilter) Interface() protoreflect.ProtoMessage {
+	return (*LockFilter)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1660,7 +1467,25 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTotalLockedAmountRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_LockFilter) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartDate != "" {
+		value := protoreflect.ValueOfString(x.StartDate)
+		if !f(fd_LockFilter_start_date, value) {
+			return
+		}
+	}
+	if x.EndDate != "" {
+		value := protoreflect.ValueOfString(x.EndDate)
+		if !f(fd_LockFilter_end_date, value) {
+			return
+		}
+	}
+	if x.MinAmount != "" {
+		value := protoreflect.ValueOfString(x.MinAmount)
+		if !f(fd_LockFilter_min_amount, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1674,13 +1499,19 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTotalLockedAmountRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_LockFilter) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "optio.lockup.LockFilter.start_date":
+		return x.StartDate != ""
+	case "optio.lockup.LockFilter.end_date":
+		return x.EndDate != ""
+	case "optio.lockup.LockFilter.min_amount":
+		return x.MinAmount != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockFilter"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.LockFilter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1690,13 +1521,19 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedAmountRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_LockFilter) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "optio.lockup.LockFilter.start_date":
+		x.StartDate = ""
+	case "optio.lockup.LockFilter.end_date":
+		x.EndDate = ""
+	case "optio.lockup.LockFilter.min_amount":
+		x.MinAmount = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockFilter"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.LockFilter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1706,13 +1543,22 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTotalLockedAmountRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LockFilter) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "optio.lockup.LockFilter.start_date":
+		value := x.StartDate
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.LockFilter.end_date":
+		value := x.EndDate
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.LockFilter.min_amount":
+		value := x.MinAmount
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockFilter"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message optio.lockup.LockFilter does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1726,13 +1572,19 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedAmountRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LockFilter) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "optio.lockup.LockFilter.start_date":
+		x.StartDate = value.Interface().(string)
+	case "optio.lockup.LockFilter.end_date":
+		x.EndDate = value.Interface().(string)
+	case "optio.lockup.LockFilter.min_amount":
+		x.MinAmount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockFilter"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.LockFilter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1746,36 +1598,48 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedAmountRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LockFilter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "optio.lockup.LockFilter.start_date":
+		panic(fmt.Errorf("field start_date of message optio.lockup.LockFilter is not mutable"))
+	case "optio.lockup.LockFilter.end_date":
+		panic(fmt.Errorf("field end_date of message optio.lockup.LockFilter is not mutable"))
+	case "optio.lockup.LockFilter.min_amount":
+		panic(fmt.Errorf("field min_amount of message optio.lockup.LockFilter is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockFilter"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.LockFilter does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTotalLockedAmountRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LockFilter) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "optio.lockup.LockFilter.start_date":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.LockFilter.end_date":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.LockFilter.min_amount":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockFilter"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.LockFilter does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTotalLockedAmountRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LockFilter) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryTotalLockedAmountRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.LockFilter", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1783,7 +1647,7 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTotalLockedAmountRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LockFilter) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1794,7 +1658,7 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedAmountRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LockFilter) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1806,7 +1670,7 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTotalLockedAmountRequest) IsValid() bool {
+func (x *fastReflection_LockFilter) IsValid() bool {
 	return x != nil
 }
 
@@ -1816,9 +1680,9 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTotalLockedAmountRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LockFilter) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTotalLockedAmountRequest)
+		x := input.Message.Interface().(*LockFilter)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1830,6 +1694,18 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
+		l = len(x.StartDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EndDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1840,7 +1716,7 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalLockedAmountRequest)
+		x := input.Message.Interface().(*LockFilter)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1859,6 +1735,27 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MinAmount) > 0 {
+			i -= len(x.MinAmount)
+			copy(dAtA[i:], x.MinAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.EndDate) > 0 {
+			i -= len(x.EndDate)
+			copy(dAtA[i:], x.EndDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EndDate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.StartDate) > 0 {
+			i -= len(x.StartDate)
+			copy(dAtA[i:], x.StartDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StartDate)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1870,7 +1767,7 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalLockedAmountRequest)
+		x := input.Message.Interface().(*LockFilter)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1902,115 +1799,264 @@ func (x *fastReflection_QueryTotalLockedAmountRequest) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedAmountRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockFilter: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.StartDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_QueryTotalLockedAmountResponse              protoreflect.MessageDescriptor
-	fd_QueryTotalLockedAmountResponse_total_locked protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_optio_lockup_query_proto_init()
-	md_QueryTotalLockedAmountResponse = File_optio_lockup_query_proto.Messages().ByName("QueryTotalLockedAmountResponse")
-	fd_QueryTotalLockedAmountResponse_total_locked = md_QueryTotalLockedAmountResponse.Fields().ByName("total_locked")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryTotalLockedAmountResponse)(nil)
-
-type fastReflection_QueryTotalLockedAmountResponse QueryTotalLockedAmountResponse
-
-func (x *QueryTotalLockedAmountResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTotalLockedAmountResponse)(x)
-}
-
-func (x *QueryTotalLockedAmountResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_optio_lockup_query_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_QueryTotalLockedAmountResponse_messageType fastReflection_QueryTotalLockedAmountResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTotalLockedAmountResponse_messageType{}
-
-type fastReflection_QueryTotalLockedAmountResponse_messageType struct{}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EndDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
 
-func (x fastReflection_QueryTotalLockedAmountResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTotalLockedAmountResponse)(nil)
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
-func (x fastReflection_QueryTotalLockedAmountResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalLockedAmountResponse)
+
+var _ protoreflect.List = (*_QueryActiveLocksResponse_1_list)(nil)
+
+type _QueryActiveLocksResponse_1_list struct {
+	list *[]*ActiveLockResource
 }
-func (x fastReflection_QueryTotalLockedAmountResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalLockedAmountResponse
+
+func (x *_QueryActiveLocksResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryActiveLocksResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryActiveLocksResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ActiveLockResource)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryActiveLocksResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ActiveLockResource)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryActiveLocksResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ActiveLockResource)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryActiveLocksResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryActiveLocksResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ActiveLockResource)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryActiveLocksResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryActiveLocksResponse            protoreflect.MessageDescriptor
+	fd_QueryActiveLocksResponse_locks      protoreflect.FieldDescriptor
+	fd_QueryActiveLocksResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryActiveLocksResponse = File_optio_lockup_query_proto.Messages().ByName("QueryActiveLocksResponse")
+	fd_QueryActiveLocksResponse_locks = md_QueryActiveLocksResponse.Fields().ByName("locks")
+	fd_QueryActiveLocksResponse_pagination = md_QueryActiveLocksResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryActiveLocksResponse)(nil)
+
+type fastReflection_QueryActiveLocksResponse QueryActiveLocksResponse
+
+func (x *QueryActiveLocksResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActiveLocksResponse)(x)
+}
+
+func (x *QueryActiveLocksResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryActiveLocksResponse_messageType fastReflection_QueryActiveLocksResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActiveLocksResponse_messageType{}
+
+type fastReflection_QueryActiveLocksResponse_messageType struct{}
+
+func (x fastReflection_QueryActiveLocksResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActiveLocksResponse)(nil)
+}
+func (x fastReflection_QueryActiveLocksResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveLocksResponse)
+}
+func (x fastReflection_QueryActiveLocksResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveLocksResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTotalLockedAmountResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalLockedAmountResponse
+func (x *fastReflection_QueryActiveLocksResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveLocksResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTotalLockedAmountResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTotalLockedAmountResponse_messageType
+func (x *fastReflection_QueryActiveLocksResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActiveLocksResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTotalLockedAmountResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalLockedAmountResponse)
+func (x *fastReflection_QueryActiveLocksResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveLocksResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTotalLockedAmountResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTotalLockedAmountResponse)(x)
+func (x *fastReflection_QueryActiveLocksResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryActiveLocksResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2018,10 +2064,16 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTotalLockedAmountResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TotalLocked != nil {
-		value := protoreflect.ValueOfMessage(x.TotalLocked.ProtoReflect())
-		if !f(fd_QueryTotalLockedAmountResponse_total_locked, value) {
+func (x *fastReflection_QueryActiveLocksResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Locks) != 0 {
+		value := protoreflect.ValueOfList(&_QueryActiveLocksResponse_1_list{list: &x.Locks})
+		if !f(fd_QueryActiveLocksResponse_locks, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryActiveLocksResponse_pagination, value) {
 			return
 		}
 	}
@@ -2038,15 +2090,17 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTotalLockedAmountResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryActiveLocksResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "optio.lockup.QueryTotalLockedAmountResponse.total_locked":
-		return x.TotalLocked != nil
+	case "optio.lockup.QueryActiveLocksResponse.locks":
+		return len(x.Locks) != 0
+	case "optio.lockup.QueryActiveLocksResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2056,15 +2110,17 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedAmountResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryActiveLocksResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryTotalLockedAmountResponse.total_locked":
-		x.TotalLocked = nil
+	case "optio.lockup.QueryActiveLocksResponse.locks":
+		x.Locks = nil
+	case "optio.lockup.QueryActiveLocksResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2074,16 +2130,22 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTotalLockedAmountResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActiveLocksResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "optio.lockup.QueryTotalLockedAmountResponse.total_locked":
-		value := x.TotalLocked
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountResponse"))
-		}
-		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountResponse does not contain field %s", descriptor.FullName()))
+	case "optio.lockup.QueryActiveLocksResponse.locks":
+		if len(x.Locks) == 0 {
+			return protoreflect.ValueOfList(&_QueryActiveLocksResponse_1_list{})
+		}
+		listValue := &_QueryActiveLocksResponse_1_list{list: &x.Locks}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.lockup.QueryActiveLocksResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2097,15 +2159,19 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedAmountResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryActiveLocksResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryTotalLockedAmountResponse.total_locked":
-		x.TotalLocked = value.Message().Interface().(*v1beta11.Coin)
+	case "optio.lockup.QueryActiveLocksResponse.locks":
+		lv := value.List()
+		clv := lv.(*_QueryActiveLocksResponse_1_list)
+		x.Locks = *clv.list
+	case "optio.lockup.QueryActiveLocksResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2119,44 +2185,53 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedAmountResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActiveLocksResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryTotalLockedAmountResponse.total_locked":
-		if x.TotalLocked == nil {
-			x.TotalLocked = new(v1beta11.Coin)
+	case "optio.lockup.QueryActiveLocksResponse.locks":
+		if x.Locks == nil {
+			x.Locks = []*ActiveLockResource{}
 		}
-		return protoreflect.ValueOfMessage(x.TotalLocked.ProtoReflect())
+		value := &_QueryActiveLocksResponse_1_list{list: &x.Locks}
+		return protoreflect.ValueOfList(value)
+	case "optio.lockup.QueryActiveLocksResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTotalLockedAmountResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryActiveLocksResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryTotalLockedAmountResponse.total_locked":
-		m := new(v1beta11.Coin)
+	case "optio.lockup.QueryActiveLocksResponse.locks":
+		list := []*ActiveLockResource{}
+		return protoreflect.ValueOfList(&_QueryActiveLocksResponse_1_list{list: &list})
+	case "optio.lockup.QueryActiveLocksResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryActiveLocksResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryActiveLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTotalLockedAmountResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryActiveLocksResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryTotalLockedAmountResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryActiveLocksResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2164,7 +2239,7 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTotalLockedAmountResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryActiveLocksResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2175,7 +2250,7 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedAmountResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryActiveLocksResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2187,7 +2262,7 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTotalLockedAmountResponse) IsValid() bool {
+func (x *fastReflection_QueryActiveLocksResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2197,9 +2272,9 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTotalLockedAmountResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryActiveLocksResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTotalLockedAmountResponse)
+		x := input.Message.Interface().(*QueryActiveLocksResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2211,8 +2286,14 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
-		if x.TotalLocked != nil {
-			l = options.Size(x.TotalLocked)
+		if len(x.Locks) > 0 {
+			for _, e := range x.Locks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2225,7 +2306,7 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalLockedAmountResponse)
+		x := input.Message.Interface().(*QueryActiveLocksResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2244,8 +2325,8 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TotalLocked != nil {
-			encoded, err := options.Marshal(x.TotalLocked)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2256,7 +2337,23 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) ProtoMethods() *protoifa
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Locks) > 0 {
+			for iNdEx := len(x.Locks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Locks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2269,7 +2366,7 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalLockedAmountResponse)
+		x := input.Message.Interface().(*QueryActiveLocksResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2301,15 +2398,15 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedAmountResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveLocksResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2336,10 +2433,44 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) ProtoMethods() *protoifa
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TotalLocked == nil {
-					x.TotalLocked = &v1beta11.Coin{}
+				x.Locks = append(x.Locks, &ActiveLockResource{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Locks[len(x.Locks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalLocked); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2379,27 +2510,33 @@ func (x *fastReflection_QueryTotalLockedAmountResponse) ProtoMethods() *protoifa
 }
 
 var (
-	md_QueryAccountLocksRequest            protoreflect.MessageDescriptor
-	fd_QueryAccountLocksRequest_addresses  protoreflect.FieldDescriptor
-	fd_QueryAccountLocksRequest_pagination protoreflect.FieldDescriptor
+	md_ActiveLockResource             protoreflect.MessageDescriptor
+	fd_ActiveLockResource_address     protoreflect.FieldDescriptor
+	fd_ActiveLockResource_unlock_date protoreflect.FieldDescriptor
+	fd_ActiveLockResource_amount      protoreflect.FieldDescriptor
+	fd_ActiveLockResource_auto_renew  protoreflect.FieldDescriptor
+	fd_ActiveLockResource_kind        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_optio_lockup_query_proto_init()
-	md_QueryAccountLocksRequest = File_optio_lockup_query_proto.Messages().ByName("QueryAccountLocksRequest")
-	fd_QueryAccountLocksRequest_addresses = md_QueryAccountLocksRequest.Fields().ByName("addresses")
-	fd_QueryAccountLocksRequest_pagination = md_QueryAccountLocksRequest.Fields().ByName("pagination")
+	md_ActiveLockResource = File_optio_lockup_query_proto.Messages().ByName("ActiveLockResource")
+	fd_ActiveLockResource_address = md_ActiveLockResource.Fields().ByName("address")
+	fd_ActiveLockResource_unlock_date = md_ActiveLockResource.Fields().ByName("unlock_date")
+	fd_ActiveLockResource_amount = md_ActiveLockResource.Fields().ByName("amount")
+	fd_ActiveLockResource_auto_renew = md_ActiveLockResource.Fields().ByName("auto_renew")
+	fd_ActiveLockResource_kind = md_ActiveLockResource.Fields().ByName("kind")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAccountLocksRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_ActiveLockResource)(nil)
 
-type fastReflection_QueryAccountLocksRequest QueryAccountLocksRequest
+type fastReflection_ActiveLockResource ActiveLockResource
 
-func (x *QueryAccountLocksRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAccountLocksRequest)(x)
+func (x *ActiveLockResource) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ActiveLockResource)(x)
 }
 
-func (x *QueryAccountLocksRequest) slowProtoReflect() protoreflect.Message {
+func (x *ActiveLockResource) slowProtoReflect() protoreflect.Message {
 	mi := &file_optio_lockup_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2411,43 +2548,43 @@ func (x *QueryAccountLocksRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAccountLocksRequest_messageType fastReflection_QueryAccountLocksRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAccountLocksRequest_messageType{}
+var _fastReflection_ActiveLockResource_messageType fastReflection_ActiveLockResource_messageType
+var _ protoreflect.MessageType = fastReflection_ActiveLockResource_messageType{}
 
-type fastReflection_QueryAccountLocksRequest_messageType struct{}
+type fastReflection_ActiveLockResource_messageType struct{}
 
-func (x fastReflection_QueryAccountLocksRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAccountLocksRequest)(nil)
+func (x fastReflection_ActiveLockResource_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ActiveLockResource)(nil)
 }
-func (x fastReflection_QueryAccountLocksRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAccountLocksRequest)
+func (x fastReflection_ActiveLockResource_messageType) New() protoreflect.Message {
+	return new(fastReflection_ActiveLockResource)
 }
-func (x fastReflection_QueryAccountLocksRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAccountLocksRequest
+func (x fastReflection_ActiveLockResource_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActiveLockResource
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAccountLocksRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAccountLocksRequest
+func (x *fastReflection_ActiveLockResource) Descriptor() protoreflect.MessageDescriptor {
+	return md_ActiveLockResource
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAccountLocksRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAccountLocksRequest_messageType
+func (x *fastReflection_ActiveLockResource) Type() protoreflect.MessageType {
+	return _fastReflection_ActiveLockResource_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAccountLocksRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAccountLocksRequest)
+func (x *fastReflection_ActiveLockResource) New() protoreflect.Message {
+	return new(fastReflection_ActiveLockResource)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAccountLocksRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAccountLocksRequest)(x)
+func (x *fastReflection_ActiveLockResource) Interface() protoreflect.ProtoMessage {
+	return (*ActiveLockResource)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2455,16 +2592,34 @@ func (x *fastReflection_QueryAccountLocksRequest) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAccountLocksRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Addresses != "" {
-		value := protoreflect.ValueOfString(x.Addresses)
-		if !f(fd_QueryAccountLocksRequest_addresses, value) {
+func (x *fastReflection_ActiveLockResource) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ActiveLockResource_address, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAccountLocksRequest_pagination, value) {
+	if x.UnlockDate != "" {
+		value := protoreflect.ValueOfString(x.UnlockDate)
+		if !f(fd_ActiveLockResource_unlock_date, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_ActiveLockResource_amount, value) {
+			return
+		}
+	}
+	if x.AutoRenew != false {
+		value := protoreflect.ValueOfBool(x.AutoRenew)
+		if !f(fd_ActiveLockResource_auto_renew, value) {
+			return
+		}
+	}
+	if x.Kind != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Kind))
+		if !f(fd_ActiveLockResource_kind, value) {
 			return
 		}
 	}
@@ -2481,17 +2636,23 @@ func (x *fastReflection_QueryAccountLocksRequest) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAccountLocksRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ActiveLockResource) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "optio.lockup.QueryAccountLocksRequest.addresses":
-		return x.Addresses != ""
-	case "optio.lockup.QueryAccountLocksRequest.pagination":
-		return x.Pagination != nil
+	case "optio.lockup.ActiveLockResource.address":
+		return x.Address != ""
+	case "optio.lockup.ActiveLockResource.unlock_date":
+		return x.UnlockDate != ""
+	case "optio.lockup.ActiveLockResource.amount":
+		return x.Amount != nil
+	case "optio.lockup.ActiveLockResource.auto_renew":
+		return x.AutoRenew != false
+	case "optio.lockup.ActiveLockResource.kind":
+		return x.Kind != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.ActiveLockResource"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.ActiveLockResource does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2501,17 +2662,23 @@ func (x *fastReflection_QueryAccountLocksRequest) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAccountLocksRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ActiveLockResource) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryAccountLocksRequest.addresses":
-		x.Addresses = ""
-	case "optio.lockup.QueryAccountLocksRequest.pagination":
-		x.Pagination = nil
+	case "optio.lockup.ActiveLockResource.address":
+		x.Address = ""
+	case "optio.lockup.ActiveLockResource.unlock_date":
+		x.UnlockDate = ""
+	case "optio.lockup.ActiveLockResource.amount":
+		x.Amount = nil
+	case "optio.lockup.ActiveLockResource.auto_renew":
+		x.AutoRenew = false
+	case "optio.lockup.ActiveLockResource.kind":
+		x.Kind = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.ActiveLockResource"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.ActiveLockResource does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2521,19 +2688,28 @@ func (x *fastReflection_QueryAccountLocksRequest) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAccountLocksRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ActiveLockResource) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "optio.lockup.QueryAccountLocksRequest.addresses":
-		value := x.Addresses
+	case "optio.lockup.ActiveLockResource.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "optio.lockup.QueryAccountLocksRequest.pagination":
-		value := x.Pagination
+	case "optio.lockup.ActiveLockResource.unlock_date":
+		value := x.UnlockDate
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.ActiveLockResource.amount":
+		value := x.Amount
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.ActiveLockResource.auto_renew":
+		value := x.AutoRenew
+		return protoreflect.ValueOfBool(value)
+	case "optio.lockup.ActiveLockResource.kind":
+		value := x.Kind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.ActiveLockResource"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message optio.lockup.ActiveLockResource does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2547,17 +2723,23 @@ func (x *fastReflection_QueryAccountLocksRequest) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAccountLocksRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ActiveLockResource) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryAccountLocksRequest.addresses":
-		x.Addresses = value.Interface().(string)
-	case "optio.lockup.QueryAccountLocksRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "optio.lockup.ActiveLockResource.address":
+		x.Address = value.Interface().(string)
+	case "optio.lockup.ActiveLockResource.unlock_date":
+		x.UnlockDate = value.Interface().(string)
+	case "optio.lockup.ActiveLockResource.amount":
+		x.Amount = value.Message().Interface().(*v1beta11.Coin)
+	case "optio.lockup.ActiveLockResource.auto_renew":
+		x.AutoRenew = value.Bool()
+	case "optio.lockup.ActiveLockResource.kind":
+		x.Kind = (LockKind)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.ActiveLockResource"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.ActiveLockResource does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2571,48 +2753,60 @@ func (x *fastReflection_QueryAccountLocksRequest) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAccountLocksRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ActiveLockResource) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryAccountLocksRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
+	case "optio.lockup.ActiveLockResource.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta11.Coin)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "optio.lockup.QueryAccountLocksRequest.addresses":
-		panic(fmt.Errorf("field addresses of message optio.lockup.QueryAccountLocksRequest is not mutable"))
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "optio.lockup.ActiveLockResource.address":
+		panic(fmt.Errorf("field address of message optio.lockup.ActiveLockResource is not mutable"))
+	case "optio.lockup.ActiveLockResource.unlock_date":
+		panic(fmt.Errorf("field unlock_date of message optio.lockup.ActiveLockResource is not mutable"))
+	case "optio.lockup.ActiveLockResource.auto_renew":
+		panic(fmt.Errorf("field auto_renew of message optio.lockup.ActiveLockResource is not mutable"))
+	case "optio.lockup.ActiveLockResource.kind":
+		panic(fmt.Errorf("field kind of message optio.lockup.ActiveLockResource is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.ActiveLockResource"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.ActiveLockResource does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAccountLocksRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ActiveLockResource) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryAccountLocksRequest.addresses":
+	case "optio.lockup.ActiveLockResource.address":
 		return protoreflect.ValueOfString("")
-	case "optio.lockup.QueryAccountLocksRequest.pagination":
-		m := new(v1beta1.PageRequest)
+	case "optio.lockup.ActiveLockResource.unlock_date":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.ActiveLockResource.amount":
+		m := new(v1beta11.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.ActiveLockResource.auto_renew":
+		return protoreflect.ValueOfBool(false)
+	case "optio.lockup.ActiveLockResource.kind":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.ActiveLockResource"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.ActiveLockResource does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAccountLocksRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ActiveLockResource) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryAccountLocksRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.ActiveLockResource", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2620,7 +2814,7 @@ func (x *fastReflection_QueryAccountLocksRequest) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAccountLocksRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ActiveLockResource) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2631,7 +2825,7 @@ func (x *fastReflection_QueryAccountLocksRequest) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAccountLocksRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ActiveLockResource) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2643,7 +2837,7 @@ func (x *fastReflection_QueryAccountLocksRequest) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAccountLocksRequest) IsValid() bool {
+func (x *fastReflection_ActiveLockResource) IsValid() bool {
 	return x != nil
 }
 
@@ -2653,9 +2847,9 @@ func (x *fastReflection_QueryAccountLocksRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAccountLocksRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ActiveLockResource) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAccountLocksRequest)
+		x := input.Message.Interface().(*ActiveLockResource)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2667,14 +2861,24 @@ func (x *fastReflection_QueryAccountLocksRequest) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		l = len(x.Addresses)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.UnlockDate)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoRenew {
+			n += 2
+		}
+		if x.Kind != 0 {
+			n += 1 + runtime.Sov(uint64(x.Kind))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2685,7 +2889,7 @@ func (x *fastReflection_QueryAccountLocksRequest) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAccountLocksRequest)
+		x := input.Message.Interface().(*ActiveLockResource)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2704,8 +2908,23 @@ func (x *fastReflection_QueryAccountLocksRequest) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Kind != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Kind))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AutoRenew {
+			i--
+			if x.AutoRenew {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2716,12 +2935,19 @@ func (x *fastReflection_QueryAccountLocksRequest) ProtoMethods() *protoiface.Met
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.UnlockDate) > 0 {
+			i -= len(x.UnlockDate)
+			copy(dAtA[i:], x.UnlockDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UnlockDate)))
+			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Addresses) > 0 {
-			i -= len(x.Addresses)
-			copy(dAtA[i:], x.Addresses)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Addresses)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2736,7 +2962,7 @@ func (x *fastReflection_QueryAccountLocksRequest) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAccountLocksRequest)
+		x := input.Message.Interface().(*ActiveLockResource)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2768,15 +2994,15 @@ func (x *fastReflection_QueryAccountLocksRequest) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountLocksRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveLockResource: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ActiveLockResource: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2804,11 +3030,43 @@ func (x *fastReflection_QueryAccountLocksRequest) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Addresses = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnlockDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2835,13 +3093,52 @@ func (x *fastReflection_QueryAccountLocksRequest) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
+				if x.Amount == nil {
+					x.Amount = &v1beta11.Coin{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoRenew = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				x.Kind = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Kind |= LockKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2877,127 +3174,72 @@ func (x *fastReflection_QueryAccountLocksRequest) ProtoMethods() *protoiface.Met
 	}
 }
 
-var _ protoreflect.List = (*_QueryAccountLocksResponse_1_list)(nil)
-
-type _QueryAccountLocksResponse_1_list struct {
-	list *[]*AccountLocksResource
-}
-
-func (x *_QueryAccountLocksResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var (
+	md_QueryTotalLockedAmountRequest protoreflect.MessageDescriptor
+)
 
-func (x *_QueryAccountLocksResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryTotalLockedAmountRequest = File_optio_lockup_query_proto.Messages().ByName("QueryTotalLockedAmountRequest")
 }
 
-func (x *_QueryAccountLocksResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*AccountLocksResource)
-	(*x.list)[i] = concreteValue
-}
+var _ protoreflect.Message = (*fastReflection_QueryTotalLockedAmountRequest)(nil)
 
-func (x *_QueryAccountLocksResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*AccountLocksResource)
-	*x.list = append(*x.list, concreteValue)
-}
+type fastReflection_QueryTotalLockedAmountRequest QueryTotalLockedAmountRequest
 
-func (x *_QueryAccountLocksResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(AccountLocksResource)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *QueryTotalLockedAmountRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTotalLockedAmountRequest)(x)
 }
 
-func (x *_QueryAccountLocksResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+func (x *QueryTotalLockedAmountRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	*x.list = (*x.list)[:n]
+	return mi.MessageOf(x)
 }
 
-func (x *_QueryAccountLocksResponse_1_list) NewElement() protoreflect.Value {
-	v := new(AccountLocksResource)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAccountLocksResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryAccountLocksResponse            protoreflect.MessageDescriptor
-	fd_QueryAccountLocksResponse_accounts   protoreflect.FieldDescriptor
-	fd_QueryAccountLocksResponse_pagination protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_optio_lockup_query_proto_init()
-	md_QueryAccountLocksResponse = File_optio_lockup_query_proto.Messages().ByName("QueryAccountLocksResponse")
-	fd_QueryAccountLocksResponse_accounts = md_QueryAccountLocksResponse.Fields().ByName("accounts")
-	fd_QueryAccountLocksResponse_pagination = md_QueryAccountLocksResponse.Fields().ByName("pagination")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryAccountLocksResponse)(nil)
-
-type fastReflection_QueryAccountLocksResponse QueryAccountLocksResponse
-
-func (x *QueryAccountLocksResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAccountLocksResponse)(x)
-}
-
-func (x *QueryAccountLocksResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_optio_lockup_query_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_QueryAccountLocksResponse_messageType fastReflection_QueryAccountLocksResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAccountLocksResponse_messageType{}
+var _fastReflection_QueryTotalLockedAmountRequest_messageType fastReflection_QueryTotalLockedAmountRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTotalLockedAmountRequest_messageType{}
 
-type fastReflection_QueryAccountLocksResponse_messageType struct{}
+type fastReflection_QueryTotalLockedAmountRequest_messageType struct{}
 
-func (x fastReflection_QueryAccountLocksResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAccountLocksResponse)(nil)
+func (x fastReflection_QueryTotalLockedAmountRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTotalLockedAmountRequest)(nil)
 }
-func (x fastReflection_QueryAccountLocksResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAccountLocksResponse)
+func (x fastReflection_QueryTotalLockedAmountRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalLockedAmountRequest)
 }
-func (x fastReflection_QueryAccountLocksResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAccountLocksResponse
+func (x fastReflection_QueryTotalLockedAmountRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalLockedAmountRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAccountLocksResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAccountLocksResponse
+func (x *fastReflection_QueryTotalLockedAmountRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalLockedAmountRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAccountLocksResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAccountLocksResponse_messageType
+func (x *fastReflection_QueryTotalLockedAmountRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTotalLockedAmountRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAccountLocksResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAccountLocksResponse)
+func (x *fastReflection_QueryTotalLockedAmountRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalLockedAmountRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAccountLocksResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAccountLocksResponse)(x)
+func (x *fastReflection_QueryTotalLockedAmountRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTotalLockedAmountRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3005,19 +3247,7 @@ func (x *fastReflection_QueryAccountLocksResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAccountLocksResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Accounts) != 0 {
-		value := protoreflect.ValueOfList(&_QueryAccountLocksResponse_1_list{list: &x.Accounts})
-		if !f(fd_QueryAccountLocksResponse_accounts, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAccountLocksResponse_pagination, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryTotalLockedAmountRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3031,17 +3261,13 @@ func (x *fastReflection_QueryAccountLocksResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAccountLocksResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTotalLockedAmountRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "optio.lockup.QueryAccountLocksResponse.accounts":
-		return len(x.Accounts) != 0
-	case "optio.lockup.QueryAccountLocksResponse.pagination":
-		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3051,17 +3277,13 @@ func (x *fastReflection_QueryAccountLocksResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAccountLocksResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTotalLockedAmountRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryAccountLocksResponse.accounts":
-		x.Accounts = nil
-	case "optio.lockup.QueryAccountLocksResponse.pagination":
-		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3071,22 +3293,13 @@ func (x *fastReflection_QueryAccountLocksResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAccountLocksResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTotalLockedAmountRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "optio.lockup.QueryAccountLocksResponse.accounts":
-		if len(x.Accounts) == 0 {
-			return protoreflect.ValueOfList(&_QueryAccountLocksResponse_1_list{})
-		}
-		listValue := &_QueryAccountLocksResponse_1_list{list: &x.Accounts}
-		return protoreflect.ValueOfList(listValue)
-	case "optio.lockup.QueryAccountLocksResponse.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3100,19 +3313,13 @@ func (x *fastReflection_QueryAccountLocksResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAccountLocksResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTotalLockedAmountRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryAccountLocksResponse.accounts":
-		lv := value.List()
-		clv := lv.(*_QueryAccountLocksResponse_1_list)
-		x.Accounts = *clv.list
-	case "optio.lockup.QueryAccountLocksResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3126,53 +3333,36 @@ func (x *fastReflection_QueryAccountLocksResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAccountLocksResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTotalLockedAmountRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryAccountLocksResponse.accounts":
-		if x.Accounts == nil {
-			x.Accounts = []*AccountLocksResource{}
-		}
-		value := &_QueryAccountLocksResponse_1_list{list: &x.Accounts}
-		return protoreflect.ValueOfList(value)
-	case "optio.lockup.QueryAccountLocksResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAccountLocksResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTotalLockedAmountRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryAccountLocksResponse.accounts":
-		list := []*AccountLocksResource{}
-		return protoreflect.ValueOfList(&_QueryAccountLocksResponse_1_list{list: &list})
-	case "optio.lockup.QueryAccountLocksResponse.pagination":
-		m := new(v1beta1.PageResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAccountLocksResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTotalLockedAmountRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryAccountLocksResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryTotalLockedAmountRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3180,7 +3370,7 @@ func (x *fastReflection_QueryAccountLocksResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAccountLocksResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTotalLockedAmountRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3191,7 +3381,7 @@ func (x *fastReflection_QueryAccountLocksResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAccountLocksResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTotalLockedAmountRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3203,7 +3393,7 @@ func (x *fastReflection_QueryAccountLocksResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAccountLocksResponse) IsValid() bool {
+func (x *fastReflection_QueryTotalLockedAmountRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3213,9 +3403,9 @@ func (x *fastReflection_QueryAccountLocksResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAccountLocksResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTotalLockedAmountRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAccountLocksResponse)
+		x := input.Message.Interface().(*QueryTotalLockedAmountRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3227,16 +3417,6 @@ func (x *fastReflection_QueryAccountLocksResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if len(x.Accounts) > 0 {
-			for _, e := range x.Accounts {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3247,7 +3427,7 @@ func (x *fastReflection_QueryAccountLocksResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAccountLocksResponse)
+		x := input.Message.Interface().(*QueryTotalLockedAmountRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3266,36 +3446,6 @@ func (x *fastReflection_QueryAccountLocksResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Accounts) > 0 {
-			for iNdEx := len(x.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Accounts[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3307,7 +3457,7 @@ func (x *fastReflection_QueryAccountLocksResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAccountLocksResponse)
+		x := input.Message.Interface().(*QueryTotalLockedAmountRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3339,93 +3489,23 @@ func (x *fastReflection_QueryAccountLocksResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountLocksResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedAmountRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if msglen < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Accounts = append(x.Accounts, &AccountLocksResource{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Accounts[len(x.Accounts)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
 					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
@@ -3450,79 +3530,79 @@ func (x *fastReflection_QueryAccountLocksResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.List = (*_AccountLocksResource_2_list)(nil)
+var _ protoreflect.List = (*_QueryTotalLockedAmountResponse_2_list)(nil)
 
-type _AccountLocksResource_2_list struct {
-	list *[]*LockResource
+type _QueryTotalLockedAmountResponse_2_list struct {
+	list *[]*v1beta11.Coin
 }
 
-func (x *_AccountLocksResource_2_list) Len() int {
+func (x *_QueryTotalLockedAmountResponse_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_AccountLocksResource_2_list) Get(i int) protoreflect.Value {
+func (x *_QueryTotalLockedAmountResponse_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_AccountLocksResource_2_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryTotalLockedAmountResponse_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*LockResource)
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_AccountLocksResource_2_list) Append(value protoreflect.Value) {
+func (x *_QueryTotalLockedAmountResponse_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*LockResource)
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_AccountLocksResource_2_list) AppendMutable() protoreflect.Value {
-	v := new(LockResource)
+func (x *_QueryTotalLockedAmountResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_AccountLocksResource_2_list) Truncate(n int) {
+func (x *_QueryTotalLockedAmountResponse_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_AccountLocksResource_2_list) NewElement() protoreflect.Value {
-	v := new(LockResource)
+func (x *_QueryTotalLockedAmountResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_AccountLocksResource_2_list) IsValid() bool {
+func (x *_QueryTotalLockedAmountResponse_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_AccountLocksResource         protoreflect.MessageDescriptor
-	fd_AccountLocksResource_address protoreflect.FieldDescriptor
-	fd_AccountLocksResource_locks   protoreflect.FieldDescriptor
+	md_QueryTotalLockedAmountResponse                protoreflect.MessageDescriptor
+	fd_QueryTotalLockedAmountResponse_total_locked   protoreflect.FieldDescriptor
+	fd_QueryTotalLockedAmountResponse_total_escrowed protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_optio_lockup_query_proto_init()
-	md_AccountLocksResource = File_optio_lockup_query_proto.Messages().ByName("AccountLocksResource")
-	fd_AccountLocksResource_address = md_AccountLocksResource.Fields().ByName("address")
-	fd_AccountLocksResource_locks = md_AccountLocksResource.Fields().ByName("locks")
+	md_QueryTotalLockedAmountResponse = File_optio_lockup_query_proto.Messages().ByName("QueryTotalLockedAmountResponse")
+	fd_QueryTotalLockedAmountResponse_total_locked = md_QueryTotalLockedAmountResponse.Fields().ByName("total_locked")
+	fd_QueryTotalLockedAmountResponse_total_escrowed = md_QueryTotalLockedAmountResponse.Fields().ByName("total_escrowed")
 }
 
-var _ protoreflect.Message = (*fastReflection_AccountLocksResource)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTotalLockedAmountResponse)(nil)
 
-type fastReflection_AccountLocksResource AccountLocksResource
+type fastReflection_QueryTotalLockedAmountResponse QueryTotalLockedAmountResponse
 
-func (x *AccountLocksResource) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AccountLocksResource)(x)
+func (x *QueryTotalLockedAmountResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTotalLockedAmountResponse)(x)
 }
 
-func (x *AccountLocksResource) slowProtoReflect() protoreflect.Message {
+func (x *QueryTotalLockedAmountResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_optio_lockup_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3534,43 +3614,43 @@ func (x *AccountLocksResource) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AccountLocksResource_messageType fastReflection_AccountLocksResource_messageType
-var _ protoreflect.MessageType = fastReflection_AccountLocksResource_messageType{}
+var _fastReflection_QueryTotalLockedAmountResponse_messageType fastReflection_QueryTotalLockedAmountResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTotalLockedAmountResponse_messageType{}
 
-type fastReflection_AccountLocksResource_messageType struct{}
+type fastReflection_QueryTotalLockedAmountResponse_messageType struct{}
 
-func (x fastReflection_AccountLocksResource_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AccountLocksResource)(nil)
+func (x fastReflection_QueryTotalLockedAmountResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTotalLockedAmountResponse)(nil)
 }
-func (x fastReflection_AccountLocksResource_messageType) New() protoreflect.Message {
-	return new(fastReflection_AccountLocksResource)
+func (x fastReflection_QueryTotalLockedAmountResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalLockedAmountResponse)
 }
-func (x fastReflection_AccountLocksResource_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccountLocksResource
+func (x fastReflection_QueryTotalLockedAmountResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalLockedAmountResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AccountLocksResource) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccountLocksResource
+func (x *fastReflection_QueryTotalLockedAmountResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalLockedAmountResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AccountLocksResource) Type() protoreflect.MessageType {
-	return _fastReflection_AccountLocksResource_messageType
+func (x *fastReflection_QueryTotalLockedAmountResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTotalLockedAmountResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AccountLocksResource) New() protoreflect.Message {
-	return new(fastReflection_AccountLocksResource)
+func (x *fastReflection_QueryTotalLockedAmountResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalLockedAmountResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AccountLocksResource) Interface() protoreflect.ProtoMessage {
-	return (*AccountLocksResource)(x)
+func (x *fastReflection_QueryTotalLockedAmountResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTotalLockedAmountResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3578,16 +3658,16 @@ func (x *fastReflection_AccountLocksResource) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AccountLocksResource) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_AccountLocksResource_address, value) {
+func (x *fastReflection_QueryTotalLockedAmountResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TotalLocked != nil {
+		value := protoreflect.ValueOfMessage(x.TotalLocked.ProtoReflect())
+		if !f(fd_QueryTotalLockedAmountResponse_total_locked, value) {
 			return
 		}
 	}
-	if len(x.Locks) != 0 {
-		value := protoreflect.ValueOfList(&_AccountLocksResource_2_list{list: &x.Locks})
-		if !f(fd_AccountLocksResource_locks, value) {
+	if len(x.TotalEscrowed) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTotalLockedAmountResponse_2_list{list: &x.TotalEscrowed})
+		if !f(fd_QueryTotalLockedAmountResponse_total_escrowed, value) {
 			return
 		}
 	}
@@ -3604,17 +3684,17 @@ func (x *fastReflection_AccountLocksResource) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AccountLocksResource) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTotalLockedAmountResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "optio.lockup.AccountLocksResource.address":
-		return x.Address != ""
-	case "optio.lockup.AccountLocksResource.locks":
-		return len(x.Locks) != 0
+	case "optio.lockup.QueryTotalLockedAmountResponse.total_locked":
+		return x.TotalLocked != nil
+	case "optio.lockup.QueryTotalLockedAmountResponse.total_escrowed":
+		return len(x.TotalEscrowed) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.AccountLocksResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.AccountLocksResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3624,17 +3704,17 @@ func (x *fastReflection_AccountLocksResource) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccountLocksResource) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTotalLockedAmountResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "optio.lockup.AccountLocksResource.address":
-		x.Address = ""
-	case "optio.lockup.AccountLocksResource.locks":
-		x.Locks = nil
+	case "optio.lockup.QueryTotalLockedAmountResponse.total_locked":
+		x.TotalLocked = nil
+	case "optio.lockup.QueryTotalLockedAmountResponse.total_escrowed":
+		x.TotalEscrowed = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.AccountLocksResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.AccountLocksResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3644,22 +3724,22 @@ func (x *fastReflection_AccountLocksResource) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AccountLocksResource) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTotalLockedAmountResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "optio.lockup.AccountLocksResource.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "optio.lockup.AccountLocksResource.locks":
-		if len(x.Locks) == 0 {
-			return protoreflect.ValueOfList(&_AccountLocksResource_2_list{})
+	case "optio.lockup.QueryTotalLockedAmountResponse.total_locked":
+		value := x.TotalLocked
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.QueryTotalLockedAmountResponse.total_escrowed":
+		if len(x.TotalEscrowed) == 0 {
+			return protoreflect.ValueOfList(&_QueryTotalLockedAmountResponse_2_list{})
 		}
-		listValue := &_AccountLocksResource_2_list{list: &x.Locks}
+		listValue := &_QueryTotalLockedAmountResponse_2_list{list: &x.TotalEscrowed}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.AccountLocksResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.AccountLocksResource does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3673,19 +3753,19 @@ func (x *fastReflection_AccountLocksResource) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccountLocksResource) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTotalLockedAmountResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "optio.lockup.AccountLocksResource.address":
-		x.Address = value.Interface().(string)
-	case "optio.lockup.AccountLocksResource.locks":
+	case "optio.lockup.QueryTotalLockedAmountResponse.total_locked":
+		x.TotalLocked = value.Message().Interface().(*v1beta11.Coin)
+	case "optio.lockup.QueryTotalLockedAmountResponse.total_escrowed":
 		lv := value.List()
-		clv := lv.(*_AccountLocksResource_2_list)
-		x.Locks = *clv.list
+		clv := lv.(*_QueryTotalLockedAmountResponse_2_list)
+		x.TotalEscrowed = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.AccountLocksResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.AccountLocksResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3699,49 +3779,53 @@ func (x *fastReflection_AccountLocksResource) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccountLocksResource) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTotalLockedAmountResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.AccountLocksResource.locks":
-		if x.Locks == nil {
-			x.Locks = []*LockResource{}
+	case "optio.lockup.QueryTotalLockedAmountResponse.total_locked":
+		if x.TotalLocked == nil {
+			x.TotalLocked = new(v1beta11.Coin)
 		}
-		value := &_AccountLocksResource_2_list{list: &x.Locks}
+		return protoreflect.ValueOfMessage(x.TotalLocked.ProtoReflect())
+	case "optio.lockup.QueryTotalLockedAmountResponse.total_escrowed":
+		if x.TotalEscrowed == nil {
+			x.TotalEscrowed = []*v1beta11.Coin{}
+		}
+		value := &_QueryTotalLockedAmountResponse_2_list{list: &x.TotalEscrowed}
 		return protoreflect.ValueOfList(value)
-	case "optio.lockup.AccountLocksResource.address":
-		panic(fmt.Errorf("field address of message optio.lockup.AccountLocksResource is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.AccountLocksResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.AccountLocksResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AccountLocksResource) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTotalLockedAmountResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.AccountLocksResource.address":
-		return protoreflect.ValueOfString("")
-	case "optio.lockup.AccountLocksResource.locks":
-		list := []*LockResource{}
-		return protoreflect.ValueOfList(&_AccountLocksResource_2_list{list: &list})
+	case "optio.lockup.QueryTotalLockedAmountResponse.total_locked":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.QueryTotalLockedAmountResponse.total_escrowed":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryTotalLockedAmountResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.AccountLocksResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryTotalLockedAmountResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.AccountLocksResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryTotalLockedAmountResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AccountLocksResource) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTotalLockedAmountResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.AccountLocksResource", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryTotalLockedAmountResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3749,7 +3833,7 @@ func (x *fastReflection_AccountLocksResource) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AccountLocksResource) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTotalLockedAmountResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3760,7 +3844,7 @@ func (x *fastReflection_AccountLocksResource) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccountLocksResource) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTotalLockedAmountResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3772,7 +3856,7 @@ func (x *fastReflection_AccountLocksResource) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AccountLocksResource) IsValid() bool {
+func (x *fastReflection_QueryTotalLockedAmountResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3782,9 +3866,9 @@ func (x *fastReflection_AccountLocksResource) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AccountLocksResource) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTotalLockedAmountResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AccountLocksResource)
+		x := input.Message.Interface().(*QueryTotalLockedAmountResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3796,12 +3880,12 @@ func (x *fastReflection_AccountLocksResource) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
-		if l > 0 {
+		if x.TotalLocked != nil {
+			l = options.Size(x.TotalLocked)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Locks) > 0 {
-			for _, e := range x.Locks {
+		if len(x.TotalEscrowed) > 0 {
+			for _, e := range x.TotalEscrowed {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -3816,7 +3900,7 @@ func (x *fastReflection_AccountLocksResource) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AccountLocksResource)
+		x := input.Message.Interface().(*QueryTotalLockedAmountResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3835,9 +3919,9 @@ func (x *fastReflection_AccountLocksResource) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Locks) > 0 {
-			for iNdEx := len(x.Locks) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Locks[iNdEx])
+		if len(x.TotalEscrowed) > 0 {
+			for iNdEx := len(x.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalEscrowed[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3851,25 +3935,32 @@ func (x *fastReflection_AccountLocksResource) ProtoMethods() *protoiface.Methods
 				dAtA[i] = 0x12
 			}
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
+		if x.TotalLocked != nil {
+			encoded, err := options.Marshal(x.TotalLocked)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
 			Buf:               input.Buf,
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AccountLocksResource)
+		x := input.Message.Interface().(*QueryTotalLockedAmountResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3901,17 +3992,17 @@ func (x *fastReflection_AccountLocksResource) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccountLocksResource: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedAmountResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccountLocksResource: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3921,27 +4012,31 @@ func (x *fastReflection_AccountLocksResource) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				if x.TotalLocked == nil {
+					x.TotalLocked = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalLocked); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalEscrowed", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3968,8 +4063,8 @@ func (x *fastReflection_AccountLocksResource) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Locks = append(x.Locks, &LockResource{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Locks[len(x.Locks)-1]); err != nil {
+				x.TotalEscrowed = append(x.TotalEscrowed, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalEscrowed[len(x.TotalEscrowed)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4008,28 +4103,76 @@ func (x *fastReflection_AccountLocksResource) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_QueryAccountLocksRequest_1_list)(nil)
+
+type _QueryAccountLocksRequest_1_list struct {
+	list *[]string
+}
+
+func (x *_QueryAccountLocksRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAccountLocksRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryAccountLocksRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAccountLocksRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAccountLocksRequest_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryAccountLocksRequest at list field Addresses as it is not of Message kind"))
+}
+
+func (x *_QueryAccountLocksRequest_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAccountLocksRequest_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryAccountLocksRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_LockResource             protoreflect.MessageDescriptor
-	fd_LockResource_unlock_date protoreflect.FieldDescriptor
-	fd_LockResource_amount      protoreflect.FieldDescriptor
+	md_QueryAccountLocksRequest            protoreflect.MessageDescriptor
+	fd_QueryAccountLocksRequest_addresses  protoreflect.FieldDescriptor
+	fd_QueryAccountLocksRequest_pagination protoreflect.FieldDescriptor
+	fd_QueryAccountLocksRequest_filter     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_optio_lockup_query_proto_init()
-	md_LockResource = File_optio_lockup_query_proto.Messages().ByName("LockResource")
-	fd_LockResource_unlock_date = md_LockResource.Fields().ByName("unlock_date")
-	fd_LockResource_amount = md_LockResource.Fields().ByName("amount")
+	md_QueryAccountLocksRequest = File_optio_lockup_query_proto.Messages().ByName("QueryAccountLocksRequest")
+	fd_QueryAccountLocksRequest_addresses = md_QueryAccountLocksRequest.Fields().ByName("addresses")
+	fd_QueryAccountLocksRequest_pagination = md_QueryAccountLocksRequest.Fields().ByName("pagination")
+	fd_QueryAccountLocksRequest_filter = md_QueryAccountLocksRequest.Fields().ByName("filter")
 }
 
-var _ protoreflect.Message = (*fastReflection_LockResource)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAccountLocksRequest)(nil)
 
-type fastReflection_LockResource LockResource
+type fastReflection_QueryAccountLocksRequest QueryAccountLocksRequest
 
-func (x *LockResource) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_LockResource)(x)
+func (x *QueryAccountLocksRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAccountLocksRequest)(x)
 }
 
-func (x *LockResource) slowProtoReflect() protoreflect.Message {
+func (x *QueryAccountLocksRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_optio_lockup_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4041,43 +4184,43 @@ func (x *LockResource) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_LockResource_messageType fastReflection_LockResource_messageType
-var _ protoreflect.MessageType = fastReflection_LockResource_messageType{}
+var _fastReflection_QueryAccountLocksRequest_messageType fastReflection_QueryAccountLocksRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAccountLocksRequest_messageType{}
 
-type fastReflection_LockResource_messageType struct{}
+type fastReflection_QueryAccountLocksRequest_messageType struct{}
 
-func (x fastReflection_LockResource_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_LockResource)(nil)
+func (x fastReflection_QueryAccountLocksRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAccountLocksRequest)(nil)
 }
-func (x fastReflection_LockResource_messageType) New() protoreflect.Message {
-	return new(fastReflection_LockResource)
+func (x fastReflection_QueryAccountLocksRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAccountLocksRequest)
 }
-func (x fastReflection_LockResource_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_LockResource
+func (x fastReflection_QueryAccountLocksRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAccountLocksRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_LockResource) Descriptor() protoreflect.MessageDescriptor {
-	return md_LockResource
+func (x *fastReflection_QueryAccountLocksRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAccountLocksRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_LockResource) Type() protoreflect.MessageType {
-	return _fastReflection_LockResource_messageType
+func (x *fastReflection_QueryAccountLocksRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAccountLocksRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_LockResource) New() protoreflect.Message {
-	return new(fastReflection_LockResource)
+func (x *fastReflection_QueryAccountLocksRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAccountLocksRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_LockResource) Interface() protoreflect.ProtoMessage {
-	return (*LockResource)(x)
+func (x *fastReflection_QueryAccountLocksRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAccountLocksRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4085,16 +4228,22 @@ func (x *fastReflection_LockResource) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_LockResource) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.UnlockDate != "" {
-		value := protoreflect.ValueOfString(x.UnlockDate)
-		if !f(fd_LockResource_unlock_date, value) {
+func (x *fastReflection_QueryAccountLocksRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Addresses) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAccountLocksRequest_1_list{list: &x.Addresses})
+		if !f(fd_QueryAccountLocksRequest_addresses, value) {
 			return
 		}
 	}
-	if x.Amount != nil {
-		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
-		if !f(fd_LockResource_amount, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAccountLocksRequest_pagination, value) {
+			return
+		}
+	}
+	if x.Filter != nil {
+		value := protoreflect.ValueOfMessage(x.Filter.ProtoReflect())
+		if !f(fd_QueryAccountLocksRequest_filter, value) {
 			return
 		}
 	}
@@ -4111,17 +4260,19 @@ func (x *fastReflection_LockResource) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_LockResource) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAccountLocksRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "optio.lockup.LockResource.unlock_date":
-		return x.UnlockDate != ""
-	case "optio.lockup.LockResource.amount":
-		return x.Amount != nil
+	case "optio.lockup.QueryAccountLocksRequest.addresses":
+		return len(x.Addresses) != 0
+	case "optio.lockup.QueryAccountLocksRequest.pagination":
+		return x.Pagination != nil
+	case "optio.lockup.QueryAccountLocksRequest.filter":
+		return x.Filter != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.LockResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4131,17 +4282,19 @@ func (x *fastReflection_LockResource) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LockResource) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAccountLocksRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "optio.lockup.LockResource.unlock_date":
-		x.UnlockDate = ""
-	case "optio.lockup.LockResource.amount":
-		x.Amount = nil
+	case "optio.lockup.QueryAccountLocksRequest.addresses":
+		x.Addresses = nil
+	case "optio.lockup.QueryAccountLocksRequest.pagination":
+		x.Pagination = nil
+	case "optio.lockup.QueryAccountLocksRequest.filter":
+		x.Filter = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.LockResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4151,19 +4304,25 @@ func (x *fastReflection_LockResource) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_LockResource) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAccountLocksRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "optio.lockup.LockResource.unlock_date":
-		value := x.UnlockDate
-		return protoreflect.ValueOfString(value)
-	case "optio.lockup.LockResource.amount":
-		value := x.Amount
+	case "optio.lockup.QueryAccountLocksRequest.addresses":
+		if len(x.Addresses) == 0 {
+			return protoreflect.ValueOfList(&_QueryAccountLocksRequest_1_list{})
+		}
+		listValue := &_QueryAccountLocksRequest_1_list{list: &x.Addresses}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.lockup.QueryAccountLocksRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.QueryAccountLocksRequest.filter":
+		value := x.Filter
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.LockResource does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4177,17 +4336,21 @@ func (x *fastReflection_LockResource) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LockResource) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAccountLocksRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "optio.lockup.LockResource.unlock_date":
-		x.UnlockDate = value.Interface().(string)
-	case "optio.lockup.LockResource.amount":
-		x.Amount = value.Message().Interface().(*v1beta11.Coin)
+	case "optio.lockup.QueryAccountLocksRequest.addresses":
+		lv := value.List()
+		clv := lv.(*_QueryAccountLocksRequest_1_list)
+		x.Addresses = *clv.list
+	case "optio.lockup.QueryAccountLocksRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "optio.lockup.QueryAccountLocksRequest.filter":
+		x.Filter = value.Message().Interface().(*LockFilter)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.LockResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4201,48 +4364,61 @@ func (x *fastReflection_LockResource) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LockResource) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAccountLocksRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.LockResource.amount":
-		if x.Amount == nil {
-			x.Amount = new(v1beta11.Coin)
+	case "optio.lockup.QueryAccountLocksRequest.addresses":
+		if x.Addresses == nil {
+			x.Addresses = []string{}
 		}
-		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
-	case "optio.lockup.LockResource.unlock_date":
-		panic(fmt.Errorf("field unlock_date of message optio.lockup.LockResource is not mutable"))
+		value := &_QueryAccountLocksRequest_1_list{list: &x.Addresses}
+		return protoreflect.ValueOfList(value)
+	case "optio.lockup.QueryAccountLocksRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "optio.lockup.QueryAccountLocksRequest.filter":
+		if x.Filter == nil {
+			x.Filter = new(LockFilter)
+		}
+		return protoreflect.ValueOfMessage(x.Filter.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.LockResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_LockResource) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAccountLocksRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.LockResource.unlock_date":
-		return protoreflect.ValueOfString("")
-	case "optio.lockup.LockResource.amount":
-		m := new(v1beta11.Coin)
+	case "optio.lockup.QueryAccountLocksRequest.addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryAccountLocksRequest_1_list{list: &list})
+	case "optio.lockup.QueryAccountLocksRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.QueryAccountLocksRequest.filter":
+		m := new(LockFilter)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockResource"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksRequest"))
 		}
-		panic(fmt.Errorf("message optio.lockup.LockResource does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_LockResource) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAccountLocksRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.LockResource", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryAccountLocksRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4250,7 +4426,7 @@ func (x *fastReflection_LockResource) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_LockResource) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAccountLocksRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4261,7 +4437,7 @@ func (x *fastReflection_LockResource) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_LockResource) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAccountLocksRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4273,7 +4449,7 @@ func (x *fastReflection_LockResource) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_LockResource) IsValid() bool {
+func (x *fastReflection_QueryAccountLocksRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4283,9 +4459,9 @@ func (x *fastReflection_LockResource) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_LockResource) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAccountLocksRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*LockResource)
+		x := input.Message.Interface().(*QueryAccountLocksRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4297,12 +4473,18 @@ func (x *fastReflection_LockResource) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.UnlockDate)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Addresses) > 0 {
+			for _, s := range x.Addresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.Amount != nil {
-			l = options.Size(x.Amount)
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Filter != nil {
+			l = options.Size(x.Filter)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4315,7 +4497,7 @@ func (x *fastReflection_LockResource) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*LockResource)
+		x := input.Message.Interface().(*QueryAccountLocksRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4334,8 +4516,8 @@ func (x *fastReflection_LockResource) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Amount != nil {
-			encoded, err := options.Marshal(x.Amount)
+		if x.Filter != nil {
+			encoded, err := options.Marshal(x.Filter)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4348,12 +4530,28 @@ func (x *fastReflection_LockResource) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.UnlockDate) > 0 {
-			i -= len(x.UnlockDate)
-			copy(dAtA[i:], x.UnlockDate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UnlockDate)))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Addresses) > 0 {
+			for iNdEx := len(x.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Addresses[iNdEx])
+				copy(dAtA[i:], x.Addresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Addresses[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4366,7 +4564,7 @@ func (x *fastReflection_LockResource) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*LockResource)
+		x := input.Message.Interface().(*QueryAccountLocksRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4398,15 +4596,15 @@ func (x *fastReflection_LockResource) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockResource: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountLocksRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockResource: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4434,11 +4632,47 @@ func (x *fastReflection_LockResource) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.UnlockDate = string(dAtA[iNdEx:postIndex])
+				x.Addresses = append(x.Addresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4465,10 +4699,10 @@ func (x *fastReflection_LockResource) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Amount == nil {
-					x.Amount = &v1beta11.Coin{}
+				if x.Filter == nil {
+					x.Filter = &LockFilter{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Filter); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4507,28 +4741,79 @@ func (x *fastReflection_LockResource) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_QueryAccountLocksResponse_1_list)(nil)
+
+type _QueryAccountLocksResponse_1_list struct {
+	list *[]*AccountLocksResource
+}
+
+func (x *_QueryAccountLocksResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAccountLocksResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAccountLocksResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccountLocksResource)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAccountLocksResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccountLocksResource)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAccountLocksResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(AccountLocksResource)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountLocksResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAccountLocksResponse_1_list) NewElement() protoreflect.Value {
+	v := new(AccountLocksResource)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountLocksResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryLocksRequest            protoreflect.MessageDescriptor
-	fd_QueryLocksRequest_address    protoreflect.FieldDescriptor
-	fd_QueryLocksRequest_pagination protoreflect.FieldDescriptor
+	md_QueryAccountLocksResponse            protoreflect.MessageDescriptor
+	fd_QueryAccountLocksResponse_accounts   protoreflect.FieldDescriptor
+	fd_QueryAccountLocksResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_optio_lockup_query_proto_init()
-	md_QueryLocksRequest = File_optio_lockup_query_proto.Messages().ByName("QueryLocksRequest")
-	fd_QueryLocksRequest_address = md_QueryLocksRequest.Fields().ByName("address")
-	fd_QueryLocksRequest_pagination = md_QueryLocksRequest.Fields().ByName("pagination")
+	md_QueryAccountLocksResponse = File_optio_lockup_query_proto.Messages().ByName("QueryAccountLocksResponse")
+	fd_QueryAccountLocksResponse_accounts = md_QueryAccountLocksResponse.Fields().ByName("accounts")
+	fd_QueryAccountLocksResponse_pagination = md_QueryAccountLocksResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryLocksRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAccountLocksResponse)(nil)
 
-type fastReflection_QueryLocksRequest QueryLocksRequest
+type fastReflection_QueryAccountLocksResponse QueryAccountLocksResponse
 
-func (x *QueryLocksRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLocksRequest)(x)
+func (x *QueryAccountLocksResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAccountLocksResponse)(x)
 }
 
-func (x *QueryLocksRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryAccountLocksResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_optio_lockup_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4540,43 +4825,43 @@ func (x *QueryLocksRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLocksRequest_messageType fastReflection_QueryLocksRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLocksRequest_messageType{}
+var _fastReflection_QueryAccountLocksResponse_messageType fastReflection_QueryAccountLocksResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAccountLocksResponse_messageType{}
 
-type fastReflection_QueryLocksRequest_messageType struct{}
+type fastReflection_QueryAccountLocksResponse_messageType struct{}
 
-func (x fastReflection_QueryLocksRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLocksRequest)(nil)
+func (x fastReflection_QueryAccountLocksResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAccountLocksResponse)(nil)
 }
-func (x fastReflection_QueryLocksRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLocksRequest)
+func (x fastReflection_QueryAccountLocksResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAccountLocksResponse)
 }
-func (x fastReflection_QueryLocksRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLocksRequest
+func (x fastReflection_QueryAccountLocksResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAccountLocksResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLocksRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLocksRequest
+func (x *fastReflection_QueryAccountLocksResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAccountLocksResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLocksRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLocksRequest_messageType
+func (x *fastReflection_QueryAccountLocksResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAccountLocksResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLocksRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryLocksRequest)
+func (x *fastReflection_QueryAccountLocksResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAccountLocksResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLocksRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryLocksRequest)(x)
+func (x *fastReflection_QueryAccountLocksResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAccountLocksResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4584,16 +4869,16 @@ func (x *fastReflection_QueryLocksRequest) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLocksRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_QueryLocksRequest_address, value) {
+func (x *fastReflection_QueryAccountLocksResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Accounts) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAccountLocksResponse_1_list{list: &x.Accounts})
+		if !f(fd_QueryAccountLocksResponse_accounts, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryLocksRequest_pagination, value) {
+		if !f(fd_QueryAccountLocksResponse_pagination, value) {
 			return
 		}
 	}
@@ -4610,17 +4895,17 @@ func (x *fastReflection_QueryLocksRequest) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLocksRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAccountLocksResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "optio.lockup.QueryLocksRequest.address":
-		return x.Address != ""
-	case "optio.lockup.QueryLocksRequest.pagination":
+	case "optio.lockup.QueryAccountLocksResponse.accounts":
+		return len(x.Accounts) != 0
+	case "optio.lockup.QueryAccountLocksResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4630,17 +4915,17 @@ func (x *fastReflection_QueryLocksRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLocksRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAccountLocksResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryLocksRequest.address":
-		x.Address = ""
-	case "optio.lockup.QueryLocksRequest.pagination":
+	case "optio.lockup.QueryAccountLocksResponse.accounts":
+		x.Accounts = nil
+	case "optio.lockup.QueryAccountLocksResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4650,19 +4935,22 @@ func (x *fastReflection_QueryLocksRequest) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLocksRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAccountLocksResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "optio.lockup.QueryLocksRequest.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "optio.lockup.QueryLocksRequest.pagination":
+	case "optio.lockup.QueryAccountLocksResponse.accounts":
+		if len(x.Accounts) == 0 {
+			return protoreflect.ValueOfList(&_QueryAccountLocksResponse_1_list{})
+		}
+		listValue := &_QueryAccountLocksResponse_1_list{list: &x.Accounts}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.lockup.QueryAccountLocksResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryLocksRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4676,17 +4964,19 @@ func (x *fastReflection_QueryLocksRequest) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLocksRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAccountLocksResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryLocksRequest.address":
-		x.Address = value.Interface().(string)
-	case "optio.lockup.QueryLocksRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "optio.lockup.QueryAccountLocksResponse.accounts":
+		lv := value.List()
+		clv := lv.(*_QueryAccountLocksResponse_1_list)
+		x.Accounts = *clv.list
+	case "optio.lockup.QueryAccountLocksResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4700,48 +4990,53 @@ func (x *fastReflection_QueryLocksRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLocksRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAccountLocksResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryLocksRequest.pagination":
+	case "optio.lockup.QueryAccountLocksResponse.accounts":
+		if x.Accounts == nil {
+			x.Accounts = []*AccountLocksResource{}
+		}
+		value := &_QueryAccountLocksResponse_1_list{list: &x.Accounts}
+		return protoreflect.ValueOfList(value)
+	case "optio.lockup.QueryAccountLocksResponse.pagination":
 		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
+			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "optio.lockup.QueryLocksRequest.address":
-		panic(fmt.Errorf("field address of message optio.lockup.QueryLocksRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLocksRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAccountLocksResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryLocksRequest.address":
-		return protoreflect.ValueOfString("")
-	case "optio.lockup.QueryLocksRequest.pagination":
-		m := new(v1beta1.PageRequest)
+	case "optio.lockup.QueryAccountLocksResponse.accounts":
+		list := []*AccountLocksResource{}
+		return protoreflect.ValueOfList(&_QueryAccountLocksResponse_1_list{list: &list})
+	case "optio.lockup.QueryAccountLocksResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountLocksResponse"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryLocksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.QueryAccountLocksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLocksRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAccountLocksResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryLocksRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryAccountLocksResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4749,7 +5044,7 @@ func (x *fastReflection_QueryLocksRequest) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLocksRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAccountLocksResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4760,7 +5055,7 @@ func (x *fastReflection_QueryLocksRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLocksRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAccountLocksResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4772,7 +5067,7 @@ func (x *fastReflection_QueryLocksRequest) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLocksRequest) IsValid() bool {
+func (x *fastReflection_QueryAccountLocksResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4782,9 +5077,9 @@ func (x *fastReflection_QueryLocksRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLocksRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAccountLocksResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLocksRequest)
+		x := input.Message.Interface().(*QueryAccountLocksResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4796,9 +5091,11 @@ func (x *fastReflection_QueryLocksRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Accounts) > 0 {
+			for _, e := range x.Accounts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
@@ -4814,7 +5111,7 @@ func (x *fastReflection_QueryLocksRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLocksRequest)
+		x := input.Message.Interface().(*QueryAccountLocksResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4847,12 +5144,21 @@ func (x *fastReflection_QueryLocksRequest) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.Accounts) > 0 {
+			for iNdEx := len(x.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Accounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4865,7 +5171,7 @@ func (x *fastReflection_QueryLocksRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLocksRequest)
+		x := input.Message.Interface().(*QueryAccountLocksResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4897,17 +5203,17 @@ func (x *fastReflection_QueryLocksRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLocksRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountLocksResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4917,23 +5223,25 @@ func (x *fastReflection_QueryLocksRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.Accounts = append(x.Accounts, &AccountLocksResource{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Accounts[len(x.Accounts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -4965,7 +5273,7 @@ func (x *fastReflection_QueryLocksRequest) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
+					x.Pagination = &v1beta1.PageResponse{}
 				}
 				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
@@ -5006,79 +5314,79 @@ func (x *fastReflection_QueryLocksRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_QueryLocksResponse_1_list)(nil)
+var _ protoreflect.List = (*_AccountLocksResource_2_list)(nil)
 
-type _QueryLocksResponse_1_list struct {
+type _AccountLocksResource_2_list struct {
 	list *[]*LockResource
 }
 
-func (x *_QueryLocksResponse_1_list) Len() int {
+func (x *_AccountLocksResource_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryLocksResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_AccountLocksResource_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryLocksResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_AccountLocksResource_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*LockResource)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryLocksResponse_1_list) Append(value protoreflect.Value) {
+func (x *_AccountLocksResource_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*LockResource)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryLocksResponse_1_list) AppendMutable() protoreflect.Value {
+func (x *_AccountLocksResource_2_list) AppendMutable() protoreflect.Value {
 	v := new(LockResource)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryLocksResponse_1_list) Truncate(n int) {
+func (x *_AccountLocksResource_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryLocksResponse_1_list) NewElement() protoreflect.Value {
+func (x *_AccountLocksResource_2_list) NewElement() protoreflect.Value {
 	v := new(LockResource)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryLocksResponse_1_list) IsValid() bool {
+func (x *_AccountLocksResource_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryLocksResponse            protoreflect.MessageDescriptor
-	fd_QueryLocksResponse_locks      protoreflect.FieldDescriptor
-	fd_QueryLocksResponse_pagination protoreflect.FieldDescriptor
+	md_AccountLocksResource         protoreflect.MessageDescriptor
+	fd_AccountLocksResource_address protoreflect.FieldDescriptor
+	fd_AccountLocksResource_locks   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_optio_lockup_query_proto_init()
-	md_QueryLocksResponse = File_optio_lockup_query_proto.Messages().ByName("QueryLocksResponse")
-	fd_QueryLocksResponse_locks = md_QueryLocksResponse.Fields().ByName("locks")
-	fd_QueryLocksResponse_pagination = md_QueryLocksResponse.Fields().ByName("pagination")
+	md_AccountLocksResource = File_optio_lockup_query_proto.Messages().ByName("AccountLocksResource")
+	fd_AccountLocksResource_address = md_AccountLocksResource.Fields().ByName("address")
+	fd_AccountLocksResource_locks = md_AccountLocksResource.Fields().ByName("locks")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryLocksResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_AccountLocksResource)(nil)
 
-type fastReflection_QueryLocksResponse QueryLocksResponse
+type fastReflection_AccountLocksResource AccountLocksResource
 
-func (x *QueryLocksResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLocksResponse)(x)
+func (x *AccountLocksResource) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccountLocksResource)(x)
 }
 
-func (x *QueryLocksResponse) slowProtoReflect() protoreflect.Message {
+func (x *AccountLocksResource) slowProtoReflect() protoreflect.Message {
 	mi := &file_optio_lockup_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5090,43 +5398,43 @@ func (x *QueryLocksResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLocksResponse_messageType fastReflection_QueryLocksResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLocksResponse_messageType{}
+var _fastReflection_AccountLocksResource_messageType fastReflection_AccountLocksResource_messageType
+var _ protoreflect.MessageType = fastReflection_AccountLocksResource_messageType{}
 
-type fastReflection_QueryLocksResponse_messageType struct{}
+type fastReflection_AccountLocksResource_messageType struct{}
 
-func (x fastReflection_QueryLocksResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLocksResponse)(nil)
+func (x fastReflection_AccountLocksResource_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccountLocksResource)(nil)
 }
-func (x fastReflection_QueryLocksResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLocksResponse)
+func (x fastReflection_AccountLocksResource_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccountLocksResource)
 }
-func (x fastReflection_QueryLocksResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLocksResponse
+func (x fastReflection_AccountLocksResource_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccountLocksResource
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLocksResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLocksResponse
+func (x *fastReflection_AccountLocksResource) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccountLocksResource
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLocksResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLocksResponse_messageType
+func (x *fastReflection_AccountLocksResource) Type() protoreflect.MessageType {
+	return _fastReflection_AccountLocksResource_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLocksResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryLocksResponse)
+func (x *fastReflection_AccountLocksResource) New() protoreflect.Message {
+	return new(fastReflection_AccountLocksResource)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLocksResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryLocksResponse)(x)
+func (x *fastReflection_AccountLocksResource) Interface() protoreflect.ProtoMessage {
+	return (*AccountLocksResource)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5134,16 +5442,16 @@ func (x *fastReflection_QueryLocksResponse) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLocksResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Locks) != 0 {
-		value := protoreflect.ValueOfList(&_QueryLocksResponse_1_list{list: &x.Locks})
-		if !f(fd_QueryLocksResponse_locks, value) {
+func (x *fastReflection_AccountLocksResource) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_AccountLocksResource_address, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryLocksResponse_pagination, value) {
+	if len(x.Locks) != 0 {
+		value := protoreflect.ValueOfList(&_AccountLocksResource_2_list{list: &x.Locks})
+		if !f(fd_AccountLocksResource_locks, value) {
 			return
 		}
 	}
@@ -5160,17 +5468,17 @@ func (x *fastReflection_QueryLocksResponse) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLocksResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AccountLocksResource) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "optio.lockup.QueryLocksResponse.locks":
+	case "optio.lockup.AccountLocksResource.address":
+		return x.Address != ""
+	case "optio.lockup.AccountLocksResource.locks":
 		return len(x.Locks) != 0
-	case "optio.lockup.QueryLocksResponse.pagination":
-		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.AccountLocksResource"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.AccountLocksResource does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5180,17 +5488,17 @@ func (x *fastReflection_QueryLocksResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLocksResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AccountLocksResource) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryLocksResponse.locks":
+	case "optio.lockup.AccountLocksResource.address":
+		x.Address = ""
+	case "optio.lockup.AccountLocksResource.locks":
 		x.Locks = nil
-	case "optio.lockup.QueryLocksResponse.pagination":
-		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.AccountLocksResource"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.AccountLocksResource does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5200,22 +5508,22 @@ func (x *fastReflection_QueryLocksResponse) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLocksResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccountLocksResource) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "optio.lockup.QueryLocksResponse.locks":
+	case "optio.lockup.AccountLocksResource.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.AccountLocksResource.locks":
 		if len(x.Locks) == 0 {
-			return protoreflect.ValueOfList(&_QueryLocksResponse_1_list{})
+			return protoreflect.ValueOfList(&_AccountLocksResource_2_list{})
 		}
-		listValue := &_QueryLocksResponse_1_list{list: &x.Locks}
+		listValue := &_AccountLocksResource_2_list{list: &x.Locks}
 		return protoreflect.ValueOfList(listValue)
-	case "optio.lockup.QueryLocksResponse.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.AccountLocksResource"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryLocksResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message optio.lockup.AccountLocksResource does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5229,19 +5537,19 @@ func (x *fastReflection_QueryLocksResponse) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLocksResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AccountLocksResource) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "optio.lockup.QueryLocksResponse.locks":
+	case "optio.lockup.AccountLocksResource.address":
+		x.Address = value.Interface().(string)
+	case "optio.lockup.AccountLocksResource.locks":
 		lv := value.List()
-		clv := lv.(*_QueryLocksResponse_1_list)
+		clv := lv.(*_AccountLocksResource_2_list)
 		x.Locks = *clv.list
-	case "optio.lockup.QueryLocksResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.AccountLocksResource"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.AccountLocksResource does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5255,53 +5563,49 @@ func (x *fastReflection_QueryLocksResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLocksResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccountLocksResource) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryLocksResponse.locks":
+	case "optio.lockup.AccountLocksResource.locks":
 		if x.Locks == nil {
 			x.Locks = []*LockResource{}
 		}
-		value := &_QueryLocksResponse_1_list{list: &x.Locks}
+		value := &_AccountLocksResource_2_list{list: &x.Locks}
 		return protoreflect.ValueOfList(value)
-	case "optio.lockup.QueryLocksResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "optio.lockup.AccountLocksResource.address":
+		panic(fmt.Errorf("field address of message optio.lockup.AccountLocksResource is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.AccountLocksResource"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.AccountLocksResource does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLocksResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccountLocksResource) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "optio.lockup.QueryLocksResponse.locks":
+	case "optio.lockup.AccountLocksResource.address":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.AccountLocksResource.locks":
 		list := []*LockResource{}
-		return protoreflect.ValueOfList(&_QueryLocksResponse_1_list{list: &list})
-	case "optio.lockup.QueryLocksResponse.pagination":
-		m := new(v1beta1.PageResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+		return protoreflect.ValueOfList(&_AccountLocksResource_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.AccountLocksResource"))
 		}
-		panic(fmt.Errorf("message optio.lockup.QueryLocksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message optio.lockup.AccountLocksResource does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLocksResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AccountLocksResource) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryLocksResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.AccountLocksResource", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5309,7 +5613,7 @@ func (x *fastReflection_QueryLocksResponse) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLocksResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AccountLocksResource) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5320,7 +5624,7 @@ func (x *fastReflection_QueryLocksResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLocksResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AccountLocksResource) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5332,7 +5636,7 @@ func (x *fastReflection_QueryLocksResponse) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLocksResponse) IsValid() bool {
+func (x *fastReflection_AccountLocksResource) IsValid() bool {
 	return x != nil
 }
 
@@ -5342,9 +5646,9 @@ func (x *fastReflection_QueryLocksResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLocksResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AccountLocksResource) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLocksResponse)
+		x := input.Message.Interface().(*AccountLocksResource)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5356,16 +5660,16 @@ func (x *fastReflection_QueryLocksResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if len(x.Locks) > 0 {
 			for _, e := range x.Locks {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5376,7 +5680,7 @@ func (x *fastReflection_QueryLocksResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLocksResponse)
+		x := input.Message.Interface().(*AccountLocksResource)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5395,20 +5699,6 @@ func (x *fastReflection_QueryLocksResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
 		if len(x.Locks) > 0 {
 			for iNdEx := len(x.Locks) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Locks[iNdEx])
@@ -5422,9 +5712,16 @@ func (x *fastReflection_QueryLocksResponse) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x12
 			}
 		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5436,7 +5733,7 @@ func (x *fastReflection_QueryLocksResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLocksResponse)
+		x := input.Message.Interface().(*AccountLocksResource)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5468,17 +5765,17 @@ func (x *fastReflection_QueryLocksResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLocksResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccountLocksResource: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccountLocksResource: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5488,29 +5785,27 @@ func (x *fastReflection_QueryLocksResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Locks = append(x.Locks, &LockResource{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Locks[len(x.Locks)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5537,10 +5832,8 @@ func (x *fastReflection_QueryLocksResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				x.Locks = append(x.Locks, &LockResource{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Locks[len(x.Locks)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5579,471 +5872,10345 @@ func (x *fastReflection_QueryLocksResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: optio/lockup/query.proto
+var (
+	md_LockResource             protoreflect.MessageDescriptor
+	fd_LockResource_unlock_date protoreflect.FieldDescriptor
+	fd_LockResource_amount      protoreflect.FieldDescriptor
+	fd_LockResource_auto_renew  protoreflect.FieldDescriptor
+	fd_LockResource_kind        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_LockResource = File_optio_lockup_query_proto.Messages().ByName("LockResource")
+	fd_LockResource_unlock_date = md_LockResource.Fields().ByName("unlock_date")
+	fd_LockResource_amount = md_LockResource.Fields().ByName("amount")
+	fd_LockResource_auto_renew = md_LockResource.Fields().ByName("auto_renew")
+	fd_LockResource_kind = md_LockResource.Fields().ByName("kind")
+}
+
+var _ protoreflect.Message = (*fastReflection_LockResource)(nil)
+
+type fastReflection_LockResource LockResource
+
+func (x *LockResource) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LockResource)(x)
+}
+
+func (x *LockResource) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LockResource_messageType fastReflection_LockResource_messageType
+var _ protoreflect.MessageType = fastReflection_LockResource_messageType{}
+
+type fastReflection_LockResource_messageType struct{}
+
+func (x fastReflection_LockResource_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LockResource)(nil)
+}
+func (x fastReflection_LockResource_messageType) New() protoreflect.Message {
+	return new(fastReflection_LockResource)
+}
+func (x fastReflection_LockResource_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockResource
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LockResource) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockResource
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LockResource) Type() protoreflect.MessageType {
+	return _fastReflection_LockResource_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LockResource) New() protoreflect.Message {
+	return new(fastReflection_LockResource)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LockResource) Interface() protoreflect.ProtoMessage {
+	return (*LockResource)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LockResource) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.UnlockDate != "" {
+		value := protoreflect.ValueOfString(x.UnlockDate)
+		if !f(fd_LockResource_unlock_date, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_LockResource_amount, value) {
+			return
+		}
+	}
+	if x.AutoRenew != false {
+		value := protoreflect.ValueOfBool(x.AutoRenew)
+		if !f(fd_LockResource_auto_renew, value) {
+			return
+		}
+	}
+	if x.Kind != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Kind))
+		if !f(fd_LockResource_kind, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LockResource) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.LockResource.unlock_date":
+		return x.UnlockDate != ""
+	case "optio.lockup.LockResource.amount":
+		return x.Amount != nil
+	case "optio.lockup.LockResource.auto_renew":
+		return x.AutoRenew != false
+	case "optio.lockup.LockResource.kind":
+		return x.Kind != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockResource"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockResource does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockResource) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.LockResource.unlock_date":
+		x.UnlockDate = ""
+	case "optio.lockup.LockResource.amount":
+		x.Amount = nil
+	case "optio.lockup.LockResource.auto_renew":
+		x.AutoRenew = false
+	case "optio.lockup.LockResource.kind":
+		x.Kind = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockResource"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockResource does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LockResource) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.LockResource.unlock_date":
+		value := x.UnlockDate
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.LockResource.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.LockResource.auto_renew":
+		value := x.AutoRenew
+		return protoreflect.ValueOfBool(value)
+	case "optio.lockup.LockResource.kind":
+		value := x.Kind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockResource"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockResource does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockResource) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.LockResource.unlock_date":
+		x.UnlockDate = value.Interface().(string)
+	case "optio.lockup.LockResource.amount":
+		x.Amount = value.Message().Interface().(*v1beta11.Coin)
+	case "optio.lockup.LockResource.auto_renew":
+		x.AutoRenew = value.Bool()
+	case "optio.lockup.LockResource.kind":
+		x.Kind = (LockKind)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockResource"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockResource does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockResource) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.LockResource.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "optio.lockup.LockResource.unlock_date":
+		panic(fmt.Errorf("field unlock_date of message optio.lockup.LockResource is not mutable"))
+	case "optio.lockup.LockResource.auto_renew":
+		panic(fmt.Errorf("field auto_renew of message optio.lockup.LockResource is not mutable"))
+	case "optio.lockup.LockResource.kind":
+		panic(fmt.Errorf("field kind of message optio.lockup.LockResource is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockResource"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockResource does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LockResource) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.LockResource.unlock_date":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.LockResource.amount":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.LockResource.auto_renew":
+		return protoreflect.ValueOfBool(false)
+	case "optio.lockup.LockResource.kind":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.LockResource"))
+		}
+		panic(fmt.Errorf("message optio.lockup.LockResource does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LockResource) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.LockResource", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LockResource) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockResource) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LockResource) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LockResource) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LockResource)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.UnlockDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoRenew {
+			n += 2
+		}
+		if x.Kind != 0 {
+			n += 1 + runtime.Sov(uint64(x.Kind))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LockResource)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Kind != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Kind))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AutoRenew {
+			i--
+			if x.AutoRenew {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.UnlockDate) > 0 {
+			i -= len(x.UnlockDate)
+			copy(dAtA[i:], x.UnlockDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UnlockDate)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LockResource)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockResource: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnlockDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoRenew = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				x.Kind = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Kind |= LockKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryLocksRequest            protoreflect.MessageDescriptor
+	fd_QueryLocksRequest_address    protoreflect.FieldDescriptor
+	fd_QueryLocksRequest_pagination protoreflect.FieldDescriptor
+	fd_QueryLocksRequest_filter     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryLocksRequest = File_optio_lockup_query_proto.Messages().ByName("QueryLocksRequest")
+	fd_QueryLocksRequest_address = md_QueryLocksRequest.Fields().ByName("address")
+	fd_QueryLocksRequest_pagination = md_QueryLocksRequest.Fields().ByName("pagination")
+	fd_QueryLocksRequest_filter = md_QueryLocksRequest.Fields().ByName("filter")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLocksRequest)(nil)
+
+type fastReflection_QueryLocksRequest QueryLocksRequest
+
+func (x *QueryLocksRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLocksRequest)(x)
+}
+
+func (x *QueryLocksRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLocksRequest_messageType fastReflection_QueryLocksRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLocksRequest_messageType{}
+
+type fastReflection_QueryLocksRequest_messageType struct{}
+
+func (x fastReflection_QueryLocksRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLocksRequest)(nil)
+}
+func (x fastReflection_QueryLocksRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLocksRequest)
+}
+func (x fastReflection_QueryLocksRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLocksRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLocksRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLocksRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLocksRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLocksRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLocksRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLocksRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLocksRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLocksRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLocksRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryLocksRequest_address, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryLocksRequest_pagination, value) {
+			return
+		}
+	}
+	if x.Filter != nil {
+		value := protoreflect.ValueOfMessage(x.Filter.ProtoReflect())
+		if !f(fd_QueryLocksRequest_filter, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLocksRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLocksRequest.address":
+		return x.Address != ""
+	case "optio.lockup.QueryLocksRequest.pagination":
+		return x.Pagination != nil
+	case "optio.lockup.QueryLocksRequest.filter":
+		return x.Filter != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLocksRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLocksRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLocksRequest.address":
+		x.Address = ""
+	case "optio.lockup.QueryLocksRequest.pagination":
+		x.Pagination = nil
+	case "optio.lockup.QueryLocksRequest.filter":
+		x.Filter = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLocksRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLocksRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.QueryLocksRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.QueryLocksRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.QueryLocksRequest.filter":
+		value := x.Filter
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLocksRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLocksRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLocksRequest.address":
+		x.Address = value.Interface().(string)
+	case "optio.lockup.QueryLocksRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "optio.lockup.QueryLocksRequest.filter":
+		x.Filter = value.Message().Interface().(*LockFilter)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLocksRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLocksRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLocksRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "optio.lockup.QueryLocksRequest.filter":
+		if x.Filter == nil {
+			x.Filter = new(LockFilter)
+		}
+		return protoreflect.ValueOfMessage(x.Filter.ProtoReflect())
+	case "optio.lockup.QueryLocksRequest.address":
+		panic(fmt.Errorf("field address of message optio.lockup.QueryLocksRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLocksRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLocksRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLocksRequest.address":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.QueryLocksRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.QueryLocksRequest.filter":
+		m := new(LockFilter)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLocksRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLocksRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryLocksRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLocksRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLocksRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLocksRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLocksRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLocksRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Filter != nil {
+			l = options.Size(x.Filter)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLocksRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Filter != nil {
+			encoded, err := options.Marshal(x.Filter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLocksRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLocksRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Filter == nil {
+					x.Filter = &LockFilter{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Filter); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryLocksResponse_1_list)(nil)
+
+type _QueryLocksResponse_1_list struct {
+	list *[]*LockResource
+}
+
+func (x *_QueryLocksResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryLocksResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryLocksResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockResource)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryLocksResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockResource)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryLocksResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(LockResource)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLocksResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryLocksResponse_1_list) NewElement() protoreflect.Value {
+	v := new(LockResource)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLocksResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryLocksResponse            protoreflect.MessageDescriptor
+	fd_QueryLocksResponse_locks      protoreflect.FieldDescriptor
+	fd_QueryLocksResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryLocksResponse = File_optio_lockup_query_proto.Messages().ByName("QueryLocksResponse")
+	fd_QueryLocksResponse_locks = md_QueryLocksResponse.Fields().ByName("locks")
+	fd_QueryLocksResponse_pagination = md_QueryLocksResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLocksResponse)(nil)
+
+type fastReflection_QueryLocksResponse QueryLocksResponse
+
+func (x *QueryLocksResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLocksResponse)(x)
+}
+
+func (x *QueryLocksResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLocksResponse_messageType fastReflection_QueryLocksResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLocksResponse_messageType{}
+
+type fastReflection_QueryLocksResponse_messageType struct{}
+
+func (x fastReflection_QueryLocksResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLocksResponse)(nil)
+}
+func (x fastReflection_QueryLocksResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLocksResponse)
+}
+func (x fastReflection_QueryLocksResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLocksResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLocksResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLocksResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLocksResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLocksResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLocksResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLocksResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLocksResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLocksResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLocksResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Locks) != 0 {
+		value := protoreflect.ValueOfList(&_QueryLocksResponse_1_list{list: &x.Locks})
+		if !f(fd_QueryLocksResponse_locks, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryLocksResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLocksResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLocksResponse.locks":
+		return len(x.Locks) != 0
+	case "optio.lockup.QueryLocksResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLocksResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLocksResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLocksResponse.locks":
+		x.Locks = nil
+	case "optio.lockup.QueryLocksResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLocksResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLocksResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.QueryLocksResponse.locks":
+		if len(x.Locks) == 0 {
+			return protoreflect.ValueOfList(&_QueryLocksResponse_1_list{})
+		}
+		listValue := &_QueryLocksResponse_1_list{list: &x.Locks}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.lockup.QueryLocksResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLocksResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLocksResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLocksResponse.locks":
+		lv := value.List()
+		clv := lv.(*_QueryLocksResponse_1_list)
+		x.Locks = *clv.list
+	case "optio.lockup.QueryLocksResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLocksResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLocksResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLocksResponse.locks":
+		if x.Locks == nil {
+			x.Locks = []*LockResource{}
+		}
+		value := &_QueryLocksResponse_1_list{list: &x.Locks}
+		return protoreflect.ValueOfList(value)
+	case "optio.lockup.QueryLocksResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLocksResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLocksResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLocksResponse.locks":
+		list := []*LockResource{}
+		return protoreflect.ValueOfList(&_QueryLocksResponse_1_list{list: &list})
+	case "optio.lockup.QueryLocksResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLocksResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLocksResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLocksResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryLocksResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLocksResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLocksResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLocksResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLocksResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLocksResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Locks) > 0 {
+			for _, e := range x.Locks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLocksResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Locks) > 0 {
+			for iNdEx := len(x.Locks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Locks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLocksResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLocksResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Locks = append(x.Locks, &LockResource{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Locks[len(x.Locks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryLockWeightedTallyRequest             protoreflect.MessageDescriptor
+	fd_QueryLockWeightedTallyRequest_proposal_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryLockWeightedTallyRequest = File_optio_lockup_query_proto.Messages().ByName("QueryLockWeightedTallyRequest")
+	fd_QueryLockWeightedTallyRequest_proposal_id = md_QueryLockWeightedTallyRequest.Fields().ByName("proposal_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLockWeightedTallyRequest)(nil)
+
+type fastReflection_QueryLockWeightedTallyRequest QueryLockWeightedTallyRequest
+
+func (x *QueryLockWeightedTallyRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLockWeightedTallyRequest)(x)
+}
+
+func (x *QueryLockWeightedTallyRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLockWeightedTallyRequest_messageType fastReflection_QueryLockWeightedTallyRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLockWeightedTallyRequest_messageType{}
+
+type fastReflection_QueryLockWeightedTallyRequest_messageType struct{}
+
+func (x fastReflection_QueryLockWeightedTallyRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLockWeightedTallyRequest)(nil)
+}
+func (x fastReflection_QueryLockWeightedTallyRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLockWeightedTallyRequest)
+}
+func (x fastReflection_QueryLockWeightedTallyRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockWeightedTallyRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLockWeightedTallyRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockWeightedTallyRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLockWeightedTallyRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLockWeightedTallyRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLockWeightedTallyRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLockWeightedTallyRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLockWeightedTallyRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLockWeightedTallyRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLockWeightedTallyRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryLockWeightedTallyRequest_proposal_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLockWeightedTallyRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockWeightedTallyRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockWeightedTallyRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockWeightedTallyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockWeightedTallyRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockWeightedTallyRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockWeightedTallyRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockWeightedTallyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLockWeightedTallyRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.QueryLockWeightedTallyRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockWeightedTallyRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockWeightedTallyRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockWeightedTallyRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockWeightedTallyRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockWeightedTallyRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockWeightedTallyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockWeightedTallyRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockWeightedTallyRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message optio.lockup.QueryLockWeightedTallyRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockWeightedTallyRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockWeightedTallyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLockWeightedTallyRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockWeightedTallyRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockWeightedTallyRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockWeightedTallyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLockWeightedTallyRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryLockWeightedTallyRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLockWeightedTallyRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockWeightedTallyRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLockWeightedTallyRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLockWeightedTallyRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLockWeightedTallyRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLockWeightedTallyRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLockWeightedTallyRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockWeightedTallyRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockWeightedTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryLockWeightedTallyResponse        protoreflect.MessageDescriptor
+	fd_QueryLockWeightedTallyResponse_tally  protoreflect.FieldDescriptor
+	fd_QueryLockWeightedTallyResponse_passes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryLockWeightedTallyResponse = File_optio_lockup_query_proto.Messages().ByName("QueryLockWeightedTallyResponse")
+	fd_QueryLockWeightedTallyResponse_tally = md_QueryLockWeightedTallyResponse.Fields().ByName("tally")
+	fd_QueryLockWeightedTallyResponse_passes = md_QueryLockWeightedTallyResponse.Fields().ByName("passes")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLockWeightedTallyResponse)(nil)
+
+type fastReflection_QueryLockWeightedTallyResponse QueryLockWeightedTallyResponse
+
+func (x *QueryLockWeightedTallyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLockWeightedTallyResponse)(x)
+}
+
+func (x *QueryLockWeightedTallyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLockWeightedTallyResponse_messageType fastReflection_QueryLockWeightedTallyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLockWeightedTallyResponse_messageType{}
+
+type fastReflection_QueryLockWeightedTallyResponse_messageType struct{}
+
+func (x fastReflection_QueryLockWeightedTallyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLockWeightedTallyResponse)(nil)
+}
+func (x fastReflection_QueryLockWeightedTallyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLockWeightedTallyResponse)
+}
+func (x fastReflection_QueryLockWeightedTallyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockWeightedTallyResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLockWeightedTallyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockWeightedTallyResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLockWeightedTallyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLockWeightedTallyResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLockWeightedTallyResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLockWeightedTallyResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLockWeightedTallyResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLockWeightedTallyResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLockWeightedTallyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tally != nil {
+		value := protoreflect.ValueOfMessage(x.Tally.ProtoReflect())
+		if !f(fd_QueryLockWeightedTallyResponse_tally, value) {
+			return
+		}
+	}
+	if x.Passes != false {
+		value := protoreflect.ValueOfBool(x.Passes)
+		if !f(fd_QueryLockWeightedTallyResponse_passes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLockWeightedTallyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockWeightedTallyResponse.tally":
+		return x.Tally != nil
+	case "optio.lockup.QueryLockWeightedTallyResponse.passes":
+		return x.Passes != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockWeightedTallyResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockWeightedTallyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockWeightedTallyResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockWeightedTallyResponse.tally":
+		x.Tally = nil
+	case "optio.lockup.QueryLockWeightedTallyResponse.passes":
+		x.Passes = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockWeightedTallyResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockWeightedTallyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLockWeightedTallyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.QueryLockWeightedTallyResponse.tally":
+		value := x.Tally
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.QueryLockWeightedTallyResponse.passes":
+		value := x.Passes
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockWeightedTallyResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockWeightedTallyResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockWeightedTallyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockWeightedTallyResponse.tally":
+		x.Tally = value.Message().Interface().(*v1.TallyResult)
+	case "optio.lockup.QueryLockWeightedTallyResponse.passes":
+		x.Passes = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockWeightedTallyResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockWeightedTallyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockWeightedTallyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockWeightedTallyResponse.tally":
+		if x.Tally == nil {
+			x.Tally = new(v1.TallyResult)
+		}
+		return protoreflect.ValueOfMessage(x.Tally.ProtoReflect())
+	case "optio.lockup.QueryLockWeightedTallyResponse.passes":
+		panic(fmt.Errorf("field passes of message optio.lockup.QueryLockWeightedTallyResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockWeightedTallyResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockWeightedTallyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLockWeightedTallyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockWeightedTallyResponse.tally":
+		m := new(v1.TallyResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.QueryLockWeightedTallyResponse.passes":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockWeightedTallyResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockWeightedTallyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLockWeightedTallyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryLockWeightedTallyResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLockWeightedTallyResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockWeightedTallyResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLockWeightedTallyResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLockWeightedTallyResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLockWeightedTallyResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Tally != nil {
+			l = options.Size(x.Tally)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Passes {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLockWeightedTallyResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Passes {
+			i--
+			if x.Passes {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Tally != nil {
+			encoded, err := options.Marshal(x.Tally)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLockWeightedTallyResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockWeightedTallyResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockWeightedTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Tally == nil {
+					x.Tally = &v1.TallyResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tally); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Passes", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Passes = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRewardsPoolRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryRewardsPoolRequest = File_optio_lockup_query_proto.Messages().ByName("QueryRewardsPoolRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRewardsPoolRequest)(nil)
+
+type fastReflection_QueryRewardsPoolRequest QueryRewardsPoolRequest
+
+func (x *QueryRewardsPoolRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRewardsPoolRequest)(x)
+}
+
+func (x *QueryRewardsPoolRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRewardsPoolRequest_messageType fastReflection_QueryRewardsPoolRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRewardsPoolRequest_messageType{}
+
+type fastReflection_QueryRewardsPoolRequest_messageType struct{}
+
+func (x fastReflection_QueryRewardsPoolRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRewardsPoolRequest)(nil)
+}
+func (x fastReflection_QueryRewardsPoolRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRewardsPoolRequest)
+}
+func (x fastReflection_QueryRewardsPoolRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRewardsPoolRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRewardsPoolRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRewardsPoolRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRewardsPoolRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRewardsPoolRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRewardsPoolRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRewardsPoolRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRewardsPoolRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRewardsPoolRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRewardsPoolRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRewardsPoolRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryRewardsPoolRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryRewardsPoolRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardsPoolRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryRewardsPoolRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryRewardsPoolRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRewardsPoolRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryRewardsPoolRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryRewardsPoolRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardsPoolRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryRewardsPoolRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryRewardsPoolRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardsPoolRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryRewardsPoolRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryRewardsPoolRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRewardsPoolRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryRewardsPoolRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryRewardsPoolRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRewardsPoolRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryRewardsPoolRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRewardsPoolRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardsPoolRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRewardsPoolRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRewardsPoolRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRewardsPoolRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRewardsPoolRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRewardsPoolRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRewardsPoolRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRewardsPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryRewardsPoolResponse_1_list)(nil)
+
+type _QueryRewardsPoolResponse_1_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryRewardsPoolResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryRewardsPoolResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryRewardsPoolResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryRewardsPoolResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryRewardsPoolResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRewardsPoolResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryRewardsPoolResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRewardsPoolResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryRewardsPoolResponse_2_list)(nil)
+
+type _QueryRewardsPoolResponse_2_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryRewardsPoolResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryRewardsPoolResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryRewardsPoolResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryRewardsPoolResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryRewardsPoolResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRewardsPoolResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryRewardsPoolResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRewardsPoolResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryRewardsPoolResponse             protoreflect.MessageDescriptor
+	fd_QueryRewardsPoolResponse_balance     protoreflect.FieldDescriptor
+	fd_QueryRewardsPoolResponse_unallocated protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryRewardsPoolResponse = File_optio_lockup_query_proto.Messages().ByName("QueryRewardsPoolResponse")
+	fd_QueryRewardsPoolResponse_balance = md_QueryRewardsPoolResponse.Fields().ByName("balance")
+	fd_QueryRewardsPoolResponse_unallocated = md_QueryRewardsPoolResponse.Fields().ByName("unallocated")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRewardsPoolResponse)(nil)
+
+type fastReflection_QueryRewardsPoolResponse QueryRewardsPoolResponse
+
+func (x *QueryRewardsPoolResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRewardsPoolResponse)(x)
+}
+
+func (x *QueryRewardsPoolResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRewardsPoolResponse_messageType fastReflection_QueryRewardsPoolResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRewardsPoolResponse_messageType{}
+
+type fastReflection_QueryRewardsPoolResponse_messageType struct{}
+
+func (x fastReflection_QueryRewardsPoolResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRewardsPoolResponse)(nil)
+}
+func (x fastReflection_QueryRewardsPoolResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRewardsPoolResponse)
+}
+func (x fastReflection_QueryRewardsPoolResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRewardsPoolResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRewardsPoolResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRewardsPoolResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRewardsPoolResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRewardsPoolResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRewardsPoolResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRewardsPoolResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRewardsPoolResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRewardsPoolResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRewardsPoolResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Balance) != 0 {
+		value := protoreflect.ValueOfList(&_QueryRewardsPoolResponse_1_list{list: &x.Balance})
+		if !f(fd_QueryRewardsPoolResponse_balance, value) {
+			return
+		}
+	}
+	if len(x.Unallocated) != 0 {
+		value := protoreflect.ValueOfList(&_QueryRewardsPoolResponse_2_list{list: &x.Unallocated})
+		if !f(fd_QueryRewardsPoolResponse_unallocated, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRewardsPoolResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.QueryRewardsPoolResponse.balance":
+		return len(x.Balance) != 0
+	case "optio.lockup.QueryRewardsPoolResponse.unallocated":
+		return len(x.Unallocated) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryRewardsPoolResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryRewardsPoolResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardsPoolResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryRewardsPoolResponse.balance":
+		x.Balance = nil
+	case "optio.lockup.QueryRewardsPoolResponse.unallocated":
+		x.Unallocated = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryRewardsPoolResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryRewardsPoolResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRewardsPoolResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.QueryRewardsPoolResponse.balance":
+		if len(x.Balance) == 0 {
+			return protoreflect.ValueOfList(&_QueryRewardsPoolResponse_1_list{})
+		}
+		listValue := &_QueryRewardsPoolResponse_1_list{list: &x.Balance}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.lockup.QueryRewardsPoolResponse.unallocated":
+		if len(x.Unallocated) == 0 {
+			return protoreflect.ValueOfList(&_QueryRewardsPoolResponse_2_list{})
+		}
+		listValue := &_QueryRewardsPoolResponse_2_list{list: &x.Unallocated}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryRewardsPoolResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryRewardsPoolResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardsPoolResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryRewardsPoolResponse.balance":
+		lv := value.List()
+		clv := lv.(*_QueryRewardsPoolResponse_1_list)
+		x.Balance = *clv.list
+	case "optio.lockup.QueryRewardsPoolResponse.unallocated":
+		lv := value.List()
+		clv := lv.(*_QueryRewardsPoolResponse_2_list)
+		x.Unallocated = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryRewardsPoolResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryRewardsPoolResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardsPoolResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryRewardsPoolResponse.balance":
+		if x.Balance == nil {
+			x.Balance = []*v1beta11.Coin{}
+		}
+		value := &_QueryRewardsPoolResponse_1_list{list: &x.Balance}
+		return protoreflect.ValueOfList(value)
+	case "optio.lockup.QueryRewardsPoolResponse.unallocated":
+		if x.Unallocated == nil {
+			x.Unallocated = []*v1beta11.Coin{}
+		}
+		value := &_QueryRewardsPoolResponse_2_list{list: &x.Unallocated}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryRewardsPoolResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryRewardsPoolResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRewardsPoolResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryRewardsPoolResponse.balance":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryRewardsPoolResponse_1_list{list: &list})
+	case "optio.lockup.QueryRewardsPoolResponse.unallocated":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryRewardsPoolResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryRewardsPoolResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryRewardsPoolResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRewardsPoolResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryRewardsPoolResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRewardsPoolResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardsPoolResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRewardsPoolResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRewardsPoolResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRewardsPoolResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Balance) > 0 {
+			for _, e := range x.Balance {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Unallocated) > 0 {
+			for _, e := range x.Unallocated {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRewardsPoolResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Unallocated) > 0 {
+			for iNdEx := len(x.Unallocated) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Unallocated[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Balance) > 0 {
+			for iNdEx := len(x.Balance) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Balance[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRewardsPoolResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRewardsPoolResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balance = append(x.Balance, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balance[len(x.Balance)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unallocated", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Unallocated = append(x.Unallocated, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Unallocated[len(x.Unallocated)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPendingRewardsRequest         protoreflect.MessageDescriptor
+	fd_QueryPendingRewardsRequest_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryPendingRewardsRequest = File_optio_lockup_query_proto.Messages().ByName("QueryPendingRewardsRequest")
+	fd_QueryPendingRewardsRequest_address = md_QueryPendingRewardsRequest.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPendingRewardsRequest)(nil)
+
+type fastReflection_QueryPendingRewardsRequest QueryPendingRewardsRequest
+
+func (x *QueryPendingRewardsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPendingRewardsRequest)(x)
+}
+
+func (x *QueryPendingRewardsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPendingRewardsRequest_messageType fastReflection_QueryPendingRewardsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPendingRewardsRequest_messageType{}
+
+type fastReflection_QueryPendingRewardsRequest_messageType struct{}
+
+func (x fastReflection_QueryPendingRewardsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPendingRewardsRequest)(nil)
+}
+func (x fastReflection_QueryPendingRewardsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingRewardsRequest)
+}
+func (x fastReflection_QueryPendingRewardsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingRewardsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPendingRewardsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingRewardsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPendingRewardsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPendingRewardsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPendingRewardsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingRewardsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPendingRewardsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPendingRewardsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPendingRewardsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryPendingRewardsRequest_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPendingRewardsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.QueryPendingRewardsRequest.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryPendingRewardsRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryPendingRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingRewardsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryPendingRewardsRequest.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryPendingRewardsRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryPendingRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPendingRewardsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.QueryPendingRewardsRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryPendingRewardsRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryPendingRewardsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingRewardsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryPendingRewardsRequest.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryPendingRewardsRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryPendingRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingRewardsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryPendingRewardsRequest.address":
+		panic(fmt.Errorf("field address of message optio.lockup.QueryPendingRewardsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryPendingRewardsRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryPendingRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPendingRewardsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryPendingRewardsRequest.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryPendingRewardsRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryPendingRewardsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPendingRewardsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryPendingRewardsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPendingRewardsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingRewardsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPendingRewardsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPendingRewardsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPendingRewardsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPendingRewardsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPendingRewardsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingRewardsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryPendingRewardsResponse_1_list)(nil)
+
+type _QueryPendingRewardsResponse_1_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryPendingRewardsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPendingRewardsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPendingRewardsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPendingRewardsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPendingRewardsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPendingRewardsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPendingRewardsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPendingRewardsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryPendingRewardsResponse         protoreflect.MessageDescriptor
+	fd_QueryPendingRewardsResponse_rewards protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryPendingRewardsResponse = File_optio_lockup_query_proto.Messages().ByName("QueryPendingRewardsResponse")
+	fd_QueryPendingRewardsResponse_rewards = md_QueryPendingRewardsResponse.Fields().ByName("rewards")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPendingRewardsResponse)(nil)
+
+type fastReflection_QueryPendingRewardsResponse QueryPendingRewardsResponse
+
+func (x *QueryPendingRewardsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPendingRewardsResponse)(x)
+}
+
+func (x *QueryPendingRewardsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPendingRewardsResponse_messageType fastReflection_QueryPendingRewardsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPendingRewardsResponse_messageType{}
+
+type fastReflection_QueryPendingRewardsResponse_messageType struct{}
+
+func (x fastReflection_QueryPendingRewardsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPendingRewardsResponse)(nil)
+}
+func (x fastReflection_QueryPendingRewardsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingRewardsResponse)
+}
+func (x fastReflection_QueryPendingRewardsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingRewardsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPendingRewardsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingRewardsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPendingRewardsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPendingRewardsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPendingRewardsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingRewardsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPendingRewardsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPendingRewardsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPendingRewardsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPendingRewardsResponse_1_list{list: &x.Rewards})
+		if !f(fd_QueryPendingRewardsResponse_rewards, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPendingRewardsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.QueryPendingRewardsResponse.rewards":
+		return len(x.Rewards) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryPendingRewardsResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryPendingRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingRewardsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryPendingRewardsResponse.rewards":
+		x.Rewards = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryPendingRewardsResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryPendingRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPendingRewardsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.QueryPendingRewardsResponse.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_QueryPendingRewardsResponse_1_list{})
+		}
+		listValue := &_QueryPendingRewardsResponse_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryPendingRewardsResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryPendingRewardsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingRewardsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryPendingRewardsResponse.rewards":
+		lv := value.List()
+		clv := lv.(*_QueryPendingRewardsResponse_1_list)
+		x.Rewards = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryPendingRewardsResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryPendingRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingRewardsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryPendingRewardsResponse.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*v1beta11.Coin{}
+		}
+		value := &_QueryPendingRewardsResponse_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryPendingRewardsResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryPendingRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPendingRewardsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryPendingRewardsResponse.rewards":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryPendingRewardsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryPendingRewardsResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryPendingRewardsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPendingRewardsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryPendingRewardsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPendingRewardsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingRewardsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPendingRewardsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPendingRewardsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPendingRewardsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPendingRewardsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPendingRewardsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingRewardsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rewards = append(x.Rewards, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAccountSummaryRequest         protoreflect.MessageDescriptor
+	fd_QueryAccountSummaryRequest_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryAccountSummaryRequest = File_optio_lockup_query_proto.Messages().ByName("QueryAccountSummaryRequest")
+	fd_QueryAccountSummaryRequest_address = md_QueryAccountSummaryRequest.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAccountSummaryRequest)(nil)
+
+type fastReflection_QueryAccountSummaryRequest QueryAccountSummaryRequest
+
+func (x *QueryAccountSummaryRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAccountSummaryRequest)(x)
+}
+
+func (x *QueryAccountSummaryRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAccountSummaryRequest_messageType fastReflection_QueryAccountSummaryRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAccountSummaryRequest_messageType{}
+
+type fastReflection_QueryAccountSummaryRequest_messageType struct{}
+
+func (x fastReflection_QueryAccountSummaryRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAccountSummaryRequest)(nil)
+}
+func (x fastReflection_QueryAccountSummaryRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAccountSummaryRequest)
+}
+func (x fastReflection_QueryAccountSummaryRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAccountSummaryRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAccountSummaryRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAccountSummaryRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAccountSummaryRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAccountSummaryRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAccountSummaryRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAccountSummaryRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAccountSummaryRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAccountSummaryRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAccountSummaryRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryAccountSummaryRequest_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAccountSummaryRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.QueryAccountSummaryRequest.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountSummaryRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryAccountSummaryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountSummaryRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryAccountSummaryRequest.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountSummaryRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryAccountSummaryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAccountSummaryRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.QueryAccountSummaryRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountSummaryRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryAccountSummaryRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountSummaryRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryAccountSummaryRequest.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountSummaryRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryAccountSummaryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountSummaryRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryAccountSummaryRequest.address":
+		panic(fmt.Errorf("field address of message optio.lockup.QueryAccountSummaryRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountSummaryRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryAccountSummaryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAccountSummaryRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryAccountSummaryRequest.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountSummaryRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryAccountSummaryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAccountSummaryRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryAccountSummaryRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAccountSummaryRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountSummaryRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAccountSummaryRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAccountSummaryRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAccountSummaryRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAccountSummaryRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAccountSummaryRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountSummaryRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAccountSummaryResponse_9_list)(nil)
+
+type _QueryAccountSummaryResponse_9_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryAccountSummaryResponse_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAccountSummaryResponse_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAccountSummaryResponse_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAccountSummaryResponse_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAccountSummaryResponse_9_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountSummaryResponse_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAccountSummaryResponse_9_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountSummaryResponse_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAccountSummaryResponse                        protoreflect.MessageDescriptor
+	fd_QueryAccountSummaryResponse_total_locked           protoreflect.FieldDescriptor
+	fd_QueryAccountSummaryResponse_total_delegated        protoreflect.FieldDescriptor
+	fd_QueryAccountSummaryResponse_locked_above_delegated protoreflect.FieldDescriptor
+	fd_QueryAccountSummaryResponse_balance                protoreflect.FieldDescriptor
+	fd_QueryAccountSummaryResponse_spendable_unlocked     protoreflect.FieldDescriptor
+	fd_QueryAccountSummaryResponse_next_unlock_date       protoreflect.FieldDescriptor
+	fd_QueryAccountSummaryResponse_next_unlock_amount     protoreflect.FieldDescriptor
+	fd_QueryAccountSummaryResponse_lock_count             protoreflect.FieldDescriptor
+	fd_QueryAccountSummaryResponse_total_escrowed         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryAccountSummaryResponse = File_optio_lockup_query_proto.Messages().ByName("QueryAccountSummaryResponse")
+	fd_QueryAccountSummaryResponse_total_locked = md_QueryAccountSummaryResponse.Fields().ByName("total_locked")
+	fd_QueryAccountSummaryResponse_total_delegated = md_QueryAccountSummaryResponse.Fields().ByName("total_delegated")
+	fd_QueryAccountSummaryResponse_locked_above_delegated = md_QueryAccountSummaryResponse.Fields().ByName("locked_above_delegated")
+	fd_QueryAccountSummaryResponse_balance = md_QueryAccountSummaryResponse.Fields().ByName("balance")
+	fd_QueryAccountSummaryResponse_spendable_unlocked = md_QueryAccountSummaryResponse.Fields().ByName("spendable_unlocked")
+	fd_QueryAccountSummaryResponse_next_unlock_date = md_QueryAccountSummaryResponse.Fields().ByName("next_unlock_date")
+	fd_QueryAccountSummaryResponse_next_unlock_amount = md_QueryAccountSummaryResponse.Fields().ByName("next_unlock_amount")
+	fd_QueryAccountSummaryResponse_lock_count = md_QueryAccountSummaryResponse.Fields().ByName("lock_count")
+	fd_QueryAccountSummaryResponse_total_escrowed = md_QueryAccountSummaryResponse.Fields().ByName("total_escrowed")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAccountSummaryResponse)(nil)
+
+type fastReflection_QueryAccountSummaryResponse QueryAccountSummaryResponse
+
+func (x *QueryAccountSummaryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAccountSummaryResponse)(x)
+}
+
+func (x *QueryAccountSummaryResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAccountSummaryResponse_messageType fastReflection_QueryAccountSummaryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAccountSummaryResponse_messageType{}
+
+type fastReflection_QueryAccountSummaryResponse_messageType struct{}
+
+func (x fastReflection_QueryAccountSummaryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAccountSummaryResponse)(nil)
+}
+func (x fastReflection_QueryAccountSummaryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAccountSummaryResponse)
+}
+func (x fastReflection_QueryAccountSummaryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAccountSummaryResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAccountSummaryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAccountSummaryResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAccountSummaryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAccountSummaryResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAccountSummaryResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAccountSummaryResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAccountSummaryResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAccountSummaryResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAccountSummaryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TotalLocked != nil {
+		value := protoreflect.ValueOfMessage(x.TotalLocked.ProtoReflect())
+		if !f(fd_QueryAccountSummaryResponse_total_locked, value) {
+			return
+		}
+	}
+	if x.TotalDelegated != nil {
+		value := protoreflect.ValueOfMessage(x.TotalDelegated.ProtoReflect())
+		if !f(fd_QueryAccountSummaryResponse_total_delegated, value) {
+			return
+		}
+	}
+	if x.LockedAboveDelegated != nil {
+		value := protoreflect.ValueOfMessage(x.LockedAboveDelegated.ProtoReflect())
+		if !f(fd_QueryAccountSummaryResponse_locked_above_delegated, value) {
+			return
+		}
+	}
+	if x.Balance != nil {
+		value := protoreflect.ValueOfMessage(x.Balance.ProtoReflect())
+		if !f(fd_QueryAccountSummaryResponse_balance, value) {
+			return
+		}
+	}
+	if x.SpendableUnlocked != nil {
+		value := protoreflect.ValueOfMessage(x.SpendableUnlocked.ProtoReflect())
+		if !f(fd_QueryAccountSummaryResponse_spendable_unlocked, value) {
+			return
+		}
+	}
+	if x.NextUnlockDate != "" {
+		value := protoreflect.ValueOfString(x.NextUnlockDate)
+		if !f(fd_QueryAccountSummaryResponse_next_unlock_date, value) {
+			return
+		}
+	}
+	if x.NextUnlockAmount != nil {
+		value := protoreflect.ValueOfMessage(x.NextUnlockAmount.ProtoReflect())
+		if !f(fd_QueryAccountSummaryResponse_next_unlock_amount, value) {
+			return
+		}
+	}
+	if x.LockCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.LockCount)
+		if !f(fd_QueryAccountSummaryResponse_lock_count, value) {
+			return
+		}
+	}
+	if len(x.TotalEscrowed) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAccountSummaryResponse_9_list{list: &x.TotalEscrowed})
+		if !f(fd_QueryAccountSummaryResponse_total_escrowed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAccountSummaryResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.QueryAccountSummaryResponse.total_locked":
+		return x.TotalLocked != nil
+	case "optio.lockup.QueryAccountSummaryResponse.total_delegated":
+		return x.TotalDelegated != nil
+	case "optio.lockup.QueryAccountSummaryResponse.locked_above_delegated":
+		return x.LockedAboveDelegated != nil
+	case "optio.lockup.QueryAccountSummaryResponse.balance":
+		return x.Balance != nil
+	case "optio.lockup.QueryAccountSummaryResponse.spendable_unlocked":
+		return x.SpendableUnlocked != nil
+	case "optio.lockup.QueryAccountSummaryResponse.next_unlock_date":
+		return x.NextUnlockDate != ""
+	case "optio.lockup.QueryAccountSummaryResponse.next_unlock_amount":
+		return x.NextUnlockAmount != nil
+	case "optio.lockup.QueryAccountSummaryResponse.lock_count":
+		return x.LockCount != uint32(0)
+	case "optio.lockup.QueryAccountSummaryResponse.total_escrowed":
+		return len(x.TotalEscrowed) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountSummaryResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryAccountSummaryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountSummaryResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryAccountSummaryResponse.total_locked":
+		x.TotalLocked = nil
+	case "optio.lockup.QueryAccountSummaryResponse.total_delegated":
+		x.TotalDelegated = nil
+	case "optio.lockup.QueryAccountSummaryResponse.locked_above_delegated":
+		x.LockedAboveDelegated = nil
+	case "optio.lockup.QueryAccountSummaryResponse.balance":
+		x.Balance = nil
+	case "optio.lockup.QueryAccountSummaryResponse.spendable_unlocked":
+		x.SpendableUnlocked = nil
+	case "optio.lockup.QueryAccountSummaryResponse.next_unlock_date":
+		x.NextUnlockDate = ""
+	case "optio.lockup.QueryAccountSummaryResponse.next_unlock_amount":
+		x.NextUnlockAmount = nil
+	case "optio.lockup.QueryAccountSummaryResponse.lock_count":
+		x.LockCount = uint32(0)
+	case "optio.lockup.QueryAccountSummaryResponse.total_escrowed":
+		x.TotalEscrowed = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountSummaryResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryAccountSummaryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAccountSummaryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.QueryAccountSummaryResponse.total_locked":
+		value := x.TotalLocked
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.total_delegated":
+		value := x.TotalDelegated
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.locked_above_delegated":
+		value := x.LockedAboveDelegated
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.balance":
+		value := x.Balance
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.spendable_unlocked":
+		value := x.SpendableUnlocked
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.next_unlock_date":
+		value := x.NextUnlockDate
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.QueryAccountSummaryResponse.next_unlock_amount":
+		value := x.NextUnlockAmount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.lock_count":
+		value := x.LockCount
+		return protoreflect.ValueOfUint32(value)
+	case "optio.lockup.QueryAccountSummaryResponse.total_escrowed":
+		if len(x.TotalEscrowed) == 0 {
+			return protoreflect.ValueOfList(&_QueryAccountSummaryResponse_9_list{})
+		}
+		listValue := &_QueryAccountSummaryResponse_9_list{list: &x.TotalEscrowed}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountSummaryResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryAccountSummaryResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountSummaryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryAccountSummaryResponse.total_locked":
+		x.TotalLocked = value.Message().Interface().(*v1beta11.Coin)
+	case "optio.lockup.QueryAccountSummaryResponse.total_delegated":
+		x.TotalDelegated = value.Message().Interface().(*v1beta11.Coin)
+	case "optio.lockup.QueryAccountSummaryResponse.locked_above_delegated":
+		x.LockedAboveDelegated = value.Message().Interface().(*v1beta11.Coin)
+	case "optio.lockup.QueryAccountSummaryResponse.balance":
+		x.Balance = value.Message().Interface().(*v1beta11.Coin)
+	case "optio.lockup.QueryAccountSummaryResponse.spendable_unlocked":
+		x.SpendableUnlocked = value.Message().Interface().(*v1beta11.Coin)
+	case "optio.lockup.QueryAccountSummaryResponse.next_unlock_date":
+		x.NextUnlockDate = value.Interface().(string)
+	case "optio.lockup.QueryAccountSummaryResponse.next_unlock_amount":
+		x.NextUnlockAmount = value.Message().Interface().(*v1beta11.Coin)
+	case "optio.lockup.QueryAccountSummaryResponse.lock_count":
+		x.LockCount = uint32(value.Uint())
+	case "optio.lockup.QueryAccountSummaryResponse.total_escrowed":
+		lv := value.List()
+		clv := lv.(*_QueryAccountSummaryResponse_9_list)
+		x.TotalEscrowed = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountSummaryResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryAccountSummaryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountSummaryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryAccountSummaryResponse.total_locked":
+		if x.TotalLocked == nil {
+			x.TotalLocked = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TotalLocked.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.total_delegated":
+		if x.TotalDelegated == nil {
+			x.TotalDelegated = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TotalDelegated.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.locked_above_delegated":
+		if x.LockedAboveDelegated == nil {
+			x.LockedAboveDelegated = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.LockedAboveDelegated.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.balance":
+		if x.Balance == nil {
+			x.Balance = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Balance.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.spendable_unlocked":
+		if x.SpendableUnlocked == nil {
+			x.SpendableUnlocked = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.SpendableUnlocked.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.next_unlock_amount":
+		if x.NextUnlockAmount == nil {
+			x.NextUnlockAmount = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.NextUnlockAmount.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.total_escrowed":
+		if x.TotalEscrowed == nil {
+			x.TotalEscrowed = []*v1beta11.Coin{}
+		}
+		value := &_QueryAccountSummaryResponse_9_list{list: &x.TotalEscrowed}
+		return protoreflect.ValueOfList(value)
+	case "optio.lockup.QueryAccountSummaryResponse.next_unlock_date":
+		panic(fmt.Errorf("field next_unlock_date of message optio.lockup.QueryAccountSummaryResponse is not mutable"))
+	case "optio.lockup.QueryAccountSummaryResponse.lock_count":
+		panic(fmt.Errorf("field lock_count of message optio.lockup.QueryAccountSummaryResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountSummaryResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryAccountSummaryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAccountSummaryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryAccountSummaryResponse.total_locked":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.total_delegated":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.locked_above_delegated":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.balance":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.spendable_unlocked":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.next_unlock_date":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.QueryAccountSummaryResponse.next_unlock_amount":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.QueryAccountSummaryResponse.lock_count":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "optio.lockup.QueryAccountSummaryResponse.total_escrowed":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryAccountSummaryResponse_9_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryAccountSummaryResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryAccountSummaryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAccountSummaryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryAccountSummaryResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAccountSummaryResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountSummaryResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAccountSummaryResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAccountSummaryResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAccountSummaryResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TotalLocked != nil {
+			l = options.Size(x.TotalLocked)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TotalDelegated != nil {
+			l = options.Size(x.TotalDelegated)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LockedAboveDelegated != nil {
+			l = options.Size(x.LockedAboveDelegated)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Balance != nil {
+			l = options.Size(x.Balance)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SpendableUnlocked != nil {
+			l = options.Size(x.SpendableUnlocked)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NextUnlockDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NextUnlockAmount != nil {
+			l = options.Size(x.NextUnlockAmount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LockCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.LockCount))
+		}
+		if len(x.TotalEscrowed) > 0 {
+			for _, e := range x.TotalEscrowed {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAccountSummaryResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TotalEscrowed) > 0 {
+			for iNdEx := len(x.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalEscrowed[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.LockCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockCount))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.NextUnlockAmount != nil {
+			encoded, err := options.Marshal(x.NextUnlockAmount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.NextUnlockDate) > 0 {
+			i -= len(x.NextUnlockDate)
+			copy(dAtA[i:], x.NextUnlockDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NextUnlockDate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.SpendableUnlocked != nil {
+			encoded, err := options.Marshal(x.SpendableUnlocked)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Balance != nil {
+			encoded, err := options.Marshal(x.Balance)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.LockedAboveDelegated != nil {
+			encoded, err := options.Marshal(x.LockedAboveDelegated)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.TotalDelegated != nil {
+			encoded, err := options.Marshal(x.TotalDelegated)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TotalLocked != nil {
+			encoded, err := options.Marshal(x.TotalLocked)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAccountSummaryResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountSummaryResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalLocked == nil {
+					x.TotalLocked = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalLocked); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalDelegated", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalDelegated == nil {
+					x.TotalDelegated = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalDelegated); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedAboveDelegated", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LockedAboveDelegated == nil {
+					x.LockedAboveDelegated = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockedAboveDelegated); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Balance == nil {
+					x.Balance = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balance); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendableUnlocked", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SpendableUnlocked == nil {
+					x.SpendableUnlocked = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpendableUnlocked); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextUnlockDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NextUnlockDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextUnlockAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NextUnlockAmount == nil {
+					x.NextUnlockAmount = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NextUnlockAmount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockCount", wireType)
+				}
+				x.LockCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LockCount |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalEscrowed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalEscrowed = append(x.TotalEscrowed, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalEscrowed[len(x.TotalEscrowed)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryLockHistoryRequest            protoreflect.MessageDescriptor
+	fd_QueryLockHistoryRequest_address    protoreflect.FieldDescriptor
+	fd_QueryLockHistoryRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryLockHistoryRequest = File_optio_lockup_query_proto.Messages().ByName("QueryLockHistoryRequest")
+	fd_QueryLockHistoryRequest_address = md_QueryLockHistoryRequest.Fields().ByName("address")
+	fd_QueryLockHistoryRequest_pagination = md_QueryLockHistoryRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLockHistoryRequest)(nil)
+
+type fastReflection_QueryLockHistoryRequest QueryLockHistoryRequest
+
+func (x *QueryLockHistoryRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLockHistoryRequest)(x)
+}
+
+func (x *QueryLockHistoryRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLockHistoryRequest_messageType fastReflection_QueryLockHistoryRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLockHistoryRequest_messageType{}
+
+type fastReflection_QueryLockHistoryRequest_messageType struct{}
+
+func (x fastReflection_QueryLockHistoryRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLockHistoryRequest)(nil)
+}
+func (x fastReflection_QueryLockHistoryRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLockHistoryRequest)
+}
+func (x fastReflection_QueryLockHistoryRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockHistoryRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLockHistoryRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockHistoryRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLockHistoryRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLockHistoryRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLockHistoryRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLockHistoryRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLockHistoryRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLockHistoryRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLockHistoryRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryLockHistoryRequest_address, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryLockHistoryRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLockHistoryRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockHistoryRequest.address":
+		return x.Address != ""
+	case "optio.lockup.QueryLockHistoryRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockHistoryRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockHistoryRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockHistoryRequest.address":
+		x.Address = ""
+	case "optio.lockup.QueryLockHistoryRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockHistoryRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLockHistoryRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.QueryLockHistoryRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.QueryLockHistoryRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockHistoryRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockHistoryRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockHistoryRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockHistoryRequest.address":
+		x.Address = value.Interface().(string)
+	case "optio.lockup.QueryLockHistoryRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockHistoryRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockHistoryRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockHistoryRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "optio.lockup.QueryLockHistoryRequest.address":
+		panic(fmt.Errorf("field address of message optio.lockup.QueryLockHistoryRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockHistoryRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLockHistoryRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockHistoryRequest.address":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.QueryLockHistoryRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockHistoryRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLockHistoryRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryLockHistoryRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLockHistoryRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockHistoryRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLockHistoryRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLockHistoryRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLockHistoryRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLockHistoryRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLockHistoryRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockHistoryRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryLockHistoryResponse_1_list)(nil)
+
+type _QueryLockHistoryResponse_1_list struct {
+	list *[]*LockHistoryEntry
+}
+
+func (x *_QueryLockHistoryResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryLockHistoryResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryLockHistoryResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockHistoryEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryLockHistoryResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockHistoryEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryLockHistoryResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(LockHistoryEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLockHistoryResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryLockHistoryResponse_1_list) NewElement() protoreflect.Value {
+	v := new(LockHistoryEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLockHistoryResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryLockHistoryResponse            protoreflect.MessageDescriptor
+	fd_QueryLockHistoryResponse_entries    protoreflect.FieldDescriptor
+	fd_QueryLockHistoryResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryLockHistoryResponse = File_optio_lockup_query_proto.Messages().ByName("QueryLockHistoryResponse")
+	fd_QueryLockHistoryResponse_entries = md_QueryLockHistoryResponse.Fields().ByName("entries")
+	fd_QueryLockHistoryResponse_pagination = md_QueryLockHistoryResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLockHistoryResponse)(nil)
+
+type fastReflection_QueryLockHistoryResponse QueryLockHistoryResponse
+
+func (x *QueryLockHistoryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLockHistoryResponse)(x)
+}
+
+func (x *QueryLockHistoryResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLockHistoryResponse_messageType fastReflection_QueryLockHistoryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLockHistoryResponse_messageType{}
+
+type fastReflection_QueryLockHistoryResponse_messageType struct{}
+
+func (x fastReflection_QueryLockHistoryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLockHistoryResponse)(nil)
+}
+func (x fastReflection_QueryLockHistoryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLockHistoryResponse)
+}
+func (x fastReflection_QueryLockHistoryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockHistoryResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLockHistoryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockHistoryResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLockHistoryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLockHistoryResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLockHistoryResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLockHistoryResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLockHistoryResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLockHistoryResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLockHistoryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_QueryLockHistoryResponse_1_list{list: &x.Entries})
+		if !f(fd_QueryLockHistoryResponse_entries, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryLockHistoryResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLockHistoryResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockHistoryResponse.entries":
+		return len(x.Entries) != 0
+	case "optio.lockup.QueryLockHistoryResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockHistoryResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockHistoryResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockHistoryResponse.entries":
+		x.Entries = nil
+	case "optio.lockup.QueryLockHistoryResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockHistoryResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLockHistoryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.QueryLockHistoryResponse.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_QueryLockHistoryResponse_1_list{})
+		}
+		listValue := &_QueryLockHistoryResponse_1_list{list: &x.Entries}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.lockup.QueryLockHistoryResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockHistoryResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockHistoryResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockHistoryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockHistoryResponse.entries":
+		lv := value.List()
+		clv := lv.(*_QueryLockHistoryResponse_1_list)
+		x.Entries = *clv.list
+	case "optio.lockup.QueryLockHistoryResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockHistoryResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockHistoryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockHistoryResponse.entries":
+		if x.Entries == nil {
+			x.Entries = []*LockHistoryEntry{}
+		}
+		value := &_QueryLockHistoryResponse_1_list{list: &x.Entries}
+		return protoreflect.ValueOfList(value)
+	case "optio.lockup.QueryLockHistoryResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockHistoryResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLockHistoryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryLockHistoryResponse.entries":
+		list := []*LockHistoryEntry{}
+		return protoreflect.ValueOfList(&_QueryLockHistoryResponse_1_list{list: &list})
+	case "optio.lockup.QueryLockHistoryResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryLockHistoryResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryLockHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLockHistoryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryLockHistoryResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLockHistoryResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLockHistoryResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLockHistoryResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLockHistoryResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLockHistoryResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLockHistoryResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLockHistoryResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockHistoryResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Entries = append(x.Entries, &LockHistoryEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryUnlockScheduleRequest            protoreflect.MessageDescriptor
+	fd_QueryUnlockScheduleRequest_start_date protoreflect.FieldDescriptor
+	fd_QueryUnlockScheduleRequest_end_date   protoreflect.FieldDescriptor
+	fd_QueryUnlockScheduleRequest_bucket     protoreflect.FieldDescriptor
+	fd_QueryUnlockScheduleRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryUnlockScheduleRequest = File_optio_lockup_query_proto.Messages().ByName("QueryUnlockScheduleRequest")
+	fd_QueryUnlockScheduleRequest_start_date = md_QueryUnlockScheduleRequest.Fields().ByName("start_date")
+	fd_QueryUnlockScheduleRequest_end_date = md_QueryUnlockScheduleRequest.Fields().ByName("end_date")
+	fd_QueryUnlockScheduleRequest_bucket = md_QueryUnlockScheduleRequest.Fields().ByName("bucket")
+	fd_QueryUnlockScheduleRequest_pagination = md_QueryUnlockScheduleRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryUnlockScheduleRequest)(nil)
+
+type fastReflection_QueryUnlockScheduleRequest QueryUnlockScheduleRequest
+
+func (x *QueryUnlockScheduleRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryUnlockScheduleRequest)(x)
+}
+
+func (x *QueryUnlockScheduleRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryUnlockScheduleRequest_messageType fastReflection_QueryUnlockScheduleRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryUnlockScheduleRequest_messageType{}
+
+type fastReflection_QueryUnlockScheduleRequest_messageType struct{}
+
+func (x fastReflection_QueryUnlockScheduleRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryUnlockScheduleRequest)(nil)
+}
+func (x fastReflection_QueryUnlockScheduleRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryUnlockScheduleRequest)
+}
+func (x fastReflection_QueryUnlockScheduleRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryUnlockScheduleRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryUnlockScheduleRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryUnlockScheduleRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryUnlockScheduleRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryUnlockScheduleRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryUnlockScheduleRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryUnlockScheduleRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryUnlockScheduleRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryUnlockScheduleRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryUnlockScheduleRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartDate != "" {
+		value := protoreflect.ValueOfString(x.StartDate)
+		if !f(fd_QueryUnlockScheduleRequest_start_date, value) {
+			return
+		}
+	}
+	if x.EndDate != "" {
+		value := protoreflect.ValueOfString(x.EndDate)
+		if !f(fd_QueryUnlockScheduleRequest_end_date, value) {
+			return
+		}
+	}
+	if x.Bucket != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Bucket))
+		if !f(fd_QueryUnlockScheduleRequest_bucket, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryUnlockScheduleRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryUnlockScheduleRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.QueryUnlockScheduleRequest.start_date":
+		return x.StartDate != ""
+	case "optio.lockup.QueryUnlockScheduleRequest.end_date":
+		return x.EndDate != ""
+	case "optio.lockup.QueryUnlockScheduleRequest.bucket":
+		return x.Bucket != 0
+	case "optio.lockup.QueryUnlockScheduleRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryUnlockScheduleRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryUnlockScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryUnlockScheduleRequest.start_date":
+		x.StartDate = ""
+	case "optio.lockup.QueryUnlockScheduleRequest.end_date":
+		x.EndDate = ""
+	case "optio.lockup.QueryUnlockScheduleRequest.bucket":
+		x.Bucket = 0
+	case "optio.lockup.QueryUnlockScheduleRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryUnlockScheduleRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryUnlockScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryUnlockScheduleRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.QueryUnlockScheduleRequest.start_date":
+		value := x.StartDate
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.QueryUnlockScheduleRequest.end_date":
+		value := x.EndDate
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.QueryUnlockScheduleRequest.bucket":
+		value := x.Bucket
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "optio.lockup.QueryUnlockScheduleRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryUnlockScheduleRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryUnlockScheduleRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryUnlockScheduleRequest.start_date":
+		x.StartDate = value.Interface().(string)
+	case "optio.lockup.QueryUnlockScheduleRequest.end_date":
+		x.EndDate = value.Interface().(string)
+	case "optio.lockup.QueryUnlockScheduleRequest.bucket":
+		x.Bucket = (UnlockScheduleBucket)(value.Enum())
+	case "optio.lockup.QueryUnlockScheduleRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryUnlockScheduleRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryUnlockScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryUnlockScheduleRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "optio.lockup.QueryUnlockScheduleRequest.start_date":
+		panic(fmt.Errorf("field start_date of message optio.lockup.QueryUnlockScheduleRequest is not mutable"))
+	case "optio.lockup.QueryUnlockScheduleRequest.end_date":
+		panic(fmt.Errorf("field end_date of message optio.lockup.QueryUnlockScheduleRequest is not mutable"))
+	case "optio.lockup.QueryUnlockScheduleRequest.bucket":
+		panic(fmt.Errorf("field bucket of message optio.lockup.QueryUnlockScheduleRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryUnlockScheduleRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryUnlockScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryUnlockScheduleRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryUnlockScheduleRequest.start_date":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.QueryUnlockScheduleRequest.end_date":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.QueryUnlockScheduleRequest.bucket":
+		return protoreflect.ValueOfEnum(0)
+	case "optio.lockup.QueryUnlockScheduleRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryUnlockScheduleRequest"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryUnlockScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryUnlockScheduleRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryUnlockScheduleRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryUnlockScheduleRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryUnlockScheduleRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryUnlockScheduleRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryUnlockScheduleRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.StartDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EndDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Bucket != 0 {
+			n += 1 + runtime.Sov(uint64(x.Bucket))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryUnlockScheduleRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Bucket != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Bucket))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.EndDate) > 0 {
+			i -= len(x.EndDate)
+			copy(dAtA[i:], x.EndDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EndDate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.StartDate) > 0 {
+			i -= len(x.StartDate)
+			copy(dAtA[i:], x.StartDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StartDate)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryUnlockScheduleRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryUnlockScheduleRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryUnlockScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StartDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EndDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				}
+				x.Bucket = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Bucket |= UnlockScheduleBucket(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryUnlockScheduleResponse_1_list)(nil)
+
+type _QueryUnlockScheduleResponse_1_list struct {
+	list *[]*UnlockScheduleEntry
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnlockScheduleEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnlockScheduleEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(UnlockScheduleEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) NewElement() protoreflect.Value {
+	v := new(UnlockScheduleEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryUnlockScheduleResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryUnlockScheduleResponse            protoreflect.MessageDescriptor
+	fd_QueryUnlockScheduleResponse_entries    protoreflect.FieldDescriptor
+	fd_QueryUnlockScheduleResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_QueryUnlockScheduleResponse = File_optio_lockup_query_proto.Messages().ByName("QueryUnlockScheduleResponse")
+	fd_QueryUnlockScheduleResponse_entries = md_QueryUnlockScheduleResponse.Fields().ByName("entries")
+	fd_QueryUnlockScheduleResponse_pagination = md_QueryUnlockScheduleResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryUnlockScheduleResponse)(nil)
+
+type fastReflection_QueryUnlockScheduleResponse QueryUnlockScheduleResponse
+
+func (x *QueryUnlockScheduleResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryUnlockScheduleResponse)(x)
+}
+
+func (x *QueryUnlockScheduleResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryUnlockScheduleResponse_messageType fastReflection_QueryUnlockScheduleResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryUnlockScheduleResponse_messageType{}
+
+type fastReflection_QueryUnlockScheduleResponse_messageType struct{}
+
+func (x fastReflection_QueryUnlockScheduleResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryUnlockScheduleResponse)(nil)
+}
+func (x fastReflection_QueryUnlockScheduleResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryUnlockScheduleResponse)
+}
+func (x fastReflection_QueryUnlockScheduleResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryUnlockScheduleResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryUnlockScheduleResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryUnlockScheduleResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryUnlockScheduleResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryUnlockScheduleResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryUnlockScheduleResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryUnlockScheduleResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryUnlockScheduleResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryUnlockScheduleResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryUnlockScheduleResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_QueryUnlockScheduleResponse_1_list{list: &x.Entries})
+		if !f(fd_QueryUnlockScheduleResponse_entries, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryUnlockScheduleResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryUnlockScheduleResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.QueryUnlockScheduleResponse.entries":
+		return len(x.Entries) != 0
+	case "optio.lockup.QueryUnlockScheduleResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryUnlockScheduleResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryUnlockScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryUnlockScheduleResponse.entries":
+		x.Entries = nil
+	case "optio.lockup.QueryUnlockScheduleResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryUnlockScheduleResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryUnlockScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryUnlockScheduleResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.QueryUnlockScheduleResponse.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_QueryUnlockScheduleResponse_1_list{})
+		}
+		listValue := &_QueryUnlockScheduleResponse_1_list{list: &x.Entries}
+		return protoreflect.ValueOfList(listValue)
+	case "optio.lockup.QueryUnlockScheduleResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryUnlockScheduleResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryUnlockScheduleResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.QueryUnlockScheduleResponse.entries":
+		lv := value.List()
+		clv := lv.(*_QueryUnlockScheduleResponse_1_list)
+		x.Entries = *clv.list
+	case "optio.lockup.QueryUnlockScheduleResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryUnlockScheduleResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryUnlockScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryUnlockScheduleResponse.entries":
+		if x.Entries == nil {
+			x.Entries = []*UnlockScheduleEntry{}
+		}
+		value := &_QueryUnlockScheduleResponse_1_list{list: &x.Entries}
+		return protoreflect.ValueOfList(value)
+	case "optio.lockup.QueryUnlockScheduleResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryUnlockScheduleResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryUnlockScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryUnlockScheduleResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.QueryUnlockScheduleResponse.entries":
+		list := []*UnlockScheduleEntry{}
+		return protoreflect.ValueOfList(&_QueryUnlockScheduleResponse_1_list{list: &list})
+	case "optio.lockup.QueryUnlockScheduleResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.QueryUnlockScheduleResponse"))
+		}
+		panic(fmt.Errorf("message optio.lockup.QueryUnlockScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryUnlockScheduleResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.QueryUnlockScheduleResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryUnlockScheduleResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryUnlockScheduleResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryUnlockScheduleResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryUnlockScheduleResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryUnlockScheduleResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryUnlockScheduleResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryUnlockScheduleResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryUnlockScheduleResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryUnlockScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Entries = append(x.Entries, &UnlockScheduleEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_UnlockScheduleEntry_4_list)(nil)
+
+type _UnlockScheduleEntry_4_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_UnlockScheduleEntry_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_UnlockScheduleEntry_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_UnlockScheduleEntry_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_UnlockScheduleEntry_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_UnlockScheduleEntry_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UnlockScheduleEntry_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_UnlockScheduleEntry_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_UnlockScheduleEntry_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_UnlockScheduleEntry               protoreflect.MessageDescriptor
+	fd_UnlockScheduleEntry_date          protoreflect.FieldDescriptor
+	fd_UnlockScheduleEntry_amount        protoreflect.FieldDescriptor
+	fd_UnlockScheduleEntry_address_count protoreflect.FieldDescriptor
+	fd_UnlockScheduleEntry_escrowed      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_optio_lockup_query_proto_init()
+	md_UnlockScheduleEntry = File_optio_lockup_query_proto.Messages().ByName("UnlockScheduleEntry")
+	fd_UnlockScheduleEntry_date = md_UnlockScheduleEntry.Fields().ByName("date")
+	fd_UnlockScheduleEntry_amount = md_UnlockScheduleEntry.Fields().ByName("amount")
+	fd_UnlockScheduleEntry_address_count = md_UnlockScheduleEntry.Fields().ByName("address_count")
+	fd_UnlockScheduleEntry_escrowed = md_UnlockScheduleEntry.Fields().ByName("escrowed")
+}
+
+var _ protoreflect.Message = (*fastReflection_UnlockScheduleEntry)(nil)
+
+type fastReflection_UnlockScheduleEntry UnlockScheduleEntry
+
+func (x *UnlockScheduleEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UnlockScheduleEntry)(x)
+}
+
+func (x *UnlockScheduleEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_optio_lockup_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_UnlockScheduleEntry_messageType fastReflection_UnlockScheduleEntry_messageType
+var _ protoreflect.MessageType = fastReflection_UnlockScheduleEntry_messageType{}
+
+type fastReflection_UnlockScheduleEntry_messageType struct{}
+
+func (x fastReflection_UnlockScheduleEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UnlockScheduleEntry)(nil)
+}
+func (x fastReflection_UnlockScheduleEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_UnlockScheduleEntry)
+}
+func (x fastReflection_UnlockScheduleEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnlockScheduleEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_UnlockScheduleEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnlockScheduleEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_UnlockScheduleEntry) Type() protoreflect.MessageType {
+	return _fastReflection_UnlockScheduleEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_UnlockScheduleEntry) New() protoreflect.Message {
+	return new(fastReflection_UnlockScheduleEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_UnlockScheduleEntry) Interface() protoreflect.ProtoMessage {
+	return (*UnlockScheduleEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_UnlockScheduleEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Date != "" {
+		value := protoreflect.ValueOfString(x.Date)
+		if !f(fd_UnlockScheduleEntry_date, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_UnlockScheduleEntry_amount, value) {
+			return
+		}
+	}
+	if x.AddressCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.AddressCount)
+		if !f(fd_UnlockScheduleEntry_address_count, value) {
+			return
+		}
+	}
+	if len(x.Escrowed) != 0 {
+		value := protoreflect.ValueOfList(&_UnlockScheduleEntry_4_list{list: &x.Escrowed})
+		if !f(fd_UnlockScheduleEntry_escrowed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_UnlockScheduleEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "optio.lockup.UnlockScheduleEntry.date":
+		return x.Date != ""
+	case "optio.lockup.UnlockScheduleEntry.amount":
+		return x.Amount != nil
+	case "optio.lockup.UnlockScheduleEntry.address_count":
+		return x.AddressCount != uint32(0)
+	case "optio.lockup.UnlockScheduleEntry.escrowed":
+		return len(x.Escrowed) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.UnlockScheduleEntry"))
+		}
+		panic(fmt.Errorf("message optio.lockup.UnlockScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnlockScheduleEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "optio.lockup.UnlockScheduleEntry.date":
+		x.Date = ""
+	case "optio.lockup.UnlockScheduleEntry.amount":
+		x.Amount = nil
+	case "optio.lockup.UnlockScheduleEntry.address_count":
+		x.AddressCount = uint32(0)
+	case "optio.lockup.UnlockScheduleEntry.escrowed":
+		x.Escrowed = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.UnlockScheduleEntry"))
+		}
+		panic(fmt.Errorf("message optio.lockup.UnlockScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_UnlockScheduleEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "optio.lockup.UnlockScheduleEntry.date":
+		value := x.Date
+		return protoreflect.ValueOfString(value)
+	case "optio.lockup.UnlockScheduleEntry.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "optio.lockup.UnlockScheduleEntry.address_count":
+		value := x.AddressCount
+		return protoreflect.ValueOfUint32(value)
+	case "optio.lockup.UnlockScheduleEntry.escrowed":
+		if len(x.Escrowed) == 0 {
+			return protoreflect.ValueOfList(&_UnlockScheduleEntry_4_list{})
+		}
+		listValue := &_UnlockScheduleEntry_4_list{list: &x.Escrowed}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.UnlockScheduleEntry"))
+		}
+		panic(fmt.Errorf("message optio.lockup.UnlockScheduleEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnlockScheduleEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "optio.lockup.UnlockScheduleEntry.date":
+		x.Date = value.Interface().(string)
+	case "optio.lockup.UnlockScheduleEntry.amount":
+		x.Amount = value.Message().Interface().(*v1beta11.Coin)
+	case "optio.lockup.UnlockScheduleEntry.address_count":
+		x.AddressCount = uint32(value.Uint())
+	case "optio.lockup.UnlockScheduleEntry.escrowed":
+		lv := value.List()
+		clv := lv.(*_UnlockScheduleEntry_4_list)
+		x.Escrowed = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.UnlockScheduleEntry"))
+		}
+		panic(fmt.Errorf("message optio.lockup.UnlockScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnlockScheduleEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.UnlockScheduleEntry.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "optio.lockup.UnlockScheduleEntry.escrowed":
+		if x.Escrowed == nil {
+			x.Escrowed = []*v1beta11.Coin{}
+		}
+		value := &_UnlockScheduleEntry_4_list{list: &x.Escrowed}
+		return protoreflect.ValueOfList(value)
+	case "optio.lockup.UnlockScheduleEntry.date":
+		panic(fmt.Errorf("field date of message optio.lockup.UnlockScheduleEntry is not mutable"))
+	case "optio.lockup.UnlockScheduleEntry.address_count":
+		panic(fmt.Errorf("field address_count of message optio.lockup.UnlockScheduleEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.UnlockScheduleEntry"))
+		}
+		panic(fmt.Errorf("message optio.lockup.UnlockScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_UnlockScheduleEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "optio.lockup.UnlockScheduleEntry.date":
+		return protoreflect.ValueOfString("")
+	case "optio.lockup.UnlockScheduleEntry.amount":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "optio.lockup.UnlockScheduleEntry.address_count":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "optio.lockup.UnlockScheduleEntry.escrowed":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_UnlockScheduleEntry_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: optio.lockup.UnlockScheduleEntry"))
+		}
+		panic(fmt.Errorf("message optio.lockup.UnlockScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_UnlockScheduleEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in optio.lockup.UnlockScheduleEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_UnlockScheduleEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnlockScheduleEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_UnlockScheduleEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_UnlockScheduleEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*UnlockScheduleEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Date)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AddressCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AddressCount))
+		}
+		if len(x.Escrowed) > 0 {
+			for _, e := range x.Escrowed {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*UnlockScheduleEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Escrowed) > 0 {
+			for iNdEx := len(x.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Escrowed[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.AddressCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AddressCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Date) > 0 {
+			i -= len(x.Date)
+			copy(dAtA[i:], x.Date)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Date)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*UnlockScheduleEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnlockScheduleEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnlockScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Date = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressCount", wireType)
+				}
+				x.AddressCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AddressCount |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Escrowed = append(x.Escrowed, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Escrowed[len(x.Escrowed)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: optio/lockup/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UnlockScheduleBucket is the period that UnlockSchedule aggregates unlocks by.
+type UnlockScheduleBucket int32
+
+const (
+	// UNLOCK_SCHEDULE_BUCKET_DAY aggregates by unlock date.
+	UnlockScheduleBucket_UNLOCK_SCHEDULE_BUCKET_DAY UnlockScheduleBucket = 0
+	// UNLOCK_SCHEDULE_BUCKET_WEEK aggregates by week, starting on Monday.
+	UnlockScheduleBucket_UNLOCK_SCHEDULE_BUCKET_WEEK UnlockScheduleBucket = 1
+	// UNLOCK_SCHEDULE_BUCKET_MONTH aggregates by calendar month.
+	UnlockScheduleBucket_UNLOCK_SCHEDULE_BUCKET_MONTH UnlockScheduleBucket = 2
+)
+
+// Enum value maps for UnlockScheduleBucket.
+var (
+	UnlockScheduleBucket_name = map[int32]string{
+		0: "UNLOCK_SCHEDULE_BUCKET_DAY",
+		1: "UNLOCK_SCHEDULE_BUCKET_WEEK",
+		2: "UNLOCK_SCHEDULE_BUCKET_MONTH",
+	}
+	UnlockScheduleBucket_value = map[string]int32{
+		"UNLOCK_SCHEDULE_BUCKET_DAY":   0,
+		"UNLOCK_SCHEDULE_BUCKET_WEEK":  1,
+		"UNLOCK_SCHEDULE_BUCKET_MONTH": 2,
+	}
+)
+
+func (x UnlockScheduleBucket) Enum() *UnlockScheduleBucket {
+	p := new(UnlockScheduleBucket)
+	*p = x
+	return p
+}
+
+func (x UnlockScheduleBucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnlockScheduleBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_optio_lockup_query_proto_enumTypes[0].Descriptor()
+}
+
+func (UnlockScheduleBucket) Type() protoreflect.EnumType {
+	return &file_optio_lockup_query_proto_enumTypes[0]
+}
+
+func (x UnlockScheduleBucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnlockScheduleBucket.Descriptor instead.
+func (UnlockScheduleBucket) EnumDescriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params holds all the parameters of this module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryActiveLocksRequest is request type for the Query/ActiveLocks RPC method.
+type QueryActiveLocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// address only returns the locks of this address if set. The locks of a single address are paged by their index
+	// in the results, so page keys of queries with and without an address cannot be mixed.
+	Address string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Filter  *LockFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *QueryActiveLocksRequest) Reset() {
+	*x = QueryActiveLocksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryActiveLocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryActiveLocksRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryActiveLocksRequest.ProtoReflect.Descriptor instead.
+func (*QueryActiveLocksRequest) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryActiveLocksRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryActiveLocksRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryActiveLocksRequest) GetFilter() *LockFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// LockFilter narrows the locks returned by the lock queries. Rolling locks are matched by their effective unlock date
+// on the current block day.
+type LockFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start_date is the first unlock date included, unbounded if empty.
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// end_date is the last unlock date included, unbounded if empty.
+	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// min_amount is the smallest lock amount included, unbounded if empty.
+	MinAmount string `protobuf:"bytes,3,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+}
+
+func (x *LockFilter) Reset() {
+	*x = LockFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockFilter) ProtoMessage() {}
+
+// Deprecated: Use LockFilter.ProtoReflect.Descriptor instead.
+func (*LockFilter) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LockFilter) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *LockFilter) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *LockFilter) GetMinAmount() string {
+	if x != nil {
+		return x.MinAmount
+	}
+	return ""
+}
+
+// QueryActiveLocksResponse is response type for the Query/ActiveLocks RPC method.
+type QueryActiveLocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locks      []*ActiveLockResource `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryActiveLocksResponse) Reset() {
+	*x = QueryActiveLocksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryActiveLocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryActiveLocksResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryActiveLocksResponse.ProtoReflect.Descriptor instead.
+func (*QueryActiveLocksResponse) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryActiveLocksResponse) GetLocks() []*ActiveLockResource {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+func (x *QueryActiveLocksResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ActiveLockResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string         `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	UnlockDate string         `protobuf:"bytes,2,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	Amount     *v1beta11.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	AutoRenew  bool           `protobuf:"varint,4,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// kind tells delegated locks of the bond denom from escrowed locks, which can be of any allowed denom.
+	Kind LockKind `protobuf:"varint,5,opt,name=kind,proto3,enum=optio.lockup.LockKind" json:"kind,omitempty"`
+}
+
+func (x *ActiveLockResource) Reset() {
+	*x = ActiveLockResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActiveLockResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveLockResource) ProtoMessage() {}
+
+// Deprecated: Use ActiveLockResource.ProtoReflect.Descriptor instead.
+func (*ActiveLockResource) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActiveLockResource) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ActiveLockResource) GetUnlockDate() string {
+	if x != nil {
+		return x.UnlockDate
+	}
+	return ""
+}
+
+func (x *ActiveLockResource) GetAmount() *v1beta11.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ActiveLockResource) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+func (x *ActiveLockResource) GetKind() LockKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LockKind_LOCK_KIND_DELEGATED
+}
+
+// QueryTotalLockedAmountRequest is request type for the Query/TotalLockedAmount RPC method.
+type QueryTotalLockedAmountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryTotalLockedAmountRequest) Reset() {
+	*x = QueryTotalLockedAmountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTotalLockedAmountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTotalLockedAmountRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTotalLockedAmountRequest.ProtoReflect.Descriptor instead.
+func (*QueryTotalLockedAmountRequest) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{6}
+}
+
+// QueryTotalLockedAmountResponse is response type for the Query/TotalLockedAmount RPC method.
+type QueryTotalLockedAmountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// total_locked is the total amount of the bond denom in delegated locks.
+	TotalLocked *v1beta11.Coin `protobuf:"bytes,1,opt,name=total_locked,json=totalLocked,proto3" json:"total_locked,omitempty"`
+	// total_escrowed is the total amount of every denom, including the bond denom, held in escrowed locks.
+	TotalEscrowed []*v1beta11.Coin `protobuf:"bytes,2,rep,name=total_escrowed,json=totalEscrowed,proto3" json:"total_escrowed,omitempty"`
+}
+
+func (x *QueryTotalLockedAmountResponse) Reset() {
+	*x = QueryTotalLockedAmountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTotalLockedAmountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTotalLockedAmountResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTotalLockedAmountResponse.ProtoReflect.Descriptor instead.
+func (*QueryTotalLockedAmountResponse) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryTotalLockedAmountResponse) GetTotalLocked() *v1beta11.Coin {
+	if x != nil {
+		return x.TotalLocked
+	}
+	return nil
+}
+
+func (x *QueryTotalLockedAmountResponse) GetTotalEscrowed() []*v1beta11.Coin {
+	if x != nil {
+		return x.TotalEscrowed
+	}
+	return nil
+}
+
+// QueryAccountLocksRequest is request type for the Query/AccountLocks RPC method.
+type QueryAccountLocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// pagination pages through addresses.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Filter     *LockFilter          `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *QueryAccountLocksRequest) Reset() {
+	*x = QueryAccountLocksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAccountLocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAccountLocksRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAccountLocksRequest.ProtoReflect.Descriptor instead.
+func (*QueryAccountLocksRequest) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryAccountLocksRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *QueryAccountLocksRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryAccountLocksRequest) GetFilter() *LockFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// QueryAccountLocksResponse is response type for the Query/AccountLocks RPC method.
+type QueryAccountLocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accounts   []*AccountLocksResource `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Pagination *v1beta1.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAccountLocksResponse) Reset() {
+	*x = QueryAccountLocksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAccountLocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAccountLocksResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAccountLocksResponse.ProtoReflect.Descriptor instead.
+func (*QueryAccountLocksResponse) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryAccountLocksResponse) GetAccounts() []*AccountLocksResource {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *QueryAccountLocksResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type AccountLocksResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Locks   []*LockResource `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks,omitempty"`
+}
+
+func (x *AccountLocksResource) Reset() {
+	*x = AccountLocksResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountLocksResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountLocksResource) ProtoMessage() {}
+
+// Deprecated: Use AccountLocksResource.ProtoReflect.Descriptor instead.
+func (*AccountLocksResource) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AccountLocksResource) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AccountLocksResource) GetLocks() []*LockResource {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+type LockResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnlockDate string         `protobuf:"bytes,1,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	Amount     *v1beta11.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	AutoRenew  bool           `protobuf:"varint,4,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// kind tells delegated locks of the bond denom from escrowed locks, which can be of any allowed denom.
+	Kind LockKind `protobuf:"varint,5,opt,name=kind,proto3,enum=optio.lockup.LockKind" json:"kind,omitempty"`
+}
+
+func (x *LockResource) Reset() {
+	*x = LockResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockResource) ProtoMessage() {}
+
+// Deprecated: Use LockResource.ProtoReflect.Descriptor instead.
+func (*LockResource) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LockResource) GetUnlockDate() string {
+	if x != nil {
+		return x.UnlockDate
+	}
+	return ""
+}
+
+func (x *LockResource) GetAmount() *v1beta11.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *LockResource) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+func (x *LockResource) GetKind() LockKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LockKind_LOCK_KIND_DELEGATED
+}
+
+// QueryLocksRequest is request type for the Query/Locks RPC method.
+type QueryLocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Filter     *LockFilter          `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *QueryLocksRequest) Reset() {
+	*x = QueryLocksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLocksRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryLocksRequest.ProtoReflect.Descriptor instead.
+func (*QueryLocksRequest) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryLocksRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryLocksRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryLocksRequest) GetFilter() *LockFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// QueryLocksResponse is response type for the Query/Locks RPC method.
+type QueryLocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locks      []*LockResource       `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryLocksResponse) Reset() {
+	*x = QueryLocksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (*QueryLocksResponse) ProtoMessage() {}
 
-// QueryActiveLocksRequest is request type for the Query/ActiveLocks RPC method.
-type QueryActiveLocksRequest struct {
+// Deprecated: Use QueryLocksResponse.ProtoReflect.Descriptor instead.
+func (*QueryLocksResponse) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryLocksResponse) GetLocks() []*LockResource {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+func (x *QueryLocksResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryLockWeightedTallyRequest is request type for the Query/LockWeightedTally RPC method.
+type QueryLockWeightedTallyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (x *QueryActiveLocksRequest) Reset() {
-	*x = QueryActiveLocksRequest{}
+func (x *QueryLockWeightedTallyRequest) Reset() {
+	*x = QueryLockWeightedTallyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_optio_lockup_query_proto_msgTypes[0]
+		mi := &file_optio_lockup_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryActiveLocksRequest) String() string {
+func (x *QueryLockWeightedTallyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryActiveLocksRequest) ProtoMessage() {}
+func (*QueryLockWeightedTallyRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryActiveLocksRequest.ProtoReflect.Descriptor instead.
-func (*QueryActiveLocksRequest) Descriptor() ([]byte, []int) {
-	return file_optio_lockup_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryLockWeightedTallyRequest.ProtoReflect.Descriptor instead.
+func (*QueryLockWeightedTallyRequest) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryActiveLocksRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryLockWeightedTallyRequest) GetProposalId() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.ProposalId
 	}
-	return nil
+	return 0
 }
 
-// QueryActiveLocksResponse is response type for the Query/ActiveLocks RPC method.
-type QueryActiveLocksResponse struct {
+// QueryLockWeightedTallyResponse is response type for the Query/LockWeightedTally RPC method.
+type QueryLockWeightedTallyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Locks      []*ActiveLockResource `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Tally *v1.TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally,omitempty"`
+	// passes reports whether the proposal would pass under the lock-weighted tally. It does not affect the outcome.
+	Passes bool `protobuf:"varint,2,opt,name=passes,proto3" json:"passes,omitempty"`
 }
 
-func (x *QueryActiveLocksResponse) Reset() {
-	*x = QueryActiveLocksResponse{}
+func (x *QueryLockWeightedTallyResponse) Reset() {
+	*x = QueryLockWeightedTallyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_optio_lockup_query_proto_msgTypes[1]
+		mi := &file_optio_lockup_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryActiveLocksResponse) String() string {
+func (x *QueryLockWeightedTallyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryActiveLocksResponse) ProtoMessage() {}
+func (*QueryLockWeightedTallyResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryActiveLocksResponse.ProtoReflect.Descriptor instead.
-func (*QueryActiveLocksResponse) Descriptor() ([]byte, []int) {
-	return file_optio_lockup_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryLockWeightedTallyResponse.ProtoReflect.Descriptor instead.
+func (*QueryLockWeightedTallyResponse) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryActiveLocksResponse) GetLocks() []*ActiveLockResource {
+func (x *QueryLockWeightedTallyResponse) GetTally() *v1.TallyResult {
 	if x != nil {
-		return x.Locks
+		return x.Tally
 	}
 	return nil
 }
 
-func (x *QueryActiveLocksResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryLockWeightedTallyResponse) GetPasses() bool {
 	if x != nil {
-		return x.Pagination
+		return x.Passes
 	}
-	return nil
+	return false
 }
 
-type ActiveLockResource struct {
+// QueryRewardsPoolRequest is request type for the Query/RewardsPool RPC method.
+type QueryRewardsPoolRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Address    string         `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	UnlockDate string         `protobuf:"bytes,2,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
-	Amount     *v1beta11.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *ActiveLockResource) Reset() {
-	*x = ActiveLockResource{}
+func (x *QueryRewardsPoolRequest) Reset() {
+	*x = QueryRewardsPoolRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_optio_lockup_query_proto_msgTypes[2]
+		mi := &file_optio_lockup_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActiveLockResource) String() string {
+func (x *QueryRewardsPoolRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActiveLockResource) ProtoMessage() {}
+func (*QueryRewardsPoolRequest) ProtoMessage() {}
 
-// Deprecated: Use ActiveLockResource.ProtoReflect.Descriptor instead.
-func (*ActiveLockResource) Descriptor() ([]byte, []int) {
-	return file_optio_lockup_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryRewardsPoolRequest.ProtoReflect.Descriptor instead.
+func (*QueryRewardsPoolRequest) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ActiveLockResource) GetAddress() string {
-	if x != nil {
-		return x.Address
+// QueryRewardsPoolResponse is response type for the Query/RewardsPool RPC method.
+type QueryRewardsPoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// balance is the total balance of the rewards pool module account.
+	Balance []*v1beta11.Coin `protobuf:"bytes,1,rep,name=balance,proto3" json:"balance,omitempty"`
+	// unallocated is the part of the balance that will be paid out at the next epoch.
+	Unallocated []*v1beta11.Coin `protobuf:"bytes,2,rep,name=unallocated,proto3" json:"unallocated,omitempty"`
+}
+
+func (x *QueryRewardsPoolResponse) Reset() {
+	*x = QueryRewardsPoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ActiveLockResource) GetUnlockDate() string {
+func (x *QueryRewardsPoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRewardsPoolResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryRewardsPoolResponse.ProtoReflect.Descriptor instead.
+func (*QueryRewardsPoolResponse) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QueryRewardsPoolResponse) GetBalance() []*v1beta11.Coin {
 	if x != nil {
-		return x.UnlockDate
+		return x.Balance
 	}
-	return ""
+	return nil
 }
 
-func (x *ActiveLockResource) GetAmount() *v1beta11.Coin {
+func (x *QueryRewardsPoolResponse) GetUnallocated() []*v1beta11.Coin {
 	if x != nil {
-		return x.Amount
+		return x.Unallocated
 	}
 	return nil
 }
 
-// QueryTotalLockedAmountRequest is request type for the Query/TotalLockedAmount RPC method.
-type QueryTotalLockedAmountRequest struct {
+// QueryPendingRewardsRequest is request type for the Query/PendingRewards RPC method.
+type QueryPendingRewardsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *QueryTotalLockedAmountRequest) Reset() {
-	*x = QueryTotalLockedAmountRequest{}
+func (x *QueryPendingRewardsRequest) Reset() {
+	*x = QueryPendingRewardsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_optio_lockup_query_proto_msgTypes[3]
+		mi := &file_optio_lockup_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTotalLockedAmountRequest) String() string {
+func (x *QueryPendingRewardsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTotalLockedAmountRequest) ProtoMessage() {}
+func (*QueryPendingRewardsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTotalLockedAmountRequest.ProtoReflect.Descriptor instead.
-func (*QueryTotalLockedAmountRequest) Descriptor() ([]byte, []int) {
-	return file_optio_lockup_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryPendingRewardsRequest.ProtoReflect.Descriptor instead.
+func (*QueryPendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{18}
 }
 
-// QueryTotalLockedAmountResponse is response type for the Query/TotalLockedAmount RPC method.
-type QueryTotalLockedAmountResponse struct {
+func (x *QueryPendingRewardsRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// QueryPendingRewardsResponse is response type for the Query/PendingRewards RPC method.
+type QueryPendingRewardsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalLocked *v1beta11.Coin `protobuf:"bytes,1,opt,name=total_locked,json=totalLocked,proto3" json:"total_locked,omitempty"`
+	Rewards []*v1beta11.Coin `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
 }
 
-func (x *QueryTotalLockedAmountResponse) Reset() {
-	*x = QueryTotalLockedAmountResponse{}
+func (x *QueryPendingRewardsResponse) Reset() {
+	*x = QueryPendingRewardsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_optio_lockup_query_proto_msgTypes[4]
+		mi := &file_optio_lockup_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTotalLockedAmountResponse) String() string {
+func (x *QueryPendingRewardsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTotalLockedAmountResponse) ProtoMessage() {}
+func (*QueryPendingRewardsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryTotalLockedAmountResponse.ProtoReflect.Descriptor instead.
-func (*QueryTotalLockedAmountResponse) Descriptor() ([]byte, []int) {
-	return file_optio_lockup_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryPendingRewardsResponse.ProtoReflect.Descriptor instead.
+func (*QueryPendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryTotalLockedAmountResponse) GetTotalLocked() *v1beta11.Coin {
+func (x *QueryPendingRewardsResponse) GetRewards() []*v1beta11.Coin {
 	if x != nil {
-		return x.TotalLocked
+		return x.Rewards
 	}
 	return nil
 }
 
-// QueryAccountLocksRequest is request type for the Query/AccountLocks RPC method.
-type QueryAccountLocksRequest struct {
+// QueryAccountSummaryRequest is request type for the Query/AccountSummary RPC method.
+type QueryAccountSummaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addresses  string               `protobuf:"bytes,1,opt,name=addresses,proto3" json:"addresses,omitempty"`
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *QueryAccountLocksRequest) Reset() {
-	*x = QueryAccountLocksRequest{}
+func (x *QueryAccountSummaryRequest) Reset() {
+	*x = QueryAccountSummaryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_optio_lockup_query_proto_msgTypes[5]
+		mi := &file_optio_lockup_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAccountLocksRequest) String() string {
+func (x *QueryAccountSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAccountLocksRequest) ProtoMessage() {}
+func (*QueryAccountSummaryRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryAccountLocksRequest.ProtoReflect.Descriptor instead.
-func (*QueryAccountLocksRequest) Descriptor() ([]byte, []int) {
-	return file_optio_lockup_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryAccountSummaryRequest.ProtoReflect.Descriptor instead.
+func (*QueryAccountSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryAccountLocksRequest) GetAddresses() string {
+func (x *QueryAccountSummaryRequest) GetAddress() string {
 	if x != nil {
-		return x.Addresses
+		return x.Address
 	}
 	return ""
 }
 
-func (x *QueryAccountLocksRequest) GetPagination() *v1beta1.PageRequest {
+// QueryAccountSummaryResponse is response type for the Query/AccountSummary RPC method. All amounts are in the bond
+// denom.
+type QueryAccountSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// total_locked is the amount in active dated and rolling locks.
+	TotalLocked *v1beta11.Coin `protobuf:"bytes,1,opt,name=total_locked,json=totalLocked,proto3" json:"total_locked,omitempty"`
+	// total_delegated is the amount currently delegated.
+	TotalDelegated *v1beta11.Coin `protobuf:"bytes,2,opt,name=total_delegated,json=totalDelegated,proto3" json:"total_delegated,omitempty"`
+	// locked_above_delegated is the locked amount not covered by delegations, which has to stay in the balance.
+	LockedAboveDelegated *v1beta11.Coin `protobuf:"bytes,3,opt,name=locked_above_delegated,json=lockedAboveDelegated,proto3" json:"locked_above_delegated,omitempty"`
+	// balance is the bank balance.
+	Balance *v1beta11.Coin `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	// spendable_unlocked is the amount that can be sent: the bank spendable balance, capped at the balance minus
+	// locked_above_delegated.
+	SpendableUnlocked *v1beta11.Coin `protobuf:"bytes,5,opt,name=spendable_unlocked,json=spendableUnlocked,proto3" json:"spendable_unlocked,omitempty"`
+	// next_unlock_date is the earliest unlock date of the dated locks, empty if there are none. Rolling locks never
+	// unlock.
+	NextUnlockDate string `protobuf:"bytes,6,opt,name=next_unlock_date,json=nextUnlockDate,proto3" json:"next_unlock_date,omitempty"`
+	// next_unlock_amount is the amount that unlocks on next_unlock_date.
+	NextUnlockAmount *v1beta11.Coin `protobuf:"bytes,7,opt,name=next_unlock_amount,json=nextUnlockAmount,proto3" json:"next_unlock_amount,omitempty"`
+	// lock_count is the number of active dated and rolling locks.
+	LockCount uint32 `protobuf:"varint,8,opt,name=lock_count,json=lockCount,proto3" json:"lock_count,omitempty"`
+	// total_escrowed is the amount of every denom held in the escrowed locks of the address, including the bond denom.
+	// Escrowed locks are not part of the other fields.
+	TotalEscrowed []*v1beta11.Coin `protobuf:"bytes,9,rep,name=total_escrowed,json=totalEscrowed,proto3" json:"total_escrowed,omitempty"`
+}
+
+func (x *QueryAccountSummaryResponse) Reset() {
+	*x = QueryAccountSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_optio_lockup_query_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAccountSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAccountSummaryResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAccountSummaryResponse.ProtoReflect.Descriptor instead.
+func (*QueryAccountSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QueryAccountSummaryResponse) GetTotalLocked() *v1beta11.Coin {
 	if x != nil {
-		return x.Pagination
+		return x.TotalLocked
 	}
 	return nil
 }
 
-// QueryAccountLocksResponse is response type for the Query/AccountLocks RPC method.
-type QueryAccountLocksResponse struct {
+func (x *QueryAccountSummaryResponse) GetTotalDelegated() *v1beta11.Coin {
+	if x != nil {
+		return x.TotalDelegated
+	}
+	return nil
+}
+
+func (x *QueryAccountSummaryResponse) GetLockedAboveDelegated() *v1beta11.Coin {
+	if x != nil {
+		return x.LockedAboveDelegated
+	}
+	return nil
+}
+
+func (x *QueryAccountSummaryResponse) GetBalance() *v1beta11.Coin {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *QueryAccountSummaryResponse) GetSpendableUnlocked() *v1beta11.Coin {
+	if x != nil {
+		return x.SpendableUnlocked
+	}
+	return nil
+}
+
+func (x *QueryAccountSummaryResponse) GetNextUnlockDate() string {
+	if x != nil {
+		return x.NextUnlockDate
+	}
+	return ""
+}
+
+func (x *QueryAccountSummaryResponse) GetNextUnlockAmount() *v1beta11.Coin {
+	if x != nil {
+		return x.NextUnlockAmount
+	}
+	return nil
+}
+
+func (x *QueryAccountSummaryResponse) GetLockCount() uint32 {
+	if x != nil {
+		return x.LockCount
+	}
+	return 0
+}
+
+func (x *QueryAccountSummaryResponse) GetTotalEscrowed() []*v1beta11.Coin {
+	if x != nil {
+		return x.TotalEscrowed
+	}
+	return nil
+}
+
+// QueryLockHistoryRequest is request type for the Query/LockHistory RPC method.
+type QueryLockHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Accounts   []*AccountLocksResource `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	Pagination *v1beta1.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Address    string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAccountLocksResponse) Reset() {
-	*x = QueryAccountLocksResponse{}
+func (x *QueryLockHistoryRequest) Reset() {
+	*x = QueryLockHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_optio_lockup_query_proto_msgTypes[6]
+		mi := &file_optio_lockup_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAccountLocksResponse) String() string {
+func (x *QueryLockHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAccountLocksResponse) ProtoMessage() {}
+func (*QueryLockHistoryRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryAccountLocksResponse.ProtoReflect.Descriptor instead.
-func (*QueryAccountLocksResponse) Descriptor() ([]byte, []int) {
-	return file_optio_lockup_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryLockHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryLockHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryAccountLocksResponse) GetAccounts() []*AccountLocksResource {
+func (x *QueryLockHistoryRequest) GetAddress() string {
 	if x != nil {
-		return x.Accounts
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryAccountLocksResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryLockHistoryRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type AccountLocksResource struct {
+// QueryLockHistoryResponse is response type for the Query/LockHistory RPC method.
+type QueryLockHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Locks   []*LockResource `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks,omitempty"`
+	Entries    []*LockHistoryEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *AccountLocksResource) Reset() {
-	*x = AccountLocksResource{}
+func (x *QueryLockHistoryResponse) Reset() {
+	*x = QueryLockHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_optio_lockup_query_proto_msgTypes[7]
+		mi := &file_optio_lockup_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AccountLocksResource) String() string {
+func (x *QueryLockHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountLocksResource) ProtoMessage() {}
+func (*QueryLockHistoryResponse) ProtoMessage() {}
 
-// Deprecated: Use AccountLocksResource.ProtoReflect.Descriptor instead.
-func (*AccountLocksResource) Descriptor() ([]byte, []int) {
-	return file_optio_lockup_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryLockHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryLockHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AccountLocksResource) GetAddress() string {
+func (x *QueryLockHistoryResponse) GetEntries() []*LockHistoryEntry {
 	if x != nil {
-		return x.Address
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-func (x *AccountLocksResource) GetLocks() []*LockResource {
+func (x *QueryLockHistoryResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
-		return x.Locks
+		return x.Pagination
 	}
 	return nil
 }
 
-type LockResource struct {
+// QueryUnlockScheduleRequest is request type for the Query/UnlockSchedule RPC method.
+type QueryUnlockScheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UnlockDate string         `protobuf:"bytes,1,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
-	Amount     *v1beta11.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// start_date is the first unlock date included, defaulting to the day after the current block day.
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// end_date is the last unlock date included, unbounded if empty.
+	EndDate string               `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Bucket  UnlockScheduleBucket `protobuf:"varint,3,opt,name=bucket,proto3,enum=optio.lockup.UnlockScheduleBucket" json:"bucket,omitempty"`
+	// pagination limits the number of buckets returned.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *LockResource) Reset() {
-	*x = LockResource{}
+func (x *QueryUnlockScheduleRequest) Reset() {
+	*x = QueryUnlockScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_optio_lockup_query_proto_msgTypes[8]
+		mi := &file_optio_lockup_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LockResource) String() string {
+func (x *QueryUnlockScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LockResource) ProtoMessage() {}
+func (*QueryUnlockScheduleRequest) ProtoMessage() {}
 
-// Deprecated: Use LockResource.ProtoReflect.Descriptor instead.
-func (*LockResource) Descriptor() ([]byte, []int) {
-	return file_optio_lockup_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QueryUnlockScheduleRequest.ProtoReflect.Descriptor instead.
+func (*QueryUnlockScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *LockResource) GetUnlockDate() string {
+func (x *QueryUnlockScheduleRequest) GetStartDate() string {
 	if x != nil {
-		return x.UnlockDate
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *LockResource) GetAmount() *v1beta11.Coin {
+func (x *QueryUnlockScheduleRequest) GetEndDate() string {
 	if x != nil {
-		return x.Amount
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *QueryUnlockScheduleRequest) GetBucket() UnlockScheduleBucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return UnlockScheduleBucket_UNLOCK_SCHEDULE_BUCKET_DAY
+}
+
+func (x *QueryUnlockScheduleRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
 
-// QueryLocksRequest is request type for the Query/Locks RPC method.
-type QueryLocksRequest struct {
+// QueryUnlockScheduleResponse is response type for the Query/UnlockSchedule RPC method. Rolling locks never unlock
+// and are not included.
+type QueryUnlockScheduleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address    string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Entries    []*UnlockScheduleEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Pagination *v1beta1.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryLocksRequest) Reset() {
-	*x = QueryLocksRequest{}
+func (x *QueryUnlockScheduleResponse) Reset() {
+	*x = QueryUnlockScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_optio_lockup_query_proto_msgTypes[9]
+		mi := &file_optio_lockup_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLocksRequest) String() string {
+func (x *QueryUnlockScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLocksRequest) ProtoMessage() {}
+func (*QueryUnlockScheduleResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryLocksRequest.ProtoReflect.Descriptor instead.
-func (*QueryLocksRequest) Descriptor() ([]byte, []int) {
-	return file_optio_lockup_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryUnlockScheduleResponse.ProtoReflect.Descriptor instead.
+func (*QueryUnlockScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryLocksRequest) GetAddress() string {
+func (x *QueryUnlockScheduleResponse) GetEntries() []*UnlockScheduleEntry {
 	if x != nil {
-		return x.Address
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryLocksRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryUnlockScheduleResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryLocksResponse is response type for the Query/Locks RPC method.
-type QueryLocksResponse struct {
+type UnlockScheduleEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Locks      []*LockResource       `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// date is the first day of the bucket.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// amount is the amount of the bond denom unlocking from delegated locks in the bucket.
+	Amount *v1beta11.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// address_count is the number of distinct addresses with delegated or escrowed locks unlocking in the bucket.
+	AddressCount uint32 `protobuf:"varint,3,opt,name=address_count,json=addressCount,proto3" json:"address_count,omitempty"`
+	// escrowed is the amount of every denom, including the bond denom, released from escrowed locks in the bucket.
+	Escrowed []*v1beta11.Coin `protobuf:"bytes,4,rep,name=escrowed,proto3" json:"escrowed,omitempty"`
 }
 
-func (x *QueryLocksResponse) Reset() {
-	*x = QueryLocksResponse{}
+func (x *UnlockScheduleEntry) Reset() {
+	*x = UnlockScheduleEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_optio_lockup_query_proto_msgTypes[10]
+		mi := &file_optio_lockup_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLocksResponse) String() string {
+func (x *UnlockScheduleEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLocksResponse) ProtoMessage() {}
+func (*UnlockScheduleEntry) ProtoMessage() {}
 
-// Deprecated: Use QueryLocksResponse.ProtoReflect.Descriptor instead.
-func (*QueryLocksResponse) Descriptor() ([]byte, []int) {
-	return file_optio_lockup_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UnlockScheduleEntry.ProtoReflect.Descriptor instead.
+func (*UnlockScheduleEntry) Descriptor() ([]byte, []int) {
+	return file_optio_lockup_query_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *QueryLocksResponse) GetLocks() []*LockResource {
+func (x *UnlockScheduleEntry) GetDate() string {
 	if x != nil {
-		return x.Locks
+		return x.Date
+	}
+	return ""
+}
+
+func (x *UnlockScheduleEntry) GetAmount() *v1beta11.Coin {
+	if x != nil {
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *QueryLocksResponse) GetPagination() *v1beta1.PageResponse {
+func (x *UnlockScheduleEntry) GetAddressCount() uint32 {
 	if x != nil {
-		return x.Pagination
+		return x.AddressCount
+	}
+	return 0
+}
+
+func (x *UnlockScheduleEntry) GetEscrowed() []*v1beta11.Coin {
+	if x != nil {
+		return x.Escrowed
 	}
 	return nil
 }
@@ -6053,145 +16220,412 @@ var File_optio_lockup_query_proto protoreflect.FileDescriptor
 var file_optio_lockup_query_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x71,
 	0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
-	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f,
-	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x61, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f,
+	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62,
+	0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67,
+	0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x76, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b,
+	0x75, 0x70, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x75, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x0a,
+	0x4c, 0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x01, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xa1, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd3, 0x01, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x44, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x2a,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x6b,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x1e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x12, 0x77, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x22, 0xb8, 0x01, 0x0a, 0x18,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xaa, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0xb3, 0x01,
+	0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f,
+	0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x2a, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0x95, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x1d, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
+	0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x70, 0x0a,
+	0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x65, 0x64, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x73, 0x22,
+	0x19, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfa, 0x01, 0x0a, 0x18, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x72, 0x0a, 0x0b, 0x75, 0x6e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x36, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x89, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6a, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x36, 0x0a, 0x1a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x9e, 0x05, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x55, 0x0a, 0x16, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x62, 0x6f, 0x76,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x14, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x62, 0x6f, 0x76, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x12, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x11, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e,
+	0x65, 0x78, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x65, 0x12, 0x4d, 0x0a,
+	0x12, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x77, 0x0a, 0x0e, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x65, 0x64, 0x22, 0x7b, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63,
+	0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xa3, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x4c,
+	0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x47,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xda, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x3a, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x22, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x46, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
 	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3c, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12,
-	0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x64, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xf0, 0x01, 0x0a, 0x13, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x18, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x01,
-	0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f,
-	0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x14, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x05,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x22, 0x68, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63,
-	0x6b, 0x44, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x75,
-	0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x95, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c,
-	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xa8, 0x04,
-	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x80, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e,
-	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x67, 0x0a, 0x08, 0x65, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x65, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x65, 0x64, 0x2a, 0x79, 0x0a, 0x14, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x1a, 0x55,
+	0x4e, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x42,
+	0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x55,
+	0x4e, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x42,
+	0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c,
+	0x55, 0x4e, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f,
+	0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x02, 0x32, 0x90,
+	0x0c, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75,
+	0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12,
+	0x14, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x99, 0x01, 0x0a, 0x11, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b,
 	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a,
-	0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x99, 0x01, 0x0a, 0x11, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x2b, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x23, 0x12, 0x21, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
+	0x2f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
 	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b,
-	0x75, 0x70, 0x2f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e,
-	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
-	0x12, 0x1b, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x79, 0x0a,
-	0x05, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c,
-	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e,
-	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x27, 0x12, 0x25, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
-	0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x7b,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x42, 0x9f, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
-	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x42, 0x0a, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xa2, 0x02, 0x03, 0x4f, 0x4c,
-	0x58, 0xaa, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
-	0xca, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xe2,
-	0x02, 0x18, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x3a, 0x3a, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b,
+	0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x79, 0x0a, 0x05, 0x4c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12,
+	0x25, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x7b, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xa7, 0x01, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x12, 0x2b, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x54, 0x61, 0x6c,
+	0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f,
+	0x63, 0x6b, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12,
+	0x2f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x6c,
+	0x6c, 0x79, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x80, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6f, 0x6f, 0x6c,
+	0x12, 0x25, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f,
+	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x70,
+	0x6f, 0x6f, 0x6c, 0x12, 0x96, 0x01, 0x0a, 0x0e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b,
+	0x75, 0x70, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x96, 0x01, 0x0a,
+	0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x28, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2f, 0x7b, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x8a, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x63, 0x6b, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x0e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75,
+	0x70, 0x2f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x42, 0x9f, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x2f, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0xa2, 0x02, 0x03, 0x4f, 0x4c, 0x58, 0xaa, 0x02, 0x0c, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xca, 0x02, 0x0c, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0xe2, 0x02, 0x18, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x3a, 0x3a, 0x4c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6206,50 +16640,113 @@ func file_optio_lockup_query_proto_rawDescGZIP() []byte {
 	return file_optio_lockup_query_proto_rawDescData
 }
 
-var file_optio_lockup_query_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_optio_lockup_query_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_optio_lockup_query_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_optio_lockup_query_proto_goTypes = []interface{}{
-	(*QueryActiveLocksRequest)(nil),        // 0: optio.lockup.QueryActiveLocksRequest
-	(*QueryActiveLocksResponse)(nil),       // 1: optio.lockup.QueryActiveLocksResponse
-	(*ActiveLockResource)(nil),             // 2: optio.lockup.ActiveLockResource
-	(*QueryTotalLockedAmountRequest)(nil),  // 3: optio.lockup.QueryTotalLockedAmountRequest
-	(*QueryTotalLockedAmountResponse)(nil), // 4: optio.lockup.QueryTotalLockedAmountResponse
-	(*QueryAccountLocksRequest)(nil),       // 5: optio.lockup.QueryAccountLocksRequest
-	(*QueryAccountLocksResponse)(nil),      // 6: optio.lockup.QueryAccountLocksResponse
-	(*AccountLocksResource)(nil),           // 7: optio.lockup.AccountLocksResource
-	(*LockResource)(nil),                   // 8: optio.lockup.LockResource
-	(*QueryLocksRequest)(nil),              // 9: optio.lockup.QueryLocksRequest
-	(*QueryLocksResponse)(nil),             // 10: optio.lockup.QueryLocksResponse
-	(*v1beta1.PageRequest)(nil),            // 11: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),           // 12: cosmos.base.query.v1beta1.PageResponse
-	(*v1beta11.Coin)(nil),                  // 13: cosmos.base.v1beta1.Coin
+	(UnlockScheduleBucket)(0),              // 0: optio.lockup.UnlockScheduleBucket
+	(*QueryParamsRequest)(nil),             // 1: optio.lockup.QueryParamsRequest
+	(*QueryParamsResponse)(nil),            // 2: optio.lockup.QueryParamsResponse
+	(*QueryActiveLocksRequest)(nil),        // 3: optio.lockup.QueryActiveLocksRequest
+	(*LockFilter)(nil),                     // 4: optio.lockup.LockFilter
+	(*QueryActiveLocksResponse)(nil),       // 5: optio.lockup.QueryActiveLocksResponse
+	(*ActiveLockResource)(nil),             // 6: optio.lockup.ActiveLockResource
+	(*QueryTotalLockedAmountRequest)(nil),  // 7: optio.lockup.QueryTotalLockedAmountRequest
+	(*QueryTotalLockedAmountResponse)(nil), // 8: optio.lockup.QueryTotalLockedAmountResponse
+	(*QueryAccountLocksRequest)(nil),       // 9: optio.lockup.QueryAccountLocksRequest
+	(*QueryAccountLocksResponse)(nil),      // 10: optio.lockup.QueryAccountLocksResponse
+	(*AccountLocksResource)(nil),           // 11: optio.lockup.AccountLocksResource
+	(*LockResource)(nil),                   // 12: optio.lockup.LockResource
+	(*QueryLocksRequest)(nil),              // 13: optio.lockup.QueryLocksRequest
+	(*QueryLocksResponse)(nil),             // 14: optio.lockup.QueryLocksResponse
+	(*QueryLockWeightedTallyRequest)(nil),  // 15: optio.lockup.QueryLockWeightedTallyRequest
+	(*QueryLockWeightedTallyResponse)(nil), // 16: optio.lockup.QueryLockWeightedTallyResponse
+	(*QueryRewardsPoolRequest)(nil),        // 17: optio.lockup.QueryRewardsPoolRequest
+	(*QueryRewardsPoolResponse)(nil),       // 18: optio.lockup.QueryRewardsPoolResponse
+	(*QueryPendingRewardsRequest)(nil),     // 19: optio.lockup.QueryPendingRewardsRequest
+	(*QueryPendingRewardsResponse)(nil),    // 20: optio.lockup.QueryPendingRewardsResponse
+	(*QueryAccountSummaryRequest)(nil),     // 21: optio.lockup.QueryAccountSummaryRequest
+	(*QueryAccountSummaryResponse)(nil),    // 22: optio.lockup.QueryAccountSummaryResponse
+	(*QueryLockHistoryRequest)(nil),        // 23: optio.lockup.QueryLockHistoryRequest
+	(*QueryLockHistoryResponse)(nil),       // 24: optio.lockup.QueryLockHistoryResponse
+	(*QueryUnlockScheduleRequest)(nil),     // 25: optio.lockup.QueryUnlockScheduleRequest
+	(*QueryUnlockScheduleResponse)(nil),    // 26: optio.lockup.QueryUnlockScheduleResponse
+	(*UnlockScheduleEntry)(nil),            // 27: optio.lockup.UnlockScheduleEntry
+	(*Params)(nil),                         // 28: optio.lockup.Params
+	(*v1beta1.PageRequest)(nil),            // 29: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),           // 30: cosmos.base.query.v1beta1.PageResponse
+	(*v1beta11.Coin)(nil),                  // 31: cosmos.base.v1beta1.Coin
+	(LockKind)(0),                          // 32: optio.lockup.LockKind
+	(*v1.TallyResult)(nil),                 // 33: cosmos.gov.v1.TallyResult
+	(*LockHistoryEntry)(nil),               // 34: optio.lockup.LockHistoryEntry
 }
 var file_optio_lockup_query_proto_depIdxs = []int32{
-	11, // 0: optio.lockup.QueryActiveLocksRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	2,  // 1: optio.lockup.QueryActiveLocksResponse.locks:type_name -> optio.lockup.ActiveLockResource
-	12, // 2: optio.lockup.QueryActiveLocksResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	13, // 3: optio.lockup.ActiveLockResource.amount:type_name -> cosmos.base.v1beta1.Coin
-	13, // 4: optio.lockup.QueryTotalLockedAmountResponse.total_locked:type_name -> cosmos.base.v1beta1.Coin
-	11, // 5: optio.lockup.QueryAccountLocksRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	7,  // 6: optio.lockup.QueryAccountLocksResponse.accounts:type_name -> optio.lockup.AccountLocksResource
-	12, // 7: optio.lockup.QueryAccountLocksResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	8,  // 8: optio.lockup.AccountLocksResource.locks:type_name -> optio.lockup.LockResource
-	13, // 9: optio.lockup.LockResource.amount:type_name -> cosmos.base.v1beta1.Coin
-	11, // 10: optio.lockup.QueryLocksRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	8,  // 11: optio.lockup.QueryLocksResponse.locks:type_name -> optio.lockup.LockResource
-	12, // 12: optio.lockup.QueryLocksResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	0,  // 13: optio.lockup.Query.ActiveLocks:input_type -> optio.lockup.QueryActiveLocksRequest
-	3,  // 14: optio.lockup.Query.TotalLockedAmount:input_type -> optio.lockup.QueryTotalLockedAmountRequest
-	5,  // 15: optio.lockup.Query.AccountLocks:input_type -> optio.lockup.QueryAccountLocksRequest
-	9,  // 16: optio.lockup.Query.Locks:input_type -> optio.lockup.QueryLocksRequest
-	1,  // 17: optio.lockup.Query.ActiveLocks:output_type -> optio.lockup.QueryActiveLocksResponse
-	4,  // 18: optio.lockup.Query.TotalLockedAmount:output_type -> optio.lockup.QueryTotalLockedAmountResponse
-	6,  // 19: optio.lockup.Query.AccountLocks:output_type -> optio.lockup.QueryAccountLocksResponse
-	10, // 20: optio.lockup.Query.Locks:output_type -> optio.lockup.QueryLocksResponse
-	17, // [17:21] is the sub-list for method output_type
-	13, // [13:17] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	28, // 0: optio.lockup.QueryParamsResponse.params:type_name -> optio.lockup.Params
+	29, // 1: optio.lockup.QueryActiveLocksRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	4,  // 2: optio.lockup.QueryActiveLocksRequest.filter:type_name -> optio.lockup.LockFilter
+	6,  // 3: optio.lockup.QueryActiveLocksResponse.locks:type_name -> optio.lockup.ActiveLockResource
+	30, // 4: optio.lockup.QueryActiveLocksResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	31, // 5: optio.lockup.ActiveLockResource.amount:type_name -> cosmos.base.v1beta1.Coin
+	32, // 6: optio.lockup.ActiveLockResource.kind:type_name -> optio.lockup.LockKind
+	31, // 7: optio.lockup.QueryTotalLockedAmountResponse.total_locked:type_name -> cosmos.base.v1beta1.Coin
+	31, // 8: optio.lockup.QueryTotalLockedAmountResponse.total_escrowed:type_name -> cosmos.base.v1beta1.Coin
+	29, // 9: optio.lockup.QueryAccountLocksRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	4,  // 10: optio.lockup.QueryAccountLocksRequest.filter:type_name -> optio.lockup.LockFilter
+	11, // 11: optio.lockup.QueryAccountLocksResponse.accounts:type_name -> optio.lockup.AccountLocksResource
+	30, // 12: optio.lockup.QueryAccountLocksResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	12, // 13: optio.lockup.AccountLocksResource.locks:type_name -> optio.lockup.LockResource
+	31, // 14: optio.lockup.LockResource.amount:type_name -> cosmos.base.v1beta1.Coin
+	32, // 15: optio.lockup.LockResource.kind:type_name -> optio.lockup.LockKind
+	29, // 16: optio.lockup.QueryLocksRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	4,  // 17: optio.lockup.QueryLocksRequest.filter:type_name -> optio.lockup.LockFilter
+	12, // 18: optio.lockup.QueryLocksResponse.locks:type_name -> optio.lockup.LockResource
+	30, // 19: optio.lockup.QueryLocksResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	33, // 20: optio.lockup.QueryLockWeightedTallyResponse.tally:type_name -> cosmos.gov.v1.TallyResult
+	31, // 21: optio.lockup.QueryRewardsPoolResponse.balance:type_name -> cosmos.base.v1beta1.Coin
+	31, // 22: optio.lockup.QueryRewardsPoolResponse.unallocated:type_name -> cosmos.base.v1beta1.Coin
+	31, // 23: optio.lockup.QueryPendingRewardsResponse.rewards:type_name -> cosmos.base.v1beta1.Coin
+	31, // 24: optio.lockup.QueryAccountSummaryResponse.total_locked:type_name -> cosmos.base.v1beta1.Coin
+	31, // 25: optio.lockup.QueryAccountSummaryResponse.total_delegated:type_name -> cosmos.base.v1beta1.Coin
+	31, // 26: optio.lockup.QueryAccountSummaryResponse.locked_above_delegated:type_name -> cosmos.base.v1beta1.Coin
+	31, // 27: optio.lockup.QueryAccountSummaryResponse.balance:type_name -> cosmos.base.v1beta1.Coin
+	31, // 28: optio.lockup.QueryAccountSummaryResponse.spendable_unlocked:type_name -> cosmos.base.v1beta1.Coin
+	31, // 29: optio.lockup.QueryAccountSummaryResponse.next_unlock_amount:type_name -> cosmos.base.v1beta1.Coin
+	31, // 30: optio.lockup.QueryAccountSummaryResponse.total_escrowed:type_name -> cosmos.base.v1beta1.Coin
+	29, // 31: optio.lockup.QueryLockHistoryRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	34, // 32: optio.lockup.QueryLockHistoryResponse.entries:type_name -> optio.lockup.LockHistoryEntry
+	30, // 33: optio.lockup.QueryLockHistoryResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 34: optio.lockup.QueryUnlockScheduleRequest.bucket:type_name -> optio.lockup.UnlockScheduleBucket
+	29, // 35: optio.lockup.QueryUnlockScheduleRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	27, // 36: optio.lockup.QueryUnlockScheduleResponse.entries:type_name -> optio.lockup.UnlockScheduleEntry
+	30, // 37: optio.lockup.QueryUnlockScheduleResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	31, // 38: optio.lockup.UnlockScheduleEntry.amount:type_name -> cosmos.base.v1beta1.Coin
+	31, // 39: optio.lockup.UnlockScheduleEntry.escrowed:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 40: optio.lockup.Query.Params:input_type -> optio.lockup.QueryParamsRequest
+	3,  // 41: optio.lockup.Query.ActiveLocks:input_type -> optio.lockup.QueryActiveLocksRequest
+	7,  // 42: optio.lockup.Query.TotalLockedAmount:input_type -> optio.lockup.QueryTotalLockedAmountRequest
+	9,  // 43: optio.lockup.Query.AccountLocks:input_type -> optio.lockup.QueryAccountLocksRequest
+	13, // 44: optio.lockup.Query.Locks:input_type -> optio.lockup.QueryLocksRequest
+	15, // 45: optio.lockup.Query.LockWeightedTally:input_type -> optio.lockup.QueryLockWeightedTallyRequest
+	17, // 46: optio.lockup.Query.RewardsPool:input_type -> optio.lockup.QueryRewardsPoolRequest
+	19, // 47: optio.lockup.Query.PendingRewards:input_type -> optio.lockup.QueryPendingRewardsRequest
+	21, // 48: optio.lockup.Query.AccountSummary:input_type -> optio.lockup.QueryAccountSummaryRequest
+	23, // 49: optio.lockup.Query.LockHistory:input_type -> optio.lockup.QueryLockHistoryRequest
+	25, // 50: optio.lockup.Query.UnlockSchedule:input_type -> optio.lockup.QueryUnlockScheduleRequest
+	2,  // 51: optio.lockup.Query.Params:output_type -> optio.lockup.QueryParamsResponse
+	5,  // 52: optio.lockup.Query.ActiveLocks:output_type -> optio.lockup.QueryActiveLocksResponse
+	8,  // 53: optio.lockup.Query.TotalLockedAmount:output_type -> optio.lockup.QueryTotalLockedAmountResponse
+	10, // 54: optio.lockup.Query.AccountLocks:output_type -> optio.lockup.QueryAccountLocksResponse
+	14, // 55: optio.lockup.Query.Locks:output_type -> optio.lockup.QueryLocksResponse
+	16, // 56: optio.lockup.Query.LockWeightedTally:output_type -> optio.lockup.QueryLockWeightedTallyResponse
+	18, // 57: optio.lockup.Query.RewardsPool:output_type -> optio.lockup.QueryRewardsPoolResponse
+	20, // 58: optio.lockup.Query.PendingRewards:output_type -> optio.lockup.QueryPendingRewardsResponse
+	22, // 59: optio.lockup.Query.AccountSummary:output_type -> optio.lockup.QueryAccountSummaryResponse
+	24, // 60: optio.lockup.Query.LockHistory:output_type -> optio.lockup.QueryLockHistoryResponse
+	26, // 61: optio.lockup.Query.UnlockSchedule:output_type -> optio.lockup.QueryUnlockScheduleResponse
+	51, // [51:62] is the sub-list for method output_type
+	40, // [40:51] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_optio_lockup_query_proto_init() }
@@ -6257,10 +16754,12 @@ func file_optio_lockup_query_proto_init() {
 	if File_optio_lockup_query_proto != nil {
 		return
 	}
+	file_optio_lockup_history_proto_init()
 	file_optio_lockup_lock_proto_init()
+	file_optio_lockup_params_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_optio_lockup_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActiveLocksRequest); i {
+			switch v := v.(*QueryParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6272,7 +16771,7 @@ func file_optio_lockup_query_proto_init() {
 			}
 		}
 		file_optio_lockup_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActiveLocksResponse); i {
+			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6284,7 +16783,7 @@ func file_optio_lockup_query_proto_init() {
 			}
 		}
 		file_optio_lockup_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActiveLockResource); i {
+			switch v := v.(*QueryActiveLocksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6296,7 +16795,7 @@ func file_optio_lockup_query_proto_init() {
 			}
 		}
 		file_optio_lockup_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTotalLockedAmountRequest); i {
+			switch v := v.(*LockFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6308,7 +16807,7 @@ func file_optio_lockup_query_proto_init() {
 			}
 		}
 		file_optio_lockup_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTotalLockedAmountResponse); i {
+			switch v := v.(*QueryActiveLocksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6320,7 +16819,7 @@ func file_optio_lockup_query_proto_init() {
 			}
 		}
 		file_optio_lockup_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAccountLocksRequest); i {
+			switch v := v.(*ActiveLockResource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6332,7 +16831,7 @@ func file_optio_lockup_query_proto_init() {
 			}
 		}
 		file_optio_lockup_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAccountLocksResponse); i {
+			switch v := v.(*QueryTotalLockedAmountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6344,7 +16843,7 @@ func file_optio_lockup_query_proto_init() {
 			}
 		}
 		file_optio_lockup_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountLocksResource); i {
+			switch v := v.(*QueryTotalLockedAmountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6356,7 +16855,7 @@ func file_optio_lockup_query_proto_init() {
 			}
 		}
 		file_optio_lockup_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockResource); i {
+			switch v := v.(*QueryAccountLocksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6368,7 +16867,7 @@ func file_optio_lockup_query_proto_init() {
 			}
 		}
 		file_optio_lockup_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryLocksRequest); i {
+			switch v := v.(*QueryAccountLocksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6380,6 +16879,42 @@ func file_optio_lockup_query_proto_init() {
 			}
 		}
 		file_optio_lockup_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountLocksResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryLocksResponse); i {
 			case 0:
 				return &v.state
@@ -6391,19 +16926,176 @@ func file_optio_lockup_query_proto_init() {
 				return nil
 			}
 		}
+		file_optio_lockup_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLockWeightedTallyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLockWeightedTallyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRewardsPoolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRewardsPoolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPendingRewardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPendingRewardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAccountSummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAccountSummaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLockHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLockHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryUnlockScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryUnlockScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_optio_lockup_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockScheduleEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_optio_lockup_query_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      1,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_optio_lockup_query_proto_goTypes,
 		DependencyIndexes: file_optio_lockup_query_proto_depIdxs,
+		EnumInfos:         file_optio_lockup_query_proto_enumTypes,
 		MessageInfos:      file_optio_lockup_query_proto_msgTypes,
 	}.Build()
 	File_optio_lockup_query_proto = out.File
@@ -19,17 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
+	Query_Params_FullMethodName            = "/optio.lockup.Query/Params"
 	Query_ActiveLocks_FullMethodName       = "/optio.lockup.Query/ActiveLocks"
 	Query_TotalLockedAmount_FullMethodName = "/optio.lockup.Query/TotalLockedAmount"
 	Query_AccountLocks_FullMethodName      = "/optio.lockup.Query/AccountLocks"
 	Query_Locks_FullMethodName             = "/optio.lockup.Query/Locks"
+	Query_LockWeightedTally_FullMethodName = "/optio.lockup.Query/LockWeightedTally"
+	Query_RewardsPool_FullMethodName       = "/optio.lockup.Query/RewardsPool"
+	Query_PendingRewards_FullMethodName    = "/optio.lockup.Query/PendingRewards"
+	Query_AccountSummary_FullMethodName    = "/optio.lockup.Query/AccountSummary"
+	Query_LockHistory_FullMethodName       = "/optio.lockup.Query/LockHistory"
+	Query_UnlockSchedule_FullMethodName    = "/optio.lockup.Query/UnlockSchedule"
 )
 
 // QueryClient is the client API for Query service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryClient interface {
-	// ActiveLocks queries all active locks with an unlock date in the future.
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ActiveLocks queries all active locks with an unlock date in the future: delegated dated locks, followed by
+	// escrowed locks and rolling locks.
 	ActiveLocks(ctx context.Context, in *QueryActiveLocksRequest, opts ...grpc.CallOption) (*QueryActiveLocksResponse, error)
 	// TotalLockedAmount queries the total amount of tokens locked across all accounts.
 	TotalLockedAmount(ctx context.Context, in *QueryTotalLockedAmountRequest, opts ...grpc.CallOption) (*QueryTotalLockedAmountResponse, error)
@@ -37,6 +47,20 @@ type QueryClient interface {
 	AccountLocks(ctx context.Context, in *QueryAccountLocksRequest, opts ...grpc.CallOption) (*QueryAccountLocksResponse, error)
 	// Locks queries active locks for an address.
 	Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error)
+	// LockWeightedTally queries the current tally of a proposal in its voting period with voting power boosted by
+	// active locks. It is informational: x/gov still decides proposals with its own staked tally.
+	LockWeightedTally(ctx context.Context, in *QueryLockWeightedTallyRequest, opts ...grpc.CallOption) (*QueryLockWeightedTallyResponse, error)
+	// RewardsPool queries the balance of the lock rewards pool.
+	RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error)
+	// PendingRewards queries the unclaimed rewards pool payouts of an address.
+	PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error)
+	// AccountSummary queries the locked, delegated and sendable amounts of an address.
+	AccountSummary(ctx context.Context, in *QueryAccountSummaryRequest, opts ...grpc.CallOption) (*QueryAccountSummaryResponse, error)
+	// LockHistory queries the recorded lock changes of an address, oldest first.
+	LockHistory(ctx context.Context, in *QueryLockHistoryRequest, opts ...grpc.CallOption) (*QueryLockHistoryResponse, error)
+	// UnlockSchedule queries the amounts of dated and escrowed locks that unlock chain-wide, aggregated by day, week
+	// or month.
+	UnlockSchedule(ctx context.Context, in *QueryUnlockScheduleRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -47,6 +71,15 @@ func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ActiveLocks(ctx context.Context, in *QueryActiveLocksRequest, opts ...grpc.CallOption) (*QueryActiveLocksResponse, error) {
 	out := new(QueryActiveLocksResponse)
 	err := c.cc.Invoke(ctx, Query_ActiveLocks_FullMethodName, in, out, opts...)
@@ -83,11 +116,68 @@ func (c *queryClient) Locks(ctx context.Context, in *QueryLocksRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) LockWeightedTally(ctx context.Context, in *QueryLockWeightedTallyRequest, opts ...grpc.CallOption) (*QueryLockWeightedTallyResponse, error) {
+	out := new(QueryLockWeightedTallyResponse)
+	err := c.cc.Invoke(ctx, Query_LockWeightedTally_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error) {
+	out := new(QueryRewardsPoolResponse)
+	err := c.cc.Invoke(ctx, Query_RewardsPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error) {
+	out := new(QueryPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountSummary(ctx context.Context, in *QueryAccountSummaryRequest, opts ...grpc.CallOption) (*QueryAccountSummaryResponse, error) {
+	out := new(QueryAccountSummaryResponse)
+	err := c.cc.Invoke(ctx, Query_AccountSummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockHistory(ctx context.Context, in *QueryLockHistoryRequest, opts ...grpc.CallOption) (*QueryLockHistoryResponse, error) {
+	out := new(QueryLockHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_LockHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnlockSchedule(ctx context.Context, in *QueryUnlockScheduleRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleResponse, error) {
+	out := new(QueryUnlockScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_UnlockSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
-	// ActiveLocks queries all active locks with an unlock date in the future.
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ActiveLocks queries all active locks with an unlock date in the future: delegated dated locks, followed by
+	// escrowed locks and rolling locks.
 	ActiveLocks(context.Context, *QueryActiveLocksRequest) (*QueryActiveLocksResponse, error)
 	// TotalLockedAmount queries the total amount of tokens locked across all accounts.
 	TotalLockedAmount(context.Context, *QueryTotalLockedAmountRequest) (*QueryTotalLockedAmountResponse, error)
@@ -95,6 +185,20 @@ type QueryServer interface {
 	AccountLocks(context.Context, *QueryAccountLocksRequest) (*QueryAccountLocksResponse, error)
 	// Locks queries active locks for an address.
 	Locks(context.Context, *QueryLocksRequest) (*QueryLocksResponse, error)
+	// LockWeightedTally queries the current tally of a proposal in its voting period with voting power boosted by
+	// active locks. It is informational: x/gov still decides proposals with its own staked tally.
+	LockWeightedTally(context.Context, *QueryLockWeightedTallyRequest) (*QueryLockWeightedTallyResponse, error)
+	// RewardsPool queries the balance of the lock rewards pool.
+	RewardsPool(context.Context, *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error)
+	// PendingRewards queries the unclaimed rewards pool payouts of an address.
+	PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error)
+	// AccountSummary queries the locked, delegated and sendable amounts of an address.
+	AccountSummary(context.Context, *QueryAccountSummaryRequest) (*QueryAccountSummaryResponse, error)
+	// LockHistory queries the recorded lock changes of an address, oldest first.
+	LockHistory(context.Context, *QueryLockHistoryRequest) (*QueryLockHistoryResponse, error)
+	// UnlockSchedule queries the amounts of dated and escrowed locks that unlock chain-wide, aggregated by day, week
+	// or month.
+	UnlockSchedule(context.Context, *QueryUnlockScheduleRequest) (*QueryUnlockScheduleResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -102,6 +206,9 @@ type QueryServer interface {
 type UnimplementedQueryServer struct {
 }
 
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) ActiveLocks(context.Context, *QueryActiveLocksRequest) (*QueryActiveLocksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActiveLocks not implemented")
 }
@@ -114,6 +221,24 @@ func (UnimplementedQueryServer) AccountLocks(context.Context, *QueryAccountLocks
 func (UnimplementedQueryServer) Locks(context.Context, *QueryLocksRequest) (*QueryLocksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Locks not implemented")
 }
+func (UnimplementedQueryServer) LockWeightedTally(context.Context, *QueryLockWeightedTallyRequest) (*QueryLockWeightedTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockWeightedTally not implemented")
+}
+func (UnimplementedQueryServer) RewardsPool(context.Context, *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsPool not implemented")
+}
+func (UnimplementedQueryServer) PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (UnimplementedQueryServer) AccountSummary(context.Context, *QueryAccountSummaryRequest) (*QueryAccountSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountSummary not implemented")
+}
+func (UnimplementedQueryServer) LockHistory(context.Context, *QueryLockHistoryRequest) (*QueryLockHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockHistory not implemented")
+}
+func (UnimplementedQueryServer) UnlockSchedule(context.Context, *QueryUnlockScheduleRequest) (*QueryUnlockScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockSchedule not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -127,6 +252,24 @@ func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
 	s.RegisterService(&Query_ServiceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ActiveLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryActiveLocksRequest)
 	if err := dec(in); err != nil {
@@ -199,6 +342,114 @@ func _Query_Locks_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LockWeightedTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockWeightedTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockWeightedTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LockWeightedTally_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockWeightedTally(ctx, req.(*QueryLockWeightedTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RewardsPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsPool(ctx, req.(*QueryRewardsPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRewards(ctx, req.(*QueryPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountSummary(ctx, req.(*QueryAccountSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LockHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockHistory(ctx, req.(*QueryLockHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnlockSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnlockScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnlockSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnlockSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnlockSchedule(ctx, req.(*QueryUnlockScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -206,6 +457,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "optio.lockup.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "ActiveLocks",
 			Handler:    _Query_ActiveLocks_Handler,
@@ -222,6 +477,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Locks",
 			Handler:    _Query_Locks_Handler,
 		},
+		{
+			MethodName: "LockWeightedTally",
+			Handler:    _Query_LockWeightedTally_Handler,
+		},
+		{
+			MethodName: "RewardsPool",
+			Handler:    _Query_RewardsPool_Handler,
+		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _Query_PendingRewards_Handler,
+		},
+		{
+			MethodName: "AccountSummary",
+			Handler:    _Query_AccountSummary_Handler,
+		},
+		{
+			MethodName: "LockHistory",
+			Handler:    _Query_LockHistory_Handler,
+		},
+		{
+			MethodName: "UnlockSchedule",
+			Handler:    _Query_UnlockSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "optio/lockup/query.proto",
@@ -19,16 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Lock_FullMethodName                     = "/optio.lockup.Msg/Lock"
-	Msg_Extend_FullMethodName                   = "/optio.lockup.Msg/Extend"
-	Msg_SendDelegateAndLock_FullMethodName      = "/optio.lockup.Msg/SendDelegateAndLock"
-	Msg_MultiSendDelegateAndLock_FullMethodName = "/optio.lockup.Msg/MultiSendDelegateAndLock"
+	Msg_UpdateParams_FullMethodName                = "/optio.lockup.Msg/UpdateParams"
+	Msg_Lock_FullMethodName                        = "/optio.lockup.Msg/Lock"
+	Msg_Extend_FullMethodName                      = "/optio.lockup.Msg/Extend"
+	Msg_SendDelegateAndLock_FullMethodName         = "/optio.lockup.Msg/SendDelegateAndLock"
+	Msg_MultiSendDelegateAndLock_FullMethodName    = "/optio.lockup.Msg/MultiSendDelegateAndLock"
+	Msg_EarlyUnlock_FullMethodName                 = "/optio.lockup.Msg/EarlyUnlock"
+	Msg_TransferLock_FullMethodName                = "/optio.lockup.Msg/TransferLock"
+	Msg_SetAutoRenew_FullMethodName                = "/optio.lockup.Msg/SetAutoRenew"
+	Msg_FundRewardsPool_FullMethodName             = "/optio.lockup.Msg/FundRewardsPool"
+	Msg_ClaimRewards_FullMethodName                = "/optio.lockup.Msg/ClaimRewards"
+	Msg_TokenizeLock_FullMethodName                = "/optio.lockup.Msg/TokenizeLock"
+	Msg_PruneExpiredLocks_FullMethodName           = "/optio.lockup.Msg/PruneExpiredLocks"
+	Msg_LockSchedule_FullMethodName                = "/optio.lockup.Msg/LockSchedule"
+	Msg_SendDelegateAndLockSchedule_FullMethodName = "/optio.lockup.Msg/SendDelegateAndLockSchedule"
+	Msg_DelegateAndLock_FullMethodName             = "/optio.lockup.Msg/DelegateAndLock"
 )
 
 // MsgClient is the client API for Msg service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// Lock locks tokens for a specific address.
 	Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error)
 	// Extend extends the lock duration for a specific address.
@@ -37,6 +51,28 @@ type MsgClient interface {
 	SendDelegateAndLock(ctx context.Context, in *MsgSendDelegateAndLock, opts ...grpc.CallOption) (*MsgSendDelegateAndLockResponse, error)
 	// MultiSendDelegateAndLock sends tokens to multiple addresses, delegates them to a validator, and locks them until specified unlock dates.
 	MultiSendDelegateAndLock(ctx context.Context, in *MsgMultiSendDelegateAndLock, opts ...grpc.CallOption) (*MsgMultiSendDelegateAndLockResponse, error)
+	// EarlyUnlock releases part or all of a lock before its unlock date in exchange for a penalty. The penalty is unbonded
+	// from the delegations backing the lock, so no liquid balance is needed.
+	EarlyUnlock(ctx context.Context, in *MsgEarlyUnlock, opts ...grpc.CallOption) (*MsgEarlyUnlockResponse, error)
+	// TransferLock moves part or all of a lock, together with the delegation backing it, to another address.
+	TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error)
+	// SetAutoRenew turns auto-renewal of a lock on or off.
+	SetAutoRenew(ctx context.Context, in *MsgSetAutoRenew, opts ...grpc.CallOption) (*MsgSetAutoRenewResponse, error)
+	// FundRewardsPool deposits tokens into the lock rewards pool.
+	FundRewardsPool(ctx context.Context, in *MsgFundRewardsPool, opts ...grpc.CallOption) (*MsgFundRewardsPoolResponse, error)
+	// ClaimRewards pays out the rewards pool payouts allocated to an address.
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	// TokenizeLock mints an x/nft token that represents a lock. Sending the token transfers the lock and its delegation.
+	TokenizeLock(ctx context.Context, in *MsgTokenizeLock, opts ...grpc.CallOption) (*MsgTokenizeLockResponse, error)
+	// PruneExpiredLocks removes the expired locks of the given addresses. Any account can submit it.
+	PruneExpiredLocks(ctx context.Context, in *MsgPruneExpiredLocks, opts ...grpc.CallOption) (*MsgPruneExpiredLocksResponse, error)
+	// LockSchedule expands a lock schedule into dated locks.
+	LockSchedule(ctx context.Context, in *MsgLockSchedule, opts ...grpc.CallOption) (*MsgLockScheduleResponse, error)
+	// SendDelegateAndLockSchedule sends tokens to an address, delegates them to a validator, and locks them according
+	// to a lock schedule.
+	SendDelegateAndLockSchedule(ctx context.Context, in *MsgSendDelegateAndLockSchedule, opts ...grpc.CallOption) (*MsgSendDelegateAndLockScheduleResponse, error)
+	// DelegateAndLock delegates liquid tokens of an address to one or more validators and locks them in one step.
+	DelegateAndLock(ctx context.Context, in *MsgDelegateAndLock, opts ...grpc.CallOption) (*MsgDelegateAndLockResponse, error)
 }
 
 type msgClient struct {
@@ -47,6 +83,15 @@ func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
 	return &msgClient{cc}
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error) {
 	out := new(MsgLockResponse)
 	err := c.cc.Invoke(ctx, Msg_Lock_FullMethodName, in, out, opts...)
@@ -83,10 +128,103 @@ func (c *msgClient) MultiSendDelegateAndLock(ctx context.Context, in *MsgMultiSe
 	return out, nil
 }
 
+func (c *msgClient) EarlyUnlock(ctx context.Context, in *MsgEarlyUnlock, opts ...grpc.CallOption) (*MsgEarlyUnlockResponse, error) {
+	out := new(MsgEarlyUnlockResponse)
+	err := c.cc.Invoke(ctx, Msg_EarlyUnlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error) {
+	out := new(MsgTransferLockResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferLock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoRenew(ctx context.Context, in *MsgSetAutoRenew, opts ...grpc.CallOption) (*MsgSetAutoRenewResponse, error) {
+	out := new(MsgSetAutoRenewResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAutoRenew_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundRewardsPool(ctx context.Context, in *MsgFundRewardsPool, opts ...grpc.CallOption) (*MsgFundRewardsPoolResponse, error) {
+	out := new(MsgFundRewardsPoolResponse)
+	err := c.cc.Invoke(ctx, Msg_FundRewardsPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TokenizeLock(ctx context.Context, in *MsgTokenizeLock, opts ...grpc.CallOption) (*MsgTokenizeLockResponse, error) {
+	out := new(MsgTokenizeLockResponse)
+	err := c.cc.Invoke(ctx, Msg_TokenizeLock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PruneExpiredLocks(ctx context.Context, in *MsgPruneExpiredLocks, opts ...grpc.CallOption) (*MsgPruneExpiredLocksResponse, error) {
+	out := new(MsgPruneExpiredLocksResponse)
+	err := c.cc.Invoke(ctx, Msg_PruneExpiredLocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockSchedule(ctx context.Context, in *MsgLockSchedule, opts ...grpc.CallOption) (*MsgLockScheduleResponse, error) {
+	out := new(MsgLockScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_LockSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendDelegateAndLockSchedule(ctx context.Context, in *MsgSendDelegateAndLockSchedule, opts ...grpc.CallOption) (*MsgSendDelegateAndLockScheduleResponse, error) {
+	out := new(MsgSendDelegateAndLockScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_SendDelegateAndLockSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateAndLock(ctx context.Context, in *MsgDelegateAndLock, opts ...grpc.CallOption) (*MsgDelegateAndLockResponse, error) {
+	out := new(MsgDelegateAndLockResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateAndLock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// Lock locks tokens for a specific address.
 	Lock(context.Context, *MsgLock) (*MsgLockResponse, error)
 	// Extend extends the lock duration for a specific address.
@@ -95,6 +233,28 @@ type MsgServer interface {
 	SendDelegateAndLock(context.Context, *MsgSendDelegateAndLock) (*MsgSendDelegateAndLockResponse, error)
 	// MultiSendDelegateAndLock sends tokens to multiple addresses, delegates them to a validator, and locks them until specified unlock dates.
 	MultiSendDelegateAndLock(context.Context, *MsgMultiSendDelegateAndLock) (*MsgMultiSendDelegateAndLockResponse, error)
+	// EarlyUnlock releases part or all of a lock before its unlock date in exchange for a penalty. The penalty is unbonded
+	// from the delegations backing the lock, so no liquid balance is needed.
+	EarlyUnlock(context.Context, *MsgEarlyUnlock) (*MsgEarlyUnlockResponse, error)
+	// TransferLock moves part or all of a lock, together with the delegation backing it, to another address.
+	TransferLock(context.Context, *MsgTransferLock) (*MsgTransferLockResponse, error)
+	// SetAutoRenew turns auto-renewal of a lock on or off.
+	SetAutoRenew(context.Context, *MsgSetAutoRenew) (*MsgSetAutoRenewResponse, error)
+	// FundRewardsPool deposits tokens into the lock rewards pool.
+	FundRewardsPool(context.Context, *MsgFundRewardsPool) (*MsgFundRewardsPoolResponse, error)
+	// ClaimRewards pays out the rewards pool payouts allocated to an address.
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	// TokenizeLock mints an x/nft token that represents a lock. Sending the token transfers the lock and its delegation.
+	TokenizeLock(context.Context, *MsgTokenizeLock) (*MsgTokenizeLockResponse, error)
+	// PruneExpiredLocks removes the expired locks of the given addresses. Any account can submit it.
+	PruneExpiredLocks(context.Context, *MsgPruneExpiredLocks) (*MsgPruneExpiredLocksResponse, error)
+	// LockSchedule expands a lock schedule into dated locks.
+	LockSchedule(context.Context, *MsgLockSchedule) (*MsgLockScheduleResponse, error)
+	// SendDelegateAndLockSchedule sends tokens to an address, delegates them to a validator, and locks them according
+	// to a lock schedule.
+	SendDelegateAndLockSchedule(context.Context, *MsgSendDelegateAndLockSchedule) (*MsgSendDelegateAndLockScheduleResponse, error)
+	// DelegateAndLock delegates liquid tokens of an address to one or more validators and locks them in one step.
+	DelegateAndLock(context.Context, *MsgDelegateAndLock) (*MsgDelegateAndLockResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -102,6 +262,9 @@ type MsgServer interface {
 type UnimplementedMsgServer struct {
 }
 
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) Lock(context.Context, *MsgLock) (*MsgLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
 }
@@ -114,6 +277,36 @@ func (UnimplementedMsgServer) SendDelegateAndLock(context.Context, *MsgSendDeleg
 func (UnimplementedMsgServer) MultiSendDelegateAndLock(context.Context, *MsgMultiSendDelegateAndLock) (*MsgMultiSendDelegateAndLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiSendDelegateAndLock not implemented")
 }
+func (UnimplementedMsgServer) EarlyUnlock(context.Context, *MsgEarlyUnlock) (*MsgEarlyUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarlyUnlock not implemented")
+}
+func (UnimplementedMsgServer) TransferLock(context.Context, *MsgTransferLock) (*MsgTransferLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLock not implemented")
+}
+func (UnimplementedMsgServer) SetAutoRenew(context.Context, *MsgSetAutoRenew) (*MsgSetAutoRenewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoRenew not implemented")
+}
+func (UnimplementedMsgServer) FundRewardsPool(context.Context, *MsgFundRewardsPool) (*MsgFundRewardsPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundRewardsPool not implemented")
+}
+func (UnimplementedMsgServer) ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (UnimplementedMsgServer) TokenizeLock(context.Context, *MsgTokenizeLock) (*MsgTokenizeLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeLock not implemented")
+}
+func (UnimplementedMsgServer) PruneExpiredLocks(context.Context, *MsgPruneExpiredLocks) (*MsgPruneExpiredLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneExpiredLocks not implemented")
+}
+func (UnimplementedMsgServer) LockSchedule(context.Context, *MsgLockSchedule) (*MsgLockScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockSchedule not implemented")
+}
+func (UnimplementedMsgServer) SendDelegateAndLockSchedule(context.Context, *MsgSendDelegateAndLockSchedule) (*MsgSendDelegateAndLockScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDelegateAndLockSchedule not implemented")
+}
+func (UnimplementedMsgServer) DelegateAndLock(context.Context, *MsgDelegateAndLock) (*MsgDelegateAndLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateAndLock not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -127,6 +320,24 @@ func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
 	s.RegisterService(&Msg_ServiceDesc, srv)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgLock)
 	if err := dec(in); err != nil {
@@ -199,6 +410,186 @@ func _Msg_MultiSendDelegateAndLock_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EarlyUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEarlyUnlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EarlyUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_EarlyUnlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EarlyUnlock(ctx, req.(*MsgEarlyUnlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferLock(ctx, req.(*MsgTransferLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRenew)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoRenew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAutoRenew_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoRenew(ctx, req.(*MsgSetAutoRenew))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundRewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundRewardsPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundRewardsPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FundRewardsPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundRewardsPool(ctx, req.(*MsgFundRewardsPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TokenizeLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenizeLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TokenizeLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TokenizeLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TokenizeLock(ctx, req.(*MsgTokenizeLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PruneExpiredLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneExpiredLocks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneExpiredLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PruneExpiredLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneExpiredLocks(ctx, req.(*MsgPruneExpiredLocks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LockSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockSchedule(ctx, req.(*MsgLockSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendDelegateAndLockSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendDelegateAndLockSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendDelegateAndLockSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SendDelegateAndLockSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendDelegateAndLockSchedule(ctx, req.(*MsgSendDelegateAndLockSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateAndLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateAndLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateAndLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateAndLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateAndLock(ctx, req.(*MsgDelegateAndLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -206,6 +597,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "optio.lockup.Msg",
 	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 		{
 			MethodName: "Lock",
 			Handler:    _Msg_Lock_Handler,
@@ -222,6 +617,46 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MultiSendDelegateAndLock",
 			Handler:    _Msg_MultiSendDelegateAndLock_Handler,
 		},
+		{
+			MethodName: "EarlyUnlock",
+			Handler:    _Msg_EarlyUnlock_Handler,
+		},
+		{
+			MethodName: "TransferLock",
+			Handler:    _Msg_TransferLock_Handler,
+		},
+		{
+			MethodName: "SetAutoRenew",
+			Handler:    _Msg_SetAutoRenew_Handler,
+		},
+		{
+			MethodName: "FundRewardsPool",
+			Handler:    _Msg_FundRewardsPool_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "TokenizeLock",
+			Handler:    _Msg_TokenizeLock_Handler,
+		},
+		{
+			MethodName: "PruneExpiredLocks",
+			Handler:    _Msg_PruneExpiredLocks_Handler,
+		},
+		{
+			MethodName: "LockSchedule",
+			Handler:    _Msg_LockSchedule_Handler,
+		},
+		{
+			MethodName: "SendDelegateAndLockSchedule",
+			Handler:    _Msg_SendDelegateAndLockSchedule_Handler,
+		},
+		{
+			MethodName: "DelegateAndLock",
+			Handler:    _Msg_DelegateAndLock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "optio/lockup/tx.proto",
@@ -31,7 +31,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
-		lockupante.NewLockedDelegationsDecorator(options.AccountKeeper, options.BankKeeper, options.LockupKeeper, options.StakingKeeper),
+		lockupante.NewLockedDelegationsDecorator(options.AccountKeeper, options.LockupKeeper, options.StakingKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
@@ -25,6 +25,7 @@ import (
 	"github.com/OptioNetwork/optio/app/posthandler"
 	v2_distro "github.com/OptioNetwork/optio/app/upgrades/v2_distro"
 	v3_lockup "github.com/OptioNetwork/optio/app/upgrades/v3_lockup"
+	v4_lockup "github.com/OptioNetwork/optio/app/upgrades/v4_lockup"
 	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -110,7 +111,7 @@ var (
 	_ servertypes.Application = (*App)(nil)
 )
 var (
-	Upgrades = []upgrades.Upgrade{v2_distro.Upgrade, v3_lockup.Upgrade}
+	Upgrades = []upgrades.Upgrade{v2_distro.Upgrade, v3_lockup.Upgrade, v4_lockup.Upgrade}
 )
 
 // App extends an ABCI application, but with most of its parameters exported.
@@ -166,6 +166,8 @@ var (
 		{Account: icatypes.ModuleName},
 		{Account: distromoduletypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: lockupmoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
+		{Account: lockupmoduletypes.RewardsPoolName},
+		{Account: lockupmoduletypes.PenaltyPoolName},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 
@@ -177,8 +179,10 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		nft.ModuleName,
+		lockupmoduletypes.PenaltyPoolName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
+		// lockupmoduletypes.RewardsPoolName
 	}
 
 	// appConfig application configuration (used by depinject)
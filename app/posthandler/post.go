@@ -16,6 +16,7 @@ type HandlerOptions struct {
 // NewPostHandler returns an empty PostHandler chain.
 func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
 	return sdk.ChainPostDecorators(
+		lockuppost.NewSettleLockNFTTransfersDecorator(options.LockupKeeper),
 		lockuppost.NewRemoveExpiredLocksDecorator(options.AccountKeeper, options.LockupKeeper),
 	), nil
 
@@ -0,0 +1,14 @@
+package v4_lockup
+
+import (
+	store "cosmossdk.io/store/types"
+	"github.com/OptioNetwork/optio/app/upgrades"
+)
+
+const UpgradeName = "v4-lockup"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades:        store.StoreUpgrades{},
+}
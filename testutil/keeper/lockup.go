@@ -10,11 +10,15 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/OptioNetwork/optio/x/lockup/keeper"
@@ -22,6 +26,16 @@ import (
 )
 
 func LockupKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return LockupKeeperWithKeepers(t, nil, nil)
+}
+
+// LockupKeeperWithKeepers returns a lockup keeper backed by the given bank and staking keepers
+func LockupKeeperWithKeepers(t testing.TB, bankKeeper types.BankKeeper, stakingKeeper types.StakingKeeper) (keeper.Keeper, sdk.Context) {
+	return LockupKeeperWithNFTKeeper(t, bankKeeper, stakingKeeper, nil)
+}
+
+// LockupKeeperWithNFTKeeper returns a lockup keeper backed by the given bank, staking and nft keepers
+func LockupKeeperWithNFTKeeper(t testing.TB, bankKeeper types.BankKeeper, stakingKeeper types.StakingKeeper, nftKeeper types.NFTKeeper) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -38,12 +52,83 @@ func LockupKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
+		bankKeeper,
 		nil,
+		stakingKeeper,
 		nil,
 		nil,
+		nftKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
 
+	// Initialize params
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+
 	return k, ctx
 }
+
+// LockupKeeperWithBankKeeper returns a lockup keeper backed by a real bank
+// keeper, with SendRestrictionFn registered as it is in the app. The mint
+// module account can mint coins to fund test accounts.
+func LockupKeeperWithBankKeeper(t testing.TB, stakingKeeper types.StakingKeeper) (keeper.Keeper, bankkeeper.BaseKeeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	authStoreKey := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	bankStoreKey := storetypes.NewKVStoreKey("bank")
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, key := range []*storetypes.KVStoreKey{storeKey, authStoreKey, bankStoreKey} {
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	}
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		cdc,
+		runtime.NewKVStoreService(authStoreKey),
+		authtypes.ProtoBaseAccount,
+		map[string][]string{
+			minttypes.ModuleName: {authtypes.Minter},
+			types.ModuleName:     {authtypes.Burner},
+		},
+		addresscodec.NewBech32Codec(bech32Prefix),
+		bech32Prefix,
+		authority.String(),
+	)
+
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		cdc,
+		runtime.NewKVStoreService(bankStoreKey),
+		accountKeeper,
+		map[string]bool{},
+		authority.String(),
+		log.NewNopLogger(),
+	)
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authority.String(),
+		bankKeeper,
+		nil,
+		stakingKeeper,
+		nil,
+		nil,
+		nil,
+	)
+	bankKeeper.AppendSendRestriction(k.SendRestrictionFn)
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	return k, bankKeeper, ctx
+}
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/OptioNetwork/optio/x/lockup/types"
@@ -10,9 +12,23 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagAutoRenew         = "auto-renew"
+	FlagEscrow            = "escrow"
+	FlagCliffPeriods      = "cliff-periods"
+	FlagSpendLimit        = "spend-limit"
+	FlagMaxUnlockDate     = "max-unlock-date"
+	FlagAllowedValidators = "allowed-validators"
+	FlagAllowedRecipients = "allowed-recipients"
+	FlagExpiration        = "expiration"
+	FlagDenom             = "denom"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -27,6 +43,17 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdExtend())
 	cmd.AddCommand(CmdSendDelegateAndLock())
 	cmd.AddCommand(CmdMultiSendDelegateAndLock())
+	cmd.AddCommand(CmdEarlyUnlock())
+	cmd.AddCommand(CmdTransferLock())
+	cmd.AddCommand(CmdSetAutoRenew())
+	cmd.AddCommand(CmdFundRewardsPool())
+	cmd.AddCommand(CmdClaimRewards())
+	cmd.AddCommand(CmdTokenizeLock())
+	cmd.AddCommand(CmdPruneExpiredLocks())
+	cmd.AddCommand(CmdLockSchedule())
+	cmd.AddCommand(CmdSendDelegateAndLockSchedule())
+	cmd.AddCommand(CmdDelegateAndLock())
+	cmd.AddCommand(CmdGrantLockAuthorization())
 
 	return cmd
 }
@@ -48,10 +75,22 @@ func CmdLock() *cobra.Command {
 				return fmt.Errorf("invalid amount: %s", args[1])
 			}
 
+			autoRenew, err := cmd.Flags().GetBool(FlagAutoRenew)
+			if err != nil {
+				return err
+			}
+
+			escrow, err := cmd.Flags().GetBool(FlagEscrow)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgLock{
 				Address:    clientCtx.GetFromAddress().String(),
 				UnlockDate: unlockDate,
 				Amount:     sdk.NewCoin("uOPT", amount),
+				AutoRenew:  autoRenew,
+				Escrow:     escrow,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -62,6 +101,8 @@ func CmdLock() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagAutoRenew, false, "Keep the lock at the same distance from the current day until auto-renewal is turned off")
+	cmd.Flags().Bool(FlagEscrow, false, "Escrow the tokens in the lockup module account instead of requiring delegations")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -79,6 +120,11 @@ func CmdExtend() *cobra.Command {
 				return err
 			}
 
+			escrow, err := cmd.Flags().GetBool(FlagEscrow)
+			if err != nil {
+				return err
+			}
+
 			extensions := make([]*types.Extension, 0, len(args))
 			for i, arg := range args {
 				parts := strings.Split(arg, ":")
@@ -97,6 +143,7 @@ func CmdExtend() *cobra.Command {
 					FromDate: fromDate,
 					ToDate:   toDate,
 					Amount:   sdk.NewCoin("uOPT", amount),
+					Escrow:   escrow,
 				})
 			}
 
@@ -113,6 +160,7 @@ func CmdExtend() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagEscrow, false, "Extend escrowed locks instead of delegated locks")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -217,3 +265,520 @@ Example:
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdEarlyUnlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "early-unlock unlock-date amount",
+		Short: "Unlock tokens of the bond denom before their unlock date in exchange for a penalty",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			unlockDate := args[0]
+			amount, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[1])
+			}
+
+			bondDenom, err := queryBondDenom(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgEarlyUnlock{
+				Address:    clientCtx.GetFromAddress().String(),
+				UnlockDate: unlockDate,
+				Amount:     sdk.NewCoin(bondDenom, amount),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdTransferLock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-lock [to-address] [validator-address] [unlock-date] [amount]",
+		Short: "Transfer a lock and the delegation backing it to another address",
+		Long: `Transfer part or all of a lock to another address, keeping its unlock date. The delegation backing the lock is moved from the given validator to the recipient.
+Example:
+  transfer-lock optio1abc... optiovaloper1xyz... 2026-12-01 1000`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddress := args[0]
+			validatorAddress := args[1]
+			unlockDate := args[2]
+			amount, ok := math.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[3])
+			}
+
+			msg := &types.MsgTransferLock{
+				FromAddress:      clientCtx.GetFromAddress().String(),
+				ToAddress:        toAddress,
+				ValidatorAddress: validatorAddress,
+				UnlockDate:       unlockDate,
+				Amount:           sdk.NewCoin("uOPT", amount),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSetAutoRenew() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-renew [unlock-date] [true|false]",
+		Short: "Turn auto-renewal of a lock on or off",
+		Long: `Turn auto-renewal of the lock that currently unlocks on the given date on or off. While auto-renewal is on, the lock stays at the same distance from the current day. Turning it off starts the countdown to the current unlock date.
+Example:
+  set-auto-renew 2027-12-01 true`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			autoRenew, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid auto-renew value: %s", args[1])
+			}
+
+			msg := &types.MsgSetAutoRenew{
+				Address:    clientCtx.GetFromAddress().String(),
+				UnlockDate: args[0],
+				AutoRenew:  autoRenew,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdFundRewardsPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-rewards-pool [amount]",
+		Short: "Deposit tokens into the lock rewards pool",
+		Long: `Deposit tokens into the lock rewards pool. The pool is paid out to lockers at the end of every reward epoch.
+Example:
+  fund-rewards-pool 1000000uOPT`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			msg := &types.MsgFundRewardsPool{
+				Depositor: clientCtx.GetFromAddress().String(),
+				Amount:    amount,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdClaimRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards",
+		Short: "Claim the rewards pool payouts allocated to your locks",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimRewards{
+				Address: clientCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdTokenizeLock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-lock [unlock-date]",
+		Short: "Mint an x/nft token representing your lock on the given date; sending the token transfers the lock",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeLock(clientCtx.GetFromAddress().String(), args[0])
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdPruneExpiredLocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-expired-locks [address]...",
+		Short: "Remove the expired locks of one or more addresses",
+		Args:  cobra.RangeArgs(1, types.MaxPruneAddresses),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPruneExpiredLocks(clientCtx.GetFromAddress().String(), args)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdDelegateAndLock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-and-lock [unlock-date] [amount] [validator-address[:weight]]...",
+		Short: "Delegate liquid tokens to one or more validators and lock them",
+		Long: `Delegate tokens from your balance and lock them until a specific unlock date in one step.
+The amount is split between the validators by weight. The weights must sum to 1 and can be omitted for a single validator.
+Example:
+  delegate-and-lock 2026-12-01 1000 optiovaloper1abc...:0.75 optiovaloper1xyz...:0.25`,
+		Args: cobra.RangeArgs(3, types.MaxDelegateAndLockValidators+2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			unlockDate := args[0]
+			amount, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[1])
+			}
+
+			validators := make([]types.ValidatorWeight, 0, len(args)-2)
+			for i, arg := range args[2:] {
+				validatorAddress, weightArg, found := strings.Cut(arg, ":")
+
+				weight := math.LegacyOneDec()
+				if found {
+					weight, err = math.LegacyNewDecFromStr(weightArg)
+					if err != nil {
+						return fmt.Errorf("invalid weight at position %d: %s", i, weightArg)
+					}
+				}
+
+				validators = append(validators, types.ValidatorWeight{ValidatorAddress: validatorAddress, Weight: weight})
+			}
+
+			bondDenom, err := queryBondDenom(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateAndLock(clientCtx.GetFromAddress().String(), validators, unlockDate, sdk.NewCoin(bondDenom, amount))
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+var lockSchedulePeriods = map[string]types.LockSchedulePeriod{
+	"daily":   types.LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_DAILY,
+	"weekly":  types.LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_WEEKLY,
+	"monthly": types.LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_MONTHLY,
+}
+
+// parseLockSchedule builds a lock schedule from its arguments and the cliff periods flag
+func parseLockSchedule(cmd *cobra.Command, startDate, period, count string) (types.LockSchedule, error) {
+	schedulePeriod, ok := lockSchedulePeriods[period]
+	if !ok {
+		return types.LockSchedule{}, fmt.Errorf("invalid period %s, expected daily, weekly or monthly", period)
+	}
+
+	scheduleCount, err := strconv.ParseUint(count, 10, 32)
+	if err != nil {
+		return types.LockSchedule{}, fmt.Errorf("invalid count: %s", count)
+	}
+
+	cliffPeriods, err := cmd.Flags().GetUint32(FlagCliffPeriods)
+	if err != nil {
+		return types.LockSchedule{}, err
+	}
+
+	return types.NewLockSchedule(startDate, schedulePeriod, uint32(scheduleCount), cliffPeriods), nil
+}
+
+func CmdLockSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-schedule [total-amount] [start-date] [daily|weekly|monthly] [count]",
+		Short: "Lock tokens in equal parts that unlock one period apart",
+		Long: `Split the total amount into count locks that unlock one period apart, the first one period after the start date.
+The locks of the first --cliff-periods periods all unlock at the end of the cliff.
+The tokens are of the bond denom unless --denom is set.
+Example:
+  lock-schedule 12000 2026-01-01 monthly 12 --cliff-periods 3`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			totalAmount, ok := math.NewIntFromString(args[0])
+			if !ok {
+				return fmt.Errorf("invalid total amount: %s", args[0])
+			}
+
+			schedule, err := parseLockSchedule(cmd, args[1], args[2], args[3])
+			if err != nil {
+				return err
+			}
+
+			escrow, err := cmd.Flags().GetBool(FlagEscrow)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			if denom == "" {
+				denom, err = queryBondDenom(cmd, clientCtx)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgLockSchedule(clientCtx.GetFromAddress().String(), sdk.NewCoin(denom, totalAmount), schedule)
+			msg.Escrow = escrow
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagCliffPeriods, 0, "Number of periods whose locks all unlock at the end of the cliff")
+	cmd.Flags().Bool(FlagEscrow, false, "Escrow the tokens in the lockup module account instead of requiring delegations")
+	cmd.Flags().String(FlagDenom, "", "Denom of the tokens to lock, the bond denom if empty")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSendDelegateAndLockSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-delegate-and-lock-schedule [to-address] [validator-address] [total-amount] [start-date] [daily|weekly|monthly] [count]",
+		Short: "Send tokens to an address, delegate them to a validator, and lock them in equal parts that unlock one period apart",
+		Long: `Send the total amount from your address to another address, delegate it to a validator, and lock it as in lock-schedule.
+Example:
+  send-delegate-and-lock-schedule optio1abc... optiovaloper1xyz... 48000 2026-01-01 monthly 48 --cliff-periods 12`,
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			totalAmount, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid total amount: %s", args[2])
+			}
+
+			schedule, err := parseLockSchedule(cmd, args[3], args[4], args[5])
+			if err != nil {
+				return err
+			}
+
+			bondDenom, err := queryBondDenom(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendDelegateAndLockSchedule(clientCtx.GetFromAddress().String(), args[0], args[1], sdk.NewCoin(bondDenom, totalAmount), schedule)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagCliffPeriods, 0, "Number of periods whose locks all unlock at the end of the cliff")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+var lockAuthorizationTypes = map[string]types.LockAuthorizationType{
+	"lock":                            types.LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_LOCK,
+	"extend":                          types.LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_EXTEND,
+	"send-delegate-and-lock":          types.LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK,
+	"lock-schedule":                   types.LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_LOCK_SCHEDULE,
+	"send-delegate-and-lock-schedule": types.LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK_SCHEDULE,
+	"delegate-and-lock":               types.LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_DELEGATE_AND_LOCK,
+}
+
+func CmdGrantLockAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-lock-authorization [grantee] [lock|extend|delegate-and-lock|send-delegate-and-lock|lock-schedule|send-delegate-and-lock-schedule]",
+		Short: "Allow the grantee to lock, extend, delegate and lock, or send, delegate and lock, at once or on a schedule, on your behalf within limits",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			authorizationType, ok := lockAuthorizationTypes[args[1]]
+			if !ok {
+				return fmt.Errorf("invalid authorization type %s, expected lock, extend, delegate-and-lock, send-delegate-and-lock, lock-schedule or send-delegate-and-lock-schedule", args[1])
+			}
+
+			var spendLimit *sdk.Coin
+			limit, err := cmd.Flags().GetString(FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+			if limit != "" {
+				coin, err := sdk.ParseCoinNormalized(limit)
+				if err != nil {
+					return err
+				}
+				spendLimit = &coin
+			}
+
+			maxUnlockDate, err := cmd.Flags().GetString(FlagMaxUnlockDate)
+			if err != nil {
+				return err
+			}
+
+			allowedValidators, err := cmd.Flags().GetStringSlice(FlagAllowedValidators)
+			if err != nil {
+				return err
+			}
+
+			allowedRecipients, err := cmd.Flags().GetStringSlice(FlagAllowedRecipients)
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewLockAuthorization(authorizationType, spendLimit, maxUnlockDate, allowedValidators, allowedRecipients)
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if exp != 0 {
+				e := time.Unix(exp, 0)
+				expiration = &e
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "Maximum amount the grantee can lock or extend in total, e.g. 1000uOPT")
+	cmd.Flags().String(FlagMaxUnlockDate, "", "Latest unlock date the grantee can lock or extend to")
+	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Validators the grantee can delegate to with delegate-and-lock, send-delegate-and-lock and send-delegate-and-lock-schedule")
+	cmd.Flags().StringSlice(FlagAllowedRecipients, []string{}, "Addresses the grantee can send, delegate and lock for, only yourself if empty")
+	cmd.Flags().Int64(FlagExpiration, 0, "Expire time of the authorization, as a unix timestamp")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// queryBondDenom returns the bond denom of the chain from the staking params.
+func queryBondDenom(cmd *cobra.Command, clientCtx client.Context) (string, error) {
+	res, err := stakingtypes.NewQueryClient(clientCtx).Params(cmd.Context(), &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return "", err
+	}
+	return res.Params.BondDenom, nil
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// escrowLock moves amount from addr into the lockup module account and locks
+// it until unlockDate. Escrowed locks are not backed by delegations, so they
+// do not count towards the locked amount of addr, even in the bond denom.
+func (k Keeper) escrowLock(ctx sdk.Context, params types.Params, addr sdk.AccAddress, unlockDate string, amount sdk.Coin) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	return k.addEscrowLock(ctx, params, addr, unlockDate, amount)
+}
+
+// addEscrowLock adds amount to the escrowed lock of addr that unlocks on
+// unlockDate, in both lock indexes. The tokens must already be escrowed.
+// Addresses the bank module blocks from receiving funds are rejected, as the
+// tokens could never be released to them.
+func (k Keeper) addEscrowLock(ctx sdk.Context, params types.Params, addr sdk.AccAddress, unlockDate string, amount sdk.Coin) error {
+	if k.bankKeeper.BlockedAddr(addr) {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", addr)
+	}
+
+	unlockTime, err := types.ParseUnlockTime(unlockDate)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", unlockDate)
+	}
+
+	existingLock, idx, found := k.GetLockByAddressDateAndDenom(ctx, addr, unlockDate, amount.Denom)
+	if found {
+		err = k.UpdateLockByAddressAndIndex(ctx, addr, idx, &types.Lock{UnlockDate: unlockDate, Amount: existingLock.Amount.Add(amount.Amount), Denom: amount.Denom, Kind: types.LockKind_LOCK_KIND_ESCROWED})
+	} else {
+		if err = k.checkLockLimit(ctx, params, addr); err != nil {
+			return err
+		}
+
+		err = k.SetLockByAddress(ctx, addr, &types.Lock{UnlockDate: unlockDate, Amount: amount.Amount, Denom: amount.Denom, Kind: types.LockKind_LOCK_KIND_ESCROWED})
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.AddToEscrowQueue(ctx, unlockTime, addr, amount)
+}
+
+// escrowLockExpired returns the tokens of an expired escrowed lock, which has
+// already been removed from both lock indexes, to addr
+func (k Keeper) escrowLockExpired(ctx sdk.Context, addr sdk.AccAddress, unlockDate string, amount sdk.Coin) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	return k.lockExpired(ctx, addr, unlockDate, amount.Amount, amount.Denom)
+}
+
+// lockEventAttributes returns the attributes of a lock event. Events of
+// escrowed locks also carry the denom, which is empty for bond denom locks.
+func lockEventAttributes(address, unlockDate string, amount math.Int, denom string) []sdk.Attribute {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyLockAddress, address),
+		sdk.NewAttribute(types.AttributeKeyUnlockDate, unlockDate),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	}
+	if denom != "" {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyDenom, denom))
+	}
+	return attributes
+}
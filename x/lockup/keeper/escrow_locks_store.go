@@ -0,0 +1,252 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetEscrowAddress returns the address of the lockup module account, which holds escrowed tokens
+func (k Keeper) GetEscrowAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
+// GetTotalEscrowed returns the total escrowed amount of denom
+func (k Keeper) GetTotalEscrowed(ctx context.Context, denom string) (math.Int, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(append(append([]byte{}, types.TotalEscrowedKey...), denom...))
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	if bz == nil {
+		return math.ZeroInt(), nil
+	}
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		return math.ZeroInt(), err
+	}
+	return amount, nil
+}
+
+// adjustTotalEscrowed adds delta (which may be negative) to the total escrowed amount of denom
+func (k Keeper) adjustTotalEscrowed(ctx context.Context, denom string, delta math.Int) error {
+	totalEscrowed, err := k.GetTotalEscrowed(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	newTotal := totalEscrowed.Add(delta)
+	if newTotal.IsNegative() {
+		return types.ErrInvalidAmount.Wrapf("total escrowed amount of %s cannot be negative: %s", denom, newTotal.String())
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	key := append(append([]byte{}, types.TotalEscrowedKey...), denom...)
+	if newTotal.IsZero() {
+		return store.Delete(key)
+	}
+
+	bz, err := newTotal.Marshal()
+	if err != nil {
+		return err
+	}
+	return store.Set(key, bz)
+}
+
+// GetAllTotalEscrowed returns the total escrowed amount of every denom
+func (k Keeper) GetAllTotalEscrowed(ctx context.Context) (sdk.Coins, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iter, err := store.Iterator(types.TotalEscrowedKey, prefixEndBytes(types.TotalEscrowedKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var totals sdk.Coins
+	for ; iter.Valid(); iter.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		totals = totals.Add(sdk.NewCoin(string(iter.Key()[len(types.TotalEscrowedKey):]), amount))
+	}
+
+	return totals, nil
+}
+
+// GetEscrowLockExpirationKey creates the key for the escrowed lock expiration queue
+// Key: Prefix + Timestamp (8 bytes) + Address length (1 byte) + Address + Denom
+func (k Keeper) GetEscrowLockExpirationKey(unlockTime time.Time, addr sdk.AccAddress, denom string) []byte {
+	key := binary.BigEndian.AppendUint64(append([]byte{}, types.EscrowLocksByDateKey...), uint64(unlockTime.Unix()))
+	key = append(key, address.MustLengthPrefix(addr)...)
+	return append(key, denom...)
+}
+
+// parseEscrowLockExpirationKey returns the unlock time, address and denom of an escrowed lock expiration queue key
+func parseEscrowLockExpirationKey(key []byte) (time.Time, sdk.AccAddress, string, error) {
+	prefixLen := len(types.EscrowLocksByDateKey)
+	if len(key) < prefixLen+9 {
+		return time.Time{}, nil, "", fmt.Errorf("invalid escrowed lock expiration key: %X", key)
+	}
+
+	unlockTime := time.Unix(int64(binary.BigEndian.Uint64(key[prefixLen:prefixLen+8])), 0)
+
+	addrLen := int(key[prefixLen+8])
+	rest := key[prefixLen+9:]
+	if len(rest) <= addrLen {
+		return time.Time{}, nil, "", fmt.Errorf("invalid escrowed lock expiration key: %X", key)
+	}
+
+	return unlockTime, sdk.AccAddress(rest[:addrLen]), string(rest[addrLen:]), nil
+}
+
+// AddToEscrowQueue adds an escrowed lock to the escrowed lock expiration queue
+// If entry exists, adds the amount. The total escrowed amount of the denom is increased accordingly
+func (k Keeper) AddToEscrowQueue(ctx context.Context, unlockTime time.Time, addr sdk.AccAddress, amount sdk.Coin) error {
+	store := k.storeService.OpenKVStore(ctx)
+	key := k.GetEscrowLockExpirationKey(unlockTime, addr, amount.Denom)
+
+	bz, err := store.Get(key)
+	if err != nil {
+		return err
+	}
+
+	currentAmount := math.ZeroInt()
+	if bz != nil {
+		if err := currentAmount.Unmarshal(bz); err != nil {
+			return err
+		}
+	}
+
+	bz, err = currentAmount.Add(amount.Amount).Marshal()
+	if err != nil {
+		return err
+	}
+
+	if err := store.Set(key, bz); err != nil {
+		return err
+	}
+
+	return k.adjustTotalEscrowed(ctx, amount.Denom, amount.Amount)
+}
+
+// RemoveFromEscrowQueue removes an amount from the escrowed lock expiration queue
+// If the resulting amount is zero, deletes the entry. The total escrowed amount of the denom is decreased accordingly
+func (k Keeper) RemoveFromEscrowQueue(ctx context.Context, unlockTime time.Time, addr sdk.AccAddress, amount sdk.Coin) error {
+	store := k.storeService.OpenKVStore(ctx)
+	key := k.GetEscrowLockExpirationKey(unlockTime, addr, amount.Denom)
+
+	bz, err := store.Get(key)
+	if err != nil {
+		return err
+	}
+
+	if bz == nil {
+		return nil
+	}
+
+	currentAmount := math.ZeroInt()
+	if err := currentAmount.Unmarshal(bz); err != nil {
+		return err
+	}
+
+	if currentAmount.LT(amount.Amount) {
+		return types.ErrInvalidAmount.Wrapf("cannot remove %s from escrowed lock expiration queue, only %s available", amount.Amount.String(), currentAmount.String())
+	}
+
+	newAmount := currentAmount.Sub(amount.Amount)
+
+	if newAmount.IsZero() {
+		err = store.Delete(key)
+	} else {
+		bz, err = newAmount.Marshal()
+		if err != nil {
+			return err
+		}
+		err = store.Set(key, bz)
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.adjustTotalEscrowed(ctx, amount.Denom, amount.Amount.Neg())
+}
+
+// IterateEscrowQueue iterates over every entry of the escrowed lock expiration queue, expired or not (read-only)
+func (k Keeper) IterateEscrowQueue(ctx context.Context, cb func(addr sdk.AccAddress, unlockTime time.Time, amount sdk.Coin) error) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iter, err := store.Iterator(types.EscrowLocksByDateKey, prefixEndBytes(types.EscrowLocksByDateKey))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		unlockTime, addr, denom, err := parseEscrowLockExpirationKey(iter.Key())
+		if err != nil {
+			return err
+		}
+
+		var amount math.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			return err
+		}
+
+		if err := cb(addr, unlockTime, sdk.NewCoin(denom, amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IterateAndDeleteExpiredEscrowLocks iterates over escrowed locks that have expired before or at cutoffTime and
+// deletes them. The deleted amounts are subtracted from the total escrowed amounts
+func (k Keeper) IterateAndDeleteExpiredEscrowLocks(ctx context.Context, cutoffTime time.Time, cb func(addr sdk.AccAddress, unlockTime time.Time, amount sdk.Coin) error) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	// End key is Prefix + CutoffTime + 1 second (to include CutoffTime)
+	endKey := binary.BigEndian.AppendUint64(append([]byte{}, types.EscrowLocksByDateKey...), uint64(cutoffTime.Unix()+1))
+
+	iter, err := store.Iterator(types.EscrowLocksByDateKey, endKey)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		unlockTime, addr, denom, err := parseEscrowLockExpirationKey(key)
+		if err != nil {
+			return err
+		}
+
+		var amount math.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			return err
+		}
+
+		if err := cb(addr, unlockTime, sdk.NewCoin(denom, amount)); err != nil {
+			return err
+		}
+
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+
+		if err := k.adjustTotalEscrowed(ctx, denom, amount.Neg()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
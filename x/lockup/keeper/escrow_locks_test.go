@@ -0,0 +1,306 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/keeper"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// escrowBankKeeper is a bank keeper that moves balances between accounts and module accounts
+type escrowBankKeeper struct {
+	balanceBankKeeper
+}
+
+func (b escrowBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b escrowBankKeeper) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := b.balances[fromAddr.String()].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("%s is smaller than %s", b.balances[fromAddr.String()], amt)
+	}
+	b.balances[fromAddr.String()] = balance
+	b.balances[toAddr.String()] = b.balances[toAddr.String()].Add(amt...)
+	return nil
+}
+
+func (b escrowBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.SendCoins(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b escrowBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if b.BlockedAddr(recipientAddr) {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", recipientAddr)
+	}
+	return b.SendCoins(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b escrowBankKeeper) InputOutputCoins(ctx context.Context, input banktypes.Input, outputs []banktypes.Output) error {
+	if err := banktypes.ValidateInputOutputs(input, outputs); err != nil {
+		return err
+	}
+
+	fromAddr := sdk.MustAccAddressFromBech32(input.Address)
+	for _, output := range outputs {
+		if err := b.SendCoins(ctx, fromAddr, sdk.MustAccAddressFromBech32(output.Address), output.Coins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setupEscrowMsgServer(t *testing.T) (keeper.Keeper, types.MsgServer, sdk.Context, escrowBankKeeper) {
+	bank := escrowBankKeeper{balanceBankKeeper{balances: map[string]sdk.Coins{}, blocked: map[string]bool{}}}
+	k, ctx := keepertest.LockupKeeperWithKeepers(t, bank, newSlashableStakingKeeper())
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	params := types.DefaultParams()
+	params.AllowedDenoms = []string{"uOPT", "uatom"}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	return k, keeper.NewMsgServerImpl(k), ctx, bank
+}
+
+func TestEscrowLock(t *testing.T) {
+	k, ms, ctx, bank := setupEscrowMsgServer(t)
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+	bank.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000), sdk.NewInt64Coin("uosmo", 1000))
+
+	_, err := ms.Lock(ctx, types.NewMsgLock(alice.String(), "2026-06-01", sdk.NewInt64Coin("uosmo", 100)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = ms.Lock(ctx, &types.MsgLock{Address: alice.String(), UnlockDate: "2026-06-01", Amount: sdk.NewInt64Coin("uatom", 100), AutoRenew: true})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = ms.Lock(ctx, types.NewMsgLock(alice.String(), "2026-06-01", sdk.NewInt64Coin("uatom", 2000)))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// escrowed locks need no delegations
+	_, err = ms.Lock(ctx, types.NewMsgLock(alice.String(), "2026-06-01", sdk.NewInt64Coin("uatom", 400)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 600), sdk.NewInt64Coin("uosmo", 1000)), bank.balances[alice.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 400)), bank.balances[escrow.String()])
+
+	locks, err := k.GetLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, []*types.Lock{{UnlockDate: "2026-06-01", Amount: math.NewInt(400), Denom: "uatom", Kind: types.LockKind_LOCK_KIND_ESCROWED}}, locks)
+
+	locked, err := k.GetLockedAmountByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.True(t, locked.IsZero())
+
+	totalLocked, err := k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.True(t, totalLocked.IsZero())
+
+	totalEscrowed, err := k.GetTotalEscrowed(ctx, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(400), totalEscrowed)
+
+	// each unlock date holds a separate escrowed lock
+	_, err = ms.Lock(ctx, types.NewMsgLock(alice.String(), "2026-09-01", sdk.NewInt64Coin("uatom", 100)))
+	require.NoError(t, err)
+
+	locks, err = k.GetLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*types.Lock{
+		{UnlockDate: "2026-06-01", Amount: math.NewInt(400), Denom: "uatom", Kind: types.LockKind_LOCK_KIND_ESCROWED},
+		{UnlockDate: "2026-09-01", Amount: math.NewInt(100), Denom: "uatom", Kind: types.LockKind_LOCK_KIND_ESCROWED},
+	}, locks)
+
+	_, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken)
+
+	// expired escrowed locks are returned to their owner
+	ctx = ctx.WithBlockTime(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 900), sdk.NewInt64Coin("uosmo", 1000)), bank.balances[alice.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)), bank.balances[escrow.String()])
+
+	locks, err = k.GetLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, []*types.Lock{{UnlockDate: "2026-09-01", Amount: math.NewInt(100), Denom: "uatom", Kind: types.LockKind_LOCK_KIND_ESCROWED}}, locks)
+
+	totals, err := k.GetAllTotalEscrowed(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)), totals)
+
+	_, broken = keeper.AllInvariants(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestEscrowBondDenomLock(t *testing.T) {
+	k, ms, ctx, bank := setupEscrowMsgServer(t)
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+	bank.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin("uOPT", 1000))
+
+	// without escrow the lock must be covered by delegations
+	_, err := ms.Lock(ctx, types.NewMsgLock(alice.String(), "2026-06-01", sdk.NewInt64Coin("uOPT", 400)))
+	require.ErrorIs(t, err, types.ErrInsufficientDelegations)
+
+	_, err = ms.Lock(ctx, &types.MsgLock{Address: alice.String(), UnlockDate: "2026-06-01", Amount: sdk.NewInt64Coin("uOPT", 400), AutoRenew: true, Escrow: true})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = ms.Lock(ctx, &types.MsgLock{Address: alice.String(), UnlockDate: "2026-06-01", Amount: sdk.NewInt64Coin("uOPT", 400), Escrow: true})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 600)), bank.balances[alice.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 400)), bank.balances[escrow.String()])
+
+	locks, err := k.GetLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, []*types.Lock{{UnlockDate: "2026-06-01", Amount: math.NewInt(400), Denom: "uOPT", Kind: types.LockKind_LOCK_KIND_ESCROWED}}, locks)
+
+	// escrowed locks are not part of the delegated lock totals
+	locked, err := k.GetLockedAmountByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.True(t, locked.IsZero())
+
+	_, _, found := k.GetLockByAddressAndDate(ctx, alice, "2026-06-01")
+	require.False(t, found)
+
+	res, err := k.TotalLockedAmount(ctx, &types.QueryTotalLockedAmountRequest{})
+	require.NoError(t, err)
+	require.True(t, res.TotalLocked.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 400)), res.TotalEscrowed)
+
+	_, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken)
+
+	// the tokens are returned at expiry
+	ctx = ctx.WithBlockTime(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 1000)), bank.balances[alice.String()])
+	require.True(t, bank.balances[escrow.String()].IsZero())
+
+	locks, err = k.GetLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Empty(t, locks)
+}
+
+func TestSendDelegateAndLockEscrow(t *testing.T) {
+	k, ms, ctx, bank := setupEscrowMsgServer(t)
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	valAddr := sdk.ValAddress(alice).String()
+	bank.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+
+	_, err := ms.SendDelegateAndLock(ctx, types.NewMsgSendDelegateAndLock(alice.String(), bob.String(), valAddr, sdk.NewInt64Coin("uatom", 100), "2026-06-01"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = ms.SendDelegateAndLock(ctx, types.NewMsgSendDelegateAndLock(alice.String(), bob.String(), "", sdk.NewInt64Coin("uatom", 100), "2026-06-01"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 900)), bank.balances[alice.String()])
+	require.True(t, bank.balances[bob.String()].IsZero())
+
+	locks, err := k.GetLocksByAddress(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, []*types.Lock{{UnlockDate: "2026-06-01", Amount: math.NewInt(100), Denom: "uatom", Kind: types.LockKind_LOCK_KIND_ESCROWED}}, locks)
+
+	_, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestEscrowLockBlockedRecipient(t *testing.T) {
+	k, ms, ctx, bank := setupEscrowMsgServer(t)
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+	blocked := authtypes.NewModuleAddress("distribution")
+	bank.blocked[blocked.String()] = true
+	bank.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+
+	// the tokens of a blocked recipient could never be released
+	_, err := ms.SendDelegateAndLock(ctx, types.NewMsgSendDelegateAndLock(alice.String(), blocked.String(), "", sdk.NewInt64Coin("uatom", 100), "2026-06-01"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = ms.MultiSendDelegateAndLock(ctx, types.NewMsgMultiSendDelegateAndLock(alice.String(), sdk.NewInt64Coin("uatom", 100), []*types.MultiSendDelegateAndLockOutput{
+		{ToAddress: blocked.String(), UnlockDate: "2026-06-01", Amount: sdk.NewInt64Coin("uatom", 100)},
+	}))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	locks, err := k.GetLocksByAddress(ctx, blocked)
+	require.NoError(t, err)
+	require.Empty(t, locks)
+
+	// a recipient blocked after locking does not fail the end blocker
+	bank.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+	bank.balances[escrow.String()] = nil
+	_, err = ms.SendDelegateAndLock(ctx, types.NewMsgSendDelegateAndLock(alice.String(), bob.String(), "", sdk.NewInt64Coin("uatom", 100), "2026-06-01"))
+	require.NoError(t, err)
+	bank.blocked[bob.String()] = true
+
+	ctx = ctx.WithBlockTime(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)), bank.balances[escrow.String()])
+	require.True(t, bank.balances[bob.String()].IsZero())
+
+	failed := false
+	for _, event := range ctx.EventManager().Events() {
+		failed = failed || event.Type == types.EventTypeEscrowReleaseFailed
+	}
+	require.True(t, failed)
+
+	// the lock is kept for a retry
+	expected := []*types.Lock{{UnlockDate: "2026-06-01", Amount: math.NewInt(100), Denom: "uatom", Kind: types.LockKind_LOCK_KIND_ESCROWED}}
+	locks, err = k.GetLocksByAddress(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, expected, locks)
+
+	totalEscrowed, err := k.GetTotalEscrowed(ctx, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), totalEscrowed)
+
+	_, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken)
+
+	// pruning the lock in a transaction reports the failure
+	txCtx, _ := ctx.CacheContext()
+	_, err = ms.PruneExpiredLocks(txCtx, &types.MsgPruneExpiredLocks{Addresses: []string{bob.String()}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the release goes through in a later block once it can
+	bank.blocked[bob.String()] = false
+	ctx = ctx.WithBlockTime(time.Date(2026, 6, 1, 0, 0, 5, 0, time.UTC))
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)), bank.balances[bob.String()])
+	require.True(t, bank.balances[escrow.String()].IsZero())
+
+	locks, err = k.GetLocksByAddress(ctx, bob)
+	require.NoError(t, err)
+	require.Empty(t, locks)
+
+	_, broken = keeper.AllInvariants(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestTotalEscrowedInvariant(t *testing.T) {
+	bank := escrowBankKeeper{balanceBankKeeper{balances: map[string]sdk.Coins{}, blocked: map[string]bool{}}}
+	k, ctx := keepertest.LockupKeeperWithKeepers(t, bank, newSlashableStakingKeeper())
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	unlockTime := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, k.AddToEscrowQueue(ctx, unlockTime, alice, sdk.NewInt64Coin("uatom", 100)))
+
+	// the module account does not hold the escrowed tokens
+	_, broken := keeper.TotalEscrowedInvariant(k)(ctx)
+	require.True(t, broken)
+
+	bank.balances[authtypes.NewModuleAddress(types.ModuleName).String()] = sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	_, broken = keeper.TotalEscrowedInvariant(k)(ctx)
+	require.False(t, broken)
+}
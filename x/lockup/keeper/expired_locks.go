@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RemoveExpiredLocks removes every lock that expired at or before the current block time
+// from both the expiration queues and the locks by address index. Escrowed tokens are
+// returned to their owners, an escrowed lock whose release fails stays until a later block
+func (k Keeper) RemoveExpiredLocks(ctx sdk.Context) error {
+	if err := k.settleExpiredLockNFTs(ctx); err != nil {
+		return err
+	}
+
+	err := k.IterateAndDeleteExpiredLocks(ctx, ctx.BlockTime(), func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+		unlockDate := types.FormatUnlockTime(unlockTime)
+
+		_, idx, found := k.GetLockByAddressAndDate(ctx, addr, unlockDate)
+		if found {
+			if err := k.DeleteLockByAddressAndIndex(ctx, addr, idx); err != nil {
+				return err
+			}
+		}
+
+		if err := k.burnLockNFT(ctx, addr, unlockDate); err != nil {
+			return err
+		}
+
+		return k.lockExpired(ctx, addr, unlockDate, amount, "")
+	})
+	if err != nil {
+		return err
+	}
+
+	// A failed release must not fail the block. The lock is kept and queued
+	// again, so the release is retried in the next block.
+	type unreleasedLock struct {
+		addr       sdk.AccAddress
+		unlockTime time.Time
+		amount     sdk.Coin
+	}
+
+	var unreleased []unreleasedLock
+	err = k.IterateAndDeleteExpiredEscrowLocks(ctx, ctx.BlockTime(), func(addr sdk.AccAddress, unlockTime time.Time, amount sdk.Coin) error {
+		unlockDate := types.FormatUnlockTime(unlockTime)
+
+		cacheCtx, write := ctx.CacheContext()
+		err := k.releaseExpiredEscrowLock(cacheCtx, addr, unlockDate, amount)
+		if err != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEscrowReleaseFailed,
+					append(
+						lockEventAttributes(addr.String(), unlockDate, amount.Amount, amount.Denom),
+						sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+					)...,
+				),
+			)
+			unreleased = append(unreleased, unreleasedLock{addr: addr, unlockTime: unlockTime, amount: amount})
+			return nil
+		}
+
+		write()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, lock := range unreleased {
+		if err := k.AddToEscrowQueue(ctx, lock.unlockTime, lock.addr, lock.amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// releaseExpiredEscrowLock removes an expired escrowed lock, which has already
+// been removed from the escrowed lock expiration queue, from the locks by
+// address index and returns its tokens to addr
+func (k Keeper) releaseExpiredEscrowLock(ctx sdk.Context, addr sdk.AccAddress, unlockDate string, amount sdk.Coin) error {
+	_, idx, found := k.GetLockByAddressDateAndDenom(ctx, addr, unlockDate, amount.Denom)
+	if found {
+		if err := k.DeleteLockByAddressAndIndex(ctx, addr, idx); err != nil {
+			return err
+		}
+	}
+
+	return k.escrowLockExpired(ctx, addr, unlockDate, amount)
+}
+
+// RemoveExpiredLocksByAddress removes the expired locks of a single address
+// from both the locks by address index and the expiration queue
+func (k Keeper) RemoveExpiredLocksByAddress(ctx sdk.Context, addr sdk.AccAddress) error {
+	locks, err := k.GetLocksByAddress(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	blockTime := ctx.BlockTime()
+
+	// a token sent without settling moves its expired lock to the token owner first
+	settled := false
+	for _, lock := range locks {
+		if lock.IsEscrowed() || types.IsLocked(blockTime, lock.UnlockDate) {
+			continue
+		}
+
+		_, found, err := k.GetLockNFTByLock(ctx, addr, lock.UnlockDate)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		if err := k.settleLockNFTByLock(ctx, addr, lock.UnlockDate); err != nil {
+			return err
+		}
+		settled = true
+	}
+
+	if settled {
+		locks, err = k.GetLocksByAddress(ctx, addr)
+		if err != nil {
+			return err
+		}
+	}
+
+	remaining := make([]*types.Lock, 0, len(locks))
+	for _, lock := range locks {
+		unlockTime, err := types.ParseUnlockTime(lock.UnlockDate)
+		if err != nil || types.IsLocked(blockTime, lock.UnlockDate) {
+			remaining = append(remaining, lock)
+			continue
+		}
+
+		if lock.IsEscrowed() {
+			amount := sdk.NewCoin(lock.Denom, lock.Amount)
+			if err := k.RemoveFromEscrowQueue(ctx, unlockTime, addr, amount); err != nil {
+				return err
+			}
+
+			if err := k.escrowLockExpired(ctx, addr, lock.UnlockDate, amount); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := k.RemoveFromExpirationQueue(ctx, unlockTime, addr, lock.Amount); err != nil {
+			return err
+		}
+
+		if err := k.burnLockNFT(ctx, addr, lock.UnlockDate); err != nil {
+			return err
+		}
+
+		if err := k.lockExpired(ctx, addr, lock.UnlockDate, lock.Amount, ""); err != nil {
+			return err
+		}
+	}
+
+	if len(remaining) == len(locks) {
+		return nil
+	}
+
+	return k.SetLocksByAddress(ctx, addr, remaining)
+}
+
+// lockExpired emits a lock_expired event and records the expiry in the lock history.
+// denom is empty for bond denom locks
+func (k Keeper) lockExpired(ctx sdk.Context, addr sdk.AccAddress, unlockDate string, amount math.Int, denom string) error {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLockExpired,
+			lockEventAttributes(addr.String(), unlockDate, amount, denom)...,
+		),
+	)
+
+	return k.recordLockHistory(ctx, types.LockHistoryEntry{
+		Address:    addr.String(),
+		Action:     types.LockHistoryAction_LOCK_HISTORY_ACTION_EXPIRE,
+		UnlockDate: unlockDate,
+		Amount:     amount,
+		Denom:      denom,
+	})
+}
+
+// settleExpiredLockNFTs settles the tokens of the locks expiring in this
+// block, so a token sent without settling releases its lock to the token owner
+func (k Keeper) settleExpiredLockNFTs(ctx sdk.Context) error {
+	type expiredLock struct {
+		addr       sdk.AccAddress
+		unlockDate string
+	}
+
+	var tokenized []expiredLock
+	err := k.IterateExpiredLocks(ctx, ctx.BlockTime(), func(addr sdk.AccAddress, unlockTime time.Time, _ math.Int) error {
+		unlockDate := types.FormatUnlockTime(unlockTime)
+		_, found, err := k.GetLockNFTByLock(ctx, addr, unlockDate)
+		if found {
+			tokenized = append(tokenized, expiredLock{addr: addr, unlockDate: unlockDate})
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, lock := range tokenized {
+		if err := k.settleLockNFTByLock(ctx, lock.addr, lock.unlockDate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/keeper"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func addLock(t *testing.T, k keeper.Keeper, ctx sdk.Context, addr sdk.AccAddress, unlockDate string, amount int64) {
+	t.Helper()
+
+	unlockTime, err := types.ParseUnlockTime(unlockDate)
+	require.NoError(t, err)
+	require.NoError(t, k.SetLockByAddress(ctx, addr, &types.Lock{UnlockDate: unlockDate, Amount: math.NewInt(amount)}))
+	require.NoError(t, k.AddToExpirationQueue(ctx, unlockTime, addr, math.NewInt(amount)))
+}
+
+func TestRemoveExpiredLocks(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	addLock(t, k, ctx, alice, "2026-01-01", 100)
+	addLock(t, k, ctx, alice, "2026-03-01", 200)
+	addLock(t, k, ctx, bob, "2026-02-01", 300)
+
+	ctx = ctx.WithBlockTime(time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+
+	aliceLocks, err := k.GetLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Len(t, aliceLocks, 1)
+	require.Equal(t, "2026-03-01", aliceLocks[0].UnlockDate)
+
+	bobLocks, err := k.GetLocksByAddress(ctx, bob)
+	require.NoError(t, err)
+	require.Empty(t, bobLocks)
+
+	var queued []string
+	require.NoError(t, k.IterateExpirationQueue(ctx, func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+		queued = append(queued, unlockTime.UTC().Format(time.DateOnly))
+		return nil
+	}))
+	require.Equal(t, []string{"2026-03-01"}, queued)
+
+	var expired []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeLockExpired {
+			expired = append(expired, event)
+		}
+	}
+	require.Len(t, expired, 2)
+	require.Equal(t, []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyLockAddress, alice.String()),
+		sdk.NewAttribute(types.AttributeKeyUnlockDate, "2026-01-01"),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "100"),
+	}, attributes(expired[0]))
+	require.Equal(t, []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyLockAddress, bob.String()),
+		sdk.NewAttribute(types.AttributeKeyUnlockDate, "2026-02-01"),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "300"),
+	}, attributes(expired[1]))
+}
+
+func TestRemoveExpiredLocksByAddress(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	addLock(t, k, ctx, alice, "2026-01-01", 100)
+	addLock(t, k, ctx, alice, "2026-03-01", 200)
+	addLock(t, k, ctx, bob, "2026-01-01", 300)
+
+	ctx = ctx.WithBlockTime(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.RemoveExpiredLocksByAddress(ctx, alice))
+
+	aliceLocks, err := k.GetLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Len(t, aliceLocks, 1)
+
+	// bob's expired lock is left for the EndBlocker
+	bobLocks, err := k.GetLocksByAddress(ctx, bob)
+	require.NoError(t, err)
+	require.Len(t, bobLocks, 1)
+
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeLockExpired, ctx.EventManager().Events()[0].Type)
+
+	// the EndBlocker must not emit a second event for alice's lock
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, bob.String(), ctx.EventManager().Events()[0].Attributes[0].Value)
+}
+
+func TestRemoveExpiredTimestampLocks(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	addLock(t, k, ctx, alice, "2026-03-01T14:30:00Z", 100)
+	addLock(t, k, ctx, alice, "2026-03-02", 200)
+
+	ctx = ctx.WithBlockTime(time.Date(2026, 3, 1, 14, 29, 59, 0, time.UTC))
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+
+	locked, err := k.GetLockedAmountByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300), *locked)
+
+	ctx = ctx.WithBlockTime(time.Date(2026, 3, 1, 14, 30, 0, 0, time.UTC)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+
+	locks, err := k.GetLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Len(t, locks, 1)
+	require.Equal(t, "2026-03-02", locks[0].UnlockDate)
+
+	var expired []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeLockExpired {
+			expired = append(expired, event)
+		}
+	}
+	require.Len(t, expired, 1)
+	require.Equal(t, []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyLockAddress, alice.String()),
+		sdk.NewAttribute(types.AttributeKeyUnlockDate, "2026-03-01T14:30:00Z"),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "100"),
+	}, attributes(expired[0]))
+}
+
+func attributes(event sdk.Event) []sdk.Attribute {
+	attrs := make([]sdk.Attribute, 0, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs = append(attrs, sdk.NewAttribute(attr.Key, attr.Value))
+	}
+	return attrs
+}
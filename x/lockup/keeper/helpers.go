@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	stdmath "math"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -9,28 +11,24 @@ import (
 func (k Keeper) GetTotalDelegatedAmount(ctx sdk.Context, addr sdk.AccAddress) (*math.Int, error) {
 	totalDelegatedAmount := math.ZeroInt()
 
-	more := true
-	for more {
+	// GetDelegatorDelegations always returns the first maxRetrieve
+	// delegations, so they are read in a single call
+	delegations, err := k.stakingKeeper.GetDelegatorDelegations(ctx, addr, stdmath.MaxUint16)
+	if err != nil {
+		return nil, err
+	}
 
-		delegations, err := k.stakingKeeper.GetDelegatorDelegations(ctx, addr, 100)
+	for _, delegation := range delegations {
+		valAddr, err := sdk.ValAddressFromBech32(delegation.GetValidatorAddr())
 		if err != nil {
-			return nil, err
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
 		}
-
-		for _, delegation := range delegations {
-			valAddr, err := sdk.ValAddressFromBech32(delegation.GetValidatorAddr())
-			if err != nil {
-				return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
-			}
-			validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
-			if err != nil {
-				return nil, err
-			}
-			tokens := validator.TokensFromShares(delegation.GetShares())
-			totalDelegatedAmount = totalDelegatedAmount.Add(tokens.Ceil().TruncateInt())
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
 		}
-		more = len(delegations) == 100
-
+		tokens := validator.TokensFromShares(delegation.GetShares())
+		totalDelegatedAmount = totalDelegatedAmount.Add(tokens.Ceil().TruncateInt())
 	}
 
 	return &totalDelegatedAmount, nil
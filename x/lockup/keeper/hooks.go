@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Hooks wraps the lockup keeper to receive staking hooks. A slash can leave an
+// address with less delegated than locked, so the affected lockers are queued
+// and reconciled at the end of the block, once the staking state is final.
+// Voluntary undelegations are not reconciled: they must not release locks.
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks returns the staking hooks of the lockup module
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeValidatorSlashed queues every locker delegating to the slashed
+// validator, including redelegations from it that the slash also reaches
+func (h Hooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, _ math.LegacyDec) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	delegations, err := h.k.stakingKeeper.GetValidatorDelegations(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	for _, delegation := range delegations {
+		delAddr, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := h.k.queueLockCheck(sdkCtx, delAddr); err != nil {
+			return err
+		}
+	}
+
+	redelegations, err := h.k.stakingKeeper.GetRedelegationsFromSrcValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	for _, redelegation := range redelegations {
+		delAddr, err := sdk.AccAddressFromBech32(redelegation.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := h.k.queueLockCheck(sdkCtx, delAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h Hooks) BeforeDelegationRemoved(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorCreated(context.Context, sdk.ValAddress) error { return nil }
+
+func (h Hooks) BeforeValidatorModified(context.Context, sdk.ValAddress) error { return nil }
+
+func (h Hooks) AfterValidatorRemoved(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBeginUnbonding(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterDelegationModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(context.Context, uint64) error { return nil }
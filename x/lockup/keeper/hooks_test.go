@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// slashableStakingKeeper is a staking keeper with a single validator whose
+// tokens can be slashed by the test
+type slashableStakingKeeper struct {
+	types.StakingKeeper
+	validator   stakingtypes.Validator
+	delegations []stakingtypes.Delegation
+}
+
+func (s *slashableStakingKeeper) BondDenom(context.Context) (string, error) {
+	return "uOPT", nil
+}
+
+func (s *slashableStakingKeeper) GetValidator(context.Context, sdk.ValAddress) (stakingtypes.Validator, error) {
+	return s.validator, nil
+}
+
+func (s *slashableStakingKeeper) GetDelegatorDelegations(_ context.Context, delegator sdk.AccAddress, _ uint16) ([]stakingtypes.Delegation, error) {
+	var delegations []stakingtypes.Delegation
+	for _, delegation := range s.delegations {
+		if delegation.DelegatorAddress == delegator.String() {
+			delegations = append(delegations, delegation)
+		}
+	}
+	return delegations, nil
+}
+
+func (s *slashableStakingKeeper) GetValidatorDelegations(context.Context, sdk.ValAddress) ([]stakingtypes.Delegation, error) {
+	return s.delegations, nil
+}
+
+func (s *slashableStakingKeeper) GetRedelegationsFromSrcValidator(context.Context, sdk.ValAddress) ([]stakingtypes.Redelegation, error) {
+	return nil, nil
+}
+
+func (s *slashableStakingKeeper) delegate(delegator sdk.AccAddress, amount int64) {
+	s.delegations = append(s.delegations, stakingtypes.NewDelegation(delegator.String(), s.validator.OperatorAddress, math.LegacyNewDec(amount)))
+	s.validator.Tokens = s.validator.Tokens.AddRaw(amount)
+	s.validator.DelegatorShares = s.validator.DelegatorShares.Add(math.LegacyNewDec(amount))
+}
+
+func (s *slashableStakingKeeper) slash(fraction math.LegacyDec) {
+	s.validator.Tokens = s.validator.Tokens.Sub(math.LegacyNewDecFromInt(s.validator.Tokens).Mul(fraction).TruncateInt())
+}
+
+func newSlashableStakingKeeper() *slashableStakingKeeper {
+	valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress()))
+	return &slashableStakingKeeper{
+		validator: stakingtypes.Validator{
+			OperatorAddress: valAddr.String(),
+			Tokens:          math.ZeroInt(),
+			DelegatorShares: math.LegacyZeroDec(),
+		},
+	}
+}
+
+func TestSlashReducesLocksProRata(t *testing.T) {
+	staking := newSlashableStakingKeeper()
+	k, ctx := keepertest.LockupKeeperWithKeepers(t, nil, staking)
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	// alice has everything locked, bob keeps half of his stake unlocked
+	staking.delegate(alice, 1000)
+	staking.delegate(bob, 1000)
+	addLock(t, k, ctx, alice, "2026-12-01", 600)
+	require.NoError(t, k.AddRollingLock(ctx, alice, 30, math.NewInt(400)))
+	addLock(t, k, ctx, bob, "2026-12-01", 500)
+
+	valAddr, err := sdk.ValAddressFromBech32(staking.validator.OperatorAddress)
+	require.NoError(t, err)
+
+	fraction := math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, k.Hooks().BeforeValidatorSlashed(ctx, valAddr, fraction))
+	staking.slash(fraction)
+
+	queued, err := k.GetLockChecks(ctx)
+	require.NoError(t, err)
+	require.Len(t, queued, 2)
+
+	require.NoError(t, k.ReconcileQueuedLocks(ctx))
+
+	lock, _, found := k.GetLockByAddressAndDate(ctx, alice, "2026-12-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(540), lock.Amount)
+
+	rolling, err := k.GetRollingLock(ctx, alice, 30)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(360), rolling)
+
+	// bob's remaining 900 still cover his 500
+	lock, _, found = k.GetLockByAddressAndDate(ctx, bob, "2026-12-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(500), lock.Amount)
+
+	totalLocked, err := k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1400), totalLocked)
+
+	queued, err = k.GetLockChecks(ctx)
+	require.NoError(t, err)
+	require.Empty(t, queued)
+
+	slashed := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeLockSlashed {
+			slashed++
+		}
+	}
+	require.Equal(t, 2, slashed)
+}
+
+func TestReduceLocksRoundsDeterministically(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	addLock(t, k, ctx, alice, "2026-12-01", 1)
+	addLock(t, k, ctx, alice, "2026-06-01", 1)
+	addLock(t, k, ctx, alice, "2027-06-01", 1)
+
+	// one token cannot be split three ways, so it comes out of the earliest lock
+	require.NoError(t, k.ReduceLocks(ctx, alice, math.NewInt(1)))
+
+	_, _, found := k.GetLockByAddressAndDate(ctx, alice, "2026-06-01")
+	require.False(t, found)
+
+	locked, err := k.GetLockedAmountByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(2), *locked)
+
+	require.Error(t, k.ReduceLocks(ctx, alice, math.NewInt(3)))
+}
+
+func TestDelegationRemovedDoesNotQueueLocks(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	// a voluntary undelegation must not release locks through reconciliation
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	addLock(t, k, ctx, alice, "2026-12-01", 100)
+
+	require.NoError(t, k.Hooks().BeforeDelegationRemoved(ctx, alice, nil))
+
+	queued, err := k.GetLockChecks(ctx)
+	require.NoError(t, err)
+	require.Empty(t, queued)
+}
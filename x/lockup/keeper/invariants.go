@@ -0,0 +1,345 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// RegisterInvariants registers all lockup invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "lock-indexes", LockIndexesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-locked", TotalLockedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-escrowed", TotalEscrowedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "expired-locks", ExpiredLocksInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "locked-delegations", LockedDelegationsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "rewards-pool", RewardsPoolInvariant(k))
+}
+
+// AllInvariants runs all invariants of the lockup module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := LockIndexesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = TotalLockedInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = TotalEscrowedInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = ExpiredLocksInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = LockedDelegationsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return RewardsPoolInvariant(k)(ctx)
+	}
+}
+
+// LockIndexesInvariant checks that, for every address and denom, the sum of its locks by address
+// equals the sum of its entries in the expiration queues
+func LockIndexesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		lockedByAddress := make(map[string]math.Int)
+		err := k.IterateLocksByAddress(ctx, func(addr sdk.AccAddress, locks []*types.Lock) error {
+			for _, lock := range locks {
+				key := addressDenomKey(addr, lock.Denom)
+				total, ok := lockedByAddress[key]
+				if !ok {
+					total = math.ZeroInt()
+				}
+				lockedByAddress[key] = total.Add(lock.Amount)
+			}
+			return nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "lock-indexes", err.Error()), true
+		}
+
+		queuedByAddress, err := queuedAmountsByAddress(ctx, k)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "lock-indexes", err.Error()), true
+		}
+
+		for _, addr := range sortedKeys(lockedByAddress, queuedByAddress) {
+			locked, ok := lockedByAddress[addr]
+			if !ok {
+				locked = math.ZeroInt()
+			}
+			queued, ok := queuedByAddress[addr]
+			if !ok {
+				queued = math.ZeroInt()
+			}
+
+			if !locked.Equal(queued) {
+				broken = true
+				msg += fmt.Sprintf("\t%s has %s in locks by address but %s in the expiration queue\n", addr, locked, queued)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "lock-indexes", fmt.Sprintf(
+			"locks by address and expiration queue mismatch\n%s", msg)), broken
+	}
+}
+
+// TotalEscrowedInvariant checks that the stored total escrowed amounts equal the sum of the escrowed lock
+// expiration queue and that the lockup module account holds every escrowed token
+func TotalEscrowedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalEscrowed, err := k.GetAllTotalEscrowed(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "total-escrowed", err.Error()), true
+		}
+
+		queued := sdk.NewCoins()
+		err = k.IterateEscrowQueue(ctx, func(_ sdk.AccAddress, _ time.Time, amount sdk.Coin) error {
+			queued = queued.Add(amount)
+			return nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "total-escrowed", err.Error()), true
+		}
+
+		balance := k.bankKeeper.GetAllBalances(ctx, k.GetEscrowAddress())
+		broken := !totalEscrowed.Equal(queued) || !balance.IsAllGTE(totalEscrowed)
+
+		return sdk.FormatInvariant(types.ModuleName, "total-escrowed", fmt.Sprintf(
+			"\tstored total escrowed: %s\n\tsum of escrowed lock expiration queue: %s\n\tmodule account balance: %s\n",
+			totalEscrowed, queued, balance)), broken
+	}
+}
+
+// TotalLockedInvariant checks that the stored total locked amount equals the sum of the expiration queue
+// and the rolling locks
+func TotalLockedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalLocked, err := k.GetTotalLocked(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "total-locked", err.Error()), true
+		}
+
+		queued := math.ZeroInt()
+		err = k.IterateExpirationQueue(ctx, func(_ sdk.AccAddress, _ time.Time, amount math.Int) error {
+			queued = queued.Add(amount)
+			return nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "total-locked", err.Error()), true
+		}
+
+		rolling := math.ZeroInt()
+		err = k.IterateRollingLocks(ctx, func(_ sdk.AccAddress, _ uint32, amount math.Int) error {
+			rolling = rolling.Add(amount)
+			return nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "total-locked", err.Error()), true
+		}
+
+		broken := !totalLocked.Equal(queued.Add(rolling))
+
+		return sdk.FormatInvariant(types.ModuleName, "total-locked", fmt.Sprintf(
+			"\tstored total locked: %s\n\tsum of expiration queue: %s\n\tsum of rolling locks: %s\n", totalLocked, queued, rolling)), broken
+	}
+}
+
+// ExpiredLocksInvariant checks that no lock remains in either index for a day that has already passed.
+// Locks that unlock on the current day are removed by the EndBlocker, which may run after this check.
+func ExpiredLocksInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		blockTime := ctx.BlockTime()
+		blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+
+		err := k.IterateExpirationQueue(ctx, func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+			if unlockTime.Before(blockDay) {
+				broken = true
+				msg += fmt.Sprintf("\t%s has %s in the expiration queue for passed date %s\n", addr, amount, types.FormatUnlockTime(unlockTime))
+			}
+			return nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "expired-locks", err.Error()), true
+		}
+
+		err = k.IterateEscrowQueue(ctx, func(addr sdk.AccAddress, unlockTime time.Time, amount sdk.Coin) error {
+			if unlockTime.Before(blockDay) {
+				broken = true
+				msg += fmt.Sprintf("\t%s has %s in the escrowed lock expiration queue for passed date %s\n", addr, amount, types.FormatUnlockTime(unlockTime))
+			}
+			return nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "expired-locks", err.Error()), true
+		}
+
+		err = k.IterateLocksByAddress(ctx, func(addr sdk.AccAddress, locks []*types.Lock) error {
+			for _, lock := range locks {
+				unlockTime, err := types.ParseUnlockTime(lock.UnlockDate)
+				if err != nil {
+					return err
+				}
+				if unlockTime.Before(blockDay) {
+					broken = true
+					msg += fmt.Sprintf("\t%s has a lock of %s for passed date %s\n", addr, lock.Amount, lock.UnlockDate)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "expired-locks", err.Error()), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "expired-locks", fmt.Sprintf(
+			"found locks for dates that have already passed\n%s", msg)), broken
+	}
+}
+
+// RewardsPoolInvariant checks that the rewards pool holds enough to pay every outstanding reward,
+// and that the outstanding rewards cover every settled pending reward
+func RewardsPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pending, err := k.GetTotalPendingRewards(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "rewards-pool", err.Error()), true
+		}
+
+		outstanding, err := k.GetOutstandingRewards(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "rewards-pool", err.Error()), true
+		}
+
+		balance := k.bankKeeper.GetAllBalances(ctx, k.GetRewardsPoolAddress())
+		broken := !balance.IsAllGTE(outstanding) || !outstanding.IsAllGTE(pending)
+
+		return sdk.FormatInvariant(types.ModuleName, "rewards-pool", fmt.Sprintf(
+			"\trewards pool balance: %s\n\toutstanding rewards: %s\n\tsum of pending rewards: %s\n", balance, outstanding, pending)), broken
+	}
+}
+
+// LockedDelegationsInvariant checks that every address has at least as many tokens delegated as it has locked.
+// A slashed validator can legitimately leave an address with less delegated than locked until the locks are
+// reconciled at the end of the block, so such addresses are reported as shortfalls without breaking the invariant.
+func LockedDelegationsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+
+		addresses := make(map[string]sdk.AccAddress)
+		err := k.IterateLocksByAddress(ctx, func(addr sdk.AccAddress, _ []*types.Lock) error {
+			addresses[addr.String()] = addr
+			return nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "locked-delegations", err.Error()), true
+		}
+
+		err = k.IterateRollingLocks(ctx, func(addr sdk.AccAddress, _ uint32, _ math.Int) error {
+			addresses[addr.String()] = addr
+			return nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "locked-delegations", err.Error()), true
+		}
+
+		keys := make([]string, 0, len(addresses))
+		for key := range addresses {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			addr := addresses[key]
+
+			locked, err := k.GetLockedAmountByAddress(ctx, addr)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "locked-delegations", err.Error()), true
+			}
+
+			delegated, err := k.GetTotalDelegatedAmount(ctx, addr)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "locked-delegations", err.Error()), true
+			}
+
+			if delegated.LT(*locked) {
+				msg += fmt.Sprintf("\t%s has a shortfall of %s (locked %s, delegated %s)\n", addr, locked.Sub(*delegated), locked, delegated)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "locked-delegations", fmt.Sprintf(
+			"locked amounts above delegated amounts\n%s", msg)), false
+	}
+}
+
+func queuedAmountsByAddress(ctx sdk.Context, k Keeper) (map[string]math.Int, error) {
+	queued := make(map[string]math.Int)
+	add := func(key string, amount math.Int) {
+		total, ok := queued[key]
+		if !ok {
+			total = math.ZeroInt()
+		}
+		queued[key] = total.Add(amount)
+	}
+
+	err := k.IterateExpirationQueue(ctx, func(addr sdk.AccAddress, _ time.Time, amount math.Int) error {
+		add(addressDenomKey(addr, ""), amount)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.IterateEscrowQueue(ctx, func(addr sdk.AccAddress, _ time.Time, amount sdk.Coin) error {
+		add(addressDenomKey(addr, amount.Denom), amount.Amount)
+		return nil
+	})
+	return queued, err
+}
+
+// addressDenomKey identifies the locks of an address in denom, which is empty for bond denom locks
+func addressDenomKey(addr sdk.AccAddress, denom string) string {
+	if denom == "" {
+		return addr.String()
+	}
+	return addr.String() + "/" + denom
+}
+
+func sortedKeys(maps ...map[string]math.Int) []string {
+	seen := make(map[string]bool)
+	keys := make([]string, 0)
+	for _, m := range maps {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/keeper"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func TestLockIndexesInvariant(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	addLock(t, k, ctx, alice, "2026-06-01", 100)
+	_, broken := keeper.LockIndexesInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// a lock without a matching expiration queue entry
+	require.NoError(t, k.SetLockByAddress(ctx, alice, &types.Lock{UnlockDate: "2026-07-01", Amount: math.NewInt(50)}))
+	msg, broken := keeper.LockIndexesInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, alice.String())
+}
+
+func TestTotalLockedInvariant(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	addLock(t, k, ctx, alice, "2026-06-01", 100)
+	require.NoError(t, k.AddRollingLock(ctx, alice, 365, math.NewInt(50)))
+	_, broken := keeper.TotalLockedInvariant(k)(ctx)
+	require.False(t, broken)
+
+	require.NoError(t, k.SetTotalLocked(ctx, math.NewInt(100)))
+	_, broken = keeper.TotalLockedInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestExpiredLocksInvariant(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	addLock(t, k, ctx, alice, "2026-06-01", 100)
+
+	// locks unlocking today are left for the EndBlocker
+	ctx = ctx.WithBlockTime(time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC))
+	_, broken := keeper.ExpiredLocksInvariant(k)(ctx)
+	require.False(t, broken)
+
+	ctx = ctx.WithBlockTime(time.Date(2026, 6, 2, 8, 0, 0, 0, time.UTC))
+	_, broken = keeper.ExpiredLocksInvariant(k)(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+	_, broken = keeper.ExpiredLocksInvariant(k)(ctx)
+	require.False(t, broken)
+}
@@ -24,6 +24,9 @@ type (
 		bankKeeper    types.BankKeeper
 		accountKeeper types.AccountKeeper
 		stakingKeeper types.StakingKeeper
+		distrKeeper   types.DistributionKeeper
+		govKeeper     types.GovKeeper
+		nftKeeper     types.NFTKeeper
 	}
 )
 
@@ -36,6 +39,9 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
 	stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistributionKeeper,
+	govKeeper types.GovKeeper,
+	nftKeeper types.NFTKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -50,6 +56,9 @@ func NewKeeper(
 		bankKeeper:    bankKeeper,
 		accountKeeper: accountKeeper,
 		stakingKeeper: stakingKeeper,
+		distrKeeper:   distrKeeper,
+		govKeeper:     govKeeper,
+		nftKeeper:     nftKeeper,
 	}
 }
 
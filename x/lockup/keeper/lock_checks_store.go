@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// GetLockCheckKey creates the key for an address queued for a lock check
+// Key: Prefix + Address
+func (k Keeper) GetLockCheckKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, types.LockChecksKey...), addr.Bytes()...)
+}
+
+// SetLockCheck queues addr to have its locks checked against its delegations
+func (k Keeper) SetLockCheck(ctx context.Context, addr sdk.AccAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(k.GetLockCheckKey(addr), []byte{})
+}
+
+// GetLockChecks returns the queued addresses, ordered by address
+func (k Keeper) GetLockChecks(ctx context.Context) ([]sdk.AccAddress, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.LockChecksKey, prefixEndBytes(types.LockChecksKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var addrs []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		addrs = append(addrs, sdk.AccAddress(iterator.Key()[len(types.LockChecksKey):]))
+	}
+
+	return addrs, nil
+}
+
+// DeleteLockCheck removes addr from the lock check queue
+func (k Keeper) DeleteLockCheck(ctx context.Context, addr sdk.AccAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(k.GetLockCheckKey(addr))
+}
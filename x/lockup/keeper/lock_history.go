@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// recordLockHistory stores entry with the next id and the current block height
+// and time. It does nothing while lock history retention is disabled.
+func (k Keeper) recordLockHistory(ctx sdk.Context, entry types.LockHistoryEntry) error {
+	if k.GetParams(ctx).LockHistoryRetentionDays == 0 {
+		return nil
+	}
+
+	id, err := k.GetNextLockHistoryID(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.SetNextLockHistoryID(ctx, id+1); err != nil {
+		return err
+	}
+
+	entry.Id = id
+	entry.Height = ctx.BlockHeight()
+	entry.Time = ctx.BlockTime().UTC()
+
+	return k.SetLockHistoryEntry(ctx, entry)
+}
+
+// PruneExpiredLockHistory deletes the lock history entries older than the
+// retention period. While retention is disabled nothing is pruned.
+func (k Keeper) PruneExpiredLockHistory(ctx sdk.Context) error {
+	retentionDays := k.GetParams(ctx).LockHistoryRetentionDays
+	if retentionDays == 0 {
+		return nil
+	}
+
+	return k.PruneLockHistory(ctx, ctx.BlockTime().AddDate(0, 0, -int(retentionDays)))
+}
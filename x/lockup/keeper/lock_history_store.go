@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// GetLockHistoryPrefix creates the prefix of the lock history of an address
+// Key: Prefix + Length-prefixed address
+func (k Keeper) GetLockHistoryPrefix(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, types.LockHistoryKey...), address.MustLengthPrefix(addr)...)
+}
+
+// GetLockHistoryKey creates the key for a lock history entry
+// Key: Prefix + Length-prefixed address + Id (8 bytes)
+func (k Keeper) GetLockHistoryKey(addr sdk.AccAddress, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(k.GetLockHistoryPrefix(addr), id)
+}
+
+// GetLockHistoryQueueKey creates the key that queues a lock history entry for pruning
+// Key: Prefix + Timestamp (8 bytes) + Length-prefixed address + Id (8 bytes)
+func (k Keeper) GetLockHistoryQueueKey(recorded time.Time, addr sdk.AccAddress, id uint64) []byte {
+	key := binary.BigEndian.AppendUint64(append([]byte{}, types.LockHistoryQueueKey...), uint64(recorded.Unix()))
+	key = append(key, address.MustLengthPrefix(addr)...)
+	return binary.BigEndian.AppendUint64(key, id)
+}
+
+// SetLockHistoryEntry stores a lock history entry and queues it for pruning
+func (k Keeper) SetLockHistoryEntry(ctx context.Context, entry types.LockHistoryEntry) error {
+	addr, err := sdk.AccAddressFromBech32(entry.Address)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(k.GetLockHistoryKey(addr, entry.Id), bz); err != nil {
+		return err
+	}
+	return store.Set(k.GetLockHistoryQueueKey(entry.Time, addr, entry.Id), []byte{})
+}
+
+// IterateLockHistory iterates over every lock history entry, ordered by address and id (read-only)
+func (k Keeper) IterateLockHistory(ctx context.Context, cb func(entry types.LockHistoryEntry) error) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.LockHistoryKey, prefixEndBytes(types.LockHistoryKey))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.LockHistoryEntry
+		if err := k.cdc.Unmarshal(iterator.Value(), &entry); err != nil {
+			return err
+		}
+
+		if err := cb(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PruneLockHistory deletes the lock history entries recorded before cutoff
+func (k Keeper) PruneLockHistory(ctx context.Context, cutoff time.Time) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	end := binary.BigEndian.AppendUint64(append([]byte{}, types.LockHistoryQueueKey...), uint64(cutoff.Unix()))
+	iterator, err := store.Iterator(types.LockHistoryQueueKey, end)
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		// Key: Prefix + Timestamp (8) + Length-prefixed address + Id (8)
+		addrAndID := key[len(types.LockHistoryQueueKey)+8:]
+		addr := sdk.AccAddress(addrAndID[1 : 1+addrAndID[0]])
+		id := binary.BigEndian.Uint64(addrAndID[1+addrAndID[0]:])
+
+		if err := store.Delete(k.GetLockHistoryKey(addr, id)); err != nil {
+			return err
+		}
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetNextLockHistoryID returns the id of the next lock history entry
+func (k Keeper) GetNextLockHistoryID(ctx context.Context) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.NextLockHistoryIDKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// SetNextLockHistoryID sets the id of the next lock history entry
+func (k Keeper) SetNextLockHistoryID(ctx context.Context, id uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return store.Set(types.NextLockHistoryIDKey, bz)
+}
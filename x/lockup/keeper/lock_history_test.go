@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func TestLockHistoryRecordsExpiries(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	addLock(t, k, ctx, alice, "2026-01-01", 100)
+	addLock(t, k, ctx, alice, "2026-01-15", 200)
+	addLock(t, k, ctx, bob, "2026-01-10", 300)
+
+	blockTime := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(42)
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+
+	res, err := k.LockHistory(ctx, &types.QueryLockHistoryRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	require.Equal(t, types.LockHistoryAction_LOCK_HISTORY_ACTION_EXPIRE, res.Entries[0].Action)
+	require.Equal(t, "2026-01-01", res.Entries[0].UnlockDate)
+	require.Equal(t, math.NewInt(100), res.Entries[0].Amount)
+	require.Equal(t, int64(42), res.Entries[0].Height)
+	require.Equal(t, blockTime, res.Entries[0].Time)
+	require.Equal(t, "2026-01-15", res.Entries[1].UnlockDate)
+
+	page, err := k.LockHistory(ctx, &types.QueryLockHistoryRequest{Address: alice.String(), Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, page.Entries, 1)
+	require.NotEmpty(t, page.Pagination.NextKey)
+
+	page, err = k.LockHistory(ctx, &types.QueryLockHistoryRequest{Address: alice.String(), Pagination: &query.PageRequest{Key: page.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, page.Entries, 1)
+	require.Equal(t, "2026-01-15", page.Entries[0].UnlockDate)
+
+	res, err = k.LockHistory(ctx, &types.QueryLockHistoryRequest{Address: bob.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, math.NewInt(300), res.Entries[0].Amount)
+}
+
+func TestLockHistoryDisabled(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	params := types.DefaultParams()
+	params.LockHistoryRetentionDays = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	addLock(t, k, ctx, alice, "2026-01-01", 100)
+
+	ctx = ctx.WithBlockTime(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+
+	res, err := k.LockHistory(ctx, &types.QueryLockHistoryRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+
+	next, err := k.GetNextLockHistoryID(ctx)
+	require.NoError(t, err)
+	require.Zero(t, next)
+}
+
+func TestPruneExpiredLockHistory(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	params := types.DefaultParams()
+	params.LockHistoryRetentionDays = 30
+	require.NoError(t, k.SetParams(ctx, params))
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	entry := func(id uint64, recorded time.Time) types.LockHistoryEntry {
+		return types.LockHistoryEntry{
+			Id:         id,
+			Address:    alice.String(),
+			Action:     types.LockHistoryAction_LOCK_HISTORY_ACTION_LOCK,
+			UnlockDate: "2027-01-01",
+			Amount:     math.NewInt(100),
+			Time:       recorded,
+		}
+	}
+	require.NoError(t, k.SetLockHistoryEntry(ctx, entry(0, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))))
+	require.NoError(t, k.SetLockHistoryEntry(ctx, entry(1, time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC))))
+	require.NoError(t, k.SetLockHistoryEntry(ctx, entry(2, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))))
+
+	ctx = ctx.WithBlockTime(time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, k.PruneExpiredLockHistory(ctx))
+
+	res, err := k.LockHistory(ctx, &types.QueryLockHistoryRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	require.Equal(t, uint64(1), res.Entries[0].Id)
+	require.Equal(t, uint64(2), res.Entries[1].Id)
+}
@@ -0,0 +1,334 @@
+package keeper
+
+import (
+	stdmath "math"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// MintLockNFT mints an x/nft token of the lockup class for the lock of owner
+// on unlockDate. The class is created on first use.
+func (k Keeper) MintLockNFT(ctx sdk.Context, owner sdk.AccAddress, unlockDate string) (string, error) {
+	lock, _, found := k.GetLockByAddressAndDate(ctx, owner, unlockDate)
+	if !found {
+		return "", types.ErrLockupNotFound.Wrapf("no lockup found for unlock date (%s)", unlockDate)
+	}
+
+	if err := k.checkNotTokenized(ctx, owner, unlockDate); err != nil {
+		return "", err
+	}
+
+	if !k.nftKeeper.HasClass(ctx, types.LockNFTClassID) {
+		err := k.nftKeeper.SaveClass(ctx, nft.Class{
+			Id:          types.LockNFTClassID,
+			Name:        "Optio lock positions",
+			Symbol:      "OPTLOCK",
+			Description: "Each token represents a lockup position. Sending a token transfers the lock and its delegation.",
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	seq, err := k.GetNextLockNFTID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := k.SetNextLockNFTID(ctx, seq+1); err != nil {
+		return "", err
+	}
+
+	data, err := codectypes.NewAnyWithValue(&types.LockNFTData{UnlockDate: lock.UnlockDate, Amount: lock.Amount})
+	if err != nil {
+		return "", err
+	}
+
+	id := types.LockNFTID(seq)
+	if err := k.nftKeeper.Mint(ctx, nft.NFT{ClassId: types.LockNFTClassID, Id: id, Data: data}, owner); err != nil {
+		return "", err
+	}
+
+	return id, k.SetLockNFT(ctx, types.LockNFT{Id: id, Owner: owner.String(), UnlockDate: unlockDate})
+}
+
+// SettleLockNFTTransfer moves the lock behind token id, together with enough
+// delegations to cover it, to the current owner of the token. It does nothing
+// if the token has not changed owner.
+func (k Keeper) SettleLockNFTTransfer(ctx sdk.Context, id string) error {
+	lockNFT, found, err := k.GetLockNFT(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return types.ErrLockupNotFound.Wrapf("no lock position for nft %s", id)
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(lockNFT.Owner)
+	if err != nil {
+		return err
+	}
+
+	toAddr := k.nftKeeper.GetOwner(ctx, types.LockNFTClassID, id)
+	if toAddr.Empty() || toAddr.Equals(fromAddr) {
+		return nil
+	}
+
+	lock, idx, found := k.GetLockByAddressAndDate(ctx, fromAddr, lockNFT.UnlockDate)
+	if !found {
+		return types.ErrLockupNotFound.Wrapf("no lockup found for nft %s on %s", id, lockNFT.UnlockDate)
+	}
+
+	if _, _, found := k.GetLockByAddressAndDate(ctx, toAddr, lockNFT.UnlockDate); found {
+		return sdkerrors.ErrInvalidRequest.Wrapf("recipient already has a lock on %s", lockNFT.UnlockDate)
+	}
+
+	if err := k.checkLockLimit(ctx, k.GetParams(ctx), toAddr); err != nil {
+		return err
+	}
+
+	if err := k.moveDelegations(ctx, fromAddr, toAddr, lock.Amount); err != nil {
+		return err
+	}
+
+	if err := k.DeleteLockByAddressAndIndex(ctx, fromAddr, idx); err != nil {
+		return err
+	}
+
+	if err := k.SetLockByAddress(ctx, toAddr, &types.Lock{UnlockDate: lock.UnlockDate, Amount: lock.Amount}); err != nil {
+		return err
+	}
+
+	unlockTime, err := types.ParseUnlockTime(lock.UnlockDate)
+	if err != nil {
+		return err
+	}
+
+	if err := k.RemoveFromExpirationQueue(ctx, unlockTime, fromAddr, lock.Amount); err != nil {
+		return err
+	}
+
+	if err := k.AddToExpirationQueue(ctx, unlockTime, toAddr, lock.Amount); err != nil {
+		return err
+	}
+
+	lockedAmount, err := k.GetLockedAmountByAddress(ctx, toAddr)
+	if err != nil {
+		return err
+	}
+
+	delegatedAmount, err := k.GetTotalDelegatedAmount(ctx, toAddr)
+	if err != nil {
+		return err
+	}
+
+	if delegatedAmount.LT(*lockedAmount) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientDelegations,
+			"recipient delegations do not cover its locks after the transfer: %s < %s",
+			delegatedAmount.String(),
+			lockedAmount.String(),
+		)
+	}
+
+	if err := k.DeleteLockNFT(ctx, lockNFT); err != nil {
+		return err
+	}
+
+	lockNFT.Owner = toAddr.String()
+	if err := k.SetLockNFT(ctx, lockNFT); err != nil {
+		return err
+	}
+
+	if err := k.recordLockTransfer(ctx, fromAddr, toAddr, lock.UnlockDate, lock.Amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLockNFTTransfer,
+			sdk.NewAttribute(types.AttributeKeyNFTID, id),
+			sdk.NewAttribute(sdk.AttributeKeySender, fromAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, lock.UnlockDate),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, lock.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// SettleLockNFTOwners settles the lock position tokens sent without the
+// post handler seeing it, such as sends executed by x/group proposals,
+// interchain accounts or other modules. Each block checks the next
+// LockNFTSettlementsPerBlock tokens, resuming where the previous block stopped.
+func (k Keeper) SettleLockNFTOwners(ctx sdk.Context) error {
+	cursor, err := k.GetLockNFTSettlementCursor(ctx)
+	if err != nil {
+		return err
+	}
+
+	lockNFTs, err := k.getLockNFTsAfter(ctx, cursor, types.LockNFTSettlementsPerBlock)
+	if err != nil {
+		return err
+	}
+
+	for _, lockNFT := range lockNFTs {
+		if err := k.settleLockNFTOwner(ctx, lockNFT); err != nil {
+			return err
+		}
+	}
+
+	// start over once the last token has been checked
+	cursor = ""
+	if len(lockNFTs) == types.LockNFTSettlementsPerBlock {
+		cursor = lockNFTs[len(lockNFTs)-1].Id
+	}
+
+	return k.SetLockNFTSettlementCursor(ctx, cursor)
+}
+
+// settleLockNFTOwner moves the lock behind lockNFT to the current owner of its
+// token. A lock that cannot move, because the owner already has a lock on the
+// same date or too many locks, sends the token back to the lock owner instead,
+// so a token never ends up separated from its lock.
+func (k Keeper) settleLockNFTOwner(ctx sdk.Context, lockNFT types.LockNFT) error {
+	owner := k.nftKeeper.GetOwner(ctx, types.LockNFTClassID, lockNFT.Id)
+	if owner.Empty() || owner.String() == lockNFT.Owner {
+		return nil
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	settleErr := k.SettleLockNFTTransfer(cacheCtx, lockNFT.Id)
+	if settleErr == nil {
+		write()
+		return nil
+	}
+
+	lockOwner, err := sdk.AccAddressFromBech32(lockNFT.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.nftKeeper.Transfer(ctx, types.LockNFTClassID, lockNFT.Id, lockOwner); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLockNFTReturned,
+			sdk.NewAttribute(types.AttributeKeyNFTID, lockNFT.Id),
+			sdk.NewAttribute(types.AttributeKeyLockAddress, lockNFT.Owner),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, lockNFT.UnlockDate),
+			sdk.NewAttribute(types.AttributeKeyReason, settleErr.Error()),
+		),
+	)
+
+	return nil
+}
+
+// settleLockNFTByLock settles the token of the lock of owner on unlockDate, if any
+func (k Keeper) settleLockNFTByLock(ctx sdk.Context, owner sdk.AccAddress, unlockDate string) error {
+	id, found, err := k.GetLockNFTByLock(ctx, owner, unlockDate)
+	if err != nil || !found {
+		return err
+	}
+
+	lockNFT, found, err := k.GetLockNFT(ctx, id)
+	if err != nil || !found {
+		return err
+	}
+
+	return k.settleLockNFTOwner(ctx, lockNFT)
+}
+
+// burnLockNFT burns the token representing the lock of owner on unlockDate, if any
+func (k Keeper) burnLockNFT(ctx sdk.Context, owner sdk.AccAddress, unlockDate string) error {
+	id, found, err := k.GetLockNFTByLock(ctx, owner, unlockDate)
+	if err != nil || !found {
+		return err
+	}
+
+	if err := k.nftKeeper.Burn(ctx, types.LockNFTClassID, id); err != nil {
+		return err
+	}
+
+	if err := k.DeleteLockNFT(ctx, types.LockNFT{Id: id, Owner: owner.String(), UnlockDate: unlockDate}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLockNFTBurn,
+			sdk.NewAttribute(types.AttributeKeyNFTID, id),
+			sdk.NewAttribute(types.AttributeKeyLockAddress, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, unlockDate),
+		),
+	)
+
+	return nil
+}
+
+// checkNotTokenized returns an error if the lock of addr on unlockDate is
+// represented by a token. Such a lock only changes by sending the token.
+func (k Keeper) checkNotTokenized(ctx sdk.Context, addr sdk.AccAddress, unlockDate string) error {
+	id, found, err := k.GetLockNFTByLock(ctx, addr, unlockDate)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return types.ErrLockTokenized.Wrapf("lock on %s is represented by nft %s", unlockDate, id)
+	}
+
+	return nil
+}
+
+// moveDelegations transfers delegations worth amount tokens from fromAddr to
+// toAddr, taking them from fromAddr's delegations in store order.
+func (k Keeper) moveDelegations(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amount math.Int) error {
+	delegations, err := k.stakingKeeper.GetDelegatorDelegations(ctx, fromAddr, stdmath.MaxUint16)
+	if err != nil {
+		return err
+	}
+
+	remaining := amount
+	for _, delegation := range delegations {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(delegation.GetValidatorAddr())
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		}
+
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		move := math.MinInt(validator.TokensFromShares(delegation.GetShares()).TruncateInt(), remaining)
+		if !move.IsPositive() {
+			continue
+		}
+
+		if err := k.transferDelegation(ctx, fromAddr, toAddr, valAddr, move); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(move)
+	}
+
+	if remaining.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInsufficientDelegations, "delegations do not cover the transferred lock: %s missing", remaining)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// GetLockNFTKey creates the key for a lock position token
+// Key: Prefix + NFT id
+func (k Keeper) GetLockNFTKey(id string) []byte {
+	return append(append([]byte{}, types.LockNFTsKey...), []byte(id)...)
+}
+
+// GetLockNFTByOwnerKey creates the key that indexes a lock position token by its lock
+// Key: Prefix + Length-prefixed owner address + Unlock date
+func (k Keeper) GetLockNFTByOwnerKey(owner sdk.AccAddress, unlockDate string) []byte {
+	key := append(append([]byte{}, types.LockNFTsByOwnerKey...), address.MustLengthPrefix(owner)...)
+	return append(key, []byte(unlockDate)...)
+}
+
+// GetLockNFT returns the lock position token with the given id
+func (k Keeper) GetLockNFT(ctx context.Context, id string) (types.LockNFT, bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(k.GetLockNFTKey(id))
+	if err != nil || bz == nil {
+		return types.LockNFT{}, false, err
+	}
+
+	var lockNFT types.LockNFT
+	if err := k.cdc.Unmarshal(bz, &lockNFT); err != nil {
+		return types.LockNFT{}, false, err
+	}
+	return lockNFT, true, nil
+}
+
+// GetLockNFTByLock returns the id of the token representing the lock of owner on unlockDate
+func (k Keeper) GetLockNFTByLock(ctx context.Context, owner sdk.AccAddress, unlockDate string) (string, bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(k.GetLockNFTByOwnerKey(owner, unlockDate))
+	if err != nil || bz == nil {
+		return "", false, err
+	}
+	return string(bz), true, nil
+}
+
+// SetLockNFT stores a lock position token in both token indexes
+func (k Keeper) SetLockNFT(ctx context.Context, lockNFT types.LockNFT) error {
+	owner, err := sdk.AccAddressFromBech32(lockNFT.Owner)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&lockNFT)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(k.GetLockNFTKey(lockNFT.Id), bz); err != nil {
+		return err
+	}
+	return store.Set(k.GetLockNFTByOwnerKey(owner, lockNFT.UnlockDate), []byte(lockNFT.Id))
+}
+
+// DeleteLockNFT removes a lock position token from both token indexes
+func (k Keeper) DeleteLockNFT(ctx context.Context, lockNFT types.LockNFT) error {
+	owner, err := sdk.AccAddressFromBech32(lockNFT.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(k.GetLockNFTKey(lockNFT.Id)); err != nil {
+		return err
+	}
+	return store.Delete(k.GetLockNFTByOwnerKey(owner, lockNFT.UnlockDate))
+}
+
+// IterateLockNFTs iterates over every lock position token, ordered by id (read-only)
+func (k Keeper) IterateLockNFTs(ctx context.Context, cb func(lockNFT types.LockNFT) error) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.LockNFTsKey, prefixEndBytes(types.LockNFTsKey))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var lockNFT types.LockNFT
+		if err := k.cdc.Unmarshal(iterator.Value(), &lockNFT); err != nil {
+			return err
+		}
+
+		if err := cb(lockNFT); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetNextLockNFTID returns the sequence number of the next lock position token
+func (k Keeper) GetNextLockNFTID(ctx context.Context) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.NextLockNFTIDKey)
+	if err != nil {
+		return 0, err
+	}
+	if bz == nil {
+		return 1, nil
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// SetNextLockNFTID sets the sequence number of the next lock position token
+func (k Keeper) SetNextLockNFTID(ctx context.Context, seq uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	return store.Set(types.NextLockNFTIDKey, bz)
+}
+
+// GetLockNFTSettlementCursor returns the id of the last lock position token
+// checked for an unsettled change of owner, or an empty id to start over
+func (k Keeper) GetLockNFTSettlementCursor(ctx context.Context) (string, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.LockNFTSettlementCursorKey)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// SetLockNFTSettlementCursor stores the id of the last lock position token
+// checked for an unsettled change of owner. An empty id starts over.
+func (k Keeper) SetLockNFTSettlementCursor(ctx context.Context, id string) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if id == "" {
+		return store.Delete(types.LockNFTSettlementCursorKey)
+	}
+	return store.Set(types.LockNFTSettlementCursorKey, []byte(id))
+}
+
+// getLockNFTsAfter returns up to limit lock position tokens with an id after
+// the given one, ordered by id
+func (k Keeper) getLockNFTsAfter(ctx context.Context, id string, limit int) ([]types.LockNFT, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	start := types.LockNFTsKey
+	if id != "" {
+		start = append(k.GetLockNFTKey(id), 0x00)
+	}
+
+	iterator, err := store.Iterator(start, prefixEndBytes(types.LockNFTsKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var lockNFTs []types.LockNFT
+	for ; iterator.Valid() && len(lockNFTs) < limit; iterator.Next() {
+		var lockNFT types.LockNFT
+		if err := k.cdc.Unmarshal(iterator.Value(), &lockNFT); err != nil {
+			return nil, err
+		}
+		lockNFTs = append(lockNFTs, lockNFT)
+	}
+
+	return lockNFTs, nil
+}
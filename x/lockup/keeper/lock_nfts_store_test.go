@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func TestLockNFTStore(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	first := types.LockNFT{Id: types.LockNFTID(1), Owner: alice.String(), UnlockDate: "2026-12-01"}
+	second := types.LockNFT{Id: types.LockNFTID(2), Owner: bob.String(), UnlockDate: "2026-12-01"}
+	require.NoError(t, k.SetLockNFT(ctx, first))
+	require.NoError(t, k.SetLockNFT(ctx, second))
+
+	got, found, err := k.GetLockNFT(ctx, "lock-1")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, first, got)
+
+	id, found, err := k.GetLockNFTByLock(ctx, bob, "2026-12-01")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "lock-2", id)
+
+	_, found, err = k.GetLockNFTByLock(ctx, alice, "2027-01-01")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, k.DeleteLockNFT(ctx, first))
+
+	_, found, err = k.GetLockNFTByLock(ctx, alice, "2026-12-01")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	var all []types.LockNFT
+	require.NoError(t, k.IterateLockNFTs(ctx, func(lockNFT types.LockNFT) error {
+		all = append(all, lockNFT)
+		return nil
+	}))
+	require.Equal(t, []types.LockNFT{second}, all)
+}
+
+func TestNextLockNFTID(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	seq, err := k.GetNextLockNFTID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), seq)
+
+	require.NoError(t, k.SetNextLockNFTID(ctx, 42))
+
+	seq, err = k.GetNextLockNFTID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), seq)
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/keeper"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// ownerNFTKeeper is an nft keeper that only tracks the owner of every token
+type ownerNFTKeeper struct {
+	types.NFTKeeper
+	owners map[string]sdk.AccAddress
+}
+
+func (n ownerNFTKeeper) HasClass(context.Context, string) bool { return true }
+
+func (n ownerNFTKeeper) Mint(_ context.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	n.owners[token.Id] = receiver
+	return nil
+}
+
+func (n ownerNFTKeeper) Burn(_ context.Context, _, nftID string) error {
+	delete(n.owners, nftID)
+	return nil
+}
+
+func (n ownerNFTKeeper) Transfer(_ context.Context, _, nftID string, receiver sdk.AccAddress) error {
+	n.owners[nftID] = receiver
+	return nil
+}
+
+func (n ownerNFTKeeper) GetOwner(_ context.Context, _, nftID string) sdk.AccAddress {
+	return n.owners[nftID]
+}
+
+// transferringStakingKeeper is an unbonding staking keeper that can delegate the unbonded tokens again
+type transferringStakingKeeper struct {
+	*unbondingStakingKeeper
+}
+
+func (s *transferringStakingKeeper) Delegate(_ context.Context, delAddr sdk.AccAddress, bondAmt math.Int, _ stakingtypes.BondStatus, _ stakingtypes.Validator, _ bool) (math.LegacyDec, error) {
+	s.delegate(delAddr, bondAmt.Int64())
+	return math.LegacyNewDecFromInt(bondAmt), nil
+}
+
+func setupLockNFTKeeper(t *testing.T) (keeper.Keeper, sdk.Context, *transferringStakingKeeper, ownerNFTKeeper) {
+	t.Helper()
+
+	staking := &transferringStakingKeeper{&unbondingStakingKeeper{newSlashableStakingKeeper()}}
+	nftKeeper := ownerNFTKeeper{owners: map[string]sdk.AccAddress{}}
+	k, ctx := keepertest.LockupKeeperWithNFTKeeper(t, nil, staking, nftKeeper)
+	return k, ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)), staking, nftKeeper
+}
+
+func TestSettleLockNFTOwnersMovesLock(t *testing.T) {
+	k, ctx, staking, nftKeeper := setupLockNFTKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	staking.delegate(alice, 1000)
+	addLock(t, k, ctx, alice, "2026-12-01", 1000)
+
+	id, err := k.MintLockNFT(ctx, alice, "2026-12-01")
+	require.NoError(t, err)
+
+	// a send the post handler never saw, such as one executed by a group proposal
+	nftKeeper.owners[id] = bob
+	require.NoError(t, k.SettleLockNFTOwners(ctx))
+
+	_, _, found := k.GetLockByAddressAndDate(ctx, alice, "2026-12-01")
+	require.False(t, found)
+
+	lock, _, found := k.GetLockByAddressAndDate(ctx, bob, "2026-12-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(1000), lock.Amount)
+
+	lockNFT, found, err := k.GetLockNFT(ctx, id)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, bob.String(), lockNFT.Owner)
+
+	delegated, err := k.GetTotalDelegatedAmount(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), *delegated)
+}
+
+func TestSettleLockNFTOwnersReturnsToken(t *testing.T) {
+	k, ctx, staking, nftKeeper := setupLockNFTKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	staking.delegate(alice, 1000)
+	staking.delegate(bob, 500)
+	addLock(t, k, ctx, alice, "2026-12-01", 1000)
+	addLock(t, k, ctx, bob, "2026-12-01", 500)
+
+	id, err := k.MintLockNFT(ctx, alice, "2026-12-01")
+	require.NoError(t, err)
+
+	// bob already has a lock on the same date, so the lock cannot follow the token
+	nftKeeper.owners[id] = bob
+	require.NoError(t, k.SettleLockNFTOwners(ctx))
+
+	require.Equal(t, alice, nftKeeper.owners[id])
+
+	lock, _, found := k.GetLockByAddressAndDate(ctx, alice, "2026-12-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(1000), lock.Amount)
+
+	lock, _, found = k.GetLockByAddressAndDate(ctx, bob, "2026-12-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(500), lock.Amount)
+
+	returned := false
+	for _, event := range ctx.EventManager().Events() {
+		returned = returned || event.Type == types.EventTypeLockNFTReturned
+	}
+	require.True(t, returned)
+}
+
+func TestRemoveExpiredLocksSettlesLockNFT(t *testing.T) {
+	k, ctx, staking, nftKeeper := setupLockNFTKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	staking.delegate(alice, 1000)
+	addLock(t, k, ctx, alice, "2026-02-01", 1000)
+
+	id, err := k.MintLockNFT(ctx, alice, "2026-02-01")
+	require.NoError(t, err)
+	nftKeeper.owners[id] = bob
+
+	// the lock expires before the end blocker reaches the token
+	ctx = ctx.WithBlockTime(time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+
+	_, found := nftKeeper.owners[id]
+	require.False(t, found)
+
+	delegated, err := k.GetTotalDelegatedAmount(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), *delegated)
+
+	delegated, err = k.GetTotalDelegatedAmount(ctx, alice)
+	require.NoError(t, err)
+	require.True(t, delegated.IsZero())
+}
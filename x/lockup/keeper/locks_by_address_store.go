@@ -1,7 +1,7 @@
 package keeper
 
 import (
-	"time"
+	"context"
 
 	"cosmossdk.io/math"
 	"github.com/OptioNetwork/optio/x/lockup/types"
@@ -22,20 +22,35 @@ func (k Keeper) GetLockedAmountByAddress(ctx sdk.Context, addr sdk.AccAddress) (
 	}
 
 	blockTime := ctx.BlockTime()
-	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
 
+	// escrowed locks are not backed by delegations
 	totalLocked := math.ZeroInt()
 	for _, lock := range locks {
-		if types.IsLocked(blockDay, lock.UnlockDate) {
+		if !lock.IsEscrowed() && types.IsLocked(blockTime, lock.UnlockDate) {
 			totalLocked = totalLocked.Add(lock.Amount)
 		}
 	}
 
+	// rolling locks never reach their unlock date
+	rollingLocks, err := k.GetRollingLocksByAddress(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	for _, lock := range rollingLocks {
+		totalLocked = totalLocked.Add(lock.Amount)
+	}
+
 	return &totalLocked, nil
 }
 
-// SetLockByAddress adds a lock to an address key
+// SetLockByAddress adds a lock to an address key. The rewards the locks of the
+// address earned so far are settled first.
 func (k Keeper) SetLockByAddress(ctx sdk.Context, addr sdk.AccAddress, lock *types.Lock) error {
+	weight, err := k.settleRewards(ctx, addr)
+	if err != nil {
+		return err
+	}
+
 	store := k.storeService.OpenKVStore(ctx)
 	key := k.GetLocksByAddressKey(addr)
 
@@ -69,25 +84,39 @@ func (k Keeper) SetLockByAddress(ctx sdk.Context, addr sdk.AccAddress, lock *typ
 		return err
 	}
 
-	return store.Set(key, bz)
+	if err := store.Set(key, bz); err != nil {
+		return err
+	}
+
+	return k.updateRewardWeight(ctx, addr, weight)
 }
 
 // SetLocksByAddress stores all locks for an address in a single key-value pair
 func (k Keeper) SetLocksByAddress(ctx sdk.Context, addr sdk.AccAddress, locks []*types.Lock) error {
+	weight, err := k.settleRewards(ctx, addr)
+	if err != nil {
+		return err
+	}
+
 	store := k.storeService.OpenKVStore(ctx)
 	key := k.GetLocksByAddressKey(addr)
 
 	if len(locks) == 0 {
-		return store.Delete(key)
+		err = store.Delete(key)
+	} else {
+		locksList := types.Locks{Locks: locks}
+		var bz []byte
+		bz, err = locksList.Marshal()
+		if err != nil {
+			return err
+		}
+		err = store.Set(key, bz)
 	}
-
-	locksList := types.Locks{Locks: locks}
-	bz, err := locksList.Marshal()
 	if err != nil {
 		return err
 	}
 
-	return store.Set(key, bz)
+	return k.updateRewardWeight(ctx, addr, weight)
 }
 
 // GetLocksByAddress retrieves all locks for an address
@@ -112,15 +141,21 @@ func (k Keeper) GetLocksByAddress(ctx sdk.Context, addr sdk.AccAddress) ([]*type
 	return locksList.Locks, nil
 }
 
-// GetLockByAddressAndDate retrieves a lock for a specific address and unlock date
+// GetLockByAddressAndDate retrieves the bond denom lock for a specific address and unlock date
 func (k Keeper) GetLockByAddressAndDate(ctx sdk.Context, addr sdk.AccAddress, unlockDate string) (*types.Lock, int, bool) {
+	return k.GetLockByAddressDateAndDenom(ctx, addr, unlockDate, "")
+}
+
+// GetLockByAddressDateAndDenom retrieves a lock for a specific address, unlock date and denom.
+// The denom is empty for bond denom locks.
+func (k Keeper) GetLockByAddressDateAndDenom(ctx sdk.Context, addr sdk.AccAddress, unlockDate, denom string) (*types.Lock, int, bool) {
 	locks, err := k.GetLocksByAddress(ctx, addr)
 	if err != nil {
 		return nil, -1, false
 	}
 
 	for idx, lock := range locks {
-		if lock.UnlockDate == unlockDate {
+		if lock.UnlockDate == unlockDate && lock.Denom == denom {
 			return lock, idx, true
 		}
 	}
@@ -128,11 +163,35 @@ func (k Keeper) GetLockByAddressAndDate(ctx sdk.Context, addr sdk.AccAddress, un
 	return nil, -1, false
 }
 
+// IterateLocksByAddress iterates over the locks of every address (read-only)
+func (k Keeper) IterateLocksByAddress(ctx context.Context, cb func(addr sdk.AccAddress, locks []*types.Lock) error) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iter, err := store.Iterator(types.LocksByAddressKey, prefixEndBytes(types.LocksByAddressKey))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		addr := sdk.AccAddress(iter.Key()[len(types.LocksByAddressKey):])
+
+		locksList := &types.Locks{}
+		if err := locksList.Unmarshal(iter.Value()); err != nil {
+			return err
+		}
+
+		if err := cb(addr, locksList.Locks); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // DeleteLocksByAddress removes all locks for an address
 func (k Keeper) DeleteLocksByAddress(ctx sdk.Context, addr sdk.AccAddress) error {
-	store := k.storeService.OpenKVStore(ctx)
-	key := k.GetLocksByAddressKey(addr)
-	return store.Delete(key)
+	return k.SetLocksByAddress(ctx, addr, nil)
 }
 
 // DeleteLockByAddressAndIndex removes a specific lock for an address by its index
@@ -28,6 +28,31 @@ func (k Keeper) GetTotalLocked(ctx context.Context) (math.Int, error) {
 	return amount, nil
 }
 
+// SetTotalLocked sets the total locked amount
+func (k Keeper) SetTotalLocked(ctx context.Context, amount math.Int) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	return store.Set(types.TotalLockedKey, bz)
+}
+
+// adjustTotalLocked adds delta (which may be negative) to the total locked amount
+func (k Keeper) adjustTotalLocked(ctx context.Context, delta math.Int) error {
+	totalLocked, err := k.GetTotalLocked(ctx)
+	if err != nil {
+		return err
+	}
+
+	newTotal := totalLocked.Add(delta)
+	if newTotal.IsNegative() {
+		return types.ErrInvalidAmount.Wrapf("total locked amount cannot be negative: %s", newTotal.String())
+	}
+
+	return k.SetTotalLocked(ctx, newTotal)
+}
+
 // GetLockExpirationKey creates the key for the lock expiration queue
 // Key: Prefix + Timestamp (8 bytes) + Address
 func (k Keeper) GetLockExpirationKey(unlockTime time.Time, addr sdk.AccAddress) []byte {
@@ -37,7 +62,7 @@ func (k Keeper) GetLockExpirationKey(unlockTime time.Time, addr sdk.AccAddress)
 }
 
 // AddToExpirationQueue adds a lock to the expiration queue
-// If entry exists, adds the amount
+// If entry exists, adds the amount. The total locked amount is increased accordingly
 func (k Keeper) AddToExpirationQueue(ctx context.Context, unlockTime time.Time, addr sdk.AccAddress, amount math.Int) error {
 	store := k.storeService.OpenKVStore(ctx)
 	key := k.GetLockExpirationKey(unlockTime, addr)
@@ -60,11 +85,15 @@ func (k Keeper) AddToExpirationQueue(ctx context.Context, unlockTime time.Time,
 		return err
 	}
 
-	return store.Set(key, bz)
+	if err := store.Set(key, bz); err != nil {
+		return err
+	}
+
+	return k.adjustTotalLocked(ctx, amount)
 }
 
 // RemoveFromExpirationQueue removes an amount from the expiration queue
-// If the resulting amount is zero, deletes the entry
+// If the resulting amount is zero, deletes the entry. The total locked amount is decreased accordingly
 func (k Keeper) RemoveFromExpirationQueue(ctx context.Context, unlockTime time.Time, addr sdk.AccAddress, amount math.Int) error {
 	store := k.storeService.OpenKVStore(ctx)
 	key := k.GetLockExpirationKey(unlockTime, addr)
@@ -90,15 +119,59 @@ func (k Keeper) RemoveFromExpirationQueue(ctx context.Context, unlockTime time.T
 	newAmount := currentAmount.Sub(amount)
 
 	if newAmount.IsZero() {
-		return store.Delete(key)
+		err = store.Delete(key)
+	} else {
+		bz, err = newAmount.Marshal()
+		if err != nil {
+			return err
+		}
+		err = store.Set(key, bz)
+	}
+	if err != nil {
+		return err
 	}
 
-	bz, err = newAmount.Marshal()
+	return k.adjustTotalLocked(ctx, amount.Neg())
+}
+
+// IterateExpirationQueue iterates over every entry of the expiration queue, expired or not (read-only)
+func (k Keeper) IterateExpirationQueue(ctx context.Context, cb func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iter, err := store.Iterator(types.LocksByDateKey, prefixEndBytes(types.LocksByDateKey))
 	if err != nil {
 		return err
 	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		// Parse Key
+		// Prefix (len) + Time (8) + Addr (Remainder)
+		prefixLen := len(types.LocksByDateKey)
+
+		if len(key) < prefixLen+8 {
+			continue
+		}
+
+		timeBz := key[prefixLen : prefixLen+8]
+		addrBz := key[prefixLen+8:]
+
+		unlockUnix := binary.BigEndian.Uint64(timeBz)
+		unlockTime := time.Unix(int64(unlockUnix), 0)
+		addr := sdk.AccAddress(addrBz)
 
-	return store.Set(key, bz)
+		var amount math.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			return err
+		}
+
+		if err := cb(addr, unlockTime, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // IterateActiveLocks iterates over all locks that have NOT expired yet (read-only)
@@ -110,8 +183,8 @@ func (k Keeper) IterateActiveLocks(ctx context.Context, currentTime time.Time, c
 	binary.BigEndian.PutUint64(startTimeBz, uint64(currentTime.Unix()+1))
 	startKey := append(types.LocksByDateKey, startTimeBz...)
 
-	// End key is nil to iterate to the end
-	iter, err := store.Iterator(startKey, nil)
+	// End key is the end of the expiration queue prefix, so later store prefixes are not read
+	iter, err := store.Iterator(startKey, prefixEndBytes(types.LocksByDateKey))
 	if err != nil {
 		return err
 	}
@@ -147,7 +220,42 @@ func (k Keeper) IterateActiveLocks(ctx context.Context, currentTime time.Time, c
 	return nil
 }
 
-// IterateAndDeleteExpiredLocks iterates over locks that have expired before or at cutoffTime and deletes them
+// IterateExpiredLocks iterates over locks that have expired before or at cutoffTime (read-only)
+func (k Keeper) IterateExpiredLocks(ctx context.Context, cutoffTime time.Time, cb func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	// End key is Prefix + CutoffTime + 1 second (to include CutoffTime)
+	endTimeBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(endTimeBz, uint64(cutoffTime.Unix()+1))
+	endKey := append(append([]byte{}, types.LocksByDateKey...), endTimeBz...)
+
+	iter, err := store.Iterator(types.LocksByDateKey, endKey)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	prefixLen := len(types.LocksByDateKey)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		unlockTime := time.Unix(int64(binary.BigEndian.Uint64(key[prefixLen:prefixLen+8])), 0)
+		addr := sdk.AccAddress(key[prefixLen+8:])
+
+		var amount math.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			return err
+		}
+
+		if err := cb(addr, unlockTime, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IterateAndDeleteExpiredLocks iterates over locks that have expired before or at cutoffTime and deletes them.
+// The deleted amounts are subtracted from the total locked amount
 func (k Keeper) IterateAndDeleteExpiredLocks(ctx context.Context, cutoffTime time.Time, cb func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error) error {
 	store := k.storeService.OpenKVStore(ctx)
 
@@ -162,6 +270,7 @@ func (k Keeper) IterateAndDeleteExpiredLocks(ctx context.Context, cutoffTime tim
 	}
 	defer iter.Close()
 
+	totalExpired := math.ZeroInt()
 	for ; iter.Valid(); iter.Next() {
 		key := iter.Key()
 		// Parse Key
@@ -186,7 +295,13 @@ func (k Keeper) IterateAndDeleteExpiredLocks(ctx context.Context, cutoffTime tim
 		if err := store.Delete(key); err != nil {
 			return err
 		}
+
+		totalExpired = totalExpired.Add(amount)
 	}
 
-	return nil
+	if totalExpired.IsZero() {
+		return nil
+	}
+
+	return k.adjustTotalLocked(ctx, totalExpired.Neg())
 }
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+)
+
+func TestTotalLocked(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	requireTotal := func(expected int64) {
+		t.Helper()
+		total, err := k.GetTotalLocked(ctx)
+		require.NoError(t, err)
+		require.Equal(t, math.NewInt(expected), total)
+	}
+
+	requireTotal(0)
+
+	require.NoError(t, k.AddToExpirationQueue(ctx, jan, alice, math.NewInt(100)))
+	require.NoError(t, k.AddToExpirationQueue(ctx, jan, alice, math.NewInt(50)))
+	require.NoError(t, k.AddToExpirationQueue(ctx, mar, bob, math.NewInt(300)))
+	requireTotal(450)
+
+	// extending moves the amount between dates without changing the total
+	require.NoError(t, k.RemoveFromExpirationQueue(ctx, jan, alice, math.NewInt(50)))
+	require.NoError(t, k.AddToExpirationQueue(ctx, mar, alice, math.NewInt(50)))
+	requireTotal(450)
+
+	require.Error(t, k.RemoveFromExpirationQueue(ctx, jan, alice, math.NewInt(101)))
+	requireTotal(450)
+
+	ctx = ctx.WithBlockTime(jan)
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+	requireTotal(350)
+
+	require.NoError(t, k.IterateAndDeleteExpiredLocks(ctx, mar, func(sdk.AccAddress, time.Time, math.Int) error { return nil }))
+	requireTotal(0)
+}
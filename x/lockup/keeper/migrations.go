@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// It backfills the total locked amount from the expiration queue.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	totalLocked := math.ZeroInt()
+
+	err := m.keeper.IterateExpirationQueue(ctx, func(_ sdk.AccAddress, _ time.Time, amount math.Int) error {
+		totalLocked = totalLocked.Add(amount)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.keeper.SetTotalLocked(ctx, totalLocked)
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It stores the default module parameters.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.SetParams(ctx, types.DefaultParams())
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// It backfills the total reward weight of every lock and the outstanding
+// rewards from the pending rewards, so epochs are allocated through the reward index.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	addresses := make(map[string]sdk.AccAddress)
+	err := m.keeper.IterateLocksByAddress(ctx, func(addr sdk.AccAddress, _ []*types.Lock) error {
+		addresses[addr.String()] = addr
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = m.keeper.IterateRollingLocks(ctx, func(addr sdk.AccAddress, _ uint32, _ math.Int) error {
+		addresses[addr.String()] = addr
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	totalWeight := types.NewRewardWeight()
+	for _, addr := range addresses {
+		weight, err := m.keeper.getRewardWeight(ctx, addr)
+		if err != nil {
+			return err
+		}
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	if err := m.keeper.SetTotalRewardWeight(ctx, totalWeight); err != nil {
+		return err
+	}
+
+	outstanding, err := m.keeper.GetTotalPendingRewards(ctx)
+	if err != nil {
+		return err
+	}
+
+	return m.keeper.SetOutstandingRewards(ctx, outstanding)
+}
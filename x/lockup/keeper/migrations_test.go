@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/keeper"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	require.NoError(t, k.AddToExpirationQueue(ctx, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), alice, math.NewInt(100)))
+	require.NoError(t, k.AddToExpirationQueue(ctx, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), bob, math.NewInt(250)))
+
+	// simulate a v1 store, where the total was never written
+	require.NoError(t, k.SetTotalLocked(ctx, math.ZeroInt()))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	total, err := k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(350), total)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	// simulate a v2 store, where params were never written
+	require.NoError(t, k.SetParams(ctx, types.Params{}))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestMigrate3to4(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	addLock(t, k, ctx, alice, "2026-01-11", 100)
+	require.NoError(t, k.AddRollingLock(ctx, bob, 30, math.NewInt(300)))
+	require.NoError(t, k.SetPendingRewards(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 5))))
+
+	// simulate a v3 store, where neither the reward weight nor the outstanding rewards were written
+	require.NoError(t, k.SetTotalRewardWeight(ctx, types.NewRewardWeight()))
+	require.NoError(t, k.SetOutstandingRewards(ctx, sdk.NewCoins()))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate3to4(ctx))
+
+	weight, err := k.GetTotalRewardWeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000+9000), weight.On(types.EpochDay(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))))
+
+	outstanding, err := k.GetOutstandingRewards(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 5)), outstanding)
+}
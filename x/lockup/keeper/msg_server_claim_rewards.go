@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if _, err := k.settleRewards(ctx, address); err != nil {
+		return nil, err
+	}
+
+	rewards, err := k.GetPendingRewards(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	if rewards.IsZero() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("no pending rewards for %s", msg.Address)
+	}
+
+	if err := k.SetPendingRewards(ctx, address, sdk.NewCoins()); err != nil {
+		return nil, err
+	}
+
+	outstanding, err := k.GetOutstandingRewards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetOutstandingRewards(ctx, outstanding.Sub(rewards...)); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsPoolName, address, rewards); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeClaimRewards,
+			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.Address),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+		),
+	})
+
+	return &types.MsgClaimRewardsResponse{Rewards: rewards}, nil
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) DelegateAndLock(goCtx context.Context, msg *types.MsgDelegateAndLock) (*types.MsgDelegateAndLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid lockup address: %s", err)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom || !params.IsDenomAllowed(msg.Amount.Denom) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("denom %s cannot be delegated and locked", msg.Amount.Denom)
+	}
+
+	if !msg.Amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid lock amount: %s", msg.Amount.String())
+	}
+
+	if msg.Amount.Amount.LT(params.MinLockAmount) {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("lock amount %s is below the minimum of %s", msg.Amount.Amount, params.MinLockAmount)
+	}
+
+	if err := types.ValidateValidatorWeights(msg.Validators); err != nil {
+		return nil, err
+	}
+
+	unlockDate, err := types.ParseUnlockTime(msg.UnlockDate)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", msg.UnlockDate)
+	}
+
+	blockTime := ctx.BlockTime()
+	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+
+	if !unlockDate.After(blockTime) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unlock date must be in the future")
+	}
+
+	if blockDay.AddDate(0, int(params.MaxLockMonths), 0).Before(unlockDate) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unlock date cannot be more than %d months from now", params.MaxLockMonths)
+	}
+
+	// The new delegations back the new lock, so only the existing locks have
+	// to be covered. Checking the delegations after delegating could reject
+	// the lock over share rounding.
+	if err := k.checkDelegationsCoverLock(ctx, address, math.ZeroInt()); err != nil {
+		return nil, err
+	}
+
+	parts := types.SplitByWeight(msg.Amount.Amount, msg.Validators)
+	for i, validator := range msg.Validators {
+		if !parts[i].IsPositive() {
+			continue
+		}
+
+		if err := k.delegate(ctx, address, validator.ValidatorAddress, sdk.NewCoin(bondDenom, parts[i])); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.addDatedLock(ctx, params, address, msg.UnlockDate, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	err = k.recordLockHistory(ctx, types.LockHistoryEntry{
+		Address:    msg.Address,
+		Action:     types.LockHistoryAction_LOCK_HISTORY_ACTION_LOCK,
+		UnlockDate: msg.UnlockDate,
+		Amount:     msg.Amount.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeLock,
+			append(
+				lockEventAttributes(msg.Address, msg.UnlockDate, msg.Amount.Amount, ""),
+				sdk.NewAttribute(types.AttributeKeyAutoRenew, strconv.FormatBool(false)),
+			)...,
+		),
+	})
+
+	return &types.MsgDelegateAndLockResponse{}, nil
+}
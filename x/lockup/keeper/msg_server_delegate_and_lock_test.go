@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/keeper"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// delegatingStakingKeeper is a slashable staking keeper that records delegations by validator
+type delegatingStakingKeeper struct {
+	*slashableStakingKeeper
+	delegated map[string]math.Int
+}
+
+func (s *delegatingStakingKeeper) GetValidator(_ context.Context, valAddr sdk.ValAddress) (stakingtypes.Validator, error) {
+	validator := s.validator
+	validator.OperatorAddress = valAddr.String()
+	return validator, nil
+}
+
+func (s *delegatingStakingKeeper) Delegate(_ context.Context, delAddr sdk.AccAddress, bondAmt math.Int, _ stakingtypes.BondStatus, validator stakingtypes.Validator, _ bool) (math.LegacyDec, error) {
+	s.delegate(delAddr, bondAmt.Int64())
+	delegated, ok := s.delegated[validator.OperatorAddress]
+	if !ok {
+		delegated = math.ZeroInt()
+	}
+	s.delegated[validator.OperatorAddress] = delegated.Add(bondAmt)
+	return math.LegacyNewDecFromInt(bondAmt), nil
+}
+
+func TestMsgDelegateAndLock(t *testing.T) {
+	staking := &delegatingStakingKeeper{slashableStakingKeeper: newSlashableStakingKeeper(), delegated: map[string]math.Int{}}
+	k, ctx := keepertest.LockupKeeperWithKeepers(t, balanceBankKeeper{}, staking)
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	ms := keeper.NewMsgServerImpl(k)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	otherValAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	validators := []types.ValidatorWeight{
+		{ValidatorAddress: valAddr, Weight: math.LegacyMustNewDecFromStr("0.75")},
+		{ValidatorAddress: otherValAddr, Weight: math.LegacyMustNewDecFromStr("0.25")},
+	}
+
+	_, err := ms.DelegateAndLock(ctx, types.NewMsgDelegateAndLock(alice.String(), validators, "2026-12-01", sdk.NewInt64Coin("uatom", 1000)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = ms.DelegateAndLock(ctx, types.NewMsgDelegateAndLock(alice.String(), validators, "2025-12-01", sdk.NewInt64Coin("uOPT", 1000)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Empty(t, staking.delegated)
+
+	// the amount is split between the validators and locked at once
+	_, err = ms.DelegateAndLock(ctx, types.NewMsgDelegateAndLock(alice.String(), validators, "2026-12-01", sdk.NewInt64Coin("uOPT", 1000)))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(750), staking.delegated[valAddr])
+	require.Equal(t, math.NewInt(250), staking.delegated[otherValAddr])
+
+	lock, _, found := k.GetLockByAddressAndDate(ctx, alice, "2026-12-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(1000), lock.Amount)
+
+	locked, err := k.GetLockedAmountByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), *locked)
+
+	// existing locks that are no longer covered are rejected before delegating
+	staking.slash(math.LegacyNewDecWithPrec(5, 1))
+	_, err = ms.DelegateAndLock(ctx, types.NewMsgDelegateAndLock(alice.String(), validators, "2026-12-01", sdk.NewInt64Coin("uOPT", 1000)))
+	require.ErrorIs(t, err, types.ErrInsufficientDelegations)
+	require.Equal(t, math.NewInt(750), staking.delegated[valAddr])
+}
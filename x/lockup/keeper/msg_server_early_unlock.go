@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"context"
+	stdmath "math"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) EarlyUnlock(goCtx context.Context, msg *types.MsgEarlyUnlock) (*types.MsgEarlyUnlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid lockup address: %s", err)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %s, expected: %s", msg.Amount.Denom, bondDenom)
+	}
+
+	if !msg.Amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid early unlock amount: %s", msg.Amount.String())
+	}
+
+	unlockDate, err := types.ParseUnlockTime(msg.UnlockDate)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", msg.UnlockDate)
+	}
+
+	blockTime := ctx.BlockTime()
+	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+
+	if !types.IsLocked(blockTime, msg.UnlockDate) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("lock for %s has already expired", msg.UnlockDate)
+	}
+
+	if err := k.checkNotTokenized(ctx, address, msg.UnlockDate); err != nil {
+		return nil, err
+	}
+
+	existingLock, idx, found := k.GetLockByAddressAndDate(ctx, address, msg.UnlockDate)
+	if !found {
+		return nil, types.ErrLockupNotFound.Wrapf("no lockup found for unlock date (%s)", msg.UnlockDate)
+	}
+
+	amount := msg.Amount.Amount
+	if existingLock.Amount.LT(amount) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("early unlock amount exceeds existing lock amount date (%s)", msg.UnlockDate)
+	}
+
+	params := k.GetParams(ctx)
+	penalty, err := k.chargeEarlyUnlockPenalty(ctx, params.PenaltyDestination, address, sdk.NewCoin(bondDenom, params.EarlyUnlockPenalty(amount, blockDay, unlockDate)))
+	if err != nil {
+		return nil, err
+	}
+
+	if existingLock.Amount.Equal(amount) {
+		err = k.DeleteLockByAddressAndIndex(ctx, address, idx)
+	} else {
+		err = k.UpdateLockByAddressAndIndex(ctx, address, idx, &types.Lock{
+			UnlockDate: existingLock.UnlockDate,
+			Amount:     existingLock.Amount.Sub(amount),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RemoveFromExpirationQueue(ctx, unlockDate, address, amount); err != nil {
+		return nil, err
+	}
+
+	err = k.recordLockHistory(ctx, types.LockHistoryEntry{
+		Address:    msg.Address,
+		Action:     types.LockHistoryAction_LOCK_HISTORY_ACTION_EARLY_UNLOCK,
+		UnlockDate: msg.UnlockDate,
+		Amount:     amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeEarlyUnlock,
+			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, msg.UnlockDate),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyPenalty, penalty.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, params.PenaltyDestination.String()),
+		),
+	})
+
+	return &types.MsgEarlyUnlockResponse{Penalty: penalty}, nil
+}
+
+// chargeEarlyUnlockPenalty takes penalty out of the delegations of addr,
+// which back the unlocked amount, in store order and sends it to destination.
+// It returns the penalty actually charged, which share rounding can make
+// slightly smaller than penalty.
+func (k Keeper) chargeEarlyUnlockPenalty(ctx sdk.Context, destination types.PenaltyDestination, addr sdk.AccAddress, penalty sdk.Coin) (sdk.Coin, error) {
+	charged := sdk.NewCoin(penalty.Denom, math.ZeroInt())
+	if !penalty.IsPositive() {
+		return charged, nil
+	}
+
+	delegations, err := k.stakingKeeper.GetDelegatorDelegations(ctx, addr, stdmath.MaxUint16)
+	if err != nil {
+		return charged, err
+	}
+
+	remaining := penalty.Amount
+	for _, delegation := range delegations {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(delegation.GetValidatorAddr())
+		if err != nil {
+			return charged, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		}
+
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return charged, err
+		}
+
+		take := math.MinInt(validator.TokensFromShares(delegation.GetShares()).TruncateInt(), remaining)
+		if !take.IsPositive() {
+			continue
+		}
+
+		shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, addr, valAddr, take)
+		if err != nil {
+			return charged, err
+		}
+
+		unbonded, err := k.stakingKeeper.Unbond(ctx, addr, valAddr, shares)
+		if err != nil {
+			return charged, err
+		}
+
+		// Unbond leaves the tokens in the staking pool that holds the validator's tokens
+		pool := stakingtypes.NotBondedPoolName
+		if validator.IsBonded() {
+			pool = stakingtypes.BondedPoolName
+		}
+
+		if err := k.sendPenalty(ctx, destination, pool, sdk.NewCoins(sdk.NewCoin(penalty.Denom, unbonded))); err != nil {
+			return charged, err
+		}
+
+		charged = charged.AddAmount(unbonded)
+		remaining = remaining.Sub(take)
+	}
+
+	if remaining.IsPositive() {
+		return charged, errorsmod.Wrapf(types.ErrInsufficientDelegations, "delegations do not cover the early unlock penalty: %s missing", remaining)
+	}
+
+	return charged, nil
+}
+
+// sendPenalty sends penalty from the staking pool to destination.
+func (k Keeper) sendPenalty(ctx sdk.Context, destination types.PenaltyDestination, pool string, penalty sdk.Coins) error {
+	if penalty.IsZero() {
+		return nil
+	}
+
+	switch destination {
+	case types.PenaltyDestination_PENALTY_DESTINATION_BURN:
+		return k.bankKeeper.BurnCoins(ctx, pool, penalty)
+	case types.PenaltyDestination_PENALTY_DESTINATION_COMMUNITY_POOL:
+		return k.distrKeeper.FundCommunityPool(ctx, penalty, authtypes.NewModuleAddress(pool))
+	case types.PenaltyDestination_PENALTY_DESTINATION_MODULE_ACCOUNT:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, pool, types.PenaltyPoolName, penalty)
+	default:
+		return sdkerrors.ErrInvalidRequest.Wrapf("unknown penalty destination: %s", destination)
+	}
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/keeper"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// penaltyBankKeeper is an escrow bank keeper that can burn coins and move them between module accounts
+type penaltyBankKeeper struct {
+	escrowBankKeeper
+}
+
+func (b penaltyBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	b.balances[addr] = b.balances[addr].Sub(amt...)
+	return nil
+}
+
+func (b penaltyBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return b.SendCoins(ctx, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+// unbondingStakingKeeper is a slashable staking keeper that can unbond delegations
+type unbondingStakingKeeper struct {
+	*slashableStakingKeeper
+}
+
+func (s *unbondingStakingKeeper) ValidateUnbondAmount(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress, amt math.Int) (math.LegacyDec, error) {
+	return s.validator.SharesFromTokens(amt)
+}
+
+func (s *unbondingStakingKeeper) Unbond(_ context.Context, delAddr sdk.AccAddress, _ sdk.ValAddress, shares math.LegacyDec) (math.Int, error) {
+	for i, delegation := range s.delegations {
+		if delegation.DelegatorAddress == delAddr.String() {
+			s.delegations[i].Shares = delegation.Shares.Sub(shares)
+		}
+	}
+	amount := s.validator.TokensFromShares(shares).TruncateInt()
+	s.validator.Tokens = s.validator.Tokens.Sub(amount)
+	s.validator.DelegatorShares = s.validator.DelegatorShares.Sub(shares)
+	return amount, nil
+}
+
+func TestMsgEarlyUnlockTakesPenaltyFromDelegations(t *testing.T) {
+	bank := penaltyBankKeeper{escrowBankKeeper{balanceBankKeeper{balances: map[string]sdk.Coins{}}}}
+	staking := &unbondingStakingKeeper{newSlashableStakingKeeper()}
+	staking.validator.Status = stakingtypes.Bonded
+	k, ctx := keepertest.LockupKeeperWithKeepers(t, bank, staking)
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	ms := keeper.NewMsgServerImpl(k)
+
+	params := types.DefaultParams()
+	params.PenaltyDestination = types.PenaltyDestination_PENALTY_DESTINATION_MODULE_ACCOUNT
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// alice has all of her tokens delegated and no liquid balance
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+	penaltyPool := authtypes.NewModuleAddress(types.PenaltyPoolName)
+	staking.delegate(alice, 1000)
+	bank.balances[bondedPool.String()] = sdk.NewCoins(sdk.NewInt64Coin("uOPT", 1000))
+	addLock(t, k, ctx, alice, "2026-12-01", 1000)
+
+	// 334 of 730 days remain, so the penalty is 25% of 400 scaled by 334/730
+	res, err := ms.EarlyUnlock(ctx, &types.MsgEarlyUnlock{Address: alice.String(), UnlockDate: "2026-12-01", Amount: sdk.NewInt64Coin("uOPT", 400)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uOPT", 45), res.Penalty)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 955)), bank.balances[bondedPool.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 45)), bank.balances[penaltyPool.String()])
+	require.True(t, bank.balances[authtypes.NewModuleAddress(types.ModuleName).String()].IsZero())
+	require.True(t, bank.balances[alice.String()].IsZero())
+
+	delegated, err := k.GetTotalDelegatedAmount(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(955), *delegated)
+
+	lock, _, found := k.GetLockByAddressAndDate(ctx, alice, "2026-12-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(600), lock.Amount)
+
+	// burnt penalties leave the staking pool
+	params.PenaltyDestination = types.PenaltyDestination_PENALTY_DESTINATION_BURN
+	require.NoError(t, k.SetParams(ctx, params))
+
+	res, err = ms.EarlyUnlock(ctx, &types.MsgEarlyUnlock{Address: alice.String(), UnlockDate: "2026-12-01", Amount: sdk.NewInt64Coin("uOPT", 200)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uOPT", 22), res.Penalty)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 933)), bank.balances[bondedPool.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 45)), bank.balances[penaltyPool.String()])
+}
@@ -23,24 +23,32 @@ func (k msgServer) Extend(goCtx context.Context, msg *types.MsgExtend) (*types.M
 		return nil, sdkerrors.ErrNotFound.Wrapf("no account found for address: %s", msg.Address)
 	}
 
+	params := k.GetParams(ctx)
+
 	events := sdk.Events{}
 	for _, extension := range msg.Extensions {
 
+		if !params.IsDenomAllowed(extension.Amount.Denom) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("denom %s is not allowed for locking", extension.Amount.Denom)
+		}
+
 		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		if extension.Amount.Denom != bondDenom {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %s, expected: %s", extension.Amount.Denom, bondDenom)
+		// Locks of denoms other than the bond denom are always escrowed
+		lockDenom := ""
+		if extension.Escrow || extension.Amount.Denom != bondDenom {
+			lockDenom = extension.Amount.Denom
 		}
 
-		fromDate, err := time.Parse(time.DateOnly, extension.FromDate)
+		fromDate, err := types.ParseUnlockTime(extension.FromDate)
 		if err != nil {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid from date format: %s", extension.FromDate)
 		}
 
-		toDate, err := time.Parse(time.DateOnly, extension.ToDate)
+		toDate, err := types.ParseUnlockTime(extension.ToDate)
 		if err != nil {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid to date format (%s)", extension.ToDate)
 		}
@@ -52,15 +60,25 @@ func (k msgServer) Extend(goCtx context.Context, msg *types.MsgExtend) (*types.M
 		blockTime := ctx.BlockTime()
 		blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
 
-		if blockDay.After(toDate) || blockDay.Equal(toDate) {
+		if !toDate.After(blockTime) {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("to date must be in the future")
 		}
 
-		if blockDay.AddDate(2, 0, 0).Before(toDate) {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("to date cannot be more than 2 years from now")
+		if blockDay.AddDate(0, int(params.MaxLockMonths), 0).Before(toDate) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("to date cannot be more than %d months from now", params.MaxLockMonths)
+		}
+
+		if lockDenom == "" {
+			if err := k.checkNotTokenized(ctx, addr, extension.FromDate); err != nil {
+				return nil, err
+			}
+
+			if err := k.checkNotTokenized(ctx, addr, extension.ToDate); err != nil {
+				return nil, err
+			}
 		}
 
-		existingFromLock, idx, found := k.GetLockByAddressAndDate(ctx, addr, extension.FromDate)
+		existingFromLock, idx, found := k.GetLockByAddressDateAndDenom(ctx, addr, extension.FromDate, lockDenom)
 		if !found {
 			return nil, types.ErrLockupNotFound.Wrapf("no lockup found for from date (%s)", extension.FromDate)
 		}
@@ -76,6 +94,8 @@ func (k msgServer) Extend(goCtx context.Context, msg *types.MsgExtend) (*types.M
 			updatedLock := &types.Lock{
 				UnlockDate: existingFromLock.UnlockDate,
 				Amount:     existingFromLock.Amount.Sub(amountToMove),
+				Denom:      lockDenom,
+				Kind:       types.LockKindOf(lockDenom),
 			}
 			err = k.UpdateLockByAddressAndIndex(ctx, addr, idx, updatedLock)
 			if err != nil {
@@ -83,17 +103,25 @@ func (k msgServer) Extend(goCtx context.Context, msg *types.MsgExtend) (*types.M
 			}
 		}
 
-		existingToLock, idx, found := k.GetLockByAddressAndDate(ctx, addr, extension.ToDate)
+		existingToLock, idx, found := k.GetLockByAddressDateAndDenom(ctx, addr, extension.ToDate, lockDenom)
 		if found {
 			updatedLock := &types.Lock{
 				UnlockDate: existingToLock.UnlockDate,
 				Amount:     existingToLock.Amount.Add(amountToMove),
+				Denom:      lockDenom,
+				Kind:       types.LockKindOf(lockDenom),
 			}
 			err = k.UpdateLockByAddressAndIndex(ctx, addr, idx, updatedLock)
 		} else {
+			if err = k.checkLockLimit(ctx, params, addr); err != nil {
+				return nil, err
+			}
+
 			newLock := &types.Lock{
 				UnlockDate: extension.ToDate,
 				Amount:     amountToMove,
+				Denom:      lockDenom,
+				Kind:       types.LockKindOf(lockDenom),
 			}
 			err = k.SetLockByAddress(ctx, addr, newLock)
 		}
@@ -101,21 +129,46 @@ func (k msgServer) Extend(goCtx context.Context, msg *types.MsgExtend) (*types.M
 			return nil, err
 		}
 
-		if err := k.RemoveFromExpirationQueue(ctx, fromDate, addr, amountToMove); err != nil {
-			return nil, err
+		if lockDenom != "" {
+			if err := k.RemoveFromEscrowQueue(ctx, fromDate, addr, extension.Amount); err != nil {
+				return nil, err
+			}
+
+			if err := k.AddToEscrowQueue(ctx, toDate, addr, extension.Amount); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := k.RemoveFromExpirationQueue(ctx, fromDate, addr, amountToMove); err != nil {
+				return nil, err
+			}
+
+			if err := k.AddToExpirationQueue(ctx, toDate, addr, amountToMove); err != nil {
+				return nil, err
+			}
 		}
 
-		if err := k.AddToExpirationQueue(ctx, toDate, addr, amountToMove); err != nil {
+		err = k.recordLockHistory(ctx, types.LockHistoryEntry{
+			Address:       msg.Address,
+			Action:        types.LockHistoryAction_LOCK_HISTORY_ACTION_EXTEND,
+			UnlockDate:    extension.ToDate,
+			OldUnlockDate: extension.FromDate,
+			Amount:        amountToMove,
+			Denom:         lockDenom,
+		})
+		if err != nil {
 			return nil, err
 		}
 
-		events = events.AppendEvent(sdk.NewEvent(
-			types.EventTypeLockExtended,
+		attributes := []sdk.Attribute{
 			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.Address),
 			sdk.NewAttribute(types.AttributeKeyOldUnlockDate, extension.FromDate),
 			sdk.NewAttribute(types.AttributeKeyUnlockDate, extension.ToDate),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, amountToMove.String()),
-		))
+		}
+		if lockDenom != "" {
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyDenom, lockDenom))
+		}
+		events = events.AppendEvent(sdk.NewEvent(types.EventTypeLockExtended, attributes...))
 	}
 
 	ctx.EventManager().EmitEvents(events)
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) FundRewardsPool(goCtx context.Context, msg *types.MsgFundRewardsPool) (*types.MsgFundRewardsPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid funding amount: %s", msg.Amount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.RewardsPoolName, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeFundRewardsPool,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+
+	return &types.MsgFundRewardsPoolResponse{}, nil
+}
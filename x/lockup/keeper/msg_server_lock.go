@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	"github.com/OptioNetwork/optio/x/lockup/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,14 +16,21 @@ import (
 
 func (k msgServer) Lock(goCtx context.Context, msg *types.MsgLock) (*types.MsgLockResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if !params.IsDenomAllowed(msg.Amount.Denom) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("denom %s is not allowed for locking", msg.Amount.Denom)
+	}
 
 	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Amount.Denom != bondDenom {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %s, expected: %s", msg.Amount.Denom, bondDenom)
+	// Allowed denoms other than the bond denom cannot be delegated, so they are always escrowed
+	lockDenom := ""
+	if msg.Escrow || msg.Amount.Denom != bondDenom {
+		lockDenom = msg.Amount.Denom
 	}
 
 	address, err := sdk.AccAddressFromBech32(msg.Address)
@@ -33,7 +42,11 @@ func (k msgServer) Lock(goCtx context.Context, msg *types.MsgLock) (*types.MsgLo
 		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid lock amount: %s", msg.Amount.String())
 	}
 
-	unlockDate, err := time.Parse(time.DateOnly, msg.UnlockDate)
+	if msg.Amount.Denom == bondDenom && msg.Amount.Amount.LT(params.MinLockAmount) {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("lock amount %s is below the minimum of %s", msg.Amount.Amount, params.MinLockAmount)
+	}
+
+	unlockDate, err := types.ParseUnlockTime(msg.UnlockDate)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", msg.UnlockDate)
 	}
@@ -41,58 +54,134 @@ func (k msgServer) Lock(goCtx context.Context, msg *types.MsgLock) (*types.MsgLo
 	blockTime := ctx.BlockTime()
 	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
 
-	if blockDay.After(unlockDate) || blockDay.Equal(unlockDate) {
+	if !unlockDate.After(blockTime) {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unlock date must be in the future")
 	}
 
-	if blockDay.AddDate(2, 0, 0).Before(unlockDate) {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unlock date cannot be more than 2 years from now")
+	if blockDay.AddDate(0, int(params.MaxLockMonths), 0).Before(unlockDate) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unlock date cannot be more than %d months from now", params.MaxLockMonths)
+	}
+
+	if lockDenom != "" {
+		if msg.AutoRenew {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("escrowed locks of %s cannot auto-renew", lockDenom)
+		}
+
+		if err := k.escrowLock(ctx, params, address, msg.UnlockDate, msg.Amount); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := k.checkDelegationsCoverLock(ctx, address, msg.Amount.Amount); err != nil {
+			return nil, err
+		}
+
+		if msg.AutoRenew {
+			// rolling locks keep a whole number of days until they unlock
+			if msg.UnlockDate != unlockDate.Format(time.DateOnly) {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf("auto-renewing locks take an unlock date, not a timestamp: %s", msg.UnlockDate)
+			}
+
+			if err := k.addRollingLock(ctx, params, address, types.DurationDays(blockDay, unlockDate), msg.Amount.Amount); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := k.addDatedLock(ctx, params, address, msg.UnlockDate, msg.Amount.Amount); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	currentLockedAmount, err := k.GetLockedAmountByAddress(ctx, address)
+	err = k.recordLockHistory(ctx, types.LockHistoryEntry{
+		Address:    msg.Address,
+		Action:     types.LockHistoryAction_LOCK_HISTORY_ACTION_LOCK,
+		UnlockDate: msg.UnlockDate,
+		Amount:     msg.Amount.Amount,
+		AutoRenew:  msg.AutoRenew,
+		Denom:      lockDenom,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	totalDelegatedAmount, err := k.GetTotalDelegatedAmount(ctx, address)
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeLock,
+			append(
+				lockEventAttributes(msg.Address, msg.UnlockDate, msg.Amount.Amount, lockDenom),
+				sdk.NewAttribute(types.AttributeKeyAutoRenew, strconv.FormatBool(msg.AutoRenew)),
+			)...,
+		),
+	})
+
+	return &types.MsgLockResponse{}, nil
+}
+
+// checkDelegationsCoverLock returns an error if the delegations of addr do not
+// cover its locked amount after locking amount more.
+func (k Keeper) checkDelegationsCoverLock(ctx sdk.Context, addr sdk.AccAddress, amount math.Int) error {
+	currentLockedAmount, err := k.GetLockedAmountByAddress(ctx, addr)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if totalDelegatedAmount.LT(currentLockedAmount.Add(msg.Amount.Amount)) {
-		return nil, errorsmod.Wrapf(
+	totalDelegatedAmount, err := k.GetTotalDelegatedAmount(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	if totalDelegatedAmount.LT(currentLockedAmount.Add(amount)) {
+		return errorsmod.Wrapf(
 			types.ErrInsufficientDelegations,
 			"insufficient delegated tokens to create new locks by the requested amount: %s < %s",
 			totalDelegatedAmount.String(),
-			currentLockedAmount.Add(msg.Amount.Amount).String(),
+			currentLockedAmount.Add(amount).String(),
 		)
 	}
 
-	exisitingLock, idx, found := k.GetLockByAddressAndDate(ctx, address, msg.UnlockDate)
+	return nil
+}
+
+// addDatedLock adds amount to the lock of addr that unlocks on unlockDate, in
+// both lock indexes.
+func (k Keeper) addDatedLock(ctx sdk.Context, params types.Params, addr sdk.AccAddress, unlockDate string, amount math.Int) error {
+	unlockTime, err := types.ParseUnlockTime(unlockDate)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", unlockDate)
+	}
+
+	if err := k.checkNotTokenized(ctx, addr, unlockDate); err != nil {
+		return err
+	}
+
+	existingLock, idx, found := k.GetLockByAddressAndDate(ctx, addr, unlockDate)
 	if found {
-		newAmount := exisitingLock.Amount.Add(msg.Amount.Amount)
-		if err = k.UpdateLockByAddressAndIndex(ctx, address, idx, &types.Lock{UnlockDate: exisitingLock.UnlockDate, Amount: newAmount}); err != nil {
-			return nil, err
-		}
+		err = k.UpdateLockByAddressAndIndex(ctx, addr, idx, &types.Lock{UnlockDate: existingLock.UnlockDate, Amount: existingLock.Amount.Add(amount)})
 	} else {
-
-		if err = k.SetLockByAddress(ctx, address, &types.Lock{UnlockDate: msg.UnlockDate, Amount: msg.Amount.Amount}); err != nil {
-			return nil, err
+		if err = k.checkLockLimit(ctx, params, addr); err != nil {
+			return err
 		}
+
+		err = k.SetLockByAddress(ctx, addr, &types.Lock{UnlockDate: unlockDate, Amount: amount})
+	}
+	if err != nil {
+		return err
 	}
 
-	if err := k.AddToExpirationQueue(ctx, unlockDate, address, msg.Amount.Amount); err != nil {
-		return nil, err
+	return k.AddToExpirationQueue(ctx, unlockTime, addr, amount)
+}
+
+// addRollingLock adds amount to the rolling lock of addr with the given duration.
+func (k Keeper) addRollingLock(ctx sdk.Context, params types.Params, addr sdk.AccAddress, durationDays uint32, amount math.Int) error {
+	existingAmount, err := k.GetRollingLock(ctx, addr, durationDays)
+	if err != nil {
+		return err
 	}
 
-	ctx.EventManager().EmitEvents([]sdk.Event{
-		sdk.NewEvent(
-			types.EventTypeLock,
-			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.Address),
-			sdk.NewAttribute(types.AttributeKeyUnlockDate, msg.UnlockDate),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
-		),
-	})
+	if existingAmount.IsZero() {
+		if err := k.checkLockLimit(ctx, params, addr); err != nil {
+			return err
+		}
+	}
 
-	return &types.MsgLockResponse{}, nil
+	return k.AddRollingLock(ctx, addr, durationDays, amount)
 }
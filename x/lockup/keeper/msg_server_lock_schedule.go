@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) LockSchedule(goCtx context.Context, msg *types.MsgLockSchedule) (*types.MsgLockScheduleResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid lockup address: %s", err)
+	}
+
+	locks, err := k.lockSchedule(goCtx, msg.Address, msg.TotalAmount, msg.Schedule, msg.Escrow)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLockScheduleResponse{Locks: locks}, nil
+}
+
+func (k msgServer) SendDelegateAndLockSchedule(goCtx context.Context, msg *types.MsgSendDelegateAndLockSchedule) (*types.MsgSendDelegateAndLockScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAddr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	toAddr, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	if !msg.TotalAmount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid total amount: %s", msg.TotalAmount.String())
+	}
+
+	// The whole amount is sent and delegated at once, then locked in parts
+	if err := k.sendAndDelegate(ctx, fromAddr, toAddr, msg.ValidatorAddress, msg.TotalAmount); err != nil {
+		return nil, err
+	}
+
+	locks, err := k.lockSchedule(goCtx, msg.ToAddress, msg.TotalAmount, msg.Schedule, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendDelegateAndLockScheduleResponse{Locks: locks}, nil
+}
+
+// lockSchedule locks totalAmount of address in the locks of schedule. Each
+// lock goes through Lock, so it is checked against the max lock horizon and
+// the delegations of address like any other lock.
+func (k msgServer) lockSchedule(goCtx context.Context, address string, totalAmount sdk.Coin, schedule types.LockSchedule, escrow bool) ([]types.Lock, error) {
+	locks, err := schedule.Locks(totalAmount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(goCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lock escrows the locks of denoms other than the bond denom
+	lockDenom := ""
+	if escrow || totalAmount.Denom != bondDenom {
+		lockDenom = totalAmount.Denom
+	}
+
+	// Check the whole schedule up front rather than failing part way through
+	if lockDenom == "" {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid lockup address: %s", err)
+		}
+
+		if err := k.checkDelegationsCoverLock(sdk.UnwrapSDKContext(goCtx), addr, totalAmount.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range locks {
+		lock := &types.MsgLock{
+			Address:    address,
+			UnlockDate: locks[i].UnlockDate,
+			Amount:     sdk.NewCoin(totalAmount.Denom, locks[i].Amount),
+			Escrow:     escrow,
+		}
+		if _, err := k.Lock(goCtx, lock); err != nil {
+			return nil, err
+		}
+
+		locks[i].Denom = lockDenom
+		locks[i].Kind = types.LockKindOf(lockDenom)
+	}
+
+	return locks, nil
+}
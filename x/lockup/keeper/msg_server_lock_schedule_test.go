@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/keeper"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func TestMsgLockSchedule(t *testing.T) {
+	staking := newSlashableStakingKeeper()
+	k, ctx := keepertest.LockupKeeperWithKeepers(t, balanceBankKeeper{}, staking)
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	ms := keeper.NewMsgServerImpl(k)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	staking.delegate(alice, 1000)
+
+	schedule := types.NewLockSchedule("2026-01-01", types.LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_MONTHLY, 4, 2)
+
+	// every lock must be covered by delegations
+	_, err := ms.LockSchedule(ctx, types.NewMsgLockSchedule(alice.String(), sdk.NewInt64Coin("uOPT", 2000), schedule))
+	require.ErrorIs(t, err, types.ErrInsufficientDelegations)
+
+	// unlock dates are checked against the max lock horizon
+	params := k.GetParams(ctx)
+	farSchedule := types.NewLockSchedule("2026-01-01", types.LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_MONTHLY, params.MaxLockMonths+1, 0)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = ms.LockSchedule(cacheCtx, types.NewMsgLockSchedule(alice.String(), sdk.NewInt64Coin("uOPT", 1000), farSchedule))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	res, err := ms.LockSchedule(ctx, types.NewMsgLockSchedule(alice.String(), sdk.NewInt64Coin("uOPT", 1000), schedule))
+	require.NoError(t, err)
+	expected := []types.Lock{
+		{UnlockDate: "2026-03-01", Amount: math.NewInt(500)},
+		{UnlockDate: "2026-04-01", Amount: math.NewInt(250)},
+		{UnlockDate: "2026-05-01", Amount: math.NewInt(250)},
+	}
+	require.Equal(t, expected, res.Locks)
+
+	locks, err := k.GetLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Len(t, locks, len(expected))
+	for i, lock := range locks {
+		require.Equal(t, expected[i], *lock)
+	}
+
+	locked, err := k.GetLockedAmountByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), *locked)
+}
+
+func TestMsgSendDelegateAndLockScheduleEscrow(t *testing.T) {
+	k, ms, ctx, bank := setupEscrowMsgServer(t)
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bank.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+
+	schedule := types.NewLockSchedule("2026-01-01", types.LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_WEEKLY, 3, 0)
+	res, err := ms.SendDelegateAndLockSchedule(ctx, types.NewMsgSendDelegateAndLockSchedule(alice.String(), bob.String(), "", sdk.NewInt64Coin("uatom", 301), schedule))
+	require.NoError(t, err)
+
+	escrowed := func(unlockDate string, amount int64) types.Lock {
+		return types.Lock{UnlockDate: unlockDate, Amount: math.NewInt(amount), Denom: "uatom", Kind: types.LockKind_LOCK_KIND_ESCROWED}
+	}
+	require.Equal(t, []types.Lock{escrowed("2026-01-08", 100), escrowed("2026-01-15", 100), escrowed("2026-01-22", 101)}, res.Locks)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 699)), bank.balances[alice.String()])
+
+	totalEscrowed, err := k.GetTotalEscrowed(ctx, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(301), totalEscrowed)
+
+	_, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken)
+}
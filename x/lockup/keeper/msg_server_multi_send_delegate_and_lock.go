@@ -2,26 +2,79 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/OptioNetwork/optio/x/lockup/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// MultiSendDelegateAndLock locks every output for its recipient. All outputs
+// are paid out of the sender in a single bank transfer. Outputs of the bond
+// denom are delegated by their recipient and backed by the new delegations,
+// outputs of any other allowed denom are sent straight to the lockup module
+// account and escrowed.
 func (k msgServer) MultiSendDelegateAndLock(goCtx context.Context, msg *types.MsgMultiSendDelegateAndLock) (*types.MsgMultiSendDelegateAndLockResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
 
-	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	fromAddr, err := sdk.AccAddressFromBech32(msg.FromAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
 	}
 
+	if len(msg.Outputs) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("no outputs")
+	}
+
+	if len(msg.Outputs) > int(params.MaxMultiSendOutputs) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%d outputs exceed the maximum of %d", len(msg.Outputs), params.MaxMultiSendOutputs)
+	}
+
+	if !params.IsDenomAllowed(msg.TotalAmount.Denom) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("denom %s is not allowed for locking", msg.TotalAmount.Denom)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Allowed denoms other than the bond denom cannot be delegated, so they are always escrowed
+	escrow := msg.TotalAmount.Denom != bondDenom
+
+	recipients := make([]sdk.AccAddress, len(msg.Outputs))
 	totalOutputs := math.ZeroInt()
-	for _, output := range msg.Outputs {
+	for i, output := range msg.Outputs {
+		if output.Amount.Denom != msg.TotalAmount.Denom {
+			return nil, sdkerrors.ErrInvalidCoins.Wrapf("output to %s is in %s, not %s", output.ToAddress, output.Amount.Denom, msg.TotalAmount.Denom)
+		}
+
 		if !output.Amount.IsPositive() {
 			return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid amount in output to %s: %s", output.ToAddress, output.Amount.String())
 		}
+
+		if !escrow && output.Amount.Amount.LT(params.MinLockAmount) {
+			return nil, sdkerrors.ErrInvalidCoins.Wrapf("lock amount %s in output to %s is below the minimum of %s", output.Amount.Amount, output.ToAddress, params.MinLockAmount)
+		}
+
+		if escrow && output.ValidatorAddress != "" {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s cannot be delegated, the validator address must be empty", output.Amount.Denom)
+		}
+
+		recipients[i], err = sdk.AccAddressFromBech32(output.ToAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+		}
+
+		if err := checkUnlockDate(ctx, params, output.UnlockDate); err != nil {
+			return nil, err
+		}
+
 		totalOutputs = totalOutputs.Add(output.Amount.Amount)
 	}
 
@@ -29,19 +82,155 @@ func (k msgServer) MultiSendDelegateAndLock(goCtx context.Context, msg *types.Ms
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("input %s does not match sum of outputs %s", msg.TotalAmount.String(), totalOutputs.String())
 	}
 
-	for _, output := range msg.Outputs {
-		sendMsg := &types.MsgSendDelegateAndLock{
-			FromAddress:      msg.FromAddress,
-			ToAddress:        output.ToAddress,
-			ValidatorAddress: output.ValidatorAddress,
-			UnlockDate:       output.UnlockDate,
-			Amount:           output.Amount,
+	bankOutputs, err := k.multiSendBankOutputs(ctx, recipients, msg.Outputs, escrow)
+	if err != nil {
+		return nil, err
+	}
+
+	input := banktypes.NewInput(fromAddr, sdk.NewCoins(msg.TotalAmount))
+	if err := k.bankKeeper.InputOutputCoins(ctx, input, bankOutputs); err != nil {
+		return nil, err
+	}
+
+	validators := make(map[string]stakingtypes.Validator)
+	results := make([]types.MultiSendDelegateAndLockResult, 0, len(msg.Outputs))
+	for i, output := range msg.Outputs {
+		newShares := math.LegacyZeroDec()
+		lockDenom := ""
+		if escrow {
+			lockDenom = output.Amount.Denom
+			if err := k.addEscrowLock(ctx, params, recipients[i], output.UnlockDate, output.Amount); err != nil {
+				return nil, err
+			}
+		} else {
+			validator, err := k.cachedValidator(ctx, validators, output.ValidatorAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			newShares, err = k.delegateToValidator(ctx, recipients[i], validator, output.Amount)
+			if err != nil {
+				return nil, err
+			}
+
+			// Delegate stores the validator with the delegated tokens added, the
+			// cached copy has to match it for the shares of the next delegation
+			validators[output.ValidatorAddress], _ = validator.AddTokensFromDel(output.Amount.Amount)
+
+			if err := k.addDatedLock(ctx, params, recipients[i], output.UnlockDate, output.Amount.Amount); err != nil {
+				return nil, err
+			}
 		}
-		_, err := k.SendDelegateAndLock(ctx, sendMsg)
+
+		err = k.recordLockHistory(ctx, types.LockHistoryEntry{
+			Address:    output.ToAddress,
+			Action:     types.LockHistoryAction_LOCK_HISTORY_ACTION_LOCK,
+			UnlockDate: output.UnlockDate,
+			Amount:     output.Amount.Amount,
+			Denom:      lockDenom,
+		})
 		if err != nil {
 			return nil, err
 		}
+
+		ctx.EventManager().EmitEvents([]sdk.Event{
+			sdk.NewEvent(
+				types.EventTypeLock,
+				append(
+					lockEventAttributes(output.ToAddress, output.UnlockDate, output.Amount.Amount, lockDenom),
+					sdk.NewAttribute(types.AttributeKeyAutoRenew, strconv.FormatBool(false)),
+				)...,
+			),
+		})
+
+		results = append(results, types.MultiSendDelegateAndLockResult{
+			ToAddress:  output.ToAddress,
+			UnlockDate: output.UnlockDate,
+			Amount:     output.Amount,
+			Kind:       types.LockKindOf(lockDenom),
+			NewShares:  newShares,
+		})
+	}
+
+	return &types.MsgMultiSendDelegateAndLockResponse{Results: results}, nil
+}
+
+// multiSendBankOutputs returns the bank outputs paying for the outputs of a
+// MsgMultiSendDelegateAndLock. Escrowed outputs are paid to the lockup module
+// account at once. Outputs to be delegated are paid to their recipients,
+// summed per recipient in the order the recipients first appear.
+//
+// The new delegations back the new locks, so only the existing locks of each
+// recipient have to be covered. They are checked once per recipient here,
+// before anything is sent or delegated.
+func (k Keeper) multiSendBankOutputs(ctx sdk.Context, recipients []sdk.AccAddress, outputs []*types.MultiSendDelegateAndLockOutput, escrow bool) ([]banktypes.Output, error) {
+	if escrow {
+		total := sdk.NewCoins()
+		for _, output := range outputs {
+			total = total.Add(output.Amount)
+		}
+		return []banktypes.Output{banktypes.NewOutput(k.GetEscrowAddress(), total)}, nil
+	}
+
+	bankOutputs := make([]banktypes.Output, 0, len(outputs))
+	indexes := make(map[string]int)
+	for i, output := range outputs {
+		idx, found := indexes[recipients[i].String()]
+		if found {
+			bankOutputs[idx].Coins = bankOutputs[idx].Coins.Add(output.Amount)
+			continue
+		}
+
+		if err := k.checkDelegationsCoverLock(ctx, recipients[i], math.ZeroInt()); err != nil {
+			return nil, err
+		}
+
+		indexes[recipients[i].String()] = len(bankOutputs)
+		bankOutputs = append(bankOutputs, banktypes.NewOutput(recipients[i], sdk.NewCoins(output.Amount)))
+	}
+
+	return bankOutputs, nil
+}
+
+// cachedValidator returns the validator with the given address from
+// validators, loading it from the staking keeper on the first lookup.
+func (k Keeper) cachedValidator(ctx sdk.Context, validators map[string]stakingtypes.Validator, validatorAddress string) (stakingtypes.Validator, error) {
+	if validator, found := validators[validatorAddress]; found {
+		return validator, nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return stakingtypes.Validator{}, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	validators[validatorAddress] = validator
+	return validator, nil
+}
+
+// checkUnlockDate returns an error if unlockDate is not in the future or is
+// further out than params allow.
+func checkUnlockDate(ctx sdk.Context, params types.Params, unlockDate string) error {
+	unlockTime, err := types.ParseUnlockTime(unlockDate)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", unlockDate)
+	}
+
+	blockTime := ctx.BlockTime()
+	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+
+	if !unlockTime.After(blockTime) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unlock date must be in the future")
+	}
+
+	if blockDay.AddDate(0, int(params.MaxLockMonths), 0).Before(unlockTime) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unlock date cannot be more than %d months from now", params.MaxLockMonths)
 	}
 
-	return &types.MsgMultiSendDelegateAndLockResponse{}, nil
+	return nil
 }
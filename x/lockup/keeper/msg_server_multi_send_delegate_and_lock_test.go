@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/keeper"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// countingBankKeeper is an escrow bank keeper that counts multi-output transfers
+type countingBankKeeper struct {
+	escrowBankKeeper
+	transfers *int
+}
+
+func (b countingBankKeeper) InputOutputCoins(ctx context.Context, input banktypes.Input, outputs []banktypes.Output) error {
+	*b.transfers++
+	return b.escrowBankKeeper.InputOutputCoins(ctx, input, outputs)
+}
+
+// countingStakingKeeper is a delegating staking keeper that counts validator lookups
+type countingStakingKeeper struct {
+	*delegatingStakingKeeper
+	lookups int
+}
+
+func (s *countingStakingKeeper) GetValidator(ctx context.Context, valAddr sdk.ValAddress) (stakingtypes.Validator, error) {
+	s.lookups++
+	return s.delegatingStakingKeeper.GetValidator(ctx, valAddr)
+}
+
+func setupMultiSendMsgServer(t *testing.T) (keeper.Keeper, types.MsgServer, sdk.Context, countingBankKeeper, *countingStakingKeeper) {
+	bank := countingBankKeeper{escrowBankKeeper{balanceBankKeeper{balances: map[string]sdk.Coins{}}}, new(int)}
+	staking := &countingStakingKeeper{delegatingStakingKeeper: &delegatingStakingKeeper{slashableStakingKeeper: newSlashableStakingKeeper(), delegated: map[string]math.Int{}}}
+	k, ctx := keepertest.LockupKeeperWithKeepers(t, bank, staking)
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	params := types.DefaultParams()
+	params.AllowedDenoms = []string{"uOPT", "uatom"}
+	params.MaxMultiSendOutputs = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	return k, keeper.NewMsgServerImpl(k), ctx, bank, staking
+}
+
+func TestMsgMultiSendDelegateAndLock(t *testing.T) {
+	k, ms, ctx, bank, staking := setupMultiSendMsgServer(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	carol := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	otherValAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	bank.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin("uOPT", 3000))
+
+	outputs := []*types.MultiSendDelegateAndLockOutput{
+		{ToAddress: bob.String(), ValidatorAddress: valAddr, UnlockDate: "2026-12-01", Amount: sdk.NewInt64Coin("uOPT", 1000)},
+		{ToAddress: carol.String(), ValidatorAddress: valAddr, UnlockDate: "2026-06-01", Amount: sdk.NewInt64Coin("uOPT", 500)},
+		{ToAddress: bob.String(), ValidatorAddress: otherValAddr, UnlockDate: "2026-12-01", Amount: sdk.NewInt64Coin("uOPT", 500)},
+	}
+
+	_, err := ms.MultiSendDelegateAndLock(ctx, types.NewMsgMultiSendDelegateAndLock(alice.String(), sdk.NewInt64Coin("uOPT", 1500), outputs))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = ms.MultiSendDelegateAndLock(ctx, types.NewMsgMultiSendDelegateAndLock(alice.String(), sdk.NewInt64Coin("uOPT", 2500), append(outputs, outputs[0])))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = ms.MultiSendDelegateAndLock(ctx, types.NewMsgMultiSendDelegateAndLock(alice.String(), sdk.NewInt64Coin("uOPT", 1000), []*types.MultiSendDelegateAndLockOutput{
+		{ToAddress: bob.String(), ValidatorAddress: valAddr, UnlockDate: "2026-12-01", Amount: sdk.NewInt64Coin("uatom", 1000)},
+	}))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+	require.Zero(t, *bank.transfers)
+
+	// all outputs are paid in one transfer and each validator is looked up once
+	res, err := ms.MultiSendDelegateAndLock(ctx, types.NewMsgMultiSendDelegateAndLock(alice.String(), sdk.NewInt64Coin("uOPT", 2000), outputs))
+	require.NoError(t, err)
+	require.Equal(t, 1, *bank.transfers)
+	require.Equal(t, 2, staking.lookups)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 1000)), bank.balances[alice.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 1500)), bank.balances[bob.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 500)), bank.balances[carol.String()])
+	require.Equal(t, math.NewInt(1500), staking.delegated[valAddr])
+	require.Equal(t, math.NewInt(500), staking.delegated[otherValAddr])
+
+	require.Len(t, res.Results, 3)
+	for i, result := range res.Results {
+		require.Equal(t, outputs[i].ToAddress, result.ToAddress)
+		require.Equal(t, outputs[i].UnlockDate, result.UnlockDate)
+		require.Equal(t, outputs[i].Amount, result.Amount)
+		require.Equal(t, types.LockKind_LOCK_KIND_DELEGATED, result.Kind)
+		require.Equal(t, math.LegacyNewDecFromInt(outputs[i].Amount.Amount), result.NewShares)
+	}
+
+	lock, _, found := k.GetLockByAddressAndDate(ctx, bob, "2026-12-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(1500), lock.Amount)
+
+	lock, _, found = k.GetLockByAddressAndDate(ctx, carol, "2026-06-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(500), lock.Amount)
+
+	// existing locks that are no longer covered are rejected before sending
+	staking.slash(math.LegacyNewDecWithPrec(5, 1))
+	_, err = ms.MultiSendDelegateAndLock(ctx, types.NewMsgMultiSendDelegateAndLock(alice.String(), sdk.NewInt64Coin("uOPT", 1000), outputs[:1]))
+	require.ErrorIs(t, err, types.ErrInsufficientDelegations)
+	require.Equal(t, 1, *bank.transfers)
+}
+
+func TestMsgMultiSendDelegateAndLockEscrow(t *testing.T) {
+	k, ms, ctx, bank, staking := setupMultiSendMsgServer(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	carol := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+	bank.balances[alice.String()] = sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+
+	valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	_, err := ms.MultiSendDelegateAndLock(ctx, types.NewMsgMultiSendDelegateAndLock(alice.String(), sdk.NewInt64Coin("uatom", 400), []*types.MultiSendDelegateAndLockOutput{
+		{ToAddress: bob.String(), ValidatorAddress: valAddr, UnlockDate: "2026-06-01", Amount: sdk.NewInt64Coin("uatom", 400)},
+	}))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// escrowed outputs go straight to the module account and need no delegations
+	res, err := ms.MultiSendDelegateAndLock(ctx, types.NewMsgMultiSendDelegateAndLock(alice.String(), sdk.NewInt64Coin("uatom", 700), []*types.MultiSendDelegateAndLockOutput{
+		{ToAddress: bob.String(), UnlockDate: "2026-06-01", Amount: sdk.NewInt64Coin("uatom", 400)},
+		{ToAddress: carol.String(), UnlockDate: "2026-09-01", Amount: sdk.NewInt64Coin("uatom", 300)},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, 1, *bank.transfers)
+	require.Zero(t, staking.lookups)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 300)), bank.balances[alice.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 700)), bank.balances[escrow.String()])
+	require.True(t, bank.balances[bob.String()].IsZero())
+
+	require.Equal(t, []types.MultiSendDelegateAndLockResult{
+		{ToAddress: bob.String(), UnlockDate: "2026-06-01", Amount: sdk.NewInt64Coin("uatom", 400), Kind: types.LockKind_LOCK_KIND_ESCROWED, NewShares: math.LegacyZeroDec()},
+		{ToAddress: carol.String(), UnlockDate: "2026-09-01", Amount: sdk.NewInt64Coin("uatom", 300), Kind: types.LockKind_LOCK_KIND_ESCROWED, NewShares: math.LegacyZeroDec()},
+	}, res.Results)
+
+	locks, err := k.GetLocksByAddress(ctx, carol)
+	require.NoError(t, err)
+	require.Equal(t, []*types.Lock{{UnlockDate: "2026-09-01", Amount: math.NewInt(300), Denom: "uatom", Kind: types.LockKind_LOCK_KIND_ESCROWED}}, locks)
+
+	_, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken)
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) PruneExpiredLocks(goCtx context.Context, msg *types.MsgPruneExpiredLocks) (*types.MsgPruneExpiredLocksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if len(msg.Addresses) > types.MaxPruneAddresses {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("too many addresses: %d > %d", len(msg.Addresses), types.MaxPruneAddresses)
+	}
+
+	for _, address := range msg.Addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+		}
+
+		if err := k.RemoveExpiredLocksByAddress(ctx, addr); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgPruneExpiredLocksResponse{}, nil
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func TestMsgPruneExpiredLocks(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	carol := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	addLock(t, k, ctx, alice, "2026-01-01", 100)
+	addLock(t, k, ctx, alice, "2026-03-01", 200)
+	addLock(t, k, ctx, bob, "2026-01-15", 300)
+	addLock(t, k, ctx, carol, "2026-01-01", 400)
+
+	ctx = ctx.WithBlockTime(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)).WithEventManager(sdk.NewEventManager())
+
+	// any account can prune the expired locks of others
+	_, err := ms.PruneExpiredLocks(ctx, types.NewMsgPruneExpiredLocks(sender.String(), []string{alice.String(), bob.String()}))
+	require.NoError(t, err)
+
+	aliceLocks, err := k.GetLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Len(t, aliceLocks, 1)
+	require.Equal(t, "2026-03-01", aliceLocks[0].UnlockDate)
+
+	bobLocks, err := k.GetLocksByAddress(ctx, bob)
+	require.NoError(t, err)
+	require.Empty(t, bobLocks)
+
+	// carol was not listed, so her expired lock is left for the EndBlocker
+	carolLocks, err := k.GetLocksByAddress(ctx, carol)
+	require.NoError(t, err)
+	require.Len(t, carolLocks, 1)
+
+	totalLocked, err := k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(600), totalLocked)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	for _, event := range events {
+		require.Equal(t, types.EventTypeLockExpired, event.Type)
+	}
+
+	// pruning again is a no-op
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = ms.PruneExpiredLocks(ctx, types.NewMsgPruneExpiredLocks(sender.String(), []string{alice.String(), bob.String()}))
+	require.NoError(t, err)
+	require.Empty(t, ctx.EventManager().Events())
+
+	_, err = ms.PruneExpiredLocks(ctx, types.NewMsgPruneExpiredLocks(sender.String(), []string{"invalid"}))
+	require.Error(t, err)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	"github.com/OptioNetwork/optio/x/lockup/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -27,33 +28,69 @@ func (k msgServer) SendDelegateAndLock(goCtx context.Context, msg *types.MsgSend
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
 	}
 
+	if err := k.sendAndDelegate(ctx, fromAddr, toAddr, msg.ValidatorAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	lock := &types.MsgLock{
+		Address:    msg.ToAddress,
+		UnlockDate: msg.UnlockDate,
+		Amount:     msg.Amount,
+	}
+	if _, err := k.Lock(goCtx, lock); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendDelegateAndLockResponse{}, nil
+}
+
+// sendAndDelegate sends amount from fromAddr to toAddr and delegates it from
+// toAddr to the validator. Allowed denoms other than the bond denom cannot be
+// delegated, so they are only sent and the validator address must be empty.
+func (k Keeper) sendAndDelegate(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, validatorAddress string, amount sdk.Coin) error {
 	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if msg.Amount.Denom != bondDenom {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %s, expected: %s", msg.Amount.Denom, bondDenom)
+	if amount.Denom != bondDenom {
+		if validatorAddress != "" {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s cannot be delegated, the validator address must be empty", amount.Denom)
+		}
+
+		return k.bankKeeper.SendCoins(ctx, fromAddr, toAddr, sdk.NewCoins(amount))
 	}
 
-	err = k.bankKeeper.SendCoins(ctx, fromAddr, toAddr, sdk.NewCoins(msg.Amount))
+	err = k.bankKeeper.SendCoins(ctx, fromAddr, toAddr, sdk.NewCoins(amount))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return k.delegate(ctx, toAddr, validatorAddress, amount)
+}
+
+// delegate delegates amount from the liquid balance of delegator to the validator.
+func (k Keeper) delegate(ctx sdk.Context, delegator sdk.AccAddress, validatorAddress string, amount sdk.Coin) error {
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
 	if err != nil {
-		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
 	}
 
 	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	newShares, err := k.stakingKeeper.Delegate(ctx, toAddr, msg.Amount.Amount, stakingtypes.Unbonded, validator, true)
+	_, err = k.delegateToValidator(ctx, delegator, validator, amount)
+	return err
+}
+
+// delegateToValidator delegates amount from the liquid balance of delegator to
+// validator and returns the new shares.
+func (k Keeper) delegateToValidator(ctx sdk.Context, delegator sdk.AccAddress, validator stakingtypes.Validator, amount sdk.Coin) (math.LegacyDec, error) {
+	newShares, err := k.stakingKeeper.Delegate(ctx, delegator, amount.Amount, stakingtypes.Unbonded, validator, true)
 	if err != nil {
-		return nil, err
+		return math.LegacyDec{}, err
 	}
 
 	// The stakingKepper.Delegate call above does not emit events.
@@ -62,25 +99,12 @@ func (k msgServer) SendDelegateAndLock(goCtx context.Context, msg *types.MsgSend
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			stakingtypes.EventTypeDelegate,
-			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, msg.ValidatorAddress),
-			sdk.NewAttribute(stakingtypes.AttributeKeyDelegator, msg.ToAddress),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, validator.GetOperator()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
 			sdk.NewAttribute(stakingtypes.AttributeKeyNewShares, newShares.String()),
 		),
 	})
 
-	_, err = k.Lock(
-		goCtx,
-		&types.MsgLock{
-			Address:    msg.ToAddress,
-			UnlockDate: msg.UnlockDate,
-			Amount:     msg.Amount,
-		},
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &types.MsgSendDelegateAndLockResponse{}, nil
+	return newShares, nil
 }
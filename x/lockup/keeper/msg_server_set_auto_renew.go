@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) SetAutoRenew(goCtx context.Context, msg *types.MsgSetAutoRenew) (*types.MsgSetAutoRenewResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid lockup address: %s", err)
+	}
+
+	unlockDate, err := types.ParseUnlockTime(msg.UnlockDate)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", msg.UnlockDate)
+	}
+
+	blockTime := ctx.BlockTime()
+	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+
+	if !types.IsLocked(blockTime, msg.UnlockDate) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unlock date must be in the future")
+	}
+
+	durationDays := types.DurationDays(blockDay, unlockDate)
+
+	var amount math.Int
+	if msg.AutoRenew {
+		// the dated lock becomes a rolling lock that stays at its current distance from the block day
+		if err := k.checkNotTokenized(ctx, address, msg.UnlockDate); err != nil {
+			return nil, err
+		}
+
+		existingLock, idx, found := k.GetLockByAddressAndDate(ctx, address, msg.UnlockDate)
+		if !found {
+			return nil, types.ErrLockupNotFound.Wrapf("no lockup found for unlock date (%s)", msg.UnlockDate)
+		}
+		amount = existingLock.Amount
+
+		if err := k.DeleteLockByAddressAndIndex(ctx, address, idx); err != nil {
+			return nil, err
+		}
+
+		if err := k.RemoveFromExpirationQueue(ctx, unlockDate, address, amount); err != nil {
+			return nil, err
+		}
+
+		if err := k.AddRollingLock(ctx, address, durationDays, amount); err != nil {
+			return nil, err
+		}
+	} else {
+		// the rolling lock stops renewing and counts down from today's unlock date
+		amount, err = k.GetRollingLock(ctx, address, durationDays)
+		if err != nil {
+			return nil, err
+		}
+
+		if amount.IsZero() {
+			return nil, types.ErrLockupNotFound.Wrapf("no auto-renewing lockup found for unlock date (%s)", msg.UnlockDate)
+		}
+
+		if err := k.RemoveRollingLock(ctx, address, durationDays, amount); err != nil {
+			return nil, err
+		}
+
+		// the rolling lock has already been removed, so replacing it stays within the lock limit
+		if err := k.addDatedLock(ctx, k.GetParams(ctx), address, msg.UnlockDate, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeAutoRenew,
+			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, msg.UnlockDate),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyAutoRenew, strconv.FormatBool(msg.AutoRenew)),
+		),
+	})
+
+	return &types.MsgSetAutoRenewResponse{}, nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/keeper"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func TestMsgSetAutoRenew(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockTime(time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC))
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	addLock(t, k, ctx, alice, "2027-01-01", 100)
+
+	_, err := ms.SetAutoRenew(ctx, types.NewMsgSetAutoRenew(alice.String(), "2026-06-01", true))
+	require.ErrorIs(t, err, types.ErrLockupNotFound)
+
+	_, err = ms.SetAutoRenew(ctx, types.NewMsgSetAutoRenew(alice.String(), "2027-01-01", true))
+	require.NoError(t, err)
+
+	locks, err := k.GetLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Empty(t, locks)
+
+	amount, err := k.GetRollingLock(ctx, alice, 365)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), amount)
+
+	// a month later the lock still unlocks a year from the block day
+	ctx = ctx.WithBlockTime(time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC))
+	_, err = ms.SetAutoRenew(ctx, types.NewMsgSetAutoRenew(alice.String(), "2027-01-01", false))
+	require.ErrorIs(t, err, types.ErrLockupNotFound)
+
+	_, err = ms.SetAutoRenew(ctx, types.NewMsgSetAutoRenew(alice.String(), "2027-02-01", false))
+	require.NoError(t, err)
+
+	locks, err = k.GetLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, []*types.Lock{{UnlockDate: "2027-02-01", Amount: math.NewInt(100)}}, locks)
+
+	rollingLocks, err := k.GetRollingLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Empty(t, rollingLocks)
+
+	total, err := k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), total)
+
+	for _, invariant := range []func(keeper.Keeper) sdk.Invariant{keeper.LockIndexesInvariant, keeper.TotalLockedInvariant} {
+		_, broken := invariant(k)(ctx)
+		require.False(t, broken)
+	}
+}
+
+func TestMsgSetAutoRenewOffWithinLockLimit(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockTime(time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC))
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	params := types.DefaultParams()
+	params.MaxLocksPerAddress = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the dated lock replaces the rolling lock, so it fits in the limit of one lock
+	require.NoError(t, k.AddRollingLock(ctx, alice, 365, math.NewInt(100)))
+	_, err := ms.SetAutoRenew(ctx, types.NewMsgSetAutoRenew(alice.String(), "2027-01-01", false))
+	require.NoError(t, err)
+
+	locks, err := k.GetLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, []*types.Lock{{UnlockDate: "2027-01-01", Amount: math.NewInt(100)}}, locks)
+}
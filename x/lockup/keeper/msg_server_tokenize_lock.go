@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) TokenizeLock(goCtx context.Context, msg *types.MsgTokenizeLock) (*types.MsgTokenizeLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid lockup address: %s", err)
+	}
+
+	if _, err := types.ParseUnlockTime(msg.UnlockDate); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", msg.UnlockDate)
+	}
+
+	blockTime := ctx.BlockTime()
+
+	if !types.IsLocked(blockTime, msg.UnlockDate) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("lock for %s has already expired", msg.UnlockDate)
+	}
+
+	nftID, err := k.MintLockNFT(ctx, address, msg.UnlockDate)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeTokenizeLock,
+			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, msg.UnlockDate),
+			sdk.NewAttribute(types.AttributeKeyNFTID, nftID),
+		),
+	})
+
+	return &types.MsgTokenizeLockResponse{ClassId: types.LockNFTClassID, NftId: nftID}, nil
+}
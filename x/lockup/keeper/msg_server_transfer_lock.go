@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (k msgServer) TransferLock(goCtx context.Context, msg *types.MsgTransferLock) (*types.MsgTransferLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fromAddr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	toAddr, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	if fromAddr.Equals(toAddr) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot transfer a lock to the same address")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %s, expected: %s", msg.Amount.Denom, bondDenom)
+	}
+
+	if !msg.Amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid transfer amount: %s", msg.Amount.String())
+	}
+
+	unlockDate, err := types.ParseUnlockTime(msg.UnlockDate)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", msg.UnlockDate)
+	}
+
+	blockTime := ctx.BlockTime()
+
+	if !types.IsLocked(blockTime, msg.UnlockDate) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("lock for %s has already expired", msg.UnlockDate)
+	}
+
+	if err := k.checkNotTokenized(ctx, fromAddr, msg.UnlockDate); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkNotTokenized(ctx, toAddr, msg.UnlockDate); err != nil {
+		return nil, err
+	}
+
+	fromLock, fromIdx, found := k.GetLockByAddressAndDate(ctx, fromAddr, msg.UnlockDate)
+	if !found {
+		return nil, types.ErrLockupNotFound.Wrapf("no lockup found for unlock date (%s)", msg.UnlockDate)
+	}
+
+	amount := msg.Amount.Amount
+	if fromLock.Amount.LT(amount) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("transfer amount exceeds existing lock amount date (%s)", msg.UnlockDate)
+	}
+
+	if err := k.transferDelegation(ctx, fromAddr, toAddr, valAddr, amount); err != nil {
+		return nil, err
+	}
+
+	if fromLock.Amount.Equal(amount) {
+		err = k.DeleteLockByAddressAndIndex(ctx, fromAddr, fromIdx)
+	} else {
+		err = k.UpdateLockByAddressAndIndex(ctx, fromAddr, fromIdx, &types.Lock{
+			UnlockDate: fromLock.UnlockDate,
+			Amount:     fromLock.Amount.Sub(amount),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	toLock, toIdx, found := k.GetLockByAddressAndDate(ctx, toAddr, msg.UnlockDate)
+	if found {
+		err = k.UpdateLockByAddressAndIndex(ctx, toAddr, toIdx, &types.Lock{
+			UnlockDate: toLock.UnlockDate,
+			Amount:     toLock.Amount.Add(amount),
+		})
+	} else {
+		if err = k.checkLockLimit(ctx, k.GetParams(ctx), toAddr); err != nil {
+			return nil, err
+		}
+
+		err = k.SetLockByAddress(ctx, toAddr, &types.Lock{UnlockDate: msg.UnlockDate, Amount: amount})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RemoveFromExpirationQueue(ctx, unlockDate, fromAddr, amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.AddToExpirationQueue(ctx, unlockDate, toAddr, amount); err != nil {
+		return nil, err
+	}
+
+	lockedAmount, err := k.GetLockedAmountByAddress(ctx, toAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatedAmount, err := k.GetTotalDelegatedAmount(ctx, toAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if delegatedAmount.LT(*lockedAmount) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInsufficientDelegations,
+			"recipient delegations do not cover its locks after the transfer: %s < %s",
+			delegatedAmount.String(),
+			lockedAmount.String(),
+		)
+	}
+
+	if err := k.recordLockTransfer(ctx, fromAddr, toAddr, msg.UnlockDate, amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeLockTransfer,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.ToAddress),
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, msg.UnlockDate),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	})
+
+	return &types.MsgTransferLockResponse{}, nil
+}
+
+// transferDelegation moves the delegation shares worth amount tokens on
+// valAddr from fromAddr to toAddr. The tokens stay bonded to the validator,
+// so no funds move between the staking pools.
+func (k Keeper) transferDelegation(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) error {
+	shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, fromAddr, valAddr, amount)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	tokenSrc := validator.GetStatus()
+
+	returnAmount, err := k.stakingKeeper.Unbond(ctx, fromAddr, valAddr, shares)
+	if err != nil {
+		return err
+	}
+
+	if !returnAmount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("transfer amount %s is too small to move any delegation", amount)
+	}
+
+	// Unbond changes the validator's tokens and shares, so reload it before
+	// delegating the returned tokens.
+	validator, err = k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	_, err = k.stakingKeeper.Delegate(ctx, toAddr, returnAmount, tokenSrc, validator, false)
+	return err
+}
+
+// recordLockTransfer records a lock transfer in the lock history of both addresses
+func (k Keeper) recordLockTransfer(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, unlockDate string, amount math.Int) error {
+	err := k.recordLockHistory(ctx, types.LockHistoryEntry{
+		Address:      fromAddr.String(),
+		Action:       types.LockHistoryAction_LOCK_HISTORY_ACTION_TRANSFER_OUT,
+		UnlockDate:   unlockDate,
+		Amount:       amount,
+		Counterparty: toAddr.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.recordLockHistory(ctx, types.LockHistoryEntry{
+		Address:      toAddr.String(),
+		Action:       types.LockHistoryAction_LOCK_HISTORY_ACTION_TRANSFER_IN,
+		UnlockDate:   unlockDate,
+		Amount:       amount,
+		Counterparty: fromAddr.String(),
+	})
+}
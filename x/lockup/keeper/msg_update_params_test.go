@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	params := types.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	updated := types.NewParams(12, math.NewInt(1000), 10, []string{"uOPT"}, math.LegacyNewDecWithPrec(5, 1), types.PenaltyDestination_PENALTY_DESTINATION_MODULE_ACCOUNT, nil, 0, 0, 500)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgUpdateParams{
+				Authority: "invalid",
+				Params:    params,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "invalid params",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    types.NewParams(0, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), types.PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 0, 0, 0),
+			},
+			expErr:    true,
+			expErrMsg: "max lock months must be positive",
+		},
+		{
+			name: "duplicate denom",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    types.NewParams(24, math.ZeroInt(), 0, []string{"uOPT", "uOPT"}, math.LegacyZeroDec(), types.PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 0, 0, 0),
+			},
+			expErr:    true,
+			expErrMsg: "duplicate allowed denom",
+		},
+		{
+			name: "all good",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    updated,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.UpdateParams(wctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	require.Equal(t, updated, k.GetParams(ctx))
+}
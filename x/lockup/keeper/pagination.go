@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// keyRange is the store key range [start, end) of one part of a paginated query
+type keyRange struct {
+	start []byte
+	end   []byte
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return bytes.Compare(key, r.start) >= 0 && (r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// pageRequestDefaults returns the page request fields, defaulting the limit and
+// counting the total when no limit is set, as query.Paginate does
+func pageRequestDefaults(pageReq *query.PageRequest) (key []byte, offset, limit uint64, countTotal, reverse bool, err error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, 0, 0, false, false, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+
+	limit = pageReq.Limit
+	countTotal = pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	// A total is only known when paging from the start of the results
+	if len(pageReq.Key) != 0 {
+		countTotal = false
+	}
+
+	return pageReq.Key, pageReq.Offset, limit, countTotal, pageReq.Reverse, nil
+}
+
+// paginateRanges pages through the store entries in ranges, in order, with the
+// semantics of query.FilteredPaginate. onResult reports whether an entry
+// matches and appends it to the results if accumulate is set. Page keys are
+// store keys, so a page resumes at the first entry not yet returned.
+func paginateRanges(
+	store corestore.KVStore,
+	ranges []keyRange,
+	pageReq *query.PageRequest,
+	onResult func(key, value []byte, accumulate bool) (bool, error),
+) (*query.PageResponse, error) {
+	key, offset, limit, countTotal, reverse, err := pageRequestDefaults(pageReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if reverse {
+		reversed := make([]keyRange, len(ranges))
+		for i, r := range ranges {
+			reversed[len(ranges)-1-i] = r
+		}
+		ranges = reversed
+	}
+
+	first := 0
+	if len(key) != 0 {
+		first = -1
+		for i, r := range ranges {
+			if r.contains(key) {
+				first = i
+				break
+			}
+		}
+		if first < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+	}
+
+	var (
+		numHits uint64
+		nextKey []byte
+		end     = offset + limit
+	)
+
+	for i := first; i < len(ranges); i++ {
+		start, stop := ranges[i].start, ranges[i].end
+		if len(key) != 0 && i == first {
+			if reverse {
+				// Include the page key itself
+				stop = append(append([]byte{}, key...), 0x00)
+			} else {
+				start = key
+			}
+		}
+
+		var iterator corestore.Iterator
+		if reverse {
+			iterator, err = store.ReverseIterator(start, stop)
+		} else {
+			iterator, err = store.Iterator(start, stop)
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		done := false
+		for ; iterator.Valid(); iterator.Next() {
+			if len(key) != 0 {
+				if numHits == limit {
+					nextKey = iterator.Key()
+					done = true
+					break
+				}
+
+				hit, err := onResult(iterator.Key(), iterator.Value(), true)
+				if err != nil {
+					iterator.Close()
+					return nil, err
+				}
+				if hit {
+					numHits++
+				}
+				continue
+			}
+
+			hit, err := onResult(iterator.Key(), iterator.Value(), numHits >= offset && numHits < end)
+			if err != nil {
+				iterator.Close()
+				return nil, err
+			}
+			if !hit {
+				continue
+			}
+
+			numHits++
+			if numHits == end+1 {
+				if nextKey == nil {
+					nextKey = iterator.Key()
+				}
+				if !countTotal {
+					done = true
+					break
+				}
+			}
+		}
+		iterator.Close()
+
+		if done {
+			break
+		}
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		res.Total = numHits
+	}
+
+	return res, nil
+}
+
+// paginateSlice returns the bounds [start, end) of the page of a result slice
+// of the given length, which the caller has already put in the requested
+// order. Page keys are big endian slice indexes.
+func paginateSlice(length int, pageReq *query.PageRequest) (int, int, *query.PageResponse, error) {
+	key, offset, limit, countTotal, _, err := pageRequestDefaults(pageReq)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	start := offset
+	if len(key) != 0 {
+		if len(key) != 8 {
+			return 0, 0, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		start = binary.BigEndian.Uint64(key)
+	}
+
+	if start > uint64(length) {
+		start = uint64(length)
+	}
+
+	end := uint64(length)
+	if limit < end-start {
+		end = start + limit
+	}
+
+	res := &query.PageResponse{}
+	if end < uint64(length) {
+		res.NextKey = binary.BigEndian.AppendUint64(nil, end)
+	}
+	if countTotal {
+		res.Total = uint64(length)
+	}
+
+	return int(start), int(end), res, nil
+}
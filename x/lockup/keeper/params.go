@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// GetParams get all parameters as types.Params
+func (k Keeper) GetParams(ctx context.Context) (params types.Params) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams set the params
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// checkLockLimit returns an error if addr already holds the maximum number of
+// locks allowed by params.
+func (k Keeper) checkLockLimit(ctx sdk.Context, params types.Params, addr sdk.AccAddress) error {
+	if params.MaxLocksPerAddress == 0 {
+		return nil
+	}
+
+	locks, err := k.GetLocksByAddress(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	rollingLocks, err := k.GetRollingLocksByAddress(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	if len(locks)+len(rollingLocks) >= int(params.MaxLocksPerAddress) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("address %s already holds the maximum of %d locks", addr, params.MaxLocksPerAddress)
+	}
+
+	return nil
+}
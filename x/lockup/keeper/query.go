@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
-	"strings"
+	"slices"
+	"sort"
 	"time"
 
 	"cosmossdk.io/math"
@@ -22,88 +24,191 @@ func (k Keeper) ActiveLocks(goCtx context.Context, req *types.QueryActiveLocksRe
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	var addr sdk.AccAddress
+	if req.Address != "" {
+		var err error
+		addr, err = sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid address: "+req.Address)
+		}
+	}
+
+	filter, err := parseLockFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	store := k.storeService.OpenKVStore(ctx)
 
-	var locks []types.ActiveLockResource
-
-	var startKey []byte
-	if req.Pagination != nil && len(req.Pagination.Key) != 0 {
-		startKey = req.Pagination.Key
-	} else {
-		startKey = types.LocksByDateKey
-	}
+	blockTime := ctx.BlockTime()
+	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
 
-	iterator, err := store.Iterator(startKey, prefixEndBytes(types.LocksByDateKey))
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	defer iterator.Close()
 
-	limit := uint64(100)
-	if req.Pagination != nil && req.Pagination.Limit != 0 {
-		limit = req.Pagination.Limit
+	// The locks of a single address are read from its own index rather than
+	// scanning the locks of every address
+	if addr != nil {
+		return k.activeLocksOfAddress(ctx, addr, filter, bondDenom, req.Pagination)
 	}
 
-	count := uint64(0)
-	var nextKey []byte
+	// Dated locks from the expiration queue come first, followed by escrowed
+	// locks from their own queue and rolling locks. Only the locks unlocking
+	// after the block time are still locked.
+	var ranges []keyRange
 
-	for ; iterator.Valid(); iterator.Next() {
-		if count >= limit {
-			nextKey = iterator.Key()
-			break
+	datedStart := blockTime.Add(time.Second)
+	if filter.startTime.After(datedStart) {
+		datedStart = filter.startTime
+	}
+	var datedEnd time.Time
+	if !filter.endTime.IsZero() {
+		datedEnd = filter.endTime.AddDate(0, 0, 1)
+	}
+	for _, prefix := range [][]byte{types.LocksByDateKey, types.EscrowLocksByDateKey} {
+		datedRange := queueRange(prefix, datedStart, datedEnd)
+		if bytes.Compare(datedRange.start, datedRange.end) < 0 {
+			ranges = append(ranges, datedRange)
 		}
+	}
 
-		key := iterator.Key()
-		value := iterator.Value()
+	ranges = append(ranges, keyRange{start: types.RollingLocksKey, end: prefixEndBytes(types.RollingLocksKey)})
 
-		// Decode
-		// Key: Prefix + Timestamp (8) + Address
-		prefixLen := len(types.LocksByDateKey)
-		if len(key) < prefixLen+8 {
-			continue
+	var locks []types.ActiveLockResource
+	pageRes, err := paginateRanges(store, ranges, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var amount math.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return false, status.Error(codes.Internal, "failed to unmarshal amount")
 		}
 
-		timeBz := key[prefixLen : prefixLen+8]
-		addrBz := key[prefixLen+8:]
+		var lock types.ActiveLockResource
+		if bytes.HasPrefix(key, types.EscrowLocksByDateKey) {
+			unlockTime, lockAddr, denom, err := parseEscrowLockExpirationKey(key)
+			if err != nil {
+				return false, status.Error(codes.Internal, err.Error())
+			}
 
-		unlockUnix := binary.BigEndian.Uint64(timeBz)
-		unlockTime := time.Unix(int64(unlockUnix), 0)
-		unlockDate := unlockTime.UTC().Format(time.DateOnly)
+			lock = types.ActiveLockResource{
+				Address:    lockAddr.String(),
+				UnlockDate: types.FormatUnlockTime(unlockTime),
+				Amount:     sdk.NewCoin(denom, amount),
+				Kind:       types.LockKind_LOCK_KIND_ESCROWED,
+			}
+		} else if bytes.HasPrefix(key, types.LocksByDateKey) {
+			// Key: Prefix + Timestamp (8) + Address
+			prefixLen := len(types.LocksByDateKey)
+			if len(key) < prefixLen+8 {
+				return false, nil
+			}
+
+			lockAddr := sdk.AccAddress(key[prefixLen+8:])
+			unlockTime := time.Unix(int64(binary.BigEndian.Uint64(key[prefixLen:prefixLen+8])), 0)
+			lock = types.ActiveLockResource{
+				Address:    lockAddr.String(),
+				UnlockDate: types.FormatUnlockTime(unlockTime),
+				Amount:     sdk.NewCoin(bondDenom, amount),
+			}
+		} else {
+			lockAddr, durationDays := parseRollingLockKey(key)
+			lock = types.ActiveLockResource{
+				Address:    lockAddr.String(),
+				UnlockDate: types.RollingUnlockDate(blockDay, durationDays).Format(time.DateOnly),
+				Amount:     sdk.NewCoin(bondDenom, amount),
+				AutoRenew:  true,
+			}
+		}
 
-		blockTime := ctx.BlockTime()
-		blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+		if !filter.matches(lock.UnlockDate, amount) {
+			return false, nil
+		}
 
-		if !types.IsLocked(blockDay, unlockDate) {
-			continue
+		if accumulate {
+			locks = append(locks, lock)
 		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryActiveLocksResponse{
+		Locks:      locks,
+		Pagination: pageRes,
+	}, nil
+}
 
-		addr := sdk.AccAddress(addrBz)
+// activeLocksOfAddress pages through the active locks of addr in the order of
+// ActiveLocks: delegated dated locks, then escrowed locks by unlock date,
+// followed by rolling locks by duration. Page keys are indexes into the
+// filtered locks of the address.
+func (k Keeper) activeLocksOfAddress(ctx sdk.Context, addr sdk.AccAddress, filter lockFilter, bondDenom string, pageReq *query.PageRequest) (*types.QueryActiveLocksResponse, error) {
+	blockTime := ctx.BlockTime()
+	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
 
-		var amount math.Int
-		if err := amount.Unmarshal(value); err != nil {
-			return nil, status.Error(codes.Internal, "failed to unmarshal amount")
+	datedLocks, err := k.GetLocksByAddress(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rollingLocks, err := k.GetRollingLocksByAddress(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	locks := make([]types.ActiveLockResource, 0, len(datedLocks)+len(rollingLocks))
+	var escrowedLocks []types.ActiveLockResource
+	for _, lock := range datedLocks {
+		if !types.IsLocked(blockTime, lock.UnlockDate) || !filter.matches(lock.UnlockDate, lock.Amount) {
+			continue
 		}
 
-		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+		if lock.IsEscrowed() {
+			escrowedLocks = append(escrowedLocks, types.ActiveLockResource{
+				Address:    addr.String(),
+				UnlockDate: lock.UnlockDate,
+				Amount:     sdk.NewCoin(lock.Denom, lock.Amount),
+				Kind:       types.LockKind_LOCK_KIND_ESCROWED,
+			})
+			continue
+		}
+
+		locks = append(locks, types.ActiveLockResource{
+			Address:    addr.String(),
+			UnlockDate: lock.UnlockDate,
+			Amount:     sdk.NewCoin(bondDenom, lock.Amount),
+		})
+	}
+	locks = append(locks, escrowedLocks...)
+
+	for _, lock := range rollingLocks {
+		unlockDate := types.RollingUnlockDate(blockDay, lock.DurationDays).Format(time.DateOnly)
+		if !filter.matches(unlockDate, lock.Amount) {
+			continue
 		}
 
 		locks = append(locks, types.ActiveLockResource{
 			Address:    addr.String(),
 			UnlockDate: unlockDate,
-			Amount:     sdk.NewCoin(bondDenom, amount),
+			Amount:     sdk.NewCoin(bondDenom, lock.Amount),
+			AutoRenew:  true,
 		})
+	}
+
+	if pageReq != nil && pageReq.Reverse {
+		slices.Reverse(locks)
+	}
 
-		count++
+	start, end, pageRes, err := paginateSlice(len(locks), pageReq)
+	if err != nil {
+		return nil, err
 	}
 
 	return &types.QueryActiveLocksResponse{
-		Locks: locks,
-		Pagination: &query.PageResponse{
-			NextKey: nextKey,
-		},
+		Locks:      locks[start:end],
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -114,16 +219,7 @@ func (k Keeper) TotalLockedAmount(goCtx context.Context, req *types.QueryTotalLo
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	totalLocked := math.ZeroInt()
-
-	blockTime := ctx.BlockTime()
-	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
-
-	err := k.IterateActiveLocks(ctx, blockDay, func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
-		totalLocked = totalLocked.Add(amount)
-		return nil
-	})
-
+	totalLocked, err := k.GetTotalLocked(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -133,8 +229,14 @@ func (k Keeper) TotalLockedAmount(goCtx context.Context, req *types.QueryTotalLo
 		return nil, sdkerrors.ErrInvalidType.Wrapf("invalid bond denom: %s", err)
 	}
 
+	totalEscrowed, err := k.GetAllTotalEscrowed(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &types.QueryTotalLockedAmountResponse{
-		TotalLocked: sdk.NewCoin(bondDenom, totalLocked),
+		TotalLocked:   sdk.NewCoin(bondDenom, totalLocked),
+		TotalEscrowed: totalEscrowed,
 	}, nil
 }
 
@@ -143,17 +245,22 @@ func (k Keeper) AccountLocks(goCtx context.Context, req *types.QueryAccountLocks
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	if req.Addresses == "" {
-		return nil, status.Error(codes.InvalidArgument, "addresses parameter is required")
+	if len(req.Addresses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one address is required")
 	}
 
-	addressList := strings.Split(req.Addresses, ",")
-	for i, addr := range addressList {
-		addressList[i] = strings.TrimSpace(addr)
+	addresses := make([]sdk.AccAddress, len(req.Addresses))
+	for i, addrStr := range req.Addresses {
+		addr, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid address: "+addrStr)
+		}
+		addresses[i] = addr
 	}
 
-	if len(addressList) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "at least one address is required")
+	filter, err := parseLockFilter(req.Filter)
+	if err != nil {
+		return nil, err
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -164,68 +271,32 @@ func (k Keeper) AccountLocks(goCtx context.Context, req *types.QueryAccountLocks
 	}
 
 	blockTime := ctx.BlockTime()
-	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
 
-	// Apply pagination
-	limit := uint64(100)
-	offset := uint64(0)
-
-	if req.Pagination != nil {
-		if req.Pagination.Limit != 0 {
-			limit = req.Pagination.Limit
-		}
-		if req.Pagination.Offset != 0 {
-			offset = req.Pagination.Offset
-		}
+	if req.Pagination != nil && req.Pagination.Reverse {
+		slices.Reverse(addresses)
 	}
 
-	startIdx := offset
-	endIdx := offset + limit
-	if endIdx > uint64(len(addressList)) {
-		endIdx = uint64(len(addressList))
+	start, end, pageRes, err := paginateSlice(len(addresses), req.Pagination)
+	if err != nil {
+		return nil, err
 	}
 
-	paginatedAddresses := addressList[startIdx:endIdx]
-	accountLocks := make([]types.AccountLocksResource, 0, len(paginatedAddresses))
-
-	for _, addrStr := range paginatedAddresses {
-		addr, err := sdk.AccAddressFromBech32(addrStr)
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "invalid address: "+addrStr)
-		}
-
-		locks, err := k.GetLocksByAddress(ctx, addr)
+	accountLocks := make([]types.AccountLocksResource, 0, end-start)
+	for _, addr := range addresses[start:end] {
+		activeLockResources, err := k.activeLockResources(ctx, addr, blockTime, bondDenom)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
-		activeLockResources := make([]types.LockResource, 0)
-		for _, lock := range locks {
-			if types.IsLocked(blockDay, lock.UnlockDate) {
-				activeLockResources = append(activeLockResources, types.LockResource{
-					UnlockDate: lock.UnlockDate,
-					Amount:     sdk.NewCoin(bondDenom, lock.Amount),
-				})
-			}
-		}
-
 		accountLocks = append(accountLocks, types.AccountLocksResource{
-			Address: addrStr,
-			Locks:   activeLockResources,
+			Address: addr.String(),
+			Locks:   filter.filter(activeLockResources),
 		})
 	}
 
-	var nextKey []byte
-	if endIdx < uint64(len(addressList)) {
-		nextKey = []byte{1} // Indicate more results available
-	}
-
 	return &types.QueryAccountLocksResponse{
-		Accounts: accountLocks,
-		Pagination: &query.PageResponse{
-			NextKey: nextKey,
-			Total:   uint64(len(addressList)),
-		},
+		Accounts:   accountLocks,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -246,62 +317,149 @@ func (k Keeper) Locks(goCtx context.Context, req *types.QueryLocksRequest) (*typ
 		return nil, status.Error(codes.InvalidArgument, "invalid address: "+req.Address)
 	}
 
-	locks, err := k.GetLocksByAddress(ctx, addr)
+	filter, err := parseLockFilter(req.Filter)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	blockTime := ctx.BlockTime()
-	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
 
 	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	activeLockResources := make([]types.LockResource, 0)
-	for _, lock := range locks {
-		if types.IsLocked(blockDay, lock.UnlockDate) {
-			activeLockResources = append(activeLockResources, types.LockResource{
-				UnlockDate: lock.UnlockDate,
-				Amount:     sdk.NewCoin(bondDenom, lock.Amount),
-			})
+	activeLockResources, err := k.activeLockResources(ctx, addr, blockTime, bondDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	locks := filter.filter(activeLockResources)
+	if req.Pagination != nil && req.Pagination.Reverse {
+		slices.Reverse(locks)
+	}
+
+	start, end, pageRes, err := paginateSlice(len(locks), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLocksResponse{
+		Locks:      locks[start:end],
+		Pagination: pageRes,
+	}, nil
+}
+
+// lockFilter is a parsed types.LockFilter
+type lockFilter struct {
+	startTime time.Time
+	endTime   time.Time
+	minAmount *math.Int
+}
+
+func parseLockFilter(filter types.LockFilter) (lockFilter, error) {
+	var (
+		parsed lockFilter
+		err    error
+	)
+
+	if filter.StartDate != "" {
+		parsed.startTime, err = time.Parse(time.DateOnly, filter.StartDate)
+		if err != nil {
+			return parsed, status.Error(codes.InvalidArgument, "invalid start date: "+filter.StartDate)
+		}
+	}
+
+	if filter.EndDate != "" {
+		parsed.endTime, err = time.Parse(time.DateOnly, filter.EndDate)
+		if err != nil {
+			return parsed, status.Error(codes.InvalidArgument, "invalid end date: "+filter.EndDate)
 		}
 	}
 
-	// Apply pagination
-	limit := uint64(100)
-	offset := uint64(0)
+	if !parsed.startTime.IsZero() && !parsed.endTime.IsZero() && parsed.endTime.Before(parsed.startTime) {
+		return parsed, status.Error(codes.InvalidArgument, "end date must not be before start date")
+	}
 
-	if req.Pagination != nil {
-		if req.Pagination.Limit != 0 {
-			limit = req.Pagination.Limit
+	if filter.MinAmount != nil && !filter.MinAmount.IsNil() {
+		if filter.MinAmount.IsNegative() {
+			return parsed, status.Error(codes.InvalidArgument, "min amount must not be negative")
 		}
-		if req.Pagination.Offset != 0 {
-			offset = req.Pagination.Offset
+		parsed.minAmount = filter.MinAmount
+	}
+
+	return parsed, nil
+}
+
+// matches reports whether a lock unlocking on unlockDate with amount passes the filter
+func (f lockFilter) matches(unlockDate string, amount math.Int) bool {
+	// Canonical unlock dates sort lexically, with a date before the timestamps on that day
+	if !f.startTime.IsZero() && unlockDate < f.startTime.Format(time.DateOnly) {
+		return false
+	}
+
+	if !f.endTime.IsZero() && unlockDate >= f.endTime.AddDate(0, 0, 1).Format(time.DateOnly) {
+		return false
+	}
+
+	return f.minAmount == nil || amount.GTE(*f.minAmount)
+}
+
+// filter returns the locks that pass the filter
+func (f lockFilter) filter(locks []types.LockResource) []types.LockResource {
+	filtered := make([]types.LockResource, 0, len(locks))
+	for _, lock := range locks {
+		if f.matches(lock.UnlockDate, lock.Amount.Amount) {
+			filtered = append(filtered, lock)
 		}
 	}
+	return filtered
+}
 
-	startIdx := offset
-	endIdx := offset + limit
-	if endIdx > uint64(len(activeLockResources)) {
-		endIdx = uint64(len(activeLockResources))
+// activeLockResources returns the active dated and rolling locks of addr, ordered by unlock date.
+// Rolling locks are reported with their effective unlock date on the day of blockTime.
+func (k Keeper) activeLockResources(ctx sdk.Context, addr sdk.AccAddress, blockTime time.Time, bondDenom string) ([]types.LockResource, error) {
+	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+
+	locks, err := k.GetLocksByAddress(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	rollingLocks, err := k.GetRollingLocksByAddress(ctx, addr)
+	if err != nil {
+		return nil, err
 	}
 
-	paginatedLocks := activeLockResources[startIdx:endIdx]
+	resources := make([]types.LockResource, 0, len(locks)+len(rollingLocks))
+	for _, lock := range locks {
+		if types.IsLocked(blockTime, lock.UnlockDate) {
+			denom := bondDenom
+			if lock.IsEscrowed() {
+				denom = lock.Denom
+			}
 
-	var nextKey []byte
-	if endIdx < uint64(len(activeLockResources)) {
-		nextKey = []byte{1} // Indicate more results available
+			resources = append(resources, types.LockResource{
+				UnlockDate: lock.UnlockDate,
+				Amount:     sdk.NewCoin(denom, lock.Amount),
+				Kind:       lock.Kind,
+			})
+		}
 	}
 
-	return &types.QueryLocksResponse{
-		Locks: paginatedLocks,
-		Pagination: &query.PageResponse{
-			NextKey: nextKey,
-			Total:   uint64(len(activeLockResources)),
-		},
-	}, nil
+	for _, lock := range rollingLocks {
+		resources = append(resources, types.LockResource{
+			UnlockDate: types.RollingUnlockDate(blockDay, lock.DurationDays).Format(time.DateOnly),
+			Amount:     sdk.NewCoin(bondDenom, lock.Amount),
+			AutoRenew:  true,
+		})
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		return resources[i].UnlockDate < resources[j].UnlockDate
+	})
+
+	return resources, nil
 }
 
 func prefixEndBytes(prefix []byte) []byte {
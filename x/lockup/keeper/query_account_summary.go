@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// AccountSummary returns the lock totals of an address and how much of its
+// balance it can send. The sendable amount is the largest send after which
+// SendRestrictionFn still finds the locked amount in the balance.
+func (k Keeper) AccountSummary(goCtx context.Context, req *types.QueryAccountSummaryRequest) (*types.QueryAccountSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	locked, err := k.GetLockedAmountByAddress(ctx, address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	delegated, err := k.GetTotalDelegatedAmount(ctx, address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	lockedAboveDelegated, err := k.GetLockedAboveDelegated(ctx, address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Any send of at most availableToSend leaves lockedAboveDelegated in the
+	// post-send balance that SendRestrictionFn checks
+	balance := k.bankKeeper.GetBalance(ctx, address, bondDenom).Amount
+	spendable := math.MinInt(
+		k.bankKeeper.SpendableCoin(ctx, address, bondDenom).Amount,
+		availableToSend(balance, lockedAboveDelegated),
+	)
+
+	locks, err := k.GetLocksByAddress(ctx, address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rollingLocks, err := k.GetRollingLocksByAddress(ctx, address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	blockTime := ctx.BlockTime()
+
+	lockCount := uint32(len(rollingLocks))
+	nextUnlockDate := ""
+	nextUnlockAmount := math.ZeroInt()
+	var totalEscrowed sdk.Coins
+	for _, lock := range locks {
+		if !types.IsLocked(blockTime, lock.UnlockDate) {
+			continue
+		}
+
+		if lock.IsEscrowed() {
+			totalEscrowed = totalEscrowed.Add(sdk.NewCoin(lock.Denom, lock.Amount))
+			continue
+		}
+		lockCount++
+
+		if nextUnlockDate == "" || lock.UnlockDate < nextUnlockDate {
+			nextUnlockDate = lock.UnlockDate
+			nextUnlockAmount = lock.Amount
+		}
+	}
+
+	return &types.QueryAccountSummaryResponse{
+		TotalLocked:          sdk.NewCoin(bondDenom, *locked),
+		TotalDelegated:       sdk.NewCoin(bondDenom, *delegated),
+		LockedAboveDelegated: sdk.NewCoin(bondDenom, lockedAboveDelegated),
+		Balance:              sdk.NewCoin(bondDenom, balance),
+		SpendableUnlocked:    sdk.NewCoin(bondDenom, spendable),
+		NextUnlockDate:       nextUnlockDate,
+		NextUnlockAmount:     sdk.NewCoin(bondDenom, nextUnlockAmount),
+		LockCount:            lockCount,
+		TotalEscrowed:        totalEscrowed,
+	}, nil
+}
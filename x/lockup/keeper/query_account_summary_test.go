@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func TestAccountSummaryQuery(t *testing.T) {
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	bank := balanceBankKeeper{balances: map[string]sdk.Coins{
+		alice.String(): sdk.NewCoins(sdk.NewInt64Coin("uOPT", 500)),
+	}}
+	staking := newSlashableStakingKeeper()
+	k, ctx := keepertest.LockupKeeperWithKeepers(t, bank, staking)
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	staking.delegate(alice, 700)
+	addLock(t, k, ctx, alice, "2026-12-01", 600)
+	addLock(t, k, ctx, alice, "2026-06-01", 200)
+	addLock(t, k, ctx, alice, "2025-12-01", 50) // expired, not yet removed
+	require.NoError(t, k.AddRollingLock(ctx, alice, 30, math.NewInt(200)))
+
+	response, err := k.AccountSummary(ctx, &types.QueryAccountSummaryRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryAccountSummaryResponse{
+		TotalLocked:          sdk.NewInt64Coin("uOPT", 1000),
+		TotalDelegated:       sdk.NewInt64Coin("uOPT", 700),
+		LockedAboveDelegated: sdk.NewInt64Coin("uOPT", 300),
+		Balance:              sdk.NewInt64Coin("uOPT", 500),
+		SpendableUnlocked:    sdk.NewInt64Coin("uOPT", 200),
+		NextUnlockDate:       "2026-06-01",
+		NextUnlockAmount:     sdk.NewInt64Coin("uOPT", 200),
+		LockCount:            3,
+	}, response)
+
+	_, err = k.AccountSummary(ctx, &types.QueryAccountSummaryRequest{Address: "invalid"})
+	require.Error(t, err)
+}
+
+func TestAccountSummaryQueryMatchesSends(t *testing.T) {
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	staking := newSlashableStakingKeeper()
+	k, bank, ctx := keepertest.LockupKeeperWithBankKeeper(t, staking)
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	fundAccount(t, bank, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 500)))
+
+	staking.delegate(alice, 700)
+	addLock(t, k, ctx, alice, "2026-12-01", 1000)
+
+	response, err := k.AccountSummary(ctx, &types.QueryAccountSummaryRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uOPT", 200), response.SpendableUnlocked)
+
+	// exactly the reported amount goes through the bank keeper, one more does not
+	cacheCtx, _ := ctx.CacheContext()
+	require.Error(t, bank.SendCoins(cacheCtx, alice, bob, sdk.NewCoins(response.SpendableUnlocked.AddAmount(math.OneInt()))))
+
+	require.NoError(t, bank.SendCoins(ctx, alice, bob, sdk.NewCoins(response.SpendableUnlocked)))
+
+	response, err = k.AccountSummary(ctx, &types.QueryAccountSummaryRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.True(t, response.SpendableUnlocked.IsZero())
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func (k Keeper) LockHistory(goCtx context.Context, req *types.QueryLockHistoryRequest) (*types.QueryLockHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), k.GetLockHistoryPrefix(address))
+
+	var entries []types.LockHistoryEntry
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var entry types.LockHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLockHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
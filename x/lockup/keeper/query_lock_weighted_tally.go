@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func (k Keeper) LockWeightedTally(goCtx context.Context, req *types.QueryLockWeightedTallyRequest) (*types.QueryLockWeightedTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposalRes, err := k.govKeeper.Proposal(ctx, &govv1.QueryProposalRequest{ProposalId: req.ProposalId})
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := proposalRes.Proposal
+	if proposal.Status != govv1.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	paramsRes, err := k.govKeeper.Params(ctx, &govv1.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var votes []*govv1.Vote
+	pageReq := &query.PageRequest{}
+	for {
+		votesRes, err := k.govKeeper.Votes(ctx, &govv1.QueryVotesRequest{ProposalId: req.ProposalId, Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+		votes = append(votes, votesRes.Votes...)
+
+		if votesRes.Pagination == nil || len(votesRes.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: votesRes.Pagination.NextKey}
+	}
+
+	passes, tally, err := k.ComputeLockWeightedTally(ctx, *proposal, votes, *paramsRes.Params)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLockWeightedTallyResponse{Tally: tally, Passes: passes}, nil
+}
@@ -0,0 +1,20 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func TestParamsQuery(t *testing.T) {
+	keeper, ctx := keepertest.LockupKeeper(t)
+	params := types.DefaultParams()
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	response, err := keeper.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryParamsResponse{Params: params}, response)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func (k Keeper) RewardsPool(goCtx context.Context, req *types.QueryRewardsPoolRequest) (*types.QueryRewardsPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	unallocated, err := k.GetUnallocatedRewards(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardsPoolResponse{
+		Balance:     k.bankKeeper.GetAllBalances(ctx, k.GetRewardsPoolAddress()),
+		Unallocated: unallocated,
+	}, nil
+}
+
+func (k Keeper) PendingRewards(goCtx context.Context, req *types.QueryPendingRewardsRequest) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	rewards, err := k.GetAccruedRewards(ctx, address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingRewardsResponse{Rewards: rewards}, nil
+}
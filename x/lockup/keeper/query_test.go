@@ -0,0 +1,249 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/keeper"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func setupLockQueries(t *testing.T) (keeper.Keeper, sdk.Context, sdk.AccAddress, sdk.AccAddress) {
+	t.Helper()
+
+	k, ctx := keepertest.LockupKeeperWithKeepers(t, balanceBankKeeper{}, newSlashableStakingKeeper())
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	addLock(t, k, ctx, alice, "2025-12-01", 10) // expired, not yet removed
+	addLock(t, k, ctx, alice, "2026-02-01", 100)
+	addLock(t, k, ctx, bob, "2026-03-01", 200)
+	addLock(t, k, ctx, alice, "2026-04-01", 300)
+	require.NoError(t, k.AddRollingLock(ctx, bob, 30, math.NewInt(400)))
+
+	return k, ctx, alice, bob
+}
+
+func addEscrowedLock(t *testing.T, k keeper.Keeper, ctx sdk.Context, addr sdk.AccAddress, unlockDate string, amount sdk.Coin) {
+	t.Helper()
+
+	unlockTime, err := types.ParseUnlockTime(unlockDate)
+	require.NoError(t, err)
+	require.NoError(t, k.SetLockByAddress(ctx, addr, &types.Lock{UnlockDate: unlockDate, Amount: amount.Amount, Denom: amount.Denom, Kind: types.LockKind_LOCK_KIND_ESCROWED}))
+	require.NoError(t, k.AddToEscrowQueue(ctx, unlockTime, addr, amount))
+}
+
+func TestActiveLocksQueryPagination(t *testing.T) {
+	k, ctx, alice, bob := setupLockQueries(t)
+
+	lock := func(addr sdk.AccAddress, date string, amount int64, autoRenew bool) types.ActiveLockResource {
+		return types.ActiveLockResource{Address: addr.String(), UnlockDate: date, Amount: sdk.NewInt64Coin("uOPT", amount), AutoRenew: autoRenew}
+	}
+	all := []types.ActiveLockResource{
+		lock(alice, "2026-02-01", 100, false),
+		lock(bob, "2026-03-01", 200, false),
+		lock(alice, "2026-04-01", 300, false),
+		lock(bob, "2026-01-31", 400, true),
+	}
+
+	res, err := k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{})
+	require.NoError(t, err)
+	require.Equal(t, all, res.Locks)
+	require.Equal(t, uint64(4), res.Pagination.Total)
+	require.Empty(t, res.Pagination.NextKey)
+
+	// next keys resume where the previous page ended, across both stores
+	var paged []types.ActiveLockResource
+	var key []byte
+	for {
+		res, err := k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Pagination: &query.PageRequest{Key: key, Limit: 3}})
+		require.NoError(t, err)
+		paged = append(paged, res.Locks...)
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		key = res.Pagination.NextKey
+	}
+	require.Equal(t, all, paged)
+
+	res, err = k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, all[1:3], res.Locks)
+	require.Equal(t, uint64(4), res.Pagination.Total)
+	require.NotEmpty(t, res.Pagination.NextKey)
+
+	res, err = k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Pagination: &query.PageRequest{Limit: 2, Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, []types.ActiveLockResource{all[3], all[2]}, res.Locks)
+
+	res, err = k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2, Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, []types.ActiveLockResource{all[1], all[0]}, res.Locks)
+	require.Empty(t, res.Pagination.NextKey)
+
+	_, err = k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Pagination: &query.PageRequest{Key: []byte("invalid"), Offset: 1}})
+	require.Error(t, err)
+}
+
+func TestActiveLocksQueryFilters(t *testing.T) {
+	k, ctx, alice, bob := setupLockQueries(t)
+
+	res, err := k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Locks, 2)
+	require.Equal(t, "2026-02-01", res.Locks[0].UnlockDate)
+	require.Equal(t, "2026-04-01", res.Locks[1].UnlockDate)
+
+	res, err = k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Address: bob.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Locks, 2)
+	require.True(t, res.Locks[1].AutoRenew)
+
+	res, err = k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Filter: types.LockFilter{StartDate: "2026-01-15", EndDate: "2026-03-01"}})
+	require.NoError(t, err)
+	require.Len(t, res.Locks, 3)
+	require.Equal(t, "2026-02-01", res.Locks[0].UnlockDate)
+	require.Equal(t, "2026-03-01", res.Locks[1].UnlockDate)
+	require.Equal(t, "2026-01-31", res.Locks[2].UnlockDate)
+	require.True(t, res.Locks[2].AutoRenew)
+
+	minAmount := math.NewInt(250)
+	res, err = k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Filter: types.LockFilter{MinAmount: &minAmount}})
+	require.NoError(t, err)
+	require.Len(t, res.Locks, 2)
+	require.Equal(t, math.NewInt(300), res.Locks[0].Amount.Amount)
+	require.Equal(t, math.NewInt(400), res.Locks[1].Amount.Amount)
+
+	_, err = k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Filter: types.LockFilter{StartDate: "2026-03-01", EndDate: "2026-02-01"}})
+	require.Error(t, err)
+
+	_, err = k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Address: "invalid"})
+	require.Error(t, err)
+}
+
+func TestActiveLocksQueryAddressPagination(t *testing.T) {
+	k, ctx, _, bob := setupLockQueries(t)
+
+	// bob's dated lock comes before his rolling lock, one page each
+	res, err := k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Address: bob.String(), Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []types.ActiveLockResource{{Address: bob.String(), UnlockDate: "2026-03-01", Amount: sdk.NewInt64Coin("uOPT", 200)}}, res.Locks)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+
+	res, err = k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Address: bob.String(), Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []types.ActiveLockResource{{Address: bob.String(), UnlockDate: "2026-01-31", Amount: sdk.NewInt64Coin("uOPT", 400), AutoRenew: true}}, res.Locks)
+	require.Empty(t, res.Pagination.NextKey)
+
+	res, err = k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Address: bob.String(), Pagination: &query.PageRequest{Limit: 1, Reverse: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Locks, 1)
+	require.True(t, res.Locks[0].AutoRenew)
+}
+
+func TestLocksQueryPagination(t *testing.T) {
+	k, ctx, alice, _ := setupLockQueries(t)
+
+	res, err := k.Locks(ctx, &types.QueryLocksRequest{Address: alice.String(), Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Locks, 1)
+	require.Equal(t, "2026-02-01", res.Locks[0].UnlockDate)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+
+	res, err = k.Locks(ctx, &types.QueryLocksRequest{Address: alice.String(), Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, res.Locks, 1)
+	require.Equal(t, "2026-04-01", res.Locks[0].UnlockDate)
+	require.Empty(t, res.Pagination.NextKey)
+
+	res, err = k.Locks(ctx, &types.QueryLocksRequest{Address: alice.String(), Filter: types.LockFilter{StartDate: "2026-03-01"}})
+	require.NoError(t, err)
+	require.Len(t, res.Locks, 1)
+	require.Equal(t, "2026-04-01", res.Locks[0].UnlockDate)
+}
+
+func TestAccountLocksQueryPagination(t *testing.T) {
+	k, ctx, alice, bob := setupLockQueries(t)
+
+	res, err := k.AccountLocks(ctx, &types.QueryAccountLocksRequest{
+		Addresses:  []string{alice.String(), bob.String()},
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, alice.String(), res.Accounts[0].Address)
+	require.Len(t, res.Accounts[0].Locks, 2)
+
+	res, err = k.AccountLocks(ctx, &types.QueryAccountLocksRequest{
+		Addresses:  []string{alice.String(), bob.String()},
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+		Filter:     types.LockFilter{EndDate: "2026-02-28"},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, bob.String(), res.Accounts[0].Address)
+	require.Len(t, res.Accounts[0].Locks, 1)
+	require.True(t, res.Accounts[0].Locks[0].AutoRenew)
+	require.Empty(t, res.Pagination.NextKey)
+
+	_, err = k.AccountLocks(ctx, &types.QueryAccountLocksRequest{})
+	require.Error(t, err)
+
+	_, err = k.AccountLocks(ctx, &types.QueryAccountLocksRequest{Addresses: []string{alice.String(), "invalid"}})
+	require.Error(t, err)
+}
+
+func TestActiveLocksQueryEscrowedLocks(t *testing.T) {
+	k, ctx, alice, bob := setupLockQueries(t)
+	addEscrowedLock(t, k, ctx, alice, "2026-03-01", sdk.NewInt64Coin("uatom", 50))
+	addEscrowedLock(t, k, ctx, bob, "2026-02-15", sdk.NewInt64Coin("uOPT", 70))
+
+	escrowed := types.LockKind_LOCK_KIND_ESCROWED
+	all := []types.ActiveLockResource{
+		{Address: alice.String(), UnlockDate: "2026-02-01", Amount: sdk.NewInt64Coin("uOPT", 100)},
+		{Address: bob.String(), UnlockDate: "2026-03-01", Amount: sdk.NewInt64Coin("uOPT", 200)},
+		{Address: alice.String(), UnlockDate: "2026-04-01", Amount: sdk.NewInt64Coin("uOPT", 300)},
+		{Address: bob.String(), UnlockDate: "2026-02-15", Amount: sdk.NewInt64Coin("uOPT", 70), Kind: escrowed},
+		{Address: alice.String(), UnlockDate: "2026-03-01", Amount: sdk.NewInt64Coin("uatom", 50), Kind: escrowed},
+		{Address: bob.String(), UnlockDate: "2026-01-31", Amount: sdk.NewInt64Coin("uOPT", 400), AutoRenew: true},
+	}
+
+	// escrowed locks come between dated and rolling locks, also across pages
+	var paged []types.ActiveLockResource
+	var key []byte
+	for {
+		res, err := k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Pagination: &query.PageRequest{Key: key, Limit: 4}})
+		require.NoError(t, err)
+		paged = append(paged, res.Locks...)
+		if key = res.Pagination.NextKey; key == nil {
+			break
+		}
+	}
+	require.Equal(t, all, paged)
+
+	res, err := k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Filter: types.LockFilter{StartDate: "2026-02-10", EndDate: "2026-03-01"}})
+	require.NoError(t, err)
+	require.Equal(t, []types.ActiveLockResource{all[1], all[3], all[4]}, res.Locks)
+
+	// the locks of a single address are in the same order
+	res, err = k.ActiveLocks(ctx, &types.QueryActiveLocksRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.ActiveLockResource{all[0], all[2], all[4]}, res.Locks)
+
+	locks, err := k.Locks(ctx, &types.QueryLocksRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.LockResource{
+		{UnlockDate: "2026-02-01", Amount: sdk.NewInt64Coin("uOPT", 100)},
+		{UnlockDate: "2026-03-01", Amount: sdk.NewInt64Coin("uatom", 50), Kind: escrowed},
+		{UnlockDate: "2026-04-01", Amount: sdk.NewInt64Coin("uOPT", 300)},
+	}, locks.Locks)
+}
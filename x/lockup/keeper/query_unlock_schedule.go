@@ -0,0 +1,330 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func (k Keeper) UnlockSchedule(goCtx context.Context, req *types.QueryUnlockScheduleRequest) (*types.QueryUnlockScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, ok := types.UnlockScheduleBucket_name[int32(req.Bucket)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bucket: %d", req.Bucket)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := k.storeService.OpenKVStore(ctx)
+
+	blockTime := ctx.BlockTime()
+	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+
+	// Locks unlocking at or before the block time are no longer locked
+	startTime := blockTime.Add(time.Second)
+	startDate := blockDay
+	if req.StartDate != "" {
+		var err error
+		startDate, err = time.Parse(time.DateOnly, req.StartDate)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid start date: "+req.StartDate)
+		}
+		startTime = startDate
+	}
+
+	// endTime is exclusive, the zero time leaves the schedule unbounded
+	var endTime time.Time
+	if req.EndDate != "" {
+		endDate, err := time.Parse(time.DateOnly, req.EndDate)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid end date: "+req.EndDate)
+		}
+
+		if endDate.Before(startDate) {
+			return nil, status.Error(codes.InvalidArgument, "end date must not be before start date")
+		}
+
+		endTime = endDate.AddDate(0, 0, 1)
+	}
+
+	pageKey, offset, limit, countTotal, reverse, err := pageRequestDefaults(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	// Page keys are expiration queue keys of the first unlock time of a bucket.
+	// They resume both queues at that time, as all locks unlocking at the same
+	// time fall into the same bucket.
+	if len(pageKey) != 0 {
+		prefixLen := len(types.LocksByDateKey)
+		if len(pageKey) < prefixLen+8 || !queueRange(types.LocksByDateKey, startTime, endTime).contains(pageKey) {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+
+		pageTime := time.Unix(int64(binary.BigEndian.Uint64(pageKey[prefixLen:prefixLen+8])), 0)
+		if reverse {
+			// Include the page key itself
+			endTime = pageTime.Add(time.Second)
+		} else {
+			startTime = pageTime
+		}
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	iterator, err := newUnlockQueueIterator(store, startTime, endTime, reverse)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iterator.Close()
+
+	var (
+		entries    []types.UnlockScheduleEntry
+		nextKey    []byte
+		numBuckets uint64
+		bucketDate time.Time
+		amount     math.Int
+		escrowed   sdk.Coins
+		addresses  map[string]struct{}
+		end        = offset + limit
+	)
+
+	// Buckets in [offset, end) are returned. Pages always end on a bucket
+	// boundary, so no bucket is split across pages.
+	inPage := func() bool {
+		return numBuckets > offset && numBuckets <= end
+	}
+	appendEntry := func() {
+		if inPage() {
+			entries = append(entries, types.UnlockScheduleEntry{
+				Date:         bucketDate.Format(time.DateOnly),
+				Amount:       sdk.NewCoin(bondDenom, amount),
+				AddressCount: uint32(len(addresses)),
+				Escrowed:     escrowed,
+			})
+		}
+	}
+
+	for iterator.Valid() {
+		lock, err := iterator.Next()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		date := unlockScheduleBucketStart(lock.unlockTime, req.Bucket)
+
+		if numBuckets == 0 || !date.Equal(bucketDate) {
+			if numBuckets > 0 {
+				appendEntry()
+			}
+
+			numBuckets++
+			bucketDate = date
+			amount = math.ZeroInt()
+			escrowed = nil
+			addresses = make(map[string]struct{})
+
+			if numBuckets == end+1 {
+				nextKey = expirationQueueDayKey(lock.unlockTime)
+				if !countTotal {
+					break
+				}
+			}
+		}
+
+		if !inPage() {
+			continue
+		}
+
+		if lock.denom != "" {
+			escrowed = escrowed.Add(sdk.NewCoin(lock.denom, lock.amount))
+		} else {
+			amount = amount.Add(lock.amount)
+		}
+		addresses[string(lock.addr)] = struct{}{}
+	}
+
+	if numBuckets > 0 {
+		appendEntry()
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = numBuckets
+	}
+
+	return &types.QueryUnlockScheduleResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// expirationQueueDayKey returns the first expiration queue key of the given unlock date.
+func expirationQueueDayKey(date time.Time) []byte {
+	return queueDayKey(types.LocksByDateKey, date)
+}
+
+// queueDayKey returns the first key of the given unlock date in the expiration
+// queue or the escrowed lock expiration queue with the given prefix.
+func queueDayKey(prefix []byte, date time.Time) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, prefix...), uint64(date.Unix()))
+}
+
+// queueRange returns the key range of the queue with the given prefix that
+// unlocks in [startTime, endTime), unbounded if endTime is zero.
+func queueRange(prefix []byte, startTime, endTime time.Time) keyRange {
+	r := keyRange{start: queueDayKey(prefix, startTime), end: prefixEndBytes(prefix)}
+	if !endTime.IsZero() {
+		r.end = queueDayKey(prefix, endTime)
+	}
+	return r
+}
+
+// unlockQueueLock is an entry of the expiration queue or the escrowed lock
+// expiration queue. The denom is empty for the bond denom locks of the
+// expiration queue.
+type unlockQueueLock struct {
+	unlockTime time.Time
+	addr       sdk.AccAddress
+	amount     math.Int
+	denom      string
+}
+
+// unlockQueueIterator iterates over the expiration queue and the escrowed lock
+// expiration queue together, in unlock time order. A queue with an empty range
+// has no iterator.
+type unlockQueueIterator struct {
+	delegated corestore.Iterator
+	escrowed  corestore.Iterator
+	reverse   bool
+}
+
+func newUnlockQueueIterator(store corestore.KVStore, startTime, endTime time.Time, reverse bool) (*unlockQueueIterator, error) {
+	open := func(prefix []byte) (corestore.Iterator, error) {
+		r := queueRange(prefix, startTime, endTime)
+		if bytes.Compare(r.start, r.end) >= 0 {
+			return nil, nil
+		}
+		if reverse {
+			return store.ReverseIterator(r.start, r.end)
+		}
+		return store.Iterator(r.start, r.end)
+	}
+
+	delegated, err := open(types.LocksByDateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	it := &unlockQueueIterator{delegated: delegated, reverse: reverse}
+	it.escrowed, err = open(types.EscrowLocksByDateKey)
+	if err != nil {
+		it.Close()
+		return nil, err
+	}
+
+	return it, nil
+}
+
+func (it *unlockQueueIterator) Valid() bool {
+	return iteratorValid(it.delegated) || iteratorValid(it.escrowed)
+}
+
+// Next returns the lock unlocking first, or last in reverse, of either queue
+// and advances past it.
+func (it *unlockQueueIterator) Next() (unlockQueueLock, error) {
+	useEscrowed := !iteratorValid(it.delegated)
+	if iteratorValid(it.delegated) && iteratorValid(it.escrowed) {
+		delegatedTime := queueKeyTime(it.delegated.Key(), types.LocksByDateKey)
+		escrowedTime := queueKeyTime(it.escrowed.Key(), types.EscrowLocksByDateKey)
+		if it.reverse {
+			useEscrowed = escrowedTime > delegatedTime
+		} else {
+			useEscrowed = escrowedTime < delegatedTime
+		}
+	}
+
+	var lock unlockQueueLock
+	var amount math.Int
+	if useEscrowed {
+		unlockTime, addr, denom, err := parseEscrowLockExpirationKey(it.escrowed.Key())
+		if err != nil {
+			return lock, err
+		}
+		if err := amount.Unmarshal(it.escrowed.Value()); err != nil {
+			return lock, err
+		}
+
+		lock = unlockQueueLock{unlockTime: unlockTime.UTC(), addr: addr, amount: amount, denom: denom}
+		it.escrowed.Next()
+		return lock, nil
+	}
+
+	// Key: Prefix + Timestamp (8) + Address
+	key := it.delegated.Key()
+	prefixLen := len(types.LocksByDateKey)
+	if len(key) < prefixLen+8 {
+		return lock, fmt.Errorf("invalid expiration queue key: %X", key)
+	}
+	if err := amount.Unmarshal(it.delegated.Value()); err != nil {
+		return lock, err
+	}
+
+	lock = unlockQueueLock{
+		unlockTime: time.Unix(int64(binary.BigEndian.Uint64(key[prefixLen:prefixLen+8])), 0).UTC(),
+		addr:       sdk.AccAddress(key[prefixLen+8:]),
+		amount:     amount,
+	}
+	it.delegated.Next()
+	return lock, nil
+}
+
+func (it *unlockQueueIterator) Close() {
+	for _, iterator := range []corestore.Iterator{it.delegated, it.escrowed} {
+		if iterator != nil {
+			iterator.Close()
+		}
+	}
+}
+
+func iteratorValid(iterator corestore.Iterator) bool {
+	return iterator != nil && iterator.Valid()
+}
+
+// queueKeyTime returns the unlock timestamp of a key of the queue with the given prefix
+func queueKeyTime(key, prefix []byte) int64 {
+	if len(key) < len(prefix)+8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(key[len(prefix) : len(prefix)+8]))
+}
+
+// unlockScheduleBucketStart returns the first day of the bucket containing date.
+func unlockScheduleBucketStart(date time.Time, bucket types.UnlockScheduleBucket) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch bucket {
+	case types.UnlockScheduleBucket_UNLOCK_SCHEDULE_BUCKET_WEEK:
+		// time.Weekday starts on Sunday; weeks start on Monday
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case types.UnlockScheduleBucket_UNLOCK_SCHEDULE_BUCKET_MONTH:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func TestUnlockScheduleQuery(t *testing.T) {
+	k, ctx := keepertest.LockupKeeperWithKeepers(t, balanceBankKeeper{}, newSlashableStakingKeeper())
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	addLock(t, k, ctx, alice, "2026-01-01", 10) // unlocks today, excluded by default
+	addLock(t, k, ctx, alice, "2026-02-02", 100)
+	addLock(t, k, ctx, bob, "2026-02-02", 200)
+	addLock(t, k, ctx, alice, "2026-02-05", 300)
+	addLock(t, k, ctx, bob, "2026-03-10", 400)
+
+	entry := func(date string, amount int64, addresses uint32) types.UnlockScheduleEntry {
+		return types.UnlockScheduleEntry{Date: date, Amount: sdk.NewInt64Coin("uOPT", amount), AddressCount: addresses}
+	}
+
+	res, err := k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleEntry{
+		entry("2026-02-02", 300, 2),
+		entry("2026-02-05", 300, 1),
+		entry("2026-03-10", 400, 1),
+	}, res.Entries)
+	require.Empty(t, res.Pagination.NextKey)
+
+	res, err = k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{StartDate: "2026-02-03", EndDate: "2026-02-28"})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleEntry{entry("2026-02-05", 300, 1)}, res.Entries)
+
+	// 2026-02-02 is a Monday
+	res, err = k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{Bucket: types.UnlockScheduleBucket_UNLOCK_SCHEDULE_BUCKET_WEEK})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleEntry{
+		entry("2026-02-02", 600, 2),
+		entry("2026-03-09", 400, 1),
+	}, res.Entries)
+
+	res, err = k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{Bucket: types.UnlockScheduleBucket_UNLOCK_SCHEDULE_BUCKET_MONTH})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleEntry{
+		entry("2026-02-01", 600, 2),
+		entry("2026-03-01", 400, 1),
+	}, res.Entries)
+
+	res, err = k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleEntry{entry("2026-02-02", 300, 2)}, res.Entries)
+	require.NotEmpty(t, res.Pagination.NextKey)
+
+	res, err = k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleEntry{entry("2026-02-05", 300, 1)}, res.Entries)
+
+	res, err = k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleEntry{entry("2026-02-05", 300, 1)}, res.Entries)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{Pagination: &query.PageRequest{Limit: 2, Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleEntry{entry("2026-03-10", 400, 1), entry("2026-02-05", 300, 1)}, res.Entries)
+
+	res, err = k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleEntry{entry("2026-02-02", 300, 2)}, res.Entries)
+
+	_, err = k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{StartDate: "2026-03-01", EndDate: "2026-02-01"})
+	require.Error(t, err)
+
+	_, err = k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{StartDate: "invalid"})
+	require.Error(t, err)
+}
+
+func TestUnlockScheduleQueryEscrowedLocks(t *testing.T) {
+	k, ctx := keepertest.LockupKeeperWithKeepers(t, balanceBankKeeper{}, newSlashableStakingKeeper())
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	carol := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	addLock(t, k, ctx, alice, "2026-02-02", 100)
+	addEscrowedLock(t, k, ctx, bob, "2026-02-02", sdk.NewInt64Coin("uatom", 50))
+	addEscrowedLock(t, k, ctx, alice, "2026-02-03", sdk.NewInt64Coin("uOPT", 20))
+	addLock(t, k, ctx, bob, "2026-03-10", 400)
+	addEscrowedLock(t, k, ctx, carol, "2026-04-01", sdk.NewInt64Coin("uatom", 5))
+
+	entry := func(date string, amount int64, addresses uint32, escrowed ...sdk.Coin) types.UnlockScheduleEntry {
+		return types.UnlockScheduleEntry{Date: date, Amount: sdk.NewInt64Coin("uOPT", amount), AddressCount: addresses, Escrowed: escrowed}
+	}
+	all := []types.UnlockScheduleEntry{
+		entry("2026-02-02", 100, 2, sdk.NewInt64Coin("uatom", 50)),
+		entry("2026-02-03", 0, 1, sdk.NewInt64Coin("uOPT", 20)),
+		entry("2026-03-10", 400, 1),
+		entry("2026-04-01", 0, 1, sdk.NewInt64Coin("uatom", 5)),
+	}
+
+	res, err := k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{})
+	require.NoError(t, err)
+	require.Equal(t, all, res.Entries)
+
+	res, err = k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{Bucket: types.UnlockScheduleBucket_UNLOCK_SCHEDULE_BUCKET_MONTH})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleEntry{
+		entry("2026-02-01", 100, 2, sdk.NewInt64Coin("uOPT", 20), sdk.NewInt64Coin("uatom", 50)),
+		entry("2026-03-01", 400, 1),
+		entry("2026-04-01", 0, 1, sdk.NewInt64Coin("uatom", 5)),
+	}, res.Entries)
+
+	// page keys resume both queues, in either direction
+	for _, reverse := range []bool{false, true} {
+		var paged []types.UnlockScheduleEntry
+		var key []byte
+		for {
+			res, err := k.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{Pagination: &query.PageRequest{Key: key, Limit: 1, Reverse: reverse}})
+			require.NoError(t, err)
+			paged = append(paged, res.Entries...)
+			if key = res.Pagination.NextKey; key == nil {
+				break
+			}
+		}
+		if reverse {
+			slices.Reverse(paged)
+		}
+		require.Equal(t, all, paged)
+	}
+}
@@ -0,0 +1,337 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// GetRewardsPoolAddress returns the address of the rewards pool module account
+func (k Keeper) GetRewardsPoolAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.RewardsPoolName)
+}
+
+// GetUnallocatedRewards returns the rewards pool balance that has not been
+// allocated to any address yet
+func (k Keeper) GetUnallocatedRewards(ctx sdk.Context) (sdk.Coins, error) {
+	balance := k.bankKeeper.GetAllBalances(ctx, k.GetRewardsPoolAddress())
+
+	outstanding, err := k.GetOutstandingRewards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unallocated, hasNeg := balance.SafeSub(outstanding...)
+	if hasNeg {
+		return nil, types.ErrInvalidAmount.Wrapf("rewards pool balance %s does not cover outstanding rewards %s", balance, outstanding)
+	}
+	return unallocated, nil
+}
+
+// DistributeRewards allocates the unallocated rewards pool balance to lockers
+// once every RewardEpochDays. The first epoch starts on the first block day
+// payouts are enabled.
+func (k Keeper) DistributeRewards(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if params.RewardEpochDays == 0 {
+		return nil
+	}
+
+	blockTime := ctx.BlockTime()
+	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+
+	lastEpoch, found, err := k.GetLastRewardEpoch(ctx)
+	if err != nil {
+		return err
+	}
+
+	if found && blockDay.Before(lastEpoch.AddDate(0, 0, int(params.RewardEpochDays))) {
+		return nil
+	}
+
+	if err := k.SetLastRewardEpoch(ctx, blockDay); err != nil {
+		return err
+	}
+
+	if !found {
+		return nil
+	}
+
+	unallocated, err := k.GetUnallocatedRewards(ctx)
+	if err != nil {
+		return err
+	}
+
+	allocated, err := k.AllocateRewards(ctx, blockDay, unallocated)
+	if err != nil {
+		return err
+	}
+
+	if allocated.IsZero() {
+		return nil
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardsDistributed,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, allocated.String()),
+		),
+	)
+
+	return nil
+}
+
+// AllocateRewards splits rewards between lockers pro-rata by the amount of each
+// lock times the days left until it unlocks. Rolling locks count with their
+// full duration and escrowed locks earn nothing. Rather than crediting every
+// locker, it adds the rewards per unit of weight to the reward index, and
+// lockers settle their share whenever their locks change or they claim. Any
+// remainder left by rounding stays unallocated. It returns the allocated total.
+func (k Keeper) AllocateRewards(ctx sdk.Context, blockDay time.Time, rewards sdk.Coins) (sdk.Coins, error) {
+	allocated := sdk.NewCoins()
+	if rewards.IsZero() {
+		return allocated, nil
+	}
+
+	totalWeight, err := k.GetTotalRewardWeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// expired locks are removed earlier in the block, so no lock counts negative
+	day := types.EpochDay(blockDay)
+	weight := totalWeight.On(day)
+	if !weight.IsPositive() {
+		return allocated, nil
+	}
+
+	perWeight := sdk.NewDecCoins()
+	for _, coin := range rewards {
+		rate := math.LegacyNewDecFromInt(coin.Amount).QuoInt(weight)
+		if !rate.IsPositive() {
+			continue
+		}
+
+		perWeight = perWeight.Add(sdk.NewDecCoinFromDec(coin.Denom, rate))
+		allocated = allocated.Add(sdk.NewCoin(coin.Denom, rate.MulInt(weight).TruncateInt()))
+	}
+
+	if allocated.IsZero() {
+		return allocated, nil
+	}
+
+	index, err := k.GetRewardIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetRewardIndex(ctx, index.Add(perWeight, day)); err != nil {
+		return nil, err
+	}
+
+	outstanding, err := k.GetOutstandingRewards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetOutstandingRewards(ctx, outstanding.Add(allocated...)); err != nil {
+		return nil, err
+	}
+
+	return allocated, nil
+}
+
+// GetAccruedRewards returns the pending rewards of addr together with the
+// rewards its locks earned since it last settled
+func (k Keeper) GetAccruedRewards(ctx context.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	pending, err := k.GetPendingRewards(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	unsettled, _, err := k.unsettledRewards(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return pending.Add(unsettled...), nil
+}
+
+// settleRewards moves the rewards the locks of addr earned since it last
+// settled into its pending rewards. It returns the reward weight of the locks,
+// which must be passed to updateRewardWeight once they have been changed.
+func (k Keeper) settleRewards(ctx context.Context, addr sdk.AccAddress) (types.RewardWeight, error) {
+	unsettled, weight, err := k.unsettledRewards(ctx, addr)
+	if err != nil {
+		return types.RewardWeight{}, err
+	}
+
+	if !unsettled.IsZero() {
+		pending, err := k.GetPendingRewards(ctx, addr)
+		if err != nil {
+			return types.RewardWeight{}, err
+		}
+
+		if err := k.SetPendingRewards(ctx, addr, pending.Add(unsettled...)); err != nil {
+			return types.RewardWeight{}, err
+		}
+	}
+
+	index, err := k.GetRewardIndex(ctx)
+	if err != nil {
+		return types.RewardWeight{}, err
+	}
+
+	snapshot, err := k.GetRewardSnapshot(ctx, addr)
+	if err != nil {
+		return types.RewardWeight{}, err
+	}
+
+	if !snapshot.Equal(index) {
+		if err := k.SetRewardSnapshot(ctx, addr, index); err != nil {
+			return types.RewardWeight{}, err
+		}
+	}
+
+	return weight, nil
+}
+
+// updateRewardWeight adds the change of the reward weight of addr since
+// settleRewards returned before to the total reward weight
+func (k Keeper) updateRewardWeight(ctx context.Context, addr sdk.AccAddress, before types.RewardWeight) error {
+	after, err := k.getRewardWeight(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	// an address without locks earns nothing until it locks again
+	if after.IsZero() {
+		if err := k.DeleteRewardSnapshot(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	totalWeight, err := k.GetTotalRewardWeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.SetTotalRewardWeight(ctx, totalWeight.Add(after.Sub(before)))
+}
+
+// unsettledRewards returns the rewards the locks of addr earned since it last
+// settled, along with the reward weight of the locks
+func (k Keeper) unsettledRewards(ctx context.Context, addr sdk.AccAddress) (sdk.Coins, types.RewardWeight, error) {
+	weight, err := k.getRewardWeight(ctx, addr)
+	if err != nil {
+		return nil, types.RewardWeight{}, err
+	}
+
+	if weight.IsZero() {
+		return sdk.NewCoins(), weight, nil
+	}
+
+	index, err := k.GetRewardIndex(ctx)
+	if err != nil {
+		return nil, types.RewardWeight{}, err
+	}
+
+	snapshot, err := k.GetRewardSnapshot(ctx, addr)
+	if err != nil {
+		return nil, types.RewardWeight{}, err
+	}
+
+	return index.RewardsSince(snapshot, weight), weight, nil
+}
+
+// getRewardWeight returns the reward weight of the dated and rolling locks of addr
+func (k Keeper) getRewardWeight(ctx context.Context, addr sdk.AccAddress) (types.RewardWeight, error) {
+	weight := types.NewRewardWeight()
+
+	locks, err := k.GetLocksByAddress(sdk.UnwrapSDKContext(ctx), addr)
+	if err != nil {
+		return types.RewardWeight{}, err
+	}
+
+	for _, lock := range locks {
+		if lock.IsEscrowed() {
+			continue
+		}
+
+		unlockTime, err := types.ParseUnlockTime(lock.UnlockDate)
+		if err != nil {
+			return types.RewardWeight{}, err
+		}
+		weight = weight.AddDatedLock(lock.Amount, types.EpochDay(unlockTime))
+	}
+
+	rollingLocks, err := k.GetRollingLocksByAddress(ctx, addr)
+	if err != nil {
+		return types.RewardWeight{}, err
+	}
+
+	for _, lock := range rollingLocks {
+		weight = weight.AddRollingLock(lock.Amount, lock.DurationDays)
+	}
+
+	return weight, nil
+}
+
+// IterateAccruedRewards iterates over the accrued rewards of every address
+// with pending rewards or locks, ordered by address (read-only)
+func (k Keeper) IterateAccruedRewards(ctx context.Context, cb func(addr sdk.AccAddress, rewards sdk.Coins) error) error {
+	addresses := make(map[string]sdk.AccAddress)
+
+	err := k.IteratePendingRewards(ctx, func(addr sdk.AccAddress, _ sdk.Coins) error {
+		addresses[string(addr)] = addr
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = k.IterateLocksByAddress(ctx, func(addr sdk.AccAddress, _ []*types.Lock) error {
+		addresses[string(addr)] = addr
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = k.IterateRollingLocks(ctx, func(addr sdk.AccAddress, _ uint32, _ math.Int) error {
+		addresses[string(addr)] = addr
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(addresses))
+	for key := range addresses {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		rewards, err := k.GetAccruedRewards(ctx, addresses[key])
+		if err != nil {
+			return err
+		}
+
+		if rewards.IsZero() {
+			continue
+		}
+
+		if err := cb(addresses[key], rewards); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// GetPendingRewardsKey creates the key for the pending rewards of an address
+// Key: Prefix + Address
+func (k Keeper) GetPendingRewardsKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, types.PendingRewardsKey...), addr.Bytes()...)
+}
+
+// GetPendingRewards returns the unclaimed rewards allocated to addr
+func (k Keeper) GetPendingRewards(ctx context.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(k.GetPendingRewardsKey(addr))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return sdk.NewCoins(), nil
+	}
+
+	var pending types.PendingRewards
+	if err := k.cdc.Unmarshal(bz, &pending); err != nil {
+		return nil, err
+	}
+	return pending.Rewards, nil
+}
+
+// SetPendingRewards stores the unclaimed rewards of addr, deleting the entry if rewards is empty
+func (k Keeper) SetPendingRewards(ctx context.Context, addr sdk.AccAddress, rewards sdk.Coins) error {
+	store := k.storeService.OpenKVStore(ctx)
+	key := k.GetPendingRewardsKey(addr)
+
+	if rewards.IsZero() {
+		return store.Delete(key)
+	}
+
+	bz, err := k.cdc.Marshal(&types.PendingRewards{Address: addr.String(), Rewards: rewards})
+	if err != nil {
+		return err
+	}
+	return store.Set(key, bz)
+}
+
+// IteratePendingRewards iterates over the unclaimed rewards of every address (read-only)
+func (k Keeper) IteratePendingRewards(ctx context.Context, cb func(addr sdk.AccAddress, rewards sdk.Coins) error) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.PendingRewardsKey, prefixEndBytes(types.PendingRewardsKey))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingRewards
+		if err := k.cdc.Unmarshal(iterator.Value(), &pending); err != nil {
+			return err
+		}
+
+		addr := sdk.AccAddress(iterator.Key()[len(types.PendingRewardsKey):])
+		if err := cb(addr, pending.Rewards); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetTotalPendingRewards returns the sum of the unclaimed rewards of every address
+func (k Keeper) GetTotalPendingRewards(ctx context.Context) (sdk.Coins, error) {
+	total := sdk.NewCoins()
+	err := k.IteratePendingRewards(ctx, func(_ sdk.AccAddress, rewards sdk.Coins) error {
+		total = total.Add(rewards...)
+		return nil
+	})
+	return total, err
+}
+
+// GetLastRewardEpoch returns the day of the last rewards pool payout, and
+// false if no epoch has started yet
+func (k Keeper) GetLastRewardEpoch(ctx context.Context) (time.Time, bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.LastRewardEpochKey)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if bz == nil {
+		return time.Time{}, false, nil
+	}
+
+	epoch, err := time.Parse(time.DateOnly, string(bz))
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return epoch, true, nil
+}
+
+// SetLastRewardEpoch stores the day of the last rewards pool payout
+func (k Keeper) SetLastRewardEpoch(ctx context.Context, epoch time.Time) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.LastRewardEpochKey, []byte(epoch.UTC().Format(time.DateOnly)))
+}
+
+// GetOutstandingRewards returns the rewards allocated by past epochs that have not been claimed yet
+func (k Keeper) GetOutstandingRewards(ctx context.Context) (sdk.Coins, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.OutstandingRewardsKey)
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return sdk.NewCoins(), nil
+	}
+
+	var outstanding types.OutstandingRewards
+	if err := k.cdc.Unmarshal(bz, &outstanding); err != nil {
+		return nil, err
+	}
+	return outstanding.Rewards, nil
+}
+
+// SetOutstandingRewards stores the rewards allocated by past epochs that have not been claimed yet
+func (k Keeper) SetOutstandingRewards(ctx context.Context, rewards sdk.Coins) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&types.OutstandingRewards{Rewards: rewards})
+	if err != nil {
+		return err
+	}
+	return store.Set(types.OutstandingRewardsKey, bz)
+}
+
+// GetRewardIndex returns the cumulative rewards paid per unit of lock weight
+func (k Keeper) GetRewardIndex(ctx context.Context) (types.RewardIndex, error) {
+	return k.getRewardIndex(ctx, types.RewardIndexKey)
+}
+
+// SetRewardIndex stores the cumulative rewards paid per unit of lock weight
+func (k Keeper) SetRewardIndex(ctx context.Context, index types.RewardIndex) error {
+	return k.setRewardIndex(ctx, types.RewardIndexKey, index)
+}
+
+// GetRewardSnapshotKey creates the key for the reward index addr last settled its rewards at
+// Key: Prefix + Address
+func (k Keeper) GetRewardSnapshotKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, types.RewardSnapshotsKey...), addr.Bytes()...)
+}
+
+// GetRewardSnapshot returns the reward index addr last settled its rewards at
+func (k Keeper) GetRewardSnapshot(ctx context.Context, addr sdk.AccAddress) (types.RewardIndex, error) {
+	return k.getRewardIndex(ctx, k.GetRewardSnapshotKey(addr))
+}
+
+// SetRewardSnapshot stores the reward index addr last settled its rewards at
+func (k Keeper) SetRewardSnapshot(ctx context.Context, addr sdk.AccAddress, index types.RewardIndex) error {
+	return k.setRewardIndex(ctx, k.GetRewardSnapshotKey(addr), index)
+}
+
+// DeleteRewardSnapshot removes the reward index addr last settled its rewards at
+func (k Keeper) DeleteRewardSnapshot(ctx context.Context, addr sdk.AccAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(k.GetRewardSnapshotKey(addr))
+}
+
+func (k Keeper) getRewardIndex(ctx context.Context, key []byte) (types.RewardIndex, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(key)
+	if err != nil {
+		return types.RewardIndex{}, err
+	}
+
+	index := types.RewardIndex{PerWeight: sdk.NewDecCoins(), PerWeightDay: sdk.NewDecCoins()}
+	if bz == nil {
+		return index, nil
+	}
+
+	if err := k.cdc.Unmarshal(bz, &index); err != nil {
+		return types.RewardIndex{}, err
+	}
+	return index, nil
+}
+
+func (k Keeper) setRewardIndex(ctx context.Context, key []byte, index types.RewardIndex) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&index)
+	if err != nil {
+		return err
+	}
+	return store.Set(key, bz)
+}
+
+// GetTotalRewardWeight returns the reward weight sums of every lock
+func (k Keeper) GetTotalRewardWeight(ctx context.Context) (types.RewardWeight, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.TotalRewardWeightKey)
+	if err != nil {
+		return types.RewardWeight{}, err
+	}
+	if bz == nil {
+		return types.NewRewardWeight(), nil
+	}
+
+	var weight types.RewardWeight
+	if err := k.cdc.Unmarshal(bz, &weight); err != nil {
+		return types.RewardWeight{}, err
+	}
+	return weight, nil
+}
+
+// SetTotalRewardWeight stores the reward weight sums of every lock
+func (k Keeper) SetTotalRewardWeight(ctx context.Context, weight types.RewardWeight) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&weight)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.TotalRewardWeightKey, bz)
+}
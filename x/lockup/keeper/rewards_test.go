@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+)
+
+func TestAllocateRewards(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	carol := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	blockDay := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// alice: 100 for 10 days, bob: 300 for 30 days, carol's lock unlocks today
+	addLock(t, k, ctx, alice, "2026-01-11", 100)
+	require.NoError(t, k.AddRollingLock(ctx, bob, 30, math.NewInt(300)))
+	addLock(t, k, ctx, carol, "2026-01-01", 500)
+
+	require.NoError(t, k.SetPendingRewards(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 5))))
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("uOPT", 1000), sdk.NewInt64Coin("uatom", 7))
+	allocated, err := k.AllocateRewards(ctx, blockDay, rewards)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 1000), sdk.NewInt64Coin("uatom", 7)), allocated)
+
+	// shares are settled lazily, so nothing is written per locker
+	alicePending, err := k.GetPendingRewards(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 5)), alicePending)
+
+	aliceAccrued, err := k.GetAccruedRewards(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 105)), aliceAccrued)
+
+	bobAccrued, err := k.GetAccruedRewards(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 900), sdk.NewInt64Coin("uatom", 6)), bobAccrued)
+
+	carolAccrued, err := k.GetAccruedRewards(ctx, carol)
+	require.NoError(t, err)
+	require.True(t, carolAccrued.IsZero())
+
+	outstanding, err := k.GetOutstandingRewards(ctx)
+	require.NoError(t, err)
+	require.Equal(t, allocated, outstanding)
+}
+
+func TestAllocateRewardsSettlesOnLockChange(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	// alice: 100 until 2026-01-21, bob: 100 over 10 rolling days
+	addLock(t, k, ctx, alice, "2026-01-21", 100)
+	require.NoError(t, k.AddRollingLock(ctx, bob, 10, math.NewInt(100)))
+
+	// alice weighs 2000 and bob 1000
+	_, err := k.AllocateRewards(ctx, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), sdk.NewCoins(sdk.NewInt64Coin("uOPT", 300)))
+	require.NoError(t, err)
+
+	// alice doubles her lock, which settles her first epoch
+	addLock(t, k, ctx, alice, "2026-01-21", 100)
+
+	alicePending, err := k.GetPendingRewards(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 200)), alicePending)
+
+	// ten days later alice weighs 2000 and bob still 1000
+	_, err = k.AllocateRewards(ctx, time.Date(2026, 1, 11, 0, 0, 0, 0, time.UTC), sdk.NewCoins(sdk.NewInt64Coin("uOPT", 600)))
+	require.NoError(t, err)
+
+	aliceAccrued, err := k.GetAccruedRewards(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 600)), aliceAccrued)
+
+	bobAccrued, err := k.GetAccruedRewards(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 300)), bobAccrued)
+
+	// removing every lock settles the address and drops its snapshot
+	require.NoError(t, k.RemoveRollingLock(ctx, bob, 10, math.NewInt(100)))
+
+	bobPending, err := k.GetPendingRewards(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 300)), bobPending)
+
+	weight, err := k.GetTotalRewardWeight(ctx)
+	require.NoError(t, err)
+	require.True(t, weight.Rolling.IsZero())
+	require.Equal(t, math.NewInt(200), weight.Amount)
+}
+
+func TestAllocateRewardsWithoutLocks(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	allocated, err := k.AllocateRewards(ctx, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), sdk.NewCoins(sdk.NewInt64Coin("uOPT", 1000)))
+	require.NoError(t, err)
+	require.True(t, allocated.IsZero())
+}
+
+func TestSetPendingRewardsDeletesEmpty(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, k.SetPendingRewards(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 10))))
+	require.NoError(t, k.SetPendingRewards(ctx, alice, sdk.NewCoins()))
+
+	count := 0
+	require.NoError(t, k.IteratePendingRewards(ctx, func(sdk.AccAddress, sdk.Coins) error {
+		count++
+		return nil
+	}))
+	require.Zero(t, count)
+}
+
+func TestDistributeRewardsStartsFirstEpoch(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, k.DistributeRewards(ctx))
+
+	epoch, found, err := k.GetLastRewardEpoch(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), epoch)
+
+	// the next epoch has not ended yet, so nothing changes
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, k.DistributeRewards(ctx))
+
+	epoch, _, err = k.GetLastRewardEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), epoch)
+}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// GetRollingLocksKey creates the key prefix for the rolling locks of an address
+// Key: Prefix + Length-prefixed address
+func (k Keeper) GetRollingLocksKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, types.RollingLocksKey...), address.MustLengthPrefix(addr)...)
+}
+
+// GetRollingLockKey creates the key for a single rolling lock
+// Key: Prefix + Length-prefixed address + Duration in days (4 bytes)
+func (k Keeper) GetRollingLockKey(addr sdk.AccAddress, durationDays uint32) []byte {
+	durationBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(durationBz, durationDays)
+	return append(k.GetRollingLocksKey(addr), durationBz...)
+}
+
+// GetRollingLock returns the amount locked by addr in the rolling lock with the given duration
+func (k Keeper) GetRollingLock(ctx context.Context, addr sdk.AccAddress, durationDays uint32) (math.Int, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(k.GetRollingLockKey(addr, durationDays))
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	if bz == nil {
+		return math.ZeroInt(), nil
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		return math.ZeroInt(), err
+	}
+	return amount, nil
+}
+
+// AddRollingLock adds amount to the rolling lock of addr with the given duration.
+// The total locked amount is increased accordingly and the rewards earned so far are settled
+func (k Keeper) AddRollingLock(ctx context.Context, addr sdk.AccAddress, durationDays uint32, amount math.Int) error {
+	weight, err := k.settleRewards(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	currentAmount, err := k.GetRollingLock(ctx, addr, durationDays)
+	if err != nil {
+		return err
+	}
+
+	bz, err := currentAmount.Add(amount).Marshal()
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(k.GetRollingLockKey(addr, durationDays), bz); err != nil {
+		return err
+	}
+
+	if err := k.updateRewardWeight(ctx, addr, weight); err != nil {
+		return err
+	}
+
+	return k.adjustTotalLocked(ctx, amount)
+}
+
+// RemoveRollingLock removes amount from the rolling lock of addr with the given duration.
+// If the resulting amount is zero, deletes the entry. The total locked amount is decreased accordingly
+// and the rewards earned so far are settled
+func (k Keeper) RemoveRollingLock(ctx context.Context, addr sdk.AccAddress, durationDays uint32, amount math.Int) error {
+	currentAmount, err := k.GetRollingLock(ctx, addr, durationDays)
+	if err != nil {
+		return err
+	}
+
+	if currentAmount.LT(amount) {
+		return types.ErrInvalidAmount.Wrapf("cannot remove %s from rolling lock, only %s available", amount.String(), currentAmount.String())
+	}
+
+	weight, err := k.settleRewards(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	key := k.GetRollingLockKey(addr, durationDays)
+
+	newAmount := currentAmount.Sub(amount)
+	if newAmount.IsZero() {
+		err = store.Delete(key)
+	} else {
+		var bz []byte
+		bz, err = newAmount.Marshal()
+		if err != nil {
+			return err
+		}
+		err = store.Set(key, bz)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := k.updateRewardWeight(ctx, addr, weight); err != nil {
+		return err
+	}
+
+	return k.adjustTotalLocked(ctx, amount.Neg())
+}
+
+// GetRollingLocksByAddress returns the rolling locks of addr, ordered by duration
+func (k Keeper) GetRollingLocksByAddress(ctx context.Context, addr sdk.AccAddress) ([]types.RollingLock, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := k.GetRollingLocksKey(addr)
+
+	iterator, err := store.Iterator(prefix, prefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var locks []types.RollingLock
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		locks = append(locks, types.RollingLock{
+			DurationDays: binary.BigEndian.Uint32(iterator.Key()[len(prefix):]),
+			Amount:       amount,
+		})
+	}
+
+	return locks, nil
+}
+
+// IterateRollingLocks iterates over every rolling lock (read-only)
+func (k Keeper) IterateRollingLocks(ctx context.Context, cb func(addr sdk.AccAddress, durationDays uint32, amount math.Int) error) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.RollingLocksKey, prefixEndBytes(types.RollingLocksKey))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr, durationDays := parseRollingLockKey(iterator.Key())
+
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			return err
+		}
+
+		if err := cb(addr, durationDays, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseRollingLockKey splits a rolling lock key into its address and duration
+func parseRollingLockKey(key []byte) (sdk.AccAddress, uint32) {
+	key = key[len(types.RollingLocksKey):]
+	addrLen := int(key[0])
+	addr := sdk.AccAddress(key[1 : 1+addrLen])
+	return addr, binary.BigEndian.Uint32(key[1+addrLen:])
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func TestRollingLocks(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	require.NoError(t, k.AddRollingLock(ctx, alice, 730, math.NewInt(100)))
+	require.NoError(t, k.AddRollingLock(ctx, alice, 30, math.NewInt(50)))
+	require.NoError(t, k.AddRollingLock(ctx, alice, 730, math.NewInt(25)))
+	require.NoError(t, k.AddRollingLock(ctx, bob, 365, math.NewInt(10)))
+
+	aliceLocks, err := k.GetRollingLocksByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, []types.RollingLock{
+		{DurationDays: 30, Amount: math.NewInt(50)},
+		{DurationDays: 730, Amount: math.NewInt(125)},
+	}, aliceLocks)
+
+	total, err := k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(185), total)
+
+	require.Error(t, k.RemoveRollingLock(ctx, alice, 30, math.NewInt(51)))
+	require.NoError(t, k.RemoveRollingLock(ctx, alice, 30, math.NewInt(50)))
+
+	amount, err := k.GetRollingLock(ctx, alice, 30)
+	require.NoError(t, err)
+	require.True(t, amount.IsZero())
+
+	total, err = k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(135), total)
+
+	var iterated []string
+	require.NoError(t, k.IterateRollingLocks(ctx, func(addr sdk.AccAddress, durationDays uint32, amount math.Int) error {
+		iterated = append(iterated, addr.String())
+		return nil
+	}))
+	require.ElementsMatch(t, []string{alice.String(), bob.String()}, iterated)
+}
+
+func TestRollingLocksNeverExpire(t *testing.T) {
+	k, ctx := keepertest.LockupKeeper(t)
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	addLock(t, k, ctx, alice, "2026-01-10", 100)
+	require.NoError(t, k.AddRollingLock(ctx, alice, 5, math.NewInt(200)))
+
+	ctx = ctx.WithBlockTime(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.RemoveExpiredLocks(ctx))
+
+	locked, err := k.GetLockedAmountByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(200), *locked)
+
+	total, err := k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(200), total)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ banktypes.SendRestrictionFn = Keeper{}.SendRestrictionFn
+
+// SendRestrictionFn is registered with x/bank and rejects any transfer of the
+// bond denom that would leave fromAddr with a balance below the amount it has
+// locked but not delegated. It applies to every bank send, whichever message,
+// module or host executes it. Delegating does not go through send
+// restrictions, so locked balances can still be delegated.
+func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := amt.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return toAddr, nil
+	}
+
+	lockedAboveDelegated, err := k.GetLockedAboveDelegated(sdk.UnwrapSDKContext(ctx), fromAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if lockedAboveDelegated.IsZero() {
+		return toAddr, nil
+	}
+
+	// x/bank debits fromAddr before it applies send restrictions, so the
+	// balance already has amt taken out
+	postSendBalance := k.bankKeeper.GetBalance(ctx, fromAddr, bondDenom).Amount
+	if postSendBalance.LT(lockedAboveDelegated) {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf("insufficient unlocked balance: sending %s leaves %s, %s short of the %s locked above delegations",
+			amount, postSendBalance, lockedAboveDelegated.Sub(postSendBalance), lockedAboveDelegated)
+	}
+
+	return toAddr, nil
+}
+
+// availableToSend returns the largest amount an address with balance can send
+// while SendRestrictionFn still finds lockedAboveDelegated in its balance
+// after the send
+func availableToSend(balance, lockedAboveDelegated math.Int) math.Int {
+	available := balance.Sub(lockedAboveDelegated)
+	if available.IsNegative() {
+		return math.ZeroInt()
+	}
+	return available
+}
+
+// GetLockedAboveDelegated returns the amount addr has locked beyond its
+// delegations, which has to stay in its balance
+func (k Keeper) GetLockedAboveDelegated(ctx sdk.Context, addr sdk.AccAddress) (math.Int, error) {
+	locked, err := k.GetLockedAmountByAddress(ctx, addr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if locked.IsZero() {
+		return math.ZeroInt(), nil
+	}
+
+	delegated, err := k.GetTotalDelegatedAmount(ctx, addr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if delegated.GTE(*locked) {
+		return math.ZeroInt(), nil
+	}
+
+	return locked.Sub(*delegated), nil
+}
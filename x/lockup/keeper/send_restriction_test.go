@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// balanceBankKeeper is a bank keeper that only knows account balances and blocked addresses
+type balanceBankKeeper struct {
+	types.BankKeeper
+	balances map[string]sdk.Coins
+	blocked  map[string]bool
+}
+
+func (b balanceBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return b.blocked[addr.String()]
+}
+
+func (b balanceBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.balances[addr.String()].AmountOf(denom))
+}
+
+func (b balanceBankKeeper) SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return b.GetBalance(ctx, addr, denom)
+}
+
+// fundAccount mints coins to addr through the mint module account
+func fundAccount(t *testing.T, bank bankkeeper.BaseKeeper, ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	t.Helper()
+
+	require.NoError(t, bank.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, bank.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+}
+
+func TestSendRestrictionFn(t *testing.T) {
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	carol := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	staking := newSlashableStakingKeeper()
+	k, bank, ctx := keepertest.LockupKeeperWithBankKeeper(t, staking)
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	fundAccount(t, bank, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 500), sdk.NewInt64Coin("uatom", 100)))
+
+	// alice has 1000 locked but only 700 delegated, so 300 of her balance stays put
+	staking.delegate(alice, 700)
+	addLock(t, k, ctx, alice, "2026-12-01", 1000)
+
+	lockedAboveDelegated, err := k.GetLockedAboveDelegated(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300), lockedAboveDelegated)
+
+	// x/bank debits alice before the restriction runs, which still leaves room for 200
+	cacheCtx, _ := ctx.CacheContext()
+	require.NoError(t, bank.SendCoins(cacheCtx, alice, bob, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 200))))
+
+	cacheCtx, _ = ctx.CacheContext()
+	err = bank.SendCoins(cacheCtx, alice, bob, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 201)))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// a multi-output transfer is checked against the balance left after all outputs
+	input := banktypes.NewInput(alice, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 200)))
+	cacheCtx, _ = ctx.CacheContext()
+	require.NoError(t, bank.InputOutputCoins(cacheCtx, input, []banktypes.Output{
+		banktypes.NewOutput(bob, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 100))),
+		banktypes.NewOutput(carol, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 100))),
+	}))
+
+	input = banktypes.NewInput(alice, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 201)))
+	cacheCtx, _ = ctx.CacheContext()
+	err = bank.InputOutputCoins(cacheCtx, input, []banktypes.Output{
+		banktypes.NewOutput(bob, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 100))),
+		banktypes.NewOutput(carol, sdk.NewCoins(sdk.NewInt64Coin("uOPT", 101))),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// other denoms are never restricted
+	require.NoError(t, bank.SendCoins(ctx, alice, bob, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))))
+
+	// addresses without locks are never restricted
+	require.NoError(t, bank.SendCoins(ctx, bob, alice, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))))
+}
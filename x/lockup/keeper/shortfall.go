@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+// queueLockCheck queues addr for ReconcileQueuedLocks if it has any locks
+func (k Keeper) queueLockCheck(ctx sdk.Context, addr sdk.AccAddress) error {
+	locked, err := k.GetLockedAmountByAddress(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	if locked.IsZero() {
+		return nil
+	}
+
+	return k.SetLockCheck(ctx, addr)
+}
+
+// ReconcileQueuedLocks reconciles the locks of every address queued by the
+// staking hooks and clears the queue
+func (k Keeper) ReconcileQueuedLocks(ctx sdk.Context) error {
+	addrs, err := k.GetLockChecks(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		if err := k.DeleteLockCheck(ctx, addr); err != nil {
+			return err
+		}
+
+		if err := k.ReconcileLocks(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReconcileLocks reduces the locks of addr by the amount they exceed its
+// delegations, so that its locks stay backed by stake
+func (k Keeper) ReconcileLocks(ctx sdk.Context, addr sdk.AccAddress) error {
+	locked, err := k.GetLockedAmountByAddress(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	delegated, err := k.GetTotalDelegatedAmount(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	if delegated.GTE(*locked) {
+		return nil
+	}
+
+	return k.ReduceLocks(ctx, addr, locked.Sub(*delegated))
+}
+
+// lockPosition is an active dated or rolling lock of a single address
+type lockPosition struct {
+	unlockDate   string
+	durationDays uint32
+	amount       math.Int
+}
+
+// ReduceLocks takes shortfall out of the active locks of addr, pro-rata by
+// lock amount. Rounding leaves at most one token per lock, which is taken from
+// the locks in order: dated locks by unlock date, then rolling locks by
+// duration. Each reduced lock emits a lock_slashed event.
+func (k Keeper) ReduceLocks(ctx sdk.Context, addr sdk.AccAddress, shortfall math.Int) error {
+	blockTime := ctx.BlockTime()
+	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+
+	locks, err := k.GetLocksByAddress(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	var positions []lockPosition
+	for _, lock := range locks {
+		if !lock.IsEscrowed() && types.IsLocked(blockTime, lock.UnlockDate) {
+			positions = append(positions, lockPosition{unlockDate: lock.UnlockDate, amount: lock.Amount})
+		}
+	}
+	sort.SliceStable(positions, func(i, j int) bool {
+		return positions[i].unlockDate < positions[j].unlockDate
+	})
+
+	rollingLocks, err := k.GetRollingLocksByAddress(ctx, addr)
+	if err != nil {
+		return err
+	}
+	for _, lock := range rollingLocks {
+		positions = append(positions, lockPosition{durationDays: lock.DurationDays, amount: lock.Amount})
+	}
+
+	total := math.ZeroInt()
+	for _, position := range positions {
+		total = total.Add(position.amount)
+	}
+
+	if total.LT(shortfall) {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "cannot reduce locks of %s by %s, only %s locked", addr, shortfall, total)
+	}
+
+	cuts := make([]math.Int, len(positions))
+	remainder := shortfall
+	for i, position := range positions {
+		cuts[i] = shortfall.Mul(position.amount).Quo(total)
+		remainder = remainder.Sub(cuts[i])
+	}
+	for i := 0; remainder.IsPositive(); i++ {
+		if cuts[i].LT(positions[i].amount) {
+			cuts[i] = cuts[i].AddRaw(1)
+			remainder = remainder.SubRaw(1)
+		}
+	}
+
+	for i, position := range positions {
+		if cuts[i].IsZero() {
+			continue
+		}
+
+		unlockDate := position.unlockDate
+		if unlockDate == "" {
+			err = k.RemoveRollingLock(ctx, addr, position.durationDays, cuts[i])
+			unlockDate = types.RollingUnlockDate(blockDay, position.durationDays).Format(time.DateOnly)
+		} else {
+			err = k.reduceDatedLock(ctx, addr, position.unlockDate, cuts[i])
+		}
+		if err != nil {
+			return err
+		}
+
+		err = k.recordLockHistory(ctx, types.LockHistoryEntry{
+			Address:    addr.String(),
+			Action:     types.LockHistoryAction_LOCK_HISTORY_ACTION_SLASH,
+			UnlockDate: unlockDate,
+			Amount:     cuts[i],
+			AutoRenew:  position.unlockDate == "",
+		})
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLockSlashed,
+				sdk.NewAttribute(types.AttributeKeyLockAddress, addr.String()),
+				sdk.NewAttribute(types.AttributeKeyUnlockDate, unlockDate),
+				sdk.NewAttribute(types.AttributeKeyAutoRenew, strconv.FormatBool(position.unlockDate == "")),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, cuts[i].String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// reduceDatedLock removes amount from the lock of addr on unlockDate. The
+// lock's token, if any, is burned with an emptied lock or updated otherwise.
+func (k Keeper) reduceDatedLock(ctx sdk.Context, addr sdk.AccAddress, unlockDate string, amount math.Int) error {
+	unlockTime, err := types.ParseUnlockTime(unlockDate)
+	if err != nil {
+		return err
+	}
+
+	lock, idx, found := k.GetLockByAddressAndDate(ctx, addr, unlockDate)
+	if !found {
+		return types.ErrLockupNotFound.Wrapf("no lockup found for unlock date (%s)", unlockDate)
+	}
+
+	remaining := lock.Amount.Sub(amount)
+	if remaining.IsZero() {
+		if err := k.burnLockNFT(ctx, addr, unlockDate); err != nil {
+			return err
+		}
+		err = k.DeleteLockByAddressAndIndex(ctx, addr, idx)
+	} else {
+		if err := k.updateLockNFTData(ctx, addr, unlockDate, remaining); err != nil {
+			return err
+		}
+		err = k.UpdateLockByAddressAndIndex(ctx, addr, idx, &types.Lock{UnlockDate: unlockDate, Amount: remaining})
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.RemoveFromExpirationQueue(ctx, unlockTime, addr, amount)
+}
+
+// updateLockNFTData refreshes the amount carried by the token of the lock of
+// owner on unlockDate, if the lock is tokenized
+func (k Keeper) updateLockNFTData(ctx sdk.Context, owner sdk.AccAddress, unlockDate string, amount math.Int) error {
+	id, found, err := k.GetLockNFTByLock(ctx, owner, unlockDate)
+	if err != nil || !found {
+		return err
+	}
+
+	data, err := codectypes.NewAnyWithValue(&types.LockNFTData{UnlockDate: unlockDate, Amount: amount})
+	if err != nil {
+		return err
+	}
+
+	return k.nftKeeper.Update(ctx, nft.NFT{ClassId: types.LockNFTClassID, Id: id, Data: data})
+}
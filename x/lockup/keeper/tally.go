@@ -0,0 +1,289 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ComputeLockWeightedTally tallies votes the way x/gov does, and then adds a
+// lock bonus to each voter's power. The bonus is the voter's locked amount, weighted
+// by the params voting power multiplier for the time left on each lock. It is
+// capped by the voter's delegations. If a lock holder does not vote, their
+// validators inherit the bonus in proportion to the bonded delegations.
+//
+// Quorum is checked against staked voting power only, so locks cannot create
+// quorum on their own. The veto and pass thresholds use the boosted power.
+//
+// The result is informational only. x/gov v0.50 has no way to override its
+// tally, so proposals are still decided by the staked tally of x/gov.
+func (k Keeper) ComputeLockWeightedTally(ctx sdk.Context, proposal govv1.Proposal, votes []*govv1.Vote, govParams govv1.Params) (passes bool, tallyResults govv1.TallyResult, err error) {
+	params := k.GetParams(ctx)
+
+	blockTime := ctx.BlockTime()
+	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+
+	results := make(map[govv1.VoteOption]math.LegacyDec)
+	results[govv1.OptionYes] = math.LegacyZeroDec()
+	results[govv1.OptionAbstain] = math.LegacyZeroDec()
+	results[govv1.OptionNo] = math.LegacyZeroDec()
+	results[govv1.OptionNoWithVeto] = math.LegacyZeroDec()
+
+	stakedVotingPower := math.LegacyZeroDec()
+	totalVotingPower := math.LegacyZeroDec()
+	currValidators := make(map[string]govv1.ValidatorGovInfo)
+	inheritedBonus := make(map[string]math.LegacyDec)
+
+	err = k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		valBz, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			return false
+		}
+		currValidators[validator.GetOperator()] = govv1.NewValidatorGovInfo(
+			valBz,
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			math.LegacyZeroDec(),
+			govv1.WeightedVoteOptions{},
+		)
+		inheritedBonus[validator.GetOperator()] = math.LegacyZeroDec()
+
+		return false
+	})
+	if err != nil {
+		return false, tallyResults, err
+	}
+
+	addPower := func(options govv1.WeightedVoteOptions, power math.LegacyDec) {
+		for _, option := range options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			results[option.Option] = results[option.Option].Add(power.Mul(weight))
+		}
+		totalVotingPower = totalVotingPower.Add(power)
+	}
+
+	voted := make(map[string]bool, len(votes))
+	for _, vote := range votes {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			return false, tallyResults, err
+		}
+		voted[voter.String()] = true
+
+		valAddrStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(voter)
+		if err != nil {
+			return false, tallyResults, err
+		}
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			currValidators[valAddrStr] = val
+		}
+
+		// iterate over all delegations from voter, deduct from any delegated-to validators
+		err = k.stakingKeeper.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr()
+
+			if val, ok := currValidators[valAddrStr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				currValidators[valAddrStr] = val
+
+				// delegation shares * bonded / total shares
+				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+				addPower(vote.Options, votingPower)
+				stakedVotingPower = stakedVotingPower.Add(votingPower)
+			}
+
+			return false
+		})
+		if err != nil {
+			return false, tallyResults, err
+		}
+
+		bonus, err := k.lockVotingBonus(ctx, params, blockDay, voter)
+		if err != nil {
+			return false, tallyResults, err
+		}
+		addPower(vote.Options, bonus)
+	}
+
+	// Lock holders that did not vote pass their bonus on to the bonded
+	// validators they delegate to.
+	holders, err := k.lockHolders(ctx)
+	if err != nil {
+		return false, tallyResults, err
+	}
+
+	for _, holder := range holders {
+		if voted[holder.String()] {
+			continue
+		}
+
+		bonus, err := k.lockVotingBonus(ctx, params, blockDay, holder)
+		if err != nil {
+			return false, tallyResults, err
+		}
+		if !bonus.IsPositive() {
+			continue
+		}
+
+		bonded := make(map[string]math.LegacyDec)
+		totalBonded := math.LegacyZeroDec()
+		err = k.stakingKeeper.IterateDelegations(ctx, holder, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+			if val, ok := currValidators[delegation.GetValidatorAddr()]; ok {
+				tokens := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+				bonded[delegation.GetValidatorAddr()] = tokens
+				totalBonded = totalBonded.Add(tokens)
+			}
+			return false
+		})
+		if err != nil {
+			return false, tallyResults, err
+		}
+		if !totalBonded.IsPositive() {
+			continue
+		}
+
+		for valAddrStr, tokens := range bonded {
+			inheritedBonus[valAddrStr] = inheritedBonus[valAddrStr].Add(bonus.Mul(tokens).Quo(totalBonded))
+		}
+	}
+
+	// iterate over the validators again to tally their voting power
+	for valAddrStr, val := range currValidators {
+		if len(val.Vote) == 0 {
+			continue
+		}
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+
+		addPower(val.Vote, votingPower.Add(inheritedBonus[valAddrStr]))
+		stakedVotingPower = stakedVotingPower.Add(votingPower)
+	}
+
+	tallyResults = govv1.NewTallyResultFromMap(results)
+
+	// If there is no staked coins, the proposal fails
+	totalBonded, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return false, tallyResults, err
+	}
+
+	if totalBonded.IsZero() {
+		return false, tallyResults, nil
+	}
+
+	// If there is not enough quorum of staked votes, the proposal fails
+	percentVoting := stakedVotingPower.Quo(math.LegacyNewDecFromInt(totalBonded))
+	quorum, _ := math.LegacyNewDecFromStr(govParams.Quorum)
+	if percentVoting.LT(quorum) {
+		return false, tallyResults, nil
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[govv1.OptionAbstain]).IsZero() {
+		return false, tallyResults, nil
+	}
+
+	vetoThreshold, _ := math.LegacyNewDecFromStr(govParams.VetoThreshold)
+	if results[govv1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
+		return false, tallyResults, nil
+	}
+
+	thresholdStr := govParams.Threshold
+	if proposal.Expedited {
+		thresholdStr = govParams.ExpeditedThreshold
+	}
+	threshold, _ := math.LegacyNewDecFromStr(thresholdStr)
+
+	return results[govv1.OptionYes].Quo(totalVotingPower.Sub(results[govv1.OptionAbstain])).GT(threshold), tallyResults, nil
+}
+
+// lockVotingBonus returns the voting power addr gains from its active locks.
+// Each lock is weighted by the multiplier for its remaining days. Rolling locks
+// always have their full duration remaining. If addr's locks are only partly
+// covered by delegations, the bonus is scaled down to match.
+func (k Keeper) lockVotingBonus(ctx sdk.Context, params types.Params, blockDay time.Time, addr sdk.AccAddress) (math.LegacyDec, error) {
+	weighted := math.LegacyZeroDec()
+	locked := math.ZeroInt()
+
+	locks, err := k.GetLocksByAddress(ctx, addr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	for _, lock := range locks {
+		// escrowed locks are not staked, so they carry no voting power
+		if lock.IsEscrowed() || !types.IsLocked(ctx.BlockTime(), lock.UnlockDate) {
+			continue
+		}
+
+		unlockDate, err := types.ParseUnlockTime(lock.UnlockDate)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		multiplier := params.VotingPowerMultiplier(types.DurationDays(blockDay, unlockDate))
+		weighted = weighted.Add(multiplier.MulInt(lock.Amount))
+		locked = locked.Add(lock.Amount)
+	}
+
+	rollingLocks, err := k.GetRollingLocksByAddress(ctx, addr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	for _, lock := range rollingLocks {
+		multiplier := params.VotingPowerMultiplier(lock.DurationDays)
+		weighted = weighted.Add(multiplier.MulInt(lock.Amount))
+		locked = locked.Add(lock.Amount)
+	}
+
+	if !weighted.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	delegated, err := k.GetTotalDelegatedAmount(ctx, addr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if delegated.LT(locked) {
+		weighted = weighted.MulInt(*delegated).QuoInt(locked)
+	}
+
+	return weighted, nil
+}
+
+// lockHolders returns every address holding a dated or rolling lock, in store
+// order with duplicates removed.
+func (k Keeper) lockHolders(ctx sdk.Context) ([]sdk.AccAddress, error) {
+	var holders []sdk.AccAddress
+	seen := make(map[string]bool)
+
+	err := k.IterateLocksByAddress(ctx, func(addr sdk.AccAddress, _ []*types.Lock) error {
+		seen[addr.String()] = true
+		holders = append(holders, addr)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.IterateRollingLocks(ctx, func(addr sdk.AccAddress, _ uint32, _ math.Int) error {
+		if !seen[addr.String()] {
+			seen[addr.String()] = true
+			holders = append(holders, addr)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return holders, nil
+}
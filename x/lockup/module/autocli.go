@@ -12,6 +12,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 		Query: &autocliv1.ServiceCommandDescriptor{
 			Service: modulev1.Query_ServiceDesc.ServiceName,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
 				{
 					RpcMethod: "Locks",
 					Use:       "locks [address]",
@@ -20,14 +25,60 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "LockWeightedTally",
+					Use:       "lock-weighted-tally [proposal-id]",
+					Short:     "Query the informational lock-weighted tally of a proposal in its voting period",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "RewardsPool",
+					Use:       "rewards-pool",
+					Short:     "Query the balance of the lock rewards pool",
+				},
+				{
+					RpcMethod: "PendingRewards",
+					Use:       "pending-rewards [address]",
+					Short:     "Query the unclaimed rewards pool payouts of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "AccountSummary",
+					Use:       "account-summary [address]",
+					Short:     "Query the locked, delegated and sendable amounts of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "LockHistory",
+					Use:       "lock-history [address]",
+					Short:     "Query the recorded lock history of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "UnlockSchedule",
+					Use:       "unlock-schedule",
+					Short:     "Query the amounts that unlock chain-wide by day, week or month",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service:              modulev1.Msg_ServiceDesc.ServiceName,
 			EnhanceCustomCommand: true, // only required if you want to use the custom command
-			RpcCommandOptions:    []*autocliv1.RpcCommandOptions{
-				// Lock, Extend, SendDelegateAndLock, and MultiSendDelegateAndLock commands are provided by custom CLI (see cli/tx.go)
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				// Lock, Extend, SendDelegateAndLock, MultiSendDelegateAndLock, EarlyUnlock, TransferLock, SetAutoRenew, FundRewardsPool, ClaimRewards, TokenizeLock, PruneExpiredLocks, LockSchedule, SendDelegateAndLockSchedule, and DelegateAndLock commands are provided by custom CLI (see cli/tx.go)
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -1,6 +1,10 @@
 package lockup
 
 import (
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/OptioNetwork/optio/x/lockup/keeper"
@@ -8,11 +12,210 @@ import (
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
-func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {}
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
+	for _, accountLocks := range genState.AccountLocks {
+		addr, err := sdk.AccAddressFromBech32(accountLocks.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		// locks are kept sorted by unlock date, as SetLockByAddress does
+		locks := append([]*types.Lock{}, accountLocks.Locks...)
+		sort.Slice(locks, func(i, j int) bool {
+			return locks[i].UnlockDate < locks[j].UnlockDate
+		})
+
+		if err := k.SetLocksByAddress(ctx, addr, locks); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, entry := range genState.ExpirationQueue {
+		addr, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		unlockTime, err := types.ParseUnlockTime(entry.UnlockDate)
+		if err != nil {
+			panic(err)
+		}
+
+		if entry.Denom != "" {
+			err = k.AddToEscrowQueue(ctx, unlockTime, addr, sdk.NewCoin(entry.Denom, entry.Amount))
+		} else {
+			err = k.AddToExpirationQueue(ctx, unlockTime, addr, entry.Amount)
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, entry := range genState.RollingLocks {
+		addr, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.AddRollingLock(ctx, addr, entry.DurationDays, entry.Amount); err != nil {
+			panic(err)
+		}
+	}
+
+	outstanding := sdk.NewCoins()
+	for _, entry := range genState.PendingRewards {
+		addr, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetPendingRewards(ctx, addr, entry.Rewards); err != nil {
+			panic(err)
+		}
+		outstanding = outstanding.Add(entry.Rewards...)
+	}
+
+	if err := k.SetOutstandingRewards(ctx, outstanding); err != nil {
+		panic(err)
+	}
+
+	if genState.LastRewardEpoch != "" {
+		lastEpoch, err := time.Parse(time.DateOnly, genState.LastRewardEpoch)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetLastRewardEpoch(ctx, lastEpoch); err != nil {
+			panic(err)
+		}
+	}
+
+	// the tokens themselves are part of the x/nft genesis
+	for _, lockNFT := range genState.LockNfts {
+		if err := k.SetLockNFT(ctx, lockNFT); err != nil {
+			panic(err)
+		}
+	}
+
+	if genState.NextLockNftId > 0 {
+		if err := k.SetNextLockNFTID(ctx, genState.NextLockNftId); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, entry := range genState.LockHistory {
+		if err := k.SetLockHistoryEntry(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.SetNextLockHistoryID(ctx, genState.NextLockHistoryId); err != nil {
+		panic(err)
+	}
+}
 
 // ExportGenesis returns the module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	err := k.IterateLocksByAddress(ctx, func(addr sdk.AccAddress, locks []*types.Lock) error {
+		genesis.AccountLocks = append(genesis.AccountLocks, types.AccountLocks{
+			Address: addr.String(),
+			Locks:   locks,
+		})
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.IterateExpirationQueue(ctx, func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+		genesis.ExpirationQueue = append(genesis.ExpirationQueue, types.ExpirationQueueEntry{
+			Address:    addr.String(),
+			UnlockDate: types.FormatUnlockTime(unlockTime),
+			Amount:     amount,
+		})
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.IterateEscrowQueue(ctx, func(addr sdk.AccAddress, unlockTime time.Time, amount sdk.Coin) error {
+		genesis.ExpirationQueue = append(genesis.ExpirationQueue, types.ExpirationQueueEntry{
+			Address:    addr.String(),
+			UnlockDate: types.FormatUnlockTime(unlockTime),
+			Amount:     amount.Amount,
+			Denom:      amount.Denom,
+		})
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.IterateRollingLocks(ctx, func(addr sdk.AccAddress, durationDays uint32, amount math.Int) error {
+		genesis.RollingLocks = append(genesis.RollingLocks, types.RollingLockEntry{
+			Address:      addr.String(),
+			DurationDays: durationDays,
+			Amount:       amount,
+		})
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// rewards not settled yet are exported as pending, since the reward index starts over on import
+	err = k.IterateAccruedRewards(ctx, func(addr sdk.AccAddress, rewards sdk.Coins) error {
+		genesis.PendingRewards = append(genesis.PendingRewards, types.PendingRewards{
+			Address: addr.String(),
+			Rewards: rewards,
+		})
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	lastEpoch, found, err := k.GetLastRewardEpoch(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if found {
+		genesis.LastRewardEpoch = lastEpoch.Format(time.DateOnly)
+	}
+
+	err = k.IterateLockNFTs(ctx, func(lockNFT types.LockNFT) error {
+		genesis.LockNfts = append(genesis.LockNfts, lockNFT)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.NextLockNftId, err = k.GetNextLockNFTID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.IterateLockHistory(ctx, func(entry types.LockHistoryEntry) error {
+		genesis.LockHistory = append(genesis.LockHistory, entry)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.NextLockHistoryId, err = k.GetNextLockHistoryID(ctx)
+	if err != nil {
+		panic(err)
+	}
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -2,26 +2,85 @@ package lockup_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	keepertest "github.com/OptioNetwork/optio/testutil/keeper"
 	"github.com/OptioNetwork/optio/testutil/nullify"
+	"github.com/OptioNetwork/optio/testutil/sample"
 	lockup "github.com/OptioNetwork/optio/x/lockup/module"
 	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	addr := sample.AccAddress()
+
 	genesisState := types.GenesisState{
+		Params: types.NewParams(12, math.NewInt(10), 5, []string{"uOPT"}, math.LegacyNewDecWithPrec(1, 1), types.PenaltyDestination_PENALTY_DESTINATION_COMMUNITY_POOL, nil, 0, 0, 100),
+		AccountLocks: []types.AccountLocks{
+			{
+				Address: addr,
+				Locks: []*types.Lock{
+					{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+					{UnlockDate: "2027-06-15", Amount: math.NewInt(2500)},
+				},
+			},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+			{Address: addr, UnlockDate: "2027-06-15", Amount: math.NewInt(2500)},
+		},
+		RollingLocks: []types.RollingLockEntry{
+			{Address: addr, DurationDays: 730, Amount: math.NewInt(500)},
+		},
+		PendingRewards: []types.PendingRewards{
+			{Address: addr, Rewards: sdk.NewCoins(sdk.NewInt64Coin("uOPT", 42))},
+		},
+		LastRewardEpoch: "2026-01-01",
+		LockNfts: []types.LockNFT{
+			{Id: "lock-3", Owner: addr, UnlockDate: "2027-06-15"},
+		},
+		NextLockNftId: 4,
+		LockHistory: []types.LockHistoryEntry{
+			{
+				Id:         6,
+				Address:    addr,
+				Action:     types.LockHistoryAction_LOCK_HISTORY_ACTION_LOCK,
+				UnlockDate: "2027-06-15",
+				Amount:     math.NewInt(2500),
+				Height:     12,
+				Time:       time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+		},
+		NextLockHistoryId: 7,
 		// this line is used by starport scaffolding # genesis/test/state
 	}
+	require.NoError(t, genesisState.Validate())
 
 	k, ctx := keepertest.LockupKeeper(t)
 	lockup.InitGenesis(ctx, k, genesisState)
 	got := lockup.ExportGenesis(ctx, k)
 	require.NotNil(t, got)
+	require.NoError(t, got.Validate())
+
+	totalLocked, err := k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(4000), totalLocked)
 
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.Equal(t, genesisState.Params, got.Params)
+	require.Equal(t, genesisState.AccountLocks, got.AccountLocks)
+	require.Equal(t, genesisState.ExpirationQueue, got.ExpirationQueue)
+	require.Equal(t, genesisState.RollingLocks, got.RollingLocks)
+	require.Equal(t, genesisState.PendingRewards, got.PendingRewards)
+	require.Equal(t, genesisState.LastRewardEpoch, got.LastRewardEpoch)
+	require.Equal(t, genesisState.LockNfts, got.LockNfts)
+	require.Equal(t, genesisState.NextLockNftId, got.NextLockNftId)
+	require.Equal(t, genesisState.LockHistory, got.LockHistory)
+	require.Equal(t, genesisState.NextLockHistoryId, got.NextLockHistoryId)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -15,7 +15,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
@@ -129,10 +131,23 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the module's genesis initialization. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
@@ -152,7 +167,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
@@ -161,9 +176,25 @@ func (am AppModule) BeginBlock(_ context.Context) error {
 }
 
 // EndBlock contains the logic that is automatically triggered at the end of each block.
-// The end block implementation is optional.
-func (am AppModule) EndBlock(_ context.Context) error {
-	return nil
+// It first reduces the locks that slashing left above their delegations and settles lock position
+// tokens sent outside of the post handler, then removes every lock that has expired and emits a
+// lock_expired event for each of them, then pays out the rewards pool if a reward epoch has ended
+// and prunes lock history past its retention.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := am.keeper.ReconcileQueuedLocks(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.SettleLockNFTOwners(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.RemoveExpiredLocks(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.DistributeRewards(sdkCtx); err != nil {
+		return err
+	}
+	return am.keeper.PruneExpiredLockHistory(sdkCtx)
 }
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -194,6 +225,9 @@ type ModuleInputs struct {
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
 	StakingKeeper types.StakingKeeper
+	DistrKeeper   types.DistributionKeeper
+	GovKeeper     *govkeeper.Keeper
+	NFTKeeper     types.NFTKeeper
 }
 
 type ModuleOutputs struct {
@@ -201,6 +235,7 @@ type ModuleOutputs struct {
 
 	LockupKeeper keeper.Keeper
 	Module       appmodule.AppModule
+	StakingHooks stakingtypes.StakingHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -217,7 +252,13 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.BankKeeper,
 		in.AccountKeeper,
 		in.StakingKeeper,
+		in.DistrKeeper,
+		govkeeper.NewQueryServer(in.GovKeeper),
+		in.NFTKeeper,
 	)
+	// locked balances are enforced on every bank send rather than per message type
+	in.BankKeeper.AppendSendRestriction(k.SendRestrictionFn)
+
 	m := NewAppModule(
 		in.Cdc,
 		k,
@@ -226,5 +267,5 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.StakingKeeper,
 	)
 
-	return ModuleOutputs{LockupKeeper: k, Module: m}
+	return ModuleOutputs{LockupKeeper: k, Module: m, StakingHooks: stakingtypes.StakingHooksWrapper{StakingHooks: k.Hooks()}}
 }
@@ -1,11 +1,8 @@
 package post
 
 import (
-	"time"
-
 	"github.com/OptioNetwork/optio/x/lockup/keeper"
 
-	lockuptypes "github.com/OptioNetwork/optio/x/lockup/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
@@ -37,39 +34,9 @@ func (d RemoveExpiredLocksDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simu
 		return next(ctx, tx, simulate, success)
 	}
 
-	addr := sdk.AccAddress(feePayer)
-	locks, err := d.lockupKeeper.GetLocksByAddress(ctx, addr)
-	if err != nil {
+	if err := d.lockupKeeper.RemoveExpiredLocksByAddress(ctx, sdk.AccAddress(feePayer)); err != nil {
 		return ctx, err
 	}
 
-	blockTime := ctx.BlockTime()
-	blockDate := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
-
-	updated := false
-	// Iterate backwards to safely delete while iterating
-	for i := len(locks) - 1; i >= 0; i-- {
-		lock := locks[i]
-		unlockTime, err := time.Parse(time.DateOnly, lock.UnlockDate)
-		if err != nil {
-			continue
-		}
-
-		if !lockuptypes.IsLocked(blockDate, lock.UnlockDate) {
-			if err := d.lockupKeeper.RemoveFromExpirationQueue(ctx, unlockTime, addr, lock.Amount); err != nil {
-				return ctx, err
-			}
-
-			locks = append(locks[:i], locks[i+1:]...)
-			updated = true
-		}
-	}
-
-	if updated {
-		if err := d.lockupKeeper.SetLocksByAddress(ctx, addr, locks); err != nil {
-			return ctx, err
-		}
-	}
-
 	return next(ctx, tx, simulate, success)
 }
@@ -0,0 +1,68 @@
+package post
+
+import (
+	"github.com/OptioNetwork/optio/x/lockup/keeper"
+	"github.com/OptioNetwork/optio/x/lockup/types"
+
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// SettleLockNFTTransfersDecorator moves the lock and delegation behind every
+// lock position token sent in the tx to the token's new owner. x/nft has no
+// transfer hooks, so transfers are settled here, in the same state as the
+// messages: a failed settlement fails the tx. Sends this decorator cannot see,
+// such as those executed by x/group or interchain accounts, are settled by the
+// lockup end blocker.
+type SettleLockNFTTransfersDecorator struct {
+	lockupKeeper keeper.Keeper
+}
+
+func NewSettleLockNFTTransfersDecorator(lockupKeeper keeper.Keeper) SettleLockNFTTransfersDecorator {
+	return SettleLockNFTTransfersDecorator{
+		lockupKeeper: lockupKeeper,
+	}
+}
+
+func (d SettleLockNFTTransfersDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	if !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	for _, id := range lockNFTSends(tx.GetMsgs()) {
+		if err := d.lockupKeeper.SettleLockNFTTransfer(ctx, id); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// lockNFTSends returns the ids of the lock position tokens sent by msgs,
+// including sends wrapped in authz MsgExec, without duplicates
+func lockNFTSends(msgs []sdk.Msg) []string {
+	var ids []string
+	seen := make(map[string]bool)
+
+	var collect func(msgs []sdk.Msg)
+	collect = func(msgs []sdk.Msg) {
+		for _, msg := range msgs {
+			switch msg := msg.(type) {
+			case *nft.MsgSend:
+				if msg.ClassId == types.LockNFTClassID && !seen[msg.Id] {
+					seen[msg.Id] = true
+					ids = append(ids, msg.Id)
+				}
+			case *authz.MsgExec:
+				inner, err := msg.GetMessages()
+				if err == nil {
+					collect(inner)
+				}
+			}
+		}
+	}
+	collect(msgs)
+
+	return ids
+}
@@ -0,0 +1,273 @@
+package types
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// gasCostPerIteration is the gas charged per allowed validator checked, as x/staking does
+const gasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = &LockAuthorization{}
+
+func NewLockAuthorization(authorizationType LockAuthorizationType, spendLimit *sdk.Coin, maxUnlockDate string, allowedValidators, allowedRecipients []string) *LockAuthorization {
+	return &LockAuthorization{
+		SpendLimit:        spendLimit,
+		MaxUnlockDate:     maxUnlockDate,
+		AllowedValidators: allowedValidators,
+		AuthorizationType: authorizationType,
+		AllowedRecipients: allowedRecipients,
+	}
+}
+
+// MsgTypeURL returns the type URL of the message granted by the authorization
+func (a LockAuthorization) MsgTypeURL() string {
+	switch a.AuthorizationType {
+	case LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_LOCK:
+		return sdk.MsgTypeURL(&MsgLock{})
+	case LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_EXTEND:
+		return sdk.MsgTypeURL(&MsgExtend{})
+	case LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK:
+		return sdk.MsgTypeURL(&MsgSendDelegateAndLock{})
+	case LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_LOCK_SCHEDULE:
+		return sdk.MsgTypeURL(&MsgLockSchedule{})
+	case LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK_SCHEDULE:
+		return sdk.MsgTypeURL(&MsgSendDelegateAndLockSchedule{})
+	case LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_DELEGATE_AND_LOCK:
+		return sdk.MsgTypeURL(&MsgDelegateAndLock{})
+	default:
+		return ""
+	}
+}
+
+func (a LockAuthorization) ValidateBasic() error {
+	if a.MsgTypeURL() == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "invalid authorization type: %s", a.AuthorizationType)
+	}
+
+	if a.SpendLimit != nil && (!a.SpendLimit.IsValid() || !a.SpendLimit.IsPositive()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit: %s", a.SpendLimit)
+	}
+
+	if a.MaxUnlockDate != "" {
+		if _, err := ParseUnlockTime(a.MaxUnlockDate); err != nil {
+			return errorsmod.Wrapf(ErrInvalidDate, "invalid max unlock date: %s", err)
+		}
+	}
+
+	if len(a.AllowedValidators) > 0 && !a.delegatesTokens() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "allowed validators only apply to delegate and lock authorizations")
+	}
+
+	seen := make(map[string]bool, len(a.AllowedValidators))
+	for _, validator := range a.AllowedValidators {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %s (%s)", validator, err)
+		}
+
+		if seen[validator] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate validator address %s", validator)
+		}
+		seen[validator] = true
+	}
+
+	if len(a.AllowedRecipients) > 0 && !a.sendsTokens() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "allowed recipients only apply to send delegate and lock authorizations")
+	}
+
+	seen = make(map[string]bool, len(a.AllowedRecipients))
+	for _, recipient := range a.AllowedRecipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %s (%s)", recipient, err)
+		}
+
+		if seen[recipient] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate recipient address %s", recipient)
+		}
+		seen[recipient] = true
+	}
+
+	return nil
+}
+
+// Accept checks that msg is within the limits of the authorization and returns
+// the authorization with its spend limit reduced by the amount of msg
+func (a LockAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.MsgTypeURL() {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("authorization does not grant %s", sdk.MsgTypeURL(msg))
+	}
+
+	var (
+		amounts     []sdk.Coin
+		unlockDates []string
+	)
+
+	switch msg := msg.(type) {
+	case *MsgLock:
+		// Auto-renewing locks never reach an unlock date
+		if msg.AutoRenew && a.MaxUnlockDate != "" {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("cannot auto-renew a lock with a max unlock date")
+		}
+		amounts = []sdk.Coin{msg.Amount}
+		unlockDates = []string{msg.UnlockDate}
+	case *MsgExtend:
+		for _, extension := range msg.Extensions {
+			if extension == nil {
+				return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("extension cannot be nil")
+			}
+			amounts = append(amounts, extension.Amount)
+			unlockDates = append(unlockDates, extension.ToDate)
+		}
+	case *MsgSendDelegateAndLock:
+		if err := a.acceptValidator(ctx, msg.ValidatorAddress); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		if err := a.acceptRecipient(ctx, msg.FromAddress, msg.ToAddress); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		amounts = []sdk.Coin{msg.Amount}
+		unlockDates = []string{msg.UnlockDate}
+	case *MsgLockSchedule:
+		dates, err := scheduleUnlockDates(msg.Schedule, msg.TotalAmount)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		amounts = []sdk.Coin{msg.TotalAmount}
+		unlockDates = dates
+	case *MsgSendDelegateAndLockSchedule:
+		if err := a.acceptValidator(ctx, msg.ValidatorAddress); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		if err := a.acceptRecipient(ctx, msg.FromAddress, msg.ToAddress); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		dates, err := scheduleUnlockDates(msg.Schedule, msg.TotalAmount)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		amounts = []sdk.Coin{msg.TotalAmount}
+		unlockDates = dates
+	case *MsgDelegateAndLock:
+		for _, validator := range msg.Validators {
+			if err := a.acceptValidator(ctx, validator.ValidatorAddress); err != nil {
+				return authz.AcceptResponse{}, err
+			}
+		}
+		amounts = []sdk.Coin{msg.Amount}
+		unlockDates = []string{msg.UnlockDate}
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
+	}
+
+	if a.MaxUnlockDate != "" {
+		maxUnlockTime, err := ParseUnlockTime(a.MaxUnlockDate)
+		if err != nil {
+			return authz.AcceptResponse{}, ErrInvalidDate.Wrapf("invalid max unlock date: %s", err)
+		}
+
+		for _, unlockDate := range unlockDates {
+			unlockTime, err := ParseUnlockTime(unlockDate)
+			if err != nil {
+				return authz.AcceptResponse{}, ErrInvalidDate.Wrapf("invalid unlock date: %s", err)
+			}
+
+			if unlockTime.After(maxUnlockTime) {
+				return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("unlock date %s is after the max unlock date %s", unlockDate, a.MaxUnlockDate)
+			}
+		}
+	}
+
+	if a.SpendLimit == nil {
+		return authz.AcceptResponse{Accept: true, Updated: &a}, nil
+	}
+
+	limitLeft := *a.SpendLimit
+	for _, amount := range amounts {
+		if amount.Denom != limitLeft.Denom {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidCoins.Wrapf("expected %s, got %s", limitLeft.Denom, amount.Denom)
+		}
+
+		if amount.Amount.GT(limitLeft.Amount) {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("spend limit exceeded: %s remaining", limitLeft)
+		}
+		limitLeft = limitLeft.Sub(amount)
+	}
+
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	updated := a
+	updated.SpendLimit = &limitLeft
+
+	return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// sendsTokens reports whether the granted message sends the granter's tokens
+// to a recipient, who delegates and locks them
+func (a LockAuthorization) sendsTokens() bool {
+	return a.AuthorizationType == LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK ||
+		a.AuthorizationType == LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK_SCHEDULE
+}
+
+// delegatesTokens reports whether the granted message delegates the locked
+// tokens to validators of the grantee's choice
+func (a LockAuthorization) delegatesTokens() bool {
+	return a.sendsTokens() || a.AuthorizationType == LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_DELEGATE_AND_LOCK
+}
+
+// scheduleUnlockDates returns the unlock dates of the locks schedule splits totalAmount into
+func scheduleUnlockDates(schedule LockSchedule, totalAmount sdk.Coin) ([]string, error) {
+	locks, err := schedule.Locks(totalAmount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	unlockDates := make([]string, len(locks))
+	for i, lock := range locks {
+		unlockDates[i] = lock.UnlockDate
+	}
+	return unlockDates, nil
+}
+
+// acceptValidator checks that validatorAddress is an allowed validator
+func (a LockAuthorization) acceptValidator(ctx context.Context, validatorAddress string) error {
+	if len(a.AllowedValidators) == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, validator := range a.AllowedValidators {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "lock authorization")
+		if validator == validatorAddress {
+			return nil
+		}
+	}
+
+	return sdkerrors.ErrUnauthorized.Wrapf("cannot delegate to validator %s", validatorAddress)
+}
+
+// acceptRecipient checks that toAddress is an allowed recipient. Without
+// allowed recipients only the granter, fromAddress, is allowed.
+func (a LockAuthorization) acceptRecipient(ctx context.Context, fromAddress, toAddress string) error {
+	if len(a.AllowedRecipients) == 0 {
+		if toAddress != fromAddress {
+			return sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s, only to the granter", toAddress)
+		}
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, recipient := range a.AllowedRecipients {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "lock authorization")
+		if recipient == toAddress {
+			return nil
+		}
+	}
+
+	return sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s", toAddress)
+}
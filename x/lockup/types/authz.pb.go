@@ -0,0 +1,611 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/lockup/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LockAuthorizationType defines the lockup message a LockAuthorization grants.
+type LockAuthorizationType int32
+
+const (
+	// LOCK_AUTHORIZATION_TYPE_UNSPECIFIED is not a valid authorization type.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_UNSPECIFIED LockAuthorizationType = 0
+	// LOCK_AUTHORIZATION_TYPE_LOCK grants MsgLock.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_LOCK LockAuthorizationType = 1
+	// LOCK_AUTHORIZATION_TYPE_EXTEND grants MsgExtend.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_EXTEND LockAuthorizationType = 2
+	// LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK grants MsgSendDelegateAndLock.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK LockAuthorizationType = 3
+	// LOCK_AUTHORIZATION_TYPE_LOCK_SCHEDULE grants MsgLockSchedule.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_LOCK_SCHEDULE LockAuthorizationType = 4
+	// LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK_SCHEDULE grants MsgSendDelegateAndLockSchedule.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK_SCHEDULE LockAuthorizationType = 5
+	// LOCK_AUTHORIZATION_TYPE_DELEGATE_AND_LOCK grants MsgDelegateAndLock.
+	LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_DELEGATE_AND_LOCK LockAuthorizationType = 6
+)
+
+var LockAuthorizationType_name = map[int32]string{
+	0: "LOCK_AUTHORIZATION_TYPE_UNSPECIFIED",
+	1: "LOCK_AUTHORIZATION_TYPE_LOCK",
+	2: "LOCK_AUTHORIZATION_TYPE_EXTEND",
+	3: "LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK",
+	4: "LOCK_AUTHORIZATION_TYPE_LOCK_SCHEDULE",
+	5: "LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK_SCHEDULE",
+	6: "LOCK_AUTHORIZATION_TYPE_DELEGATE_AND_LOCK",
+}
+
+var LockAuthorizationType_value = map[string]int32{
+	"LOCK_AUTHORIZATION_TYPE_UNSPECIFIED":                     0,
+	"LOCK_AUTHORIZATION_TYPE_LOCK":                            1,
+	"LOCK_AUTHORIZATION_TYPE_EXTEND":                          2,
+	"LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK":          3,
+	"LOCK_AUTHORIZATION_TYPE_LOCK_SCHEDULE":                   4,
+	"LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK_SCHEDULE": 5,
+	"LOCK_AUTHORIZATION_TYPE_DELEGATE_AND_LOCK":               6,
+}
+
+func (x LockAuthorizationType) String() string {
+	return proto.EnumName(LockAuthorizationType_name, int32(x))
+}
+
+func (LockAuthorizationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a96f67c27407092c, []int{0}
+}
+
+// LockAuthorization allows the grantee to lock, extend, delegate and lock, or
+// send, delegate and lock the granter's tokens, at once or on a schedule,
+// within the given limits.
+type LockAuthorization struct {
+	// spend_limit is the amount the grantee can still lock or extend. It is
+	// decremented by every accepted message, and the grant is removed once it is
+	// spent. There is no limit when it is not set.
+	SpendLimit *types.Coin `protobuf:"bytes,1,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	// max_unlock_date is the latest unlock date the grantee can lock or extend
+	// to. There is no limit when it is empty.
+	MaxUnlockDate string `protobuf:"bytes,2,opt,name=max_unlock_date,json=maxUnlockDate,proto3" json:"max_unlock_date,omitempty"`
+	// allowed_validators are the validators the grantee can delegate to with
+	// MsgSendDelegateAndLock, MsgSendDelegateAndLockSchedule and
+	// MsgDelegateAndLock. Any validator is allowed when it is empty.
+	AllowedValidators []string `protobuf:"bytes,3,rep,name=allowed_validators,json=allowedValidators,proto3" json:"allowed_validators,omitempty"`
+	// authorization_type is the message the grantee is allowed to execute.
+	AuthorizationType LockAuthorizationType `protobuf:"varint,4,opt,name=authorization_type,json=authorizationType,proto3,enum=optio.lockup.LockAuthorizationType" json:"authorization_type,omitempty"`
+	// allowed_recipients are the addresses the grantee can send, delegate and
+	// lock the granter's tokens for with MsgSendDelegateAndLock and
+	// MsgSendDelegateAndLockSchedule. Only the granter is allowed when it is empty.
+	AllowedRecipients []string `protobuf:"bytes,5,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+}
+
+func (m *LockAuthorization) Reset()         { *m = LockAuthorization{} }
+func (m *LockAuthorization) String() string { return proto.CompactTextString(m) }
+func (*LockAuthorization) ProtoMessage()    {}
+func (*LockAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96f67c27407092c, []int{0}
+}
+func (m *LockAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockAuthorization.Merge(m, src)
+}
+func (m *LockAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockAuthorization proto.InternalMessageInfo
+
+func (m *LockAuthorization) GetSpendLimit() *types.Coin {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *LockAuthorization) GetMaxUnlockDate() string {
+	if m != nil {
+		return m.MaxUnlockDate
+	}
+	return ""
+}
+
+func (m *LockAuthorization) GetAllowedValidators() []string {
+	if m != nil {
+		return m.AllowedValidators
+	}
+	return nil
+}
+
+func (m *LockAuthorization) GetAuthorizationType() LockAuthorizationType {
+	if m != nil {
+		return m.AuthorizationType
+	}
+	return LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_UNSPECIFIED
+}
+
+func (m *LockAuthorization) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("optio.lockup.LockAuthorizationType", LockAuthorizationType_name, LockAuthorizationType_value)
+	proto.RegisterType((*LockAuthorization)(nil), "optio.lockup.LockAuthorization")
+}
+
+func init() { proto.RegisterFile("optio/lockup/authz.proto", fileDescriptor_a96f67c27407092c) }
+
+var fileDescriptor_a96f67c27407092c = []byte{
+	// 546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x93, 0xb6, 0x52, 0xa7, 0x3c, 0x92, 0x11, 0x48, 0x6e, 0x05, 0x56, 0x48, 0x05, 0xa4,
+	0x15, 0xb1, 0xd5, 0xb0, 0x40, 0x2a, 0x2b, 0x37, 0x76, 0xdb, 0x88, 0xc8, 0x89, 0x1c, 0x07, 0x41,
+	0x37, 0xa3, 0x89, 0x3d, 0x6a, 0x46, 0x89, 0x3d, 0x96, 0x3d, 0x69, 0xd3, 0x7e, 0x02, 0x2b, 0x3e,
+	0x05, 0xa4, 0x7c, 0x04, 0x62, 0x55, 0xba, 0x62, 0x89, 0x92, 0x05, 0xbf, 0x81, 0xfc, 0x48, 0x1f,
+	0xa4, 0x41, 0x62, 0x63, 0xe9, 0xde, 0x73, 0xee, 0x99, 0x73, 0xe7, 0x8c, 0x81, 0xc8, 0x7c, 0x4e,
+	0x99, 0x32, 0x60, 0x76, 0x7f, 0xe8, 0x2b, 0x78, 0xc8, 0x7b, 0xe7, 0xb2, 0x1f, 0x30, 0xce, 0xe0,
+	0xbd, 0x18, 0x91, 0x13, 0x64, 0xa3, 0x80, 0x5d, 0xea, 0x31, 0x25, 0xfe, 0x26, 0x84, 0x8d, 0x75,
+	0x9b, 0x85, 0x2e, 0x0b, 0x51, 0x5c, 0x29, 0x49, 0x91, 0x42, 0x52, 0x52, 0x29, 0x5d, 0x1c, 0x12,
+	0xe5, 0x64, 0xa7, 0x4b, 0x38, 0xde, 0x51, 0x6c, 0x46, 0xbd, 0x04, 0x2f, 0x7d, 0xcd, 0x81, 0x42,
+	0x83, 0xd9, 0x7d, 0x75, 0xc8, 0x7b, 0x2c, 0xa0, 0xe7, 0x98, 0x53, 0xe6, 0xc1, 0x5d, 0xb0, 0x16,
+	0xfa, 0xc4, 0x73, 0xd0, 0x80, 0xba, 0x94, 0x8b, 0x42, 0x51, 0x28, 0xaf, 0x55, 0xd7, 0xe5, 0x54,
+	0x39, 0xd2, 0x92, 0x53, 0x2d, 0xb9, 0xc6, 0xa8, 0x67, 0x82, 0x98, 0xdd, 0x88, 0xc8, 0xf0, 0x05,
+	0x78, 0xe8, 0xe2, 0x11, 0x1a, 0x7a, 0x91, 0x5f, 0xe4, 0x60, 0x4e, 0xc4, 0x6c, 0x51, 0x28, 0xaf,
+	0x9a, 0xf7, 0x5d, 0x3c, 0xea, 0xc4, 0x5d, 0x0d, 0x73, 0x02, 0x5b, 0x00, 0xe2, 0xc1, 0x80, 0x9d,
+	0x12, 0x07, 0x9d, 0xe0, 0x01, 0x75, 0x30, 0x67, 0x41, 0x28, 0xe6, 0x8a, 0xb9, 0xf2, 0xea, 0xde,
+	0xb3, 0xcb, 0x71, 0xe5, 0x69, 0x7a, 0xda, 0xfb, 0x19, 0xa8, 0x3a, 0x4e, 0x40, 0xc2, 0xb0, 0xcd,
+	0x03, 0xea, 0x1d, 0x9b, 0x85, 0x74, 0xf8, 0x0a, 0x0e, 0xa1, 0x09, 0x20, 0xbe, 0xb9, 0x06, 0xe2,
+	0x67, 0x3e, 0x11, 0x97, 0x8a, 0x42, 0xf9, 0x41, 0x75, 0x53, 0xbe, 0x79, 0x89, 0xf2, 0xdc, 0xca,
+	0xd6, 0x99, 0x4f, 0xcc, 0x02, 0xfe, 0xbb, 0x05, 0x0f, 0xae, 0x5d, 0x06, 0xc4, 0xa6, 0x3e, 0x25,
+	0x1e, 0x0f, 0xc5, 0xe5, 0xd8, 0xa5, 0x78, 0x39, 0xae, 0x3c, 0x4a, 0x5d, 0xde, 0x6d, 0xce, 0xbc,
+	0x1a, 0xd9, 0xad, 0x7f, 0x1f, 0x57, 0x4a, 0x29, 0x39, 0x09, 0x77, 0x76, 0x83, 0xb7, 0x7c, 0x7c,
+	0xfa, 0xfd, 0x65, 0x5b, 0xba, 0xf5, 0x12, 0xe6, 0xac, 0x6e, 0xff, 0xc8, 0x82, 0xc7, 0x77, 0x2e,
+	0x00, 0x5f, 0x82, 0xcd, 0x46, 0xb3, 0xf6, 0x0e, 0xa9, 0x1d, 0xeb, 0xb0, 0x69, 0xd6, 0x8f, 0x54,
+	0xab, 0xde, 0x34, 0x90, 0xf5, 0xb1, 0xa5, 0xa3, 0x8e, 0xd1, 0x6e, 0xe9, 0xb5, 0xfa, 0x7e, 0x5d,
+	0xd7, 0xf2, 0x19, 0x58, 0x04, 0x4f, 0x16, 0x11, 0xa3, 0x7e, 0x5e, 0x80, 0x25, 0x20, 0x2d, 0x62,
+	0xe8, 0x1f, 0x2c, 0xdd, 0xd0, 0xf2, 0x59, 0x58, 0x05, 0xf2, 0x22, 0x4e, 0x5b, 0x37, 0x34, 0xa4,
+	0xe9, 0x0d, 0xfd, 0x40, 0xb5, 0x74, 0xa4, 0x1a, 0x5a, 0xa2, 0x9b, 0x83, 0x5b, 0xe0, 0xf9, 0xbf,
+	0x4e, 0x46, 0xed, 0xda, 0xa1, 0xae, 0x75, 0x1a, 0x7a, 0x7e, 0x09, 0xbe, 0x05, 0x6f, 0xfe, 0x4f,
+	0xfe, 0x7a, 0x78, 0x19, 0x56, 0xc0, 0xd6, 0xa2, 0xe1, 0x79, 0x5b, 0x2b, 0x7b, 0xfb, 0xdf, 0x26,
+	0x92, 0x70, 0x31, 0x91, 0x84, 0x5f, 0x13, 0x49, 0xf8, 0x3c, 0x95, 0x32, 0x17, 0x53, 0x29, 0xf3,
+	0x73, 0x2a, 0x65, 0x8e, 0x5e, 0x1d, 0x53, 0xde, 0x1b, 0x76, 0x65, 0x9b, 0xb9, 0x4a, 0x33, 0x0a,
+	0xc6, 0x20, 0xfc, 0x94, 0x05, 0x7d, 0x25, 0x49, 0x69, 0x34, 0xcb, 0x29, 0x7a, 0x6c, 0x61, 0x77,
+	0x25, 0xfe, 0xad, 0x5e, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x01, 0xa8, 0x4d, 0x65, 0xce, 0x03,
+	0x00, 0x00,
+}
+
+func (m *LockAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.AuthorizationType != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.AuthorizationType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllowedValidators) > 0 {
+		for iNdEx := len(m.AllowedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValidators[iNdEx])
+			copy(dAtA[i:], m.AllowedValidators[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxUnlockDate) > 0 {
+		i -= len(m.MaxUnlockDate)
+		copy(dAtA[i:], m.MaxUnlockDate)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MaxUnlockDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpendLimit != nil {
+		{
+			size, err := m.SpendLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LockAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpendLimit != nil {
+		l = m.SpendLimit.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.MaxUnlockDate)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.AllowedValidators) > 0 {
+		for _, s := range m.AllowedValidators {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.AuthorizationType != 0 {
+		n += 1 + sovAuthz(uint64(m.AuthorizationType))
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LockAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpendLimit == nil {
+				m.SpendLimit = &types.Coin{}
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxUnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValidators = append(m.AllowedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationType", wireType)
+			}
+			m.AuthorizationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthorizationType |= LockAuthorizationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
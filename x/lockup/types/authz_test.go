@@ -0,0 +1,240 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockAuthorization_ValidateBasic(t *testing.T) {
+	valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	recipient := sample.AccAddress()
+	limit := sdk.NewCoin(bondDenom, math.NewInt(1000))
+	zero := sdk.NewCoin(bondDenom, math.ZeroInt())
+
+	tests := []struct {
+		name          string
+		authorization *LockAuthorization
+		err           error
+	}{
+		{
+			name:          "unspecified type",
+			authorization: NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_UNSPECIFIED, nil, "", nil, nil),
+			err:           sdkerrors.ErrInvalidType,
+		}, {
+			name:          "zero spend limit",
+			authorization: NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_LOCK, &zero, "", nil, nil),
+			err:           sdkerrors.ErrInvalidCoins,
+		}, {
+			name:          "invalid max unlock date",
+			authorization: NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_LOCK, nil, "12/01/2026", nil, nil),
+			err:           ErrInvalidDate,
+		}, {
+			name:          "validators for lock",
+			authorization: NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_LOCK, nil, "", []string{valAddr}, nil),
+			err:           sdkerrors.ErrInvalidRequest,
+		}, {
+			name:          "invalid validator",
+			authorization: NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK, nil, "", []string{"invalid"}, nil),
+			err:           sdkerrors.ErrInvalidAddress,
+		}, {
+			name:          "duplicate validator",
+			authorization: NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK, nil, "", []string{valAddr, valAddr}, nil),
+			err:           sdkerrors.ErrInvalidRequest,
+		}, {
+			name:          "recipients for extend",
+			authorization: NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_EXTEND, nil, "", nil, []string{recipient}),
+			err:           sdkerrors.ErrInvalidRequest,
+		}, {
+			name:          "invalid recipient",
+			authorization: NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK, nil, "", nil, []string{"invalid"}),
+			err:           sdkerrors.ErrInvalidAddress,
+		}, {
+			name:          "duplicate recipient",
+			authorization: NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK, nil, "", nil, []string{recipient, recipient}),
+			err:           sdkerrors.ErrInvalidRequest,
+		}, {
+			name:          "valid",
+			authorization: NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK, &limit, "2027-01-01", []string{valAddr}, []string{recipient}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.authorization.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLockAuthorization_Accept(t *testing.T) {
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey(StoreKey), storetypes.NewTransientStoreKey("transient_test"))
+
+	granter := sample.AccAddress()
+	valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	otherValAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	coin := func(amount int64) sdk.Coin { return sdk.NewCoin(bondDenom, math.NewInt(amount)) }
+
+	limit := coin(1000)
+	authorization := NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_LOCK, &limit, "2027-01-01", nil, nil)
+	require.Equal(t, "/optio.lockup.MsgLock", authorization.MsgTypeURL())
+
+	// the spend limit is decremented by each accepted lock
+	res, err := authorization.Accept(ctx, &MsgLock{Address: granter, UnlockDate: "2026-12-01", Amount: coin(400)})
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	updated := res.Updated.(*LockAuthorization)
+	require.Equal(t, coin(600), *updated.SpendLimit)
+	require.Equal(t, coin(1000), *authorization.SpendLimit)
+
+	_, err = updated.Accept(ctx, &MsgLock{Address: granter, UnlockDate: "2026-12-01", Amount: coin(601)})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	_, err = updated.Accept(ctx, &MsgLock{Address: granter, UnlockDate: "2027-01-01T00:00:01Z", Amount: coin(100)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = updated.Accept(ctx, &MsgLock{Address: granter, UnlockDate: "2026-12-01", Amount: coin(100), AutoRenew: true})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = updated.Accept(ctx, &MsgLock{Address: granter, UnlockDate: "2026-12-01", Amount: sdk.NewCoin("stake", math.NewInt(100))})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	_, err = updated.Accept(ctx, NewMsgExtend(granter, []*Extension{{FromDate: "2026-12-01", ToDate: "2027-01-01", Amount: coin(100)}}))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	// the grant is removed once the spend limit is used up
+	res, err = updated.Accept(ctx, &MsgLock{Address: granter, UnlockDate: "2027-01-01", Amount: coin(600)})
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	// extensions count every extended amount against the limit
+	authorization = NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_EXTEND, &limit, "", nil, nil)
+	res, err = authorization.Accept(ctx, NewMsgExtend(granter, []*Extension{
+		{FromDate: "2026-06-01", ToDate: "2026-12-01", Amount: coin(300)},
+		{FromDate: "2026-07-01", ToDate: "2028-12-01", Amount: coin(200)},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, coin(500), *res.Updated.(*LockAuthorization).SpendLimit)
+
+	// without a spend limit any amount is accepted
+	recipient := sample.AccAddress()
+	authorization = NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK, nil, "", []string{valAddr}, []string{recipient})
+	msg := NewMsgSendDelegateAndLock(granter, recipient, valAddr, coin(1_000_000), "2030-01-01")
+	res, err = authorization.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Equal(t, authorization, res.Updated)
+
+	msg.ValidatorAddress = otherValAddr
+	_, err = authorization.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// only the allowed recipients can receive the tokens
+	msg = NewMsgSendDelegateAndLock(granter, sample.AccAddress(), valAddr, coin(100), "2030-01-01")
+	_, err = authorization.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestLockAuthorization_AcceptDefaultsToGranter(t *testing.T) {
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey(StoreKey), storetypes.NewTransientStoreKey("transient_test"))
+
+	granter := sample.AccAddress()
+	valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	coin := sdk.NewCoin(bondDenom, math.NewInt(100))
+
+	// without allowed recipients the grantee can only lock the tokens for the granter
+	authorization := NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK, nil, "", nil, nil)
+
+	res, err := authorization.Accept(ctx, NewMsgSendDelegateAndLock(granter, granter, valAddr, coin, "2030-01-01"))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+
+	_, err = authorization.Accept(ctx, NewMsgSendDelegateAndLock(granter, sample.AccAddress(), valAddr, coin, "2030-01-01"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestLockAuthorization_AcceptSchedule(t *testing.T) {
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey(StoreKey), storetypes.NewTransientStoreKey("transient_test"))
+
+	granter := sample.AccAddress()
+	valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	coin := func(amount int64) sdk.Coin { return sdk.NewCoin(bondDenom, math.NewInt(amount)) }
+	schedule := NewLockSchedule("2026-09-01", LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_MONTHLY, 4, 0)
+
+	limit := coin(1000)
+	authorization := NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_LOCK_SCHEDULE, &limit, "2027-01-01", nil, nil)
+	require.Equal(t, "/optio.lockup.MsgLockSchedule", authorization.MsgTypeURL())
+
+	// the whole amount counts against the limit
+	res, err := authorization.Accept(ctx, NewMsgLockSchedule(granter, coin(400), schedule))
+	require.NoError(t, err)
+	require.Equal(t, coin(600), *res.Updated.(*LockAuthorization).SpendLimit)
+
+	// every lock of the schedule has to unlock by the max unlock date
+	longer := NewLockSchedule("2026-09-01", LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_MONTHLY, 5, 0)
+	_, err = authorization.Accept(ctx, NewMsgLockSchedule(granter, coin(400), longer))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = authorization.Accept(ctx, NewMsgLockSchedule(granter, coin(400), LockSchedule{}))
+	require.Error(t, err)
+
+	recipient := sample.AccAddress()
+	authorization = NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_SEND_DELEGATE_AND_LOCK_SCHEDULE, &limit, "", []string{valAddr}, []string{recipient})
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "/optio.lockup.MsgSendDelegateAndLockSchedule", authorization.MsgTypeURL())
+
+	res, err = authorization.Accept(ctx, NewMsgSendDelegateAndLockSchedule(granter, recipient, valAddr, coin(1000), schedule))
+	require.NoError(t, err)
+	require.True(t, res.Delete)
+
+	_, err = authorization.Accept(ctx, NewMsgSendDelegateAndLockSchedule(granter, sample.AccAddress(), valAddr, coin(100), schedule))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	otherValAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	_, err = authorization.Accept(ctx, NewMsgSendDelegateAndLockSchedule(granter, recipient, otherValAddr, coin(100), schedule))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestLockAuthorization_AcceptDelegateAndLock(t *testing.T) {
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey(StoreKey), storetypes.NewTransientStoreKey("transient_test"))
+
+	granter := sample.AccAddress()
+	valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	otherValAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	coin := func(amount int64) sdk.Coin { return sdk.NewCoin(bondDenom, math.NewInt(amount)) }
+
+	limit := coin(1000)
+	authorization := NewLockAuthorization(LockAuthorizationType_LOCK_AUTHORIZATION_TYPE_DELEGATE_AND_LOCK, &limit, "2027-01-01", []string{valAddr}, nil)
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "/optio.lockup.MsgDelegateAndLock", authorization.MsgTypeURL())
+
+	res, err := authorization.Accept(ctx, NewMsgDelegateAndLock(granter, []ValidatorWeight{{ValidatorAddress: valAddr, Weight: math.LegacyOneDec()}}, "2026-12-01", coin(400)))
+	require.NoError(t, err)
+	require.Equal(t, coin(600), *res.Updated.(*LockAuthorization).SpendLimit)
+
+	// every validator of the split has to be allowed
+	_, err = authorization.Accept(ctx, NewMsgDelegateAndLock(granter, []ValidatorWeight{
+		{ValidatorAddress: valAddr, Weight: math.LegacyNewDecWithPrec(5, 1)},
+		{ValidatorAddress: otherValAddr, Weight: math.LegacyNewDecWithPrec(5, 1)},
+	}, "2026-12-01", coin(400)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = authorization.Accept(ctx, NewMsgDelegateAndLock(granter, []ValidatorWeight{{ValidatorAddress: valAddr, Weight: math.LegacyOneDec()}}, "2027-02-01", coin(400)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// recipients only apply to authorizations that send the tokens
+	authorization.AllowedRecipients = []string{sample.AccAddress()}
+	require.ErrorIs(t, authorization.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+}
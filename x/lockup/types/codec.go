@@ -4,6 +4,8 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/gogoproto/proto"
 	// this line is used by starport scaffolding # 1
 )
 
@@ -20,6 +22,46 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMultiSendDelegateAndLock{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgEarlyUnlock{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransferLock{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetAutoRenew{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFundRewardsPool{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgClaimRewards{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTokenizeLock{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPruneExpiredLocks{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgLockSchedule{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendDelegateAndLockSchedule{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDelegateAndLock{},
+	)
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&LockAuthorization{},
+	)
+	// LockNFTData is packed into the data of lock position tokens
+	registry.RegisterImplementations((*proto.Message)(nil),
+		&LockNFTData{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -11,4 +11,6 @@ var (
 	ErrLockupNotFound          = sdkerrors.Register(ModuleName, 1103, "lockup not found")
 	ErrInvalidDate             = sdkerrors.Register(ModuleName, 1104, "invalid date")
 	ErrInvalidAmount           = sdkerrors.Register(ModuleName, 1105, "invalid amount")
+	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 1106, "invalid genesis state")
+	ErrLockTokenized           = sdkerrors.Register(ModuleName, 1107, "lock is represented by an nft")
 )
@@ -1,12 +1,32 @@
 package types
 
 const (
-	EventTypeLock         = "lock"
-	EventTypeLockExtended = "lock_extended"
-	EventTypeLockExpired  = "lock_expired"
+	EventTypeLock                = "lock"
+	EventTypeLockExtended        = "lock_extended"
+	EventTypeLockExpired         = "lock_expired"
+	EventTypeEarlyUnlock         = "early_unlock"
+	EventTypeLockTransfer        = "lock_transfer"
+	EventTypeAutoRenew           = "lock_auto_renew"
+	EventTypeRewardsDistributed  = "rewards_distributed"
+	EventTypeFundRewardsPool     = "fund_rewards_pool"
+	EventTypeClaimRewards        = "claim_rewards"
+	EventTypeTokenizeLock        = "tokenize_lock"
+	EventTypeLockNFTTransfer     = "lock_nft_transfer"
+	EventTypeLockNFTBurn         = "lock_nft_burn"
+	EventTypeLockNFTReturned     = "lock_nft_returned"
+	EventTypeEscrowReleaseFailed = "escrow_release_failed"
+	EventTypeLockSlashed         = "lock_slashed"
 
 	AttributeKeyLockAddress   = "address"
 	AttributeKeyAmount        = "amount"
 	AttributeKeyUnlockDate    = "unlock_date"
 	AttributeKeyOldUnlockDate = "old_unlock_date"
+	AttributeKeyPenalty       = "penalty"
+	AttributeKeyDestination   = "penalty_destination"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyAutoRenew     = "auto_renew"
+	AttributeKeyDepositor     = "depositor"
+	AttributeKeyNFTID         = "nft_id"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyReason        = "reason"
 )
@@ -30,6 +30,8 @@ type Extension struct {
 	FromDate string     `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
 	ToDate   string     `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
 	Amount   types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// escrow extends an escrowed lock of the bond denom. Locks of other denoms are always escrowed.
+	Escrow bool `protobuf:"varint,4,opt,name=escrow,proto3" json:"escrow,omitempty"`
 }
 
 func (m *Extension) Reset()         { *m = Extension{} }
@@ -86,6 +88,13 @@ func (m *Extension) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Extension) GetEscrow() bool {
+	if m != nil {
+		return m.Escrow
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Extension)(nil), "optio.lockup.Extension")
 }
@@ -93,24 +102,25 @@ func init() {
 func init() { proto.RegisterFile("optio/lockup/extension.proto", fileDescriptor_3b9003ac0ed92aff) }
 
 var fileDescriptor_3b9003ac0ed92aff = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
+	// 289 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
-	0x14, 0x85, 0x63, 0x40, 0x81, 0x04, 0x16, 0x22, 0x24, 0xda, 0x80, 0x4c, 0xc5, 0xd4, 0x01, 0xd9,
-	0x2a, 0x0c, 0xec, 0xe5, 0x67, 0x04, 0xa9, 0x23, 0x4b, 0xe5, 0x04, 0x13, 0xac, 0x12, 0xdf, 0x28,
-	0xbe, 0x81, 0xc2, 0x53, 0xf0, 0x58, 0x1d, 0x3b, 0x32, 0x21, 0x94, 0xbc, 0x08, 0x8a, 0xed, 0x2e,
-	0x96, 0xef, 0xfd, 0x8e, 0xac, 0xcf, 0x27, 0x3e, 0x85, 0x0a, 0x15, 0xf0, 0x37, 0xc8, 0x17, 0x4d,
-	0xc5, 0xe5, 0x12, 0xa5, 0x36, 0x0a, 0x34, 0xab, 0x6a, 0x40, 0x48, 0x0e, 0x2c, 0x65, 0x8e, 0xa6,
-	0x47, 0x05, 0x14, 0x60, 0x01, 0xef, 0x6f, 0x2e, 0x93, 0x0e, 0x73, 0x30, 0x25, 0x98, 0xb9, 0x03,
-	0x6e, 0xf0, 0x88, 0xba, 0x89, 0x67, 0xc2, 0x48, 0xfe, 0x3e, 0xc9, 0x24, 0x8a, 0x09, 0xcf, 0x41,
-	0xf9, 0xe7, 0xd3, 0x43, 0x51, 0x2a, 0x0d, 0xdc, 0x9e, 0x6e, 0x75, 0xfe, 0x15, 0x47, 0x77, 0x1b,
-	0x89, 0xe4, 0x24, 0x8e, 0x5e, 0x6a, 0x28, 0xe7, 0xcf, 0x02, 0xe5, 0x80, 0x8c, 0xc8, 0x38, 0x9a,
-	0xed, 0xf5, 0x8b, 0x5b, 0x81, 0x32, 0x39, 0x8e, 0x77, 0x11, 0x1c, 0xda, 0xb2, 0x28, 0x44, 0xb0,
-	0xe0, 0x3a, 0x0e, 0x45, 0x09, 0x8d, 0xc6, 0xc1, 0xf6, 0x88, 0x8c, 0xf7, 0x2f, 0x87, 0xcc, 0x4b,
-	0xf5, 0x1a, 0xcc, 0x6b, 0xb0, 0x1b, 0x50, 0x7a, 0xba, 0xb3, 0xfa, 0x3d, 0x0b, 0x66, 0x3e, 0x3e,
-	0xbd, 0x5f, 0xb5, 0x94, 0xac, 0x5b, 0x4a, 0xfe, 0x5a, 0x4a, 0xbe, 0x3b, 0x1a, 0xac, 0x3b, 0x1a,
-	0xfc, 0x74, 0x34, 0x78, 0xba, 0x28, 0x14, 0xbe, 0x36, 0x19, 0xcb, 0xa1, 0xe4, 0x8f, 0x7d, 0x25,
-	0x0f, 0x12, 0x3f, 0xa0, 0x5e, 0x70, 0xd7, 0xde, 0x72, 0xd3, 0x1f, 0x7e, 0x56, 0xd2, 0x64, 0xa1,
-	0xfd, 0xca, 0xd5, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xce, 0xae, 0x25, 0xf8, 0x5c, 0x01, 0x00,
+	0x14, 0x85, 0x63, 0xa8, 0x42, 0x6b, 0x58, 0x88, 0x10, 0xb4, 0x05, 0x99, 0x8a, 0xa9, 0x03, 0xb2,
+	0x55, 0x18, 0xd8, 0xcb, 0xcf, 0x08, 0x52, 0x47, 0x96, 0xca, 0x09, 0x26, 0x58, 0x25, 0xb9, 0x51,
+	0x7c, 0x43, 0xcb, 0x5b, 0x20, 0x9e, 0xaa, 0x63, 0x47, 0x26, 0x84, 0x92, 0x17, 0x41, 0xb1, 0xdd,
+	0xc5, 0xf2, 0xb9, 0xdf, 0x95, 0xf5, 0xf9, 0xd0, 0x33, 0x28, 0x50, 0x83, 0x78, 0x87, 0x64, 0x51,
+	0x15, 0x42, 0xad, 0x50, 0xe5, 0x46, 0x43, 0xce, 0x8b, 0x12, 0x10, 0xa2, 0x03, 0x4b, 0xb9, 0xa3,
+	0xc3, 0xa3, 0x14, 0x52, 0xb0, 0x40, 0xb4, 0x37, 0xb7, 0x33, 0x1c, 0x24, 0x60, 0x32, 0x30, 0x73,
+	0x07, 0x5c, 0xf0, 0x88, 0xb9, 0x24, 0x62, 0x69, 0x94, 0xf8, 0x98, 0xc4, 0x0a, 0xe5, 0x44, 0x24,
+	0xa0, 0xfd, 0xf3, 0xc3, 0x43, 0x99, 0xe9, 0x1c, 0x84, 0x3d, 0xdd, 0xe8, 0xe2, 0x9b, 0xd0, 0xde,
+	0xfd, 0xd6, 0x22, 0x3a, 0xa5, 0xbd, 0xd7, 0x12, 0xb2, 0xf9, 0x8b, 0x44, 0xd5, 0x27, 0x23, 0x32,
+	0xee, 0xcd, 0xba, 0xed, 0xe0, 0x4e, 0xa2, 0x8a, 0x4e, 0xe8, 0x1e, 0x82, 0x43, 0x3b, 0x16, 0x85,
+	0x08, 0x16, 0xdc, 0xd0, 0x50, 0x66, 0x50, 0xe5, 0xd8, 0xdf, 0x1d, 0x91, 0xf1, 0xfe, 0xd5, 0x80,
+	0x7b, 0xab, 0xd6, 0x83, 0x7b, 0x0f, 0x7e, 0x0b, 0x3a, 0x9f, 0x76, 0xd6, 0xbf, 0xe7, 0xc1, 0xcc,
+	0xaf, 0x47, 0xc7, 0x34, 0x54, 0x26, 0x29, 0x61, 0xd9, 0xef, 0x8c, 0xc8, 0xb8, 0x3b, 0xf3, 0x69,
+	0xfa, 0xb0, 0xae, 0x19, 0xd9, 0xd4, 0x8c, 0xfc, 0xd5, 0x8c, 0x7c, 0x35, 0x2c, 0xd8, 0x34, 0x2c,
+	0xf8, 0x69, 0x58, 0xf0, 0x7c, 0x99, 0x6a, 0x7c, 0xab, 0x62, 0x9e, 0x40, 0x26, 0x9e, 0xda, 0xae,
+	0x1e, 0x15, 0x2e, 0xa1, 0x5c, 0x08, 0x57, 0xeb, 0x6a, 0x5b, 0x2c, 0x7e, 0x16, 0xca, 0xc4, 0xa1,
+	0xfd, 0xe3, 0xf5, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x09, 0x9d, 0xf1, 0x2c, 0x75, 0x01, 0x00,
 	0x00,
 }
 
@@ -134,6 +144,16 @@ func (m *Extension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Escrow {
+		i--
+		if m.Escrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -188,6 +208,9 @@ func (m *Extension) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovExtension(uint64(l))
+	if m.Escrow {
+		n += 2
+	}
 	return n
 }
 
@@ -323,6 +346,26 @@ func (m *Extension) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Escrow = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExtension(dAtA[iNdEx:])
@@ -1,5 +1,15 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -8,13 +18,222 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		AccountLocks:    []AccountLocks{},
+		ExpirationQueue: []ExpirationQueueEntry{},
+		RollingLocks:    []RollingLockEntry{},
+		PendingRewards:  []PendingRewards{},
+		LockNfts:        []LockNFT{},
+		NextLockNftId:   1,
+		LockHistory:     []LockHistoryEntry{},
 		// this line is used by starport scaffolding # genesis/types/default
+
+		Params: DefaultParams(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
-// failure.
+// failure. Both lock indexes must describe exactly the same set of locks.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "invalid params: %s", err)
+	}
+
+	locked := make(map[string]math.Int)
+
+	seenAddresses := make(map[string]bool)
+	for _, accountLocks := range gs.AccountLocks {
+		if _, err := sdk.AccAddressFromBech32(accountLocks.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lock address %s: %s", accountLocks.Address, err)
+		}
+
+		if seenAddresses[accountLocks.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate locks for address %s", accountLocks.Address)
+		}
+		seenAddresses[accountLocks.Address] = true
+
+		seenDates := make(map[string]bool)
+		for _, lock := range accountLocks.Locks {
+			if lock == nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "nil lock for address %s", accountLocks.Address)
+			}
+
+			if _, err := ParseUnlockTime(lock.UnlockDate); err != nil {
+				return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date %s for address %s", lock.UnlockDate, accountLocks.Address)
+			}
+
+			if lock.Kind != LockKindOf(lock.Denom) {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "lock of kind %s for address %s has denom %q", lock.Kind, accountLocks.Address, lock.Denom)
+			}
+
+			if lock.IsEscrowed() {
+				if err := sdk.ValidateDenom(lock.Denom); err != nil {
+					return errorsmod.Wrapf(ErrInvalidGenesis, "invalid lock denom %s for address %s: %s", lock.Denom, accountLocks.Address, err)
+				}
+			}
+
+			// an address holds one lock per unlock date and denom
+			dateKey := lockIndexKey("", lock.UnlockDate, lock.Denom)
+			if seenDates[dateKey] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate unlock date %s for address %s", lock.UnlockDate, accountLocks.Address)
+			}
+			seenDates[dateKey] = true
+
+			if lock.Amount.IsNil() || !lock.Amount.IsPositive() {
+				return errorsmod.Wrapf(ErrInvalidAmount, "non-positive lock amount for address %s on %s", accountLocks.Address, lock.UnlockDate)
+			}
+
+			locked[lockIndexKey(accountLocks.Address, lock.UnlockDate, lock.Denom)] = lock.Amount
+		}
+	}
+
+	seenEntries := make(map[string]bool)
+	for _, entry := range gs.ExpirationQueue {
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid expiration queue address %s: %s", entry.Address, err)
+		}
+
+		if _, err := ParseUnlockTime(entry.UnlockDate); err != nil {
+			return errorsmod.Wrapf(ErrInvalidDate, "invalid expiration queue date %s for address %s", entry.UnlockDate, entry.Address)
+		}
+
+		if entry.Amount.IsNil() || !entry.Amount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidAmount, "non-positive expiration queue amount for address %s on %s", entry.Address, entry.UnlockDate)
+		}
+
+		if entry.Denom != "" {
+			if err := sdk.ValidateDenom(entry.Denom); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "invalid expiration queue denom %s for address %s: %s", entry.Denom, entry.Address, err)
+			}
+		}
+
+		key := lockIndexKey(entry.Address, entry.UnlockDate, entry.Denom)
+		if seenEntries[key] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate expiration queue entry for address %s on %s", entry.Address, entry.UnlockDate)
+		}
+		seenEntries[key] = true
+
+		amount, found := locked[key]
+		if !found {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "expiration queue entry for address %s on %s has no matching lock", entry.Address, entry.UnlockDate)
+		}
+
+		if !amount.Equal(entry.Amount) {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "expiration queue amount %s does not match locked amount %s for address %s on %s", entry.Amount, amount, entry.Address, entry.UnlockDate)
+		}
+	}
+
+	for _, accountLocks := range gs.AccountLocks {
+		for _, lock := range accountLocks.Locks {
+			if !seenEntries[lockIndexKey(accountLocks.Address, lock.UnlockDate, lock.Denom)] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "lock for address %s on %s has no expiration queue entry", accountLocks.Address, lock.UnlockDate)
+			}
+		}
+	}
+
+	seenRollingLocks := make(map[string]bool)
+	for _, entry := range gs.RollingLocks {
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid rolling lock address %s: %s", entry.Address, err)
+		}
+
+		if entry.DurationDays == 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "zero duration rolling lock for address %s", entry.Address)
+		}
+
+		if entry.Amount.IsNil() || !entry.Amount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidAmount, "non-positive rolling lock amount for address %s over %d days", entry.Address, entry.DurationDays)
+		}
+
+		key := fmt.Sprintf("%s/%d", entry.Address, entry.DurationDays)
+		if seenRollingLocks[key] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate rolling lock for address %s over %d days", entry.Address, entry.DurationDays)
+		}
+		seenRollingLocks[key] = true
+	}
+
+	seenRewards := make(map[string]bool)
+	for _, entry := range gs.PendingRewards {
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pending rewards address %s: %s", entry.Address, err)
+		}
+
+		if seenRewards[entry.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate pending rewards for address %s", entry.Address)
+		}
+		seenRewards[entry.Address] = true
+
+		if !entry.Rewards.IsValid() || entry.Rewards.IsZero() {
+			return errorsmod.Wrapf(ErrInvalidAmount, "invalid pending rewards %s for address %s", entry.Rewards, entry.Address)
+		}
+	}
+
+	if gs.LastRewardEpoch != "" {
+		if _, err := time.Parse(time.DateOnly, gs.LastRewardEpoch); err != nil {
+			return errorsmod.Wrapf(ErrInvalidDate, "invalid last reward epoch %s", gs.LastRewardEpoch)
+		}
+	}
+
+	seenNFTs := make(map[string]bool)
+	seenPositions := make(map[string]bool)
+	for _, lockNFT := range gs.LockNfts {
+		if seenNFTs[lockNFT.Id] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate lock nft %s", lockNFT.Id)
+		}
+		seenNFTs[lockNFT.Id] = true
+
+		var seq uint64
+		if _, err := fmt.Sscanf(lockNFT.Id, "lock-%d", &seq); err != nil || LockNFTID(seq) != lockNFT.Id {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid lock nft id %s", lockNFT.Id)
+		}
+
+		if seq >= gs.NextLockNftId {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "lock nft %s is not below the next lock nft id %d", lockNFT.Id, gs.NextLockNftId)
+		}
+
+		key := lockIndexKey(lockNFT.Owner, lockNFT.UnlockDate, "")
+		if _, found := locked[key]; !found {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "lock nft %s has no matching lock for address %s on %s", lockNFT.Id, lockNFT.Owner, lockNFT.UnlockDate)
+		}
+
+		if seenPositions[key] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate lock nft for address %s on %s", lockNFT.Owner, lockNFT.UnlockDate)
+		}
+		seenPositions[key] = true
+	}
+
+	seenHistory := make(map[uint64]bool)
+	for _, entry := range gs.LockHistory {
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid lock history address %s", entry.Address)
+		}
+
+		if seenHistory[entry.Id] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate lock history entry %d", entry.Id)
+		}
+		seenHistory[entry.Id] = true
+
+		if entry.Id >= gs.NextLockHistoryId {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "lock history entry %d is not below the next lock history id %d", entry.Id, gs.NextLockHistoryId)
+		}
+
+		if _, ok := LockHistoryAction_name[int32(entry.Action)]; !ok || entry.Action == LockHistoryAction_LOCK_HISTORY_ACTION_UNSPECIFIED {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid lock history action %d", entry.Action)
+		}
+
+		if entry.Amount.IsNil() || entry.Amount.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid lock history amount for entry %d", entry.Id)
+		}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 	return nil
 }
+
+// lockIndexKey identifies a lock by address, unlock date and denom, which is
+// empty for bond denom locks
+func lockIndexKey(address, unlockDate, denom string) string {
+	if denom == "" {
+		return address + "/" + unlockDate
+	}
+	return address + "/" + unlockDate + "/" + denom
+}
@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -24,6 +28,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the lockup module's genesis state.
 type GenesisState struct {
+	// account_locks holds the locks stored for every address.
+	AccountLocks []AccountLocks `protobuf:"bytes,1,rep,name=account_locks,json=accountLocks,proto3" json:"account_locks"`
+	// expiration_queue holds the locks_by_date index.
+	ExpirationQueue []ExpirationQueueEntry `protobuf:"bytes,2,rep,name=expiration_queue,json=expirationQueue,proto3" json:"expiration_queue"`
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// rolling_locks holds the auto-renewing locks, which are not part of the expiration queue.
+	RollingLocks []RollingLockEntry `protobuf:"bytes,4,rep,name=rolling_locks,json=rollingLocks,proto3" json:"rolling_locks"`
+	// pending_rewards holds the unclaimed rewards pool payouts of every address.
+	PendingRewards []PendingRewards `protobuf:"bytes,5,rep,name=pending_rewards,json=pendingRewards,proto3" json:"pending_rewards"`
+	// last_reward_epoch is the day of the last rewards pool payout. Empty if no epoch has started.
+	LastRewardEpoch string `protobuf:"bytes,6,opt,name=last_reward_epoch,json=lastRewardEpoch,proto3" json:"last_reward_epoch,omitempty"`
+	// lock_nfts holds the lock positions that are represented by x/nft tokens.
+	LockNfts []LockNFT `protobuf:"bytes,7,rep,name=lock_nfts,json=lockNfts,proto3" json:"lock_nfts"`
+	// next_lock_nft_id is the sequence number of the next lock position token.
+	NextLockNftId uint64 `protobuf:"varint,8,opt,name=next_lock_nft_id,json=nextLockNftId,proto3" json:"next_lock_nft_id,omitempty"`
+	// lock_history holds the lock history entries that have not been pruned yet.
+	LockHistory []LockHistoryEntry `protobuf:"bytes,9,rep,name=lock_history,json=lockHistory,proto3" json:"lock_history"`
+	// next_lock_history_id is the id of the next lock history entry.
+	NextLockHistoryId uint64 `protobuf:"varint,10,opt,name=next_lock_history_id,json=nextLockHistoryId,proto3" json:"next_lock_history_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -59,23 +83,299 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetAccountLocks() []AccountLocks {
+	if m != nil {
+		return m.AccountLocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExpirationQueue() []ExpirationQueueEntry {
+	if m != nil {
+		return m.ExpirationQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetRollingLocks() []RollingLockEntry {
+	if m != nil {
+		return m.RollingLocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRewards() []PendingRewards {
+	if m != nil {
+		return m.PendingRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastRewardEpoch() string {
+	if m != nil {
+		return m.LastRewardEpoch
+	}
+	return ""
+}
+
+func (m *GenesisState) GetLockNfts() []LockNFT {
+	if m != nil {
+		return m.LockNfts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLockNftId() uint64 {
+	if m != nil {
+		return m.NextLockNftId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLockHistory() []LockHistoryEntry {
+	if m != nil {
+		return m.LockHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLockHistoryId() uint64 {
+	if m != nil {
+		return m.NextLockHistoryId
+	}
+	return 0
+}
+
+// AccountLocks defines the locks held by a single address.
+type AccountLocks struct {
+	Address string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Locks   []*Lock `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks,omitempty"`
+}
+
+func (m *AccountLocks) Reset()         { *m = AccountLocks{} }
+func (m *AccountLocks) String() string { return proto.CompactTextString(m) }
+func (*AccountLocks) ProtoMessage()    {}
+func (*AccountLocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_412bd64c3fa9273b, []int{1}
+}
+func (m *AccountLocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountLocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountLocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountLocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountLocks.Merge(m, src)
+}
+func (m *AccountLocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountLocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountLocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountLocks proto.InternalMessageInfo
+
+func (m *AccountLocks) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountLocks) GetLocks() []*Lock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+// ExpirationQueueEntry defines a single entry of the lock expiration queue.
+type ExpirationQueueEntry struct {
+	Address    string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	UnlockDate string                `protobuf:"bytes,2,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	Amount     cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// denom is the denom of an escrowed lock, empty for delegated locks.
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *ExpirationQueueEntry) Reset()         { *m = ExpirationQueueEntry{} }
+func (m *ExpirationQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*ExpirationQueueEntry) ProtoMessage()    {}
+func (*ExpirationQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_412bd64c3fa9273b, []int{2}
+}
+func (m *ExpirationQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpirationQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpirationQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpirationQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpirationQueueEntry.Merge(m, src)
+}
+func (m *ExpirationQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpirationQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpirationQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpirationQueueEntry proto.InternalMessageInfo
+
+func (m *ExpirationQueueEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExpirationQueueEntry) GetUnlockDate() string {
+	if m != nil {
+		return m.UnlockDate
+	}
+	return ""
+}
+
+func (m *ExpirationQueueEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// RollingLockEntry defines a single auto-renewing lock.
+type RollingLockEntry struct {
+	Address      string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DurationDays uint32                `protobuf:"varint,2,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	Amount       cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *RollingLockEntry) Reset()         { *m = RollingLockEntry{} }
+func (m *RollingLockEntry) String() string { return proto.CompactTextString(m) }
+func (*RollingLockEntry) ProtoMessage()    {}
+func (*RollingLockEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_412bd64c3fa9273b, []int{3}
+}
+func (m *RollingLockEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollingLockEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollingLockEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollingLockEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollingLockEntry.Merge(m, src)
+}
+func (m *RollingLockEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollingLockEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollingLockEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollingLockEntry proto.InternalMessageInfo
+
+func (m *RollingLockEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RollingLockEntry) GetDurationDays() uint32 {
+	if m != nil {
+		return m.DurationDays
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "optio.lockup.GenesisState")
+	proto.RegisterType((*AccountLocks)(nil), "optio.lockup.AccountLocks")
+	proto.RegisterType((*ExpirationQueueEntry)(nil), "optio.lockup.ExpirationQueueEntry")
+	proto.RegisterType((*RollingLockEntry)(nil), "optio.lockup.RollingLockEntry")
 }
 
 func init() { proto.RegisterFile("optio/lockup/genesis.proto", fileDescriptor_412bd64c3fa9273b) }
 
 var fileDescriptor_412bd64c3fa9273b = []byte{
-	// 138 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0x2f, 0x28, 0xc9,
-	0xcc, 0xd7, 0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x2d, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x01, 0xcb, 0xe9, 0x41, 0xe4, 0x94, 0xf8, 0xb8,
-	0x78, 0xdc, 0x21, 0xd2, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x4e, 0x6e, 0x27, 0x1e, 0xc9, 0x31, 0x5e,
-	0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31,
-	0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x93, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f,
-	0xab, 0xef, 0x0f, 0x32, 0xc2, 0x2f, 0xb5, 0xa4, 0x3c, 0xbf, 0x28, 0x5b, 0x1f, 0x62, 0x57, 0x05,
-	0xcc, 0xb6, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x65, 0xc6, 0x80, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x77, 0x08, 0xb9, 0x37, 0x8a, 0x00, 0x00, 0x00,
+	// 669 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x4f, 0x13, 0x4f,
+	0x18, 0xed, 0xfe, 0xa0, 0x85, 0x4e, 0xb7, 0x3f, 0x60, 0x52, 0x74, 0x6d, 0xcc, 0xd2, 0xd4, 0x83,
+	0x0d, 0xd1, 0x5d, 0x83, 0x07, 0x4f, 0x1e, 0x24, 0x80, 0xd4, 0x90, 0x8a, 0xab, 0x07, 0xe3, 0xa5,
+	0x19, 0xba, 0x43, 0xbb, 0xa1, 0x3b, 0xb3, 0xee, 0xcc, 0x06, 0xfa, 0x5f, 0x78, 0xf7, 0xe6, 0xc9,
+	0xa3, 0x26, 0xfe, 0x11, 0x1c, 0x89, 0x27, 0xe3, 0x81, 0x18, 0x38, 0xf8, 0x6f, 0x98, 0x6f, 0x66,
+	0x2a, 0xbb, 0x40, 0x38, 0x79, 0x81, 0xce, 0xf7, 0xde, 0xbc, 0xf7, 0x66, 0xe6, 0xdb, 0x0f, 0x35,
+	0x79, 0x22, 0x23, 0xee, 0x8f, 0xf9, 0xe0, 0x20, 0x4b, 0xfc, 0x21, 0x65, 0x54, 0x44, 0xc2, 0x4b,
+	0x52, 0x2e, 0x39, 0xb6, 0x15, 0xe6, 0x69, 0xac, 0xd9, 0x18, 0xf2, 0x21, 0x57, 0x80, 0x0f, 0xbf,
+	0x34, 0xa7, 0x79, 0x67, 0xc0, 0x45, 0xcc, 0x45, 0x5f, 0x03, 0x7a, 0x61, 0xa0, 0x25, 0x12, 0x47,
+	0x8c, 0xfb, 0xea, 0xaf, 0x29, 0x15, 0xdd, 0x46, 0x91, 0x90, 0x3c, 0x9d, 0x18, 0xec, 0x76, 0x01,
+	0x83, 0x7f, 0x06, 0xb8, 0x55, 0x00, 0xd8, 0xbe, 0x9c, 0x5a, 0x17, 0xea, 0x09, 0x49, 0x49, 0x2c,
+	0xae, 0xf5, 0x49, 0xe9, 0x21, 0x49, 0x43, 0x83, 0xb5, 0x3f, 0x95, 0x91, 0xfd, 0x5c, 0x9f, 0xf3,
+	0xb5, 0x24, 0x92, 0xe2, 0x17, 0xa8, 0x4e, 0x06, 0x03, 0x9e, 0x31, 0xd9, 0x87, 0x0d, 0xc2, 0xb1,
+	0x5a, 0x33, 0x9d, 0xda, 0x5a, 0xd3, 0xcb, 0x1f, 0xdf, 0x7b, 0xa6, 0x29, 0x3b, 0xc0, 0x58, 0xaf,
+	0x1e, 0x9f, 0xae, 0x94, 0x3e, 0xff, 0xfe, 0xb2, 0x6a, 0x05, 0x36, 0xc9, 0x01, 0xf8, 0x2d, 0x5a,
+	0xa4, 0x47, 0x49, 0x94, 0x12, 0x19, 0x71, 0xd6, 0x7f, 0x9f, 0xd1, 0x8c, 0x3a, 0xff, 0x29, 0xb9,
+	0x76, 0x51, 0x6e, 0xf3, 0x2f, 0xeb, 0x15, 0x90, 0x36, 0x99, 0x4c, 0x27, 0x79, 0xd9, 0x05, 0x5a,
+	0x24, 0xe0, 0x27, 0xa8, 0xa2, 0x8f, 0xe8, 0xcc, 0xb4, 0xac, 0x4e, 0x6d, 0xad, 0x51, 0xd4, 0xdb,
+	0x55, 0x58, 0x5e, 0xc1, 0xd0, 0x71, 0x0f, 0xd5, 0x53, 0x3e, 0x1e, 0x47, 0x6c, 0x68, 0x8e, 0x37,
+	0xab, 0xf2, 0xb8, 0xc5, 0xfd, 0x81, 0xa6, 0xc0, 0x29, 0xae, 0x64, 0xb1, 0xd3, 0x0b, 0x50, 0xe0,
+	0x5d, 0xb4, 0x90, 0x50, 0x16, 0x82, 0x9e, 0xb9, 0x58, 0xa7, 0xac, 0x14, 0xef, 0x5e, 0x4a, 0xa4,
+	0x49, 0x81, 0xe6, 0xe4, 0xf5, 0xfe, 0x4f, 0x0a, 0x10, 0x5e, 0x45, 0x4b, 0x63, 0x22, 0xa4, 0x91,
+	0xeb, 0xd3, 0x84, 0x0f, 0x46, 0x4e, 0xa5, 0x65, 0x75, 0xaa, 0xc1, 0x02, 0x00, 0x9a, 0xb7, 0x09,
+	0x65, 0xfc, 0x14, 0x55, 0x41, 0xbf, 0xcf, 0xf6, 0xa5, 0x70, 0xe6, 0x94, 0xef, 0x72, 0xd1, 0x17,
+	0x52, 0xf6, 0xb6, 0xde, 0xe4, 0x0d, 0xe7, 0x01, 0xea, 0xed, 0x4b, 0x81, 0xef, 0xa3, 0x45, 0x46,
+	0x8f, 0xf4, 0x43, 0x83, 0x46, 0x3f, 0x0a, 0x9d, 0xf9, 0x96, 0xd5, 0x99, 0x0d, 0xea, 0x50, 0xdf,
+	0xd1, 0xbc, 0x6e, 0x88, 0x77, 0x90, 0xad, 0x38, 0xa6, 0x47, 0x9d, 0xea, 0x75, 0x97, 0x06, 0xf4,
+	0x6d, 0x4d, 0xb8, 0x72, 0x69, 0xb5, 0xf1, 0x05, 0x88, 0x7d, 0xd4, 0xb8, 0xb0, 0x35, 0x92, 0x60,
+	0x8d, 0x94, 0xf5, 0xd2, 0xd4, 0xda, 0xd0, 0xbb, 0x61, 0x3b, 0x40, 0x76, 0xbe, 0xe1, 0xb0, 0x83,
+	0xe6, 0x48, 0x18, 0xa6, 0x54, 0x40, 0x77, 0xc2, 0xc5, 0x4c, 0x97, 0xb8, 0x83, 0xca, 0xfa, 0x59,
+	0x75, 0x9b, 0xe1, 0xab, 0x09, 0x03, 0x4d, 0x68, 0x7f, 0xb5, 0x50, 0xe3, 0xba, 0xb6, 0xbb, 0x41,
+	0x7c, 0x05, 0xd5, 0x32, 0xa6, 0x42, 0x87, 0x44, 0x42, 0x27, 0x03, 0x8a, 0x74, 0x69, 0x03, 0xbe,
+	0x9d, 0x6d, 0x54, 0x21, 0x31, 0xc4, 0x54, 0x5d, 0x59, 0x5d, 0x7f, 0x04, 0x17, 0xf0, 0xf3, 0x74,
+	0x65, 0x59, 0x4f, 0x02, 0x11, 0x1e, 0x78, 0x11, 0xf7, 0x63, 0x22, 0x47, 0x5e, 0x97, 0xc9, 0xef,
+	0xdf, 0x1e, 0x22, 0x33, 0x22, 0xba, 0x4c, 0x9a, 0x36, 0xd5, 0xfb, 0x71, 0x03, 0x95, 0x43, 0xca,
+	0x78, 0xec, 0xcc, 0x2a, 0x13, 0xbd, 0x68, 0x7f, 0xb4, 0xd0, 0xe2, 0xe5, 0xd6, 0xbc, 0x21, 0xef,
+	0x3d, 0x54, 0x0f, 0x33, 0xf3, 0xf1, 0x85, 0x64, 0x22, 0x54, 0xe2, 0x7a, 0x60, 0x4f, 0x8b, 0x1b,
+	0x64, 0x22, 0xfe, 0x5d, 0xe6, 0xf5, 0xad, 0xe3, 0x33, 0xd7, 0x3a, 0x39, 0x73, 0xad, 0x5f, 0x67,
+	0xae, 0xf5, 0xe1, 0xdc, 0x2d, 0x9d, 0x9c, 0xbb, 0xa5, 0x1f, 0xe7, 0x6e, 0xe9, 0xdd, 0x83, 0x61,
+	0x24, 0x47, 0xd9, 0x9e, 0x37, 0xe0, 0xb1, 0xff, 0x12, 0x1e, 0xa4, 0x47, 0xe5, 0x21, 0x4f, 0x0f,
+	0x7c, 0x3d, 0x98, 0x8e, 0xa6, 0xa3, 0x49, 0x4e, 0x12, 0x2a, 0xf6, 0x2a, 0x6a, 0x32, 0x3d, 0xfe,
+	0x13, 0x00, 0x00, 0xff, 0xff, 0xed, 0xf7, 0xdb, 0x6f, 0x8d, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -98,6 +398,260 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextLockHistoryId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLockHistoryId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.LockHistory) > 0 {
+		for iNdEx := len(m.LockHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextLockNftId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLockNftId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.LockNfts) > 0 {
+		for iNdEx := len(m.LockNfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockNfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LastRewardEpoch) > 0 {
+		i -= len(m.LastRewardEpoch)
+		copy(dAtA[i:], m.LastRewardEpoch)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LastRewardEpoch)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RollingLocks) > 0 {
+		for iNdEx := len(m.RollingLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RollingLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ExpirationQueue) > 0 {
+		for iNdEx := len(m.ExpirationQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpirationQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AccountLocks) > 0 {
+		for iNdEx := len(m.AccountLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountLocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountLocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountLocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpirationQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpirationQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpirationQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollingLockEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollingLockEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollingLockEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DurationDays != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DurationDays))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -118,17 +672,125 @@ func (m *GenesisState) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.AccountLocks) > 0 {
+		for _, e := range m.AccountLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExpirationQueue) > 0 {
+		for _, e := range m.ExpirationQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RollingLocks) > 0 {
+		for _, e := range m.RollingLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.LastRewardEpoch)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.LockNfts) > 0 {
+		for _, e := range m.LockNfts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLockNftId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextLockNftId))
+	}
+	if len(m.LockHistory) > 0 {
+		for _, e := range m.LockHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLockHistoryId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextLockHistoryId))
+	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *AccountLocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *ExpirationQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *RollingLockEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DurationDays != 0 {
+		n += 1 + sovGenesis(uint64(m.DurationDays))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -156,6 +818,744 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountLocks = append(m.AccountLocks, AccountLocks{})
+			if err := m.AccountLocks[len(m.AccountLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpirationQueue = append(m.ExpirationQueue, ExpirationQueueEntry{})
+			if err := m.ExpirationQueue[len(m.ExpirationQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollingLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollingLocks = append(m.RollingLocks, RollingLockEntry{})
+			if err := m.RollingLocks[len(m.RollingLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewards = append(m.PendingRewards, PendingRewards{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastRewardEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockNfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockNfts = append(m.LockNfts, LockNFT{})
+			if err := m.LockNfts[len(m.LockNfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLockNftId", wireType)
+			}
+			m.NextLockNftId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLockNftId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockHistory = append(m.LockHistory, LockHistoryEntry{})
+			if err := m.LockHistory[len(m.LockHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLockHistoryId", wireType)
+			}
+			m.NextLockHistoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLockHistoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountLocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountLocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountLocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, &Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpirationQueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpirationQueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpirationQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollingLockEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollingLockEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollingLockEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationDays", wireType)
+			}
+			m.DurationDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,11 +3,16 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	"github.com/OptioNetwork/optio/testutil/sample"
 	"github.com/OptioNetwork/optio/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	addr := sample.AccAddress()
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -19,13 +24,442 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{
+						Address: addr,
+						Locks: []*types.Lock{
+							{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+							{UnlockDate: "2027-01-01", Amount: math.NewInt(500)},
+						},
+					},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+					{Address: addr, UnlockDate: "2027-01-01", Amount: math.NewInt(500)},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "invalid address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{
+						Address: "invalid_address",
+						Locks:   []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)}}},
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2027-01-01", Amount: math.NewInt(1000)}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+					{Address: addr, UnlockDate: "2027-01-01", Amount: math.NewInt(1000)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate unlock date",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{
+						Address: addr,
+						Locks: []*types.Lock{
+							{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+							{UnlockDate: "2026-12-01", Amount: math.NewInt(500)},
+						},
+					},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1500)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid unlock date",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "12/01/2026", Amount: math.NewInt(1000)}}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "non-positive lock amount",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.ZeroInt()}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.ZeroInt()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "non-positive queue amount",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(-1)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate queue entry",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "queue amount does not match lock",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(999)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "queue entry without lock",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "lock without queue entry",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)}}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid escrowed lock",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{
+						Address: addr,
+						Locks: []*types.Lock{
+							{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+							{UnlockDate: "2026-12-01", Amount: math.NewInt(500), Denom: "uatom", Kind: types.LockKind_LOCK_KIND_ESCROWED},
+						},
+					},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(500), Denom: "uatom"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "escrowed lock without queue entry of its denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.NewInt(500), Denom: "uatom", Kind: types.LockKind_LOCK_KIND_ESCROWED}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(500)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "escrowed bond denom lock beside a delegated lock",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{
+						Address: addr,
+						Locks: []*types.Lock{
+							{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+							{UnlockDate: "2026-12-01", Amount: math.NewInt(500), Denom: "uOPT", Kind: types.LockKind_LOCK_KIND_ESCROWED},
+						},
+					},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(500), Denom: "uOPT"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "escrowed lock without kind",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.NewInt(500), Denom: "uatom"}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(500), Denom: "uatom"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid escrowed lock denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.NewInt(500), Denom: "!", Kind: types.LockKind_LOCK_KIND_ESCROWED}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(500), Denom: "!"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid params",
+			genState: &types.GenesisState{
+				Params: types.NewParams(0, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), types.PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 0, 0, 0),
+			},
+			valid: false,
+		},
+		{
+			desc: "valid rolling lock",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RollingLocks: []types.RollingLockEntry{
+					{Address: addr, DurationDays: 730, Amount: math.NewInt(1000)},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "zero duration rolling lock",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RollingLocks: []types.RollingLockEntry{
+					{Address: addr, DurationDays: 0, Amount: math.NewInt(1000)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate rolling lock",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RollingLocks: []types.RollingLockEntry{
+					{Address: addr, DurationDays: 730, Amount: math.NewInt(1000)},
+					{Address: addr, DurationDays: 730, Amount: math.NewInt(1000)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid pending rewards",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingRewards: []types.PendingRewards{
+					{Address: addr, Rewards: sdk.NewCoins(sdk.NewInt64Coin("uOPT", 10))},
+				},
+				LastRewardEpoch: "2026-01-01",
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate pending rewards",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingRewards: []types.PendingRewards{
+					{Address: addr, Rewards: sdk.NewCoins(sdk.NewInt64Coin("uOPT", 10))},
+					{Address: addr, Rewards: sdk.NewCoins(sdk.NewInt64Coin("uOPT", 10))},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "empty pending rewards",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingRewards: []types.PendingRewards{
+					{Address: addr, Rewards: sdk.NewCoins()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid last reward epoch",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				LastRewardEpoch: "01/01/2026",
+			},
+			valid: false,
+		},
+		{
+			desc: "valid lock nft",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+				},
+				LockNfts: []types.LockNFT{
+					{Id: "lock-1", Owner: addr, UnlockDate: "2026-12-01"},
+				},
+				NextLockNftId: 2,
+			},
+			valid: true,
+		},
+		{
+			desc: "lock nft id not below next id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+				},
+				LockNfts: []types.LockNFT{
+					{Id: "lock-1", Owner: addr, UnlockDate: "2026-12-01"},
+				},
+				NextLockNftId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid lock nft id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+				},
+				LockNfts: []types.LockNFT{
+					{Id: "lock-01", Owner: addr, UnlockDate: "2026-12-01"},
+				},
+				NextLockNftId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "lock nft without lock",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+				},
+				LockNfts: []types.LockNFT{
+					{Id: "lock-1", Owner: addr, UnlockDate: "2027-01-01"},
+				},
+				NextLockNftId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate lock nft for a lock",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr, Locks: []*types.Lock{{UnlockDate: "2026-12-01", Amount: math.NewInt(1000)}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+				},
+				LockNfts: []types.LockNFT{
+					{Id: "lock-1", Owner: addr, UnlockDate: "2026-12-01"},
+					{Id: "lock-2", Owner: addr, UnlockDate: "2026-12-01"},
+				},
+				NextLockNftId: 3,
+			},
+			valid: false,
+		},
+		{
+			desc: "valid lock history",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				LockHistory: []types.LockHistoryEntry{
+					{Id: 0, Address: addr, Action: types.LockHistoryAction_LOCK_HISTORY_ACTION_LOCK, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+					{Id: 1, Address: addr, Action: types.LockHistoryAction_LOCK_HISTORY_ACTION_EXPIRE, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+				},
+				NextLockHistoryId: 2,
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate lock history id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				LockHistory: []types.LockHistoryEntry{
+					{Id: 0, Address: addr, Action: types.LockHistoryAction_LOCK_HISTORY_ACTION_LOCK, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+					{Id: 0, Address: addr, Action: types.LockHistoryAction_LOCK_HISTORY_ACTION_EXPIRE, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+				},
+				NextLockHistoryId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "lock history id not below next id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				LockHistory: []types.LockHistoryEntry{
+					{Id: 1, Address: addr, Action: types.LockHistoryAction_LOCK_HISTORY_ACTION_LOCK, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+				},
+				NextLockHistoryId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "unspecified lock history action",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				LockHistory: []types.LockHistoryEntry{
+					{Id: 0, Address: addr, UnlockDate: "2026-12-01", Amount: math.NewInt(1000)},
+				},
+				NextLockHistoryId: 1,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
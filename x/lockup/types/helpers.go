@@ -1,12 +1,83 @@
 package types
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
-func IsLocked(currentDate time.Time, unlockDate string) bool {
-	unlockTime, err := time.Parse(time.DateOnly, unlockDate)
+// ParseUnlockTime parses the unlock date of a lock. It is either a date in
+// time.DateOnly format, which unlocks at the start of that UTC day, or a
+// timestamp in time.RFC3339 format in UTC that is not at midnight, which
+// unlocks at exactly that time. Only this canonical form is accepted, so an
+// unlock time has a single string representation.
+func ParseUnlockTime(unlockDate string) (time.Time, error) {
+	if unlockTime, err := time.Parse(time.DateOnly, unlockDate); err == nil {
+		return unlockTime, nil
+	}
+
+	unlockTime, err := time.Parse(time.RFC3339, unlockDate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unlock date %s is neither a date nor an RFC3339 timestamp", unlockDate)
+	}
+
+	if canonical := FormatUnlockTime(unlockTime); canonical != unlockDate {
+		return time.Time{}, fmt.Errorf("unlock timestamp %s must be written as %s", unlockDate, canonical)
+	}
+
+	return unlockTime, nil
+}
+
+// FormatUnlockTime returns the canonical unlock date of unlockTime: a date if
+// it is at midnight UTC and a timestamp otherwise.
+func FormatUnlockTime(unlockTime time.Time) string {
+	unlockTime = unlockTime.UTC()
+	if unlockTime.Equal(time.Date(unlockTime.Year(), unlockTime.Month(), unlockTime.Day(), 0, 0, 0, 0, time.UTC)) {
+		return unlockTime.Format(time.DateOnly)
+	}
+	return unlockTime.Truncate(time.Second).Format(time.RFC3339)
+}
+
+// IsLocked reports whether a lock with the given unlock date is still locked at
+// currentTime. Date locks are locked until the start of their unlock day.
+func IsLocked(currentTime time.Time, unlockDate string) bool {
+	unlockTime, err := ParseUnlockTime(unlockDate)
 	if err != nil {
 		return false
 	}
 
-	return currentDate.Before(unlockTime)
+	return currentTime.Before(unlockTime)
+}
+
+// IsEscrowed reports whether the lock holds tokens escrowed in the lockup
+// module account rather than being backed by delegations.
+func (l Lock) IsEscrowed() bool {
+	return l.Kind == LockKind_LOCK_KIND_ESCROWED
+}
+
+// LockKindOf returns the kind of a lock of denom, which is empty for
+// delegated locks.
+func LockKindOf(denom string) LockKind {
+	if denom == "" {
+		return LockKind_LOCK_KIND_DELEGATED
+	}
+	return LockKind_LOCK_KIND_ESCROWED
+}
+
+// RollingUnlockDate returns the effective unlock date, on blockDay, of an
+// auto-renewing lock with the given duration.
+func RollingUnlockDate(blockDay time.Time, durationDays uint32) time.Time {
+	return blockDay.AddDate(0, 0, int(durationDays))
+}
+
+// DurationDays returns the number of whole days from blockDay to unlockDate.
+func DurationDays(blockDay, unlockDate time.Time) uint32 {
+	if !unlockDate.After(blockDay) {
+		return 0
+	}
+	return uint32(unlockDate.Sub(blockDay).Hours() / 24)
+}
+
+// EpochDay returns the number of whole days from the Unix epoch to t.
+func EpochDay(t time.Time) int64 {
+	return t.Unix() / 86400
 }
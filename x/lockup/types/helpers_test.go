@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/OptioNetwork/optio/x/lockup/types"
+)
+
+func TestParseUnlockTime(t *testing.T) {
+	tests := []struct {
+		unlockDate string
+		expected   time.Time
+		valid      bool
+	}{
+		{unlockDate: "2026-03-01", expected: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), valid: true},
+		{unlockDate: "2026-03-01T14:30:00Z", expected: time.Date(2026, 3, 1, 14, 30, 0, 0, time.UTC), valid: true},
+		{unlockDate: "2026-03-01T00:00:00Z"},      // must be written as a date
+		{unlockDate: "2026-03-01T16:30:00+02:00"}, // must be in UTC
+		{unlockDate: "2026-03-01T14:30:00.5Z"},    // second precision only
+		{unlockDate: "01/03/2026"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.unlockDate, func(t *testing.T) {
+			unlockTime, err := types.ParseUnlockTime(tc.unlockDate)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, unlockTime)
+			require.Equal(t, tc.unlockDate, types.FormatUnlockTime(unlockTime))
+		})
+	}
+}
+
+func TestIsLocked(t *testing.T) {
+	blockTime := time.Date(2026, 3, 1, 14, 30, 0, 0, time.UTC)
+
+	require.False(t, types.IsLocked(blockTime, "2026-03-01"))
+	require.True(t, types.IsLocked(blockTime, "2026-03-02"))
+	require.False(t, types.IsLocked(blockTime, "2026-03-01T14:30:00Z"))
+	require.True(t, types.IsLocked(blockTime, "2026-03-01T14:30:01Z"))
+	require.False(t, types.IsLocked(blockTime, "invalid"))
+}
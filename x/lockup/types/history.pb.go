@@ -0,0 +1,856 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/lockup/history.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LockHistoryAction defines the change to a lock recorded by a lock history entry.
+type LockHistoryAction int32
+
+const (
+	// LOCK_HISTORY_ACTION_UNSPECIFIED is not a valid action.
+	LockHistoryAction_LOCK_HISTORY_ACTION_UNSPECIFIED LockHistoryAction = 0
+	// LOCK_HISTORY_ACTION_LOCK adds to a lock.
+	LockHistoryAction_LOCK_HISTORY_ACTION_LOCK LockHistoryAction = 1
+	// LOCK_HISTORY_ACTION_EXTEND moves an amount from old_unlock_date to unlock_date.
+	LockHistoryAction_LOCK_HISTORY_ACTION_EXTEND LockHistoryAction = 2
+	// LOCK_HISTORY_ACTION_TRANSFER_IN receives a lock from counterparty.
+	LockHistoryAction_LOCK_HISTORY_ACTION_TRANSFER_IN LockHistoryAction = 3
+	// LOCK_HISTORY_ACTION_TRANSFER_OUT sends a lock to counterparty.
+	LockHistoryAction_LOCK_HISTORY_ACTION_TRANSFER_OUT LockHistoryAction = 4
+	// LOCK_HISTORY_ACTION_EXPIRE removes a lock that reached its unlock date.
+	LockHistoryAction_LOCK_HISTORY_ACTION_EXPIRE LockHistoryAction = 5
+	// LOCK_HISTORY_ACTION_EARLY_UNLOCK removes an amount from a lock before its unlock date.
+	LockHistoryAction_LOCK_HISTORY_ACTION_EARLY_UNLOCK LockHistoryAction = 6
+	// LOCK_HISTORY_ACTION_SLASH removes an amount from a lock that slashing left above the delegations.
+	LockHistoryAction_LOCK_HISTORY_ACTION_SLASH LockHistoryAction = 7
+)
+
+var LockHistoryAction_name = map[int32]string{
+	0: "LOCK_HISTORY_ACTION_UNSPECIFIED",
+	1: "LOCK_HISTORY_ACTION_LOCK",
+	2: "LOCK_HISTORY_ACTION_EXTEND",
+	3: "LOCK_HISTORY_ACTION_TRANSFER_IN",
+	4: "LOCK_HISTORY_ACTION_TRANSFER_OUT",
+	5: "LOCK_HISTORY_ACTION_EXPIRE",
+	6: "LOCK_HISTORY_ACTION_EARLY_UNLOCK",
+	7: "LOCK_HISTORY_ACTION_SLASH",
+}
+
+var LockHistoryAction_value = map[string]int32{
+	"LOCK_HISTORY_ACTION_UNSPECIFIED":  0,
+	"LOCK_HISTORY_ACTION_LOCK":         1,
+	"LOCK_HISTORY_ACTION_EXTEND":       2,
+	"LOCK_HISTORY_ACTION_TRANSFER_IN":  3,
+	"LOCK_HISTORY_ACTION_TRANSFER_OUT": 4,
+	"LOCK_HISTORY_ACTION_EXPIRE":       5,
+	"LOCK_HISTORY_ACTION_EARLY_UNLOCK": 6,
+	"LOCK_HISTORY_ACTION_SLASH":        7,
+}
+
+func (x LockHistoryAction) String() string {
+	return proto.EnumName(LockHistoryAction_name, int32(x))
+}
+
+func (LockHistoryAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c709d5cf660a68ab, []int{0}
+}
+
+// LockHistoryEntry records a single change to the locks of an address.
+type LockHistoryEntry struct {
+	// id orders the entries of every address.
+	Id      uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string            `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Action  LockHistoryAction `protobuf:"varint,3,opt,name=action,proto3,enum=optio.lockup.LockHistoryAction" json:"action,omitempty"`
+	// unlock_date is the unlock date of the changed lock. For auto-renewing locks it is the unlock date as of the block.
+	UnlockDate string `protobuf:"bytes,4,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	// old_unlock_date is the unlock date an extension moved the amount from.
+	OldUnlockDate string                `protobuf:"bytes,5,opt,name=old_unlock_date,json=oldUnlockDate,proto3" json:"old_unlock_date,omitempty"`
+	Amount        cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	AutoRenew     bool                  `protobuf:"varint,7,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// counterparty is the other address of a transfer.
+	Counterparty string    `protobuf:"bytes,8,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	Height       int64     `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	Time         time.Time `protobuf:"bytes,10,opt,name=time,proto3,stdtime" json:"time"`
+	// denom is the denom of an escrowed lock, empty for delegated locks.
+	Denom string `protobuf:"bytes,11,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *LockHistoryEntry) Reset()         { *m = LockHistoryEntry{} }
+func (m *LockHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*LockHistoryEntry) ProtoMessage()    {}
+func (*LockHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c709d5cf660a68ab, []int{0}
+}
+func (m *LockHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockHistoryEntry.Merge(m, src)
+}
+func (m *LockHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockHistoryEntry proto.InternalMessageInfo
+
+func (m *LockHistoryEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LockHistoryEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LockHistoryEntry) GetAction() LockHistoryAction {
+	if m != nil {
+		return m.Action
+	}
+	return LockHistoryAction_LOCK_HISTORY_ACTION_UNSPECIFIED
+}
+
+func (m *LockHistoryEntry) GetUnlockDate() string {
+	if m != nil {
+		return m.UnlockDate
+	}
+	return ""
+}
+
+func (m *LockHistoryEntry) GetOldUnlockDate() string {
+	if m != nil {
+		return m.OldUnlockDate
+	}
+	return ""
+}
+
+func (m *LockHistoryEntry) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
+}
+
+func (m *LockHistoryEntry) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
+func (m *LockHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LockHistoryEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *LockHistoryEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("optio.lockup.LockHistoryAction", LockHistoryAction_name, LockHistoryAction_value)
+	proto.RegisterType((*LockHistoryEntry)(nil), "optio.lockup.LockHistoryEntry")
+}
+
+func init() { proto.RegisterFile("optio/lockup/history.proto", fileDescriptor_c709d5cf660a68ab) }
+
+var fileDescriptor_c709d5cf660a68ab = []byte{
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xb3, 0x49, 0x9a, 0xb6, 0xdb, 0x7e, 0xfd, 0xdc, 0x55, 0x41, 0xdb, 0x88, 0xda, 0x56,
+	0x41, 0x28, 0xaa, 0xc0, 0x46, 0xe5, 0x00, 0xd7, 0xb4, 0x75, 0x15, 0x8b, 0xc8, 0xa9, 0x36, 0x8e,
+	0x44, 0xb9, 0x58, 0xae, 0xbd, 0x38, 0x56, 0x62, 0xaf, 0x65, 0xaf, 0x55, 0xf2, 0x16, 0x7d, 0x0a,
+	0xc4, 0x11, 0x09, 0x1e, 0xa2, 0xc7, 0x8a, 0x13, 0xe2, 0x50, 0x50, 0x7b, 0xe0, 0x35, 0x90, 0xd7,
+	0x8e, 0x28, 0xa2, 0xe5, 0x62, 0xf9, 0xff, 0x9f, 0xdf, 0xce, 0x8c, 0x67, 0xbc, 0xb0, 0xcd, 0x12,
+	0x1e, 0x32, 0x7d, 0xca, 0xbc, 0x49, 0x9e, 0xe8, 0xe3, 0x30, 0xe3, 0x2c, 0x9d, 0x69, 0x49, 0xca,
+	0x38, 0x43, 0xab, 0x22, 0xa6, 0x95, 0xb1, 0xf6, 0xba, 0x1b, 0x85, 0x31, 0xd3, 0xc5, 0xb3, 0x04,
+	0xda, 0x9b, 0x1e, 0xcb, 0x22, 0x96, 0x39, 0x42, 0xe9, 0xa5, 0xa8, 0x42, 0x1b, 0x01, 0x0b, 0x58,
+	0xe9, 0x17, 0x6f, 0x95, 0xab, 0x04, 0x8c, 0x05, 0x53, 0xaa, 0x0b, 0x75, 0x92, 0xbf, 0xd5, 0x79,
+	0x18, 0xd1, 0x8c, 0xbb, 0x51, 0x52, 0x02, 0xdb, 0x9f, 0x1a, 0x50, 0xea, 0x33, 0x6f, 0xd2, 0x2b,
+	0x1b, 0x31, 0x62, 0x9e, 0xce, 0xd0, 0x1a, 0xac, 0x87, 0x3e, 0x06, 0x2a, 0xe8, 0x34, 0x49, 0x3d,
+	0xf4, 0x11, 0x86, 0x8b, 0xae, 0xef, 0xa7, 0x34, 0xcb, 0x70, 0x5d, 0x05, 0x9d, 0x65, 0x32, 0x97,
+	0xe8, 0x05, 0x6c, 0xb9, 0x1e, 0x0f, 0x59, 0x8c, 0x1b, 0x2a, 0xe8, 0xac, 0xed, 0x2a, 0xda, 0xcd,
+	0x4f, 0xd0, 0x6e, 0x64, 0xee, 0x0a, 0x8c, 0x54, 0x38, 0x52, 0xe0, 0x4a, 0x1e, 0x17, 0x94, 0xe3,
+	0xbb, 0x9c, 0xe2, 0xa6, 0x48, 0x0b, 0x4b, 0xeb, 0xc0, 0xe5, 0x14, 0x3d, 0x86, 0xff, 0xb3, 0xa9,
+	0xef, 0xdc, 0x84, 0x16, 0x04, 0xf4, 0x1f, 0x9b, 0xfa, 0xa3, 0xdf, 0x5c, 0x0f, 0xb6, 0xdc, 0x88,
+	0xe5, 0x31, 0xc7, 0xad, 0x22, 0xbc, 0xf7, 0xec, 0xfc, 0x52, 0xa9, 0x7d, 0xbb, 0x54, 0xee, 0x95,
+	0xd3, 0xc9, 0xfc, 0x89, 0x16, 0x32, 0x3d, 0x72, 0xf9, 0x58, 0x33, 0x63, 0xfe, 0xe5, 0xf3, 0x53,
+	0x58, 0x8d, 0xcd, 0x8c, 0xf9, 0x87, 0x9f, 0x1f, 0x77, 0x00, 0xa9, 0xce, 0xa3, 0x2d, 0x08, 0xdd,
+	0x9c, 0x33, 0x27, 0xa5, 0x31, 0x3d, 0xc5, 0x8b, 0x2a, 0xe8, 0x2c, 0x91, 0xe5, 0xc2, 0x21, 0x85,
+	0x81, 0xb6, 0xe1, 0xaa, 0x57, 0x70, 0x34, 0x4d, 0xdc, 0x94, 0xcf, 0xf0, 0x92, 0xe8, 0xe6, 0x0f,
+	0x0f, 0xdd, 0x87, 0xad, 0x31, 0x0d, 0x83, 0x31, 0xc7, 0xcb, 0x2a, 0xe8, 0x34, 0x48, 0xa5, 0xd0,
+	0x4b, 0xd8, 0x2c, 0x06, 0x8f, 0xa1, 0x0a, 0x3a, 0x2b, 0xbb, 0x6d, 0xad, 0xdc, 0x8a, 0x36, 0xdf,
+	0x8a, 0x66, 0xcf, 0xb7, 0xb2, 0xb7, 0x54, 0xb4, 0x7f, 0xf6, 0x5d, 0x01, 0x44, 0x9c, 0x40, 0x1b,
+	0x70, 0xc1, 0xa7, 0x31, 0x8b, 0xf0, 0x8a, 0x28, 0x57, 0x8a, 0x9d, 0xf7, 0x75, 0xb8, 0xfe, 0xd7,
+	0x6c, 0xd1, 0x43, 0xa8, 0xf4, 0x07, 0xfb, 0xaf, 0x9c, 0x9e, 0x39, 0xb4, 0x07, 0xe4, 0xd8, 0xe9,
+	0xee, 0xdb, 0xe6, 0xc0, 0x72, 0x46, 0xd6, 0xf0, 0xc8, 0xd8, 0x37, 0x0f, 0x4d, 0xe3, 0x40, 0xaa,
+	0xa1, 0x07, 0x10, 0xdf, 0x06, 0x15, 0x9e, 0x04, 0x90, 0x0c, 0xdb, 0xb7, 0x45, 0x8d, 0xd7, 0xb6,
+	0x61, 0x1d, 0x48, 0xf5, 0xbb, 0x4a, 0xd8, 0xa4, 0x6b, 0x0d, 0x0f, 0x0d, 0xe2, 0x98, 0x96, 0xd4,
+	0x40, 0x8f, 0xa0, 0xfa, 0x4f, 0x68, 0x30, 0xb2, 0xa5, 0xe6, 0xdd, 0xa5, 0x8e, 0x4c, 0x62, 0x48,
+	0x0b, 0x77, 0x65, 0x31, 0xba, 0xa4, 0x7f, 0xec, 0x8c, 0x2c, 0xd1, 0x70, 0x0b, 0x6d, 0xc1, 0xcd,
+	0xdb, 0xa8, 0x61, 0xbf, 0x3b, 0xec, 0x49, 0x8b, 0x7b, 0x87, 0xe7, 0x57, 0x32, 0xb8, 0xb8, 0x92,
+	0xc1, 0x8f, 0x2b, 0x19, 0x9c, 0x5d, 0xcb, 0xb5, 0x8b, 0x6b, 0xb9, 0xf6, 0xf5, 0x5a, 0xae, 0xbd,
+	0x79, 0x12, 0x84, 0x7c, 0x9c, 0x9f, 0x68, 0x1e, 0x8b, 0xf4, 0x41, 0xf1, 0xcf, 0x5a, 0x94, 0x9f,
+	0xb2, 0x74, 0xa2, 0x97, 0xf7, 0xf3, 0xdd, 0xfc, 0x86, 0xf2, 0x59, 0x42, 0xb3, 0x93, 0x96, 0x58,
+	0xd5, 0xf3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x26, 0x54, 0x4e, 0xd3, 0xbe, 0x03, 0x00, 0x00,
+}
+
+func (m *LockHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintHistory(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.OldUnlockDate) > 0 {
+		i -= len(m.OldUnlockDate)
+		copy(dAtA[i:], m.OldUnlockDate)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.OldUnlockDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Action != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LockHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovHistory(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovHistory(uint64(m.Action))
+	}
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.OldUnlockDate)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	if m.AutoRenew {
+		n += 2
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovHistory(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LockHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= LockHistoryAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldUnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldUnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "lockup"
@@ -9,14 +11,54 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_lockup"
+
+	// RewardsPoolName defines the module account holding the lock rewards pool.
+	// x/distro mints can fund the pool by setting its receiving address to this
+	// account's address.
+	RewardsPoolName = "lockup_rewards"
+
+	// PenaltyPoolName defines the module account receiving early unlock
+	// penalties when they are sent to a module account.
+	PenaltyPoolName = "lockup_penalties"
+
+	// LockNFTClassID defines the x/nft class of lock position tokens.
+	LockNFTClassID = "lockup"
+
+	// LockNFTSettlementsPerBlock defines how many lock position tokens the end
+	// blocker checks for an unsettled change of owner in each block.
+	LockNFTSettlementsPerBlock = 100
 )
 
 var (
-	LocksByDateKey    = []byte("locks_by_date")
-	LocksByAddressKey = []byte("locks_by_address")
-	TotalLockedKey    = []byte("total_locked")
+	ParamsKey = []byte("p_lockup")
+
+	LocksByDateKey             = []byte("locks_by_date")
+	LocksByAddressKey          = []byte("locks_by_address")
+	TotalLockedKey             = []byte("total_locked")
+	RollingLocksKey            = []byte("rolling_locks")
+	PendingRewardsKey          = []byte("pending_rewards")
+	LastRewardEpochKey         = []byte("last_reward_epoch")
+	RewardIndexKey             = []byte("reward_index")
+	RewardSnapshotsKey         = []byte("reward_snapshots")
+	TotalRewardWeightKey       = []byte("total_reward_weight")
+	OutstandingRewardsKey      = []byte("outstanding_rewards")
+	LockNFTsKey                = []byte("lock_nfts")
+	LockNFTsByOwnerKey         = []byte("owner_lock_nfts")
+	NextLockNFTIDKey           = []byte("next_lock_nft_id")
+	LockNFTSettlementCursorKey = []byte("nft_settlement_cursor")
+	LockChecksKey              = []byte("lock_checks")
+	LockHistoryKey             = []byte("lock_history")
+	LockHistoryQueueKey        = []byte("history_queue")
+	NextLockHistoryIDKey       = []byte("next_lock_history_id")
+	EscrowLocksByDateKey       = []byte("escrow_locks_by_date")
+	TotalEscrowedKey           = []byte("total_escrowed")
 )
 
+// LockNFTID returns the x/nft token id of the lock position with the given sequence number.
+func LockNFTID(seq uint64) string {
+	return fmt.Sprintf("lock-%d", seq)
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
@@ -27,9 +27,78 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// LockKind is the kind of a lock.
+type LockKind int32
+
+const (
+	// LOCK_KIND_DELEGATED is a lock of the bond denom backed by the delegations of its owner.
+	LockKind_LOCK_KIND_DELEGATED LockKind = 0
+	// LOCK_KIND_ESCROWED is a lock whose tokens are held by the lockup module account and returned at expiry.
+	LockKind_LOCK_KIND_ESCROWED LockKind = 1
+)
+
+var LockKind_name = map[int32]string{
+	0: "LOCK_KIND_DELEGATED",
+	1: "LOCK_KIND_ESCROWED",
+}
+
+var LockKind_value = map[string]int32{
+	"LOCK_KIND_DELEGATED": 0,
+	"LOCK_KIND_ESCROWED":  1,
+}
+
+func (x LockKind) String() string {
+	return proto.EnumName(LockKind_name, int32(x))
+}
+
+func (LockKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d59a785ea6fd3746, []int{0}
+}
+
+// LockSchedulePeriod is the interval between the unlock dates of a lock schedule.
+type LockSchedulePeriod int32
+
+const (
+	// LOCK_SCHEDULE_PERIOD_UNSPECIFIED is not a valid period.
+	LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_UNSPECIFIED LockSchedulePeriod = 0
+	// LOCK_SCHEDULE_PERIOD_DAILY unlocks every day.
+	LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_DAILY LockSchedulePeriod = 1
+	// LOCK_SCHEDULE_PERIOD_WEEKLY unlocks every 7 days.
+	LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_WEEKLY LockSchedulePeriod = 2
+	// LOCK_SCHEDULE_PERIOD_MONTHLY unlocks on the same day of every month, or on the last day of shorter months.
+	LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_MONTHLY LockSchedulePeriod = 3
+)
+
+var LockSchedulePeriod_name = map[int32]string{
+	0: "LOCK_SCHEDULE_PERIOD_UNSPECIFIED",
+	1: "LOCK_SCHEDULE_PERIOD_DAILY",
+	2: "LOCK_SCHEDULE_PERIOD_WEEKLY",
+	3: "LOCK_SCHEDULE_PERIOD_MONTHLY",
+}
+
+var LockSchedulePeriod_value = map[string]int32{
+	"LOCK_SCHEDULE_PERIOD_UNSPECIFIED": 0,
+	"LOCK_SCHEDULE_PERIOD_DAILY":       1,
+	"LOCK_SCHEDULE_PERIOD_WEEKLY":      2,
+	"LOCK_SCHEDULE_PERIOD_MONTHLY":     3,
+}
+
+func (x LockSchedulePeriod) String() string {
+	return proto.EnumName(LockSchedulePeriod_name, int32(x))
+}
+
+func (LockSchedulePeriod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d59a785ea6fd3746, []int{1}
+}
+
 type Lock struct {
+	// unlock_date is either a date such as 2026-03-01, which unlocks at the start of that UTC day, or an RFC3339
+	// timestamp in UTC such as 2026-03-01T14:30:00Z, which unlocks at the first block at or after that time.
 	UnlockDate string                `protobuf:"bytes,1,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
 	Amount     cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// denom is the denom of an escrowed lock, which may be the bond denom. It is empty for delegated locks.
+	Denom string   `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Kind  LockKind `protobuf:"varint,4,opt,name=kind,proto3,enum=optio.lockup.LockKind" json:"kind,omitempty"`
 }
 
 func (m *Lock) Reset()         { *m = Lock{} }
@@ -72,6 +141,20 @@ func (m *Lock) GetUnlockDate() string {
 	return ""
 }
 
+func (m *Lock) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Lock) GetKind() LockKind {
+	if m != nil {
+		return m.Kind
+	}
+	return LockKind_LOCK_KIND_DELEGATED
+}
+
 type Locks struct {
 	Locks []*Lock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
 }
@@ -116,35 +199,176 @@ func (m *Locks) GetLocks() []*Lock {
 	return nil
 }
 
+// RollingLock is an auto-renewing lock. Its effective unlock date is always
+// duration_days after the current block day.
+type RollingLock struct {
+	DurationDays uint32                `protobuf:"varint,1,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	Amount       cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *RollingLock) Reset()         { *m = RollingLock{} }
+func (m *RollingLock) String() string { return proto.CompactTextString(m) }
+func (*RollingLock) ProtoMessage()    {}
+func (*RollingLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d59a785ea6fd3746, []int{2}
+}
+func (m *RollingLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollingLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollingLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollingLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollingLock.Merge(m, src)
+}
+func (m *RollingLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollingLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollingLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollingLock proto.InternalMessageInfo
+
+func (m *RollingLock) GetDurationDays() uint32 {
+	if m != nil {
+		return m.DurationDays
+	}
+	return 0
+}
+
+// LockSchedule splits an amount into count equal locks that unlock one period apart, the first one period after
+// start_date. Any remainder is added to the last lock. The locks of the first cliff_periods periods all unlock at the
+// end of the cliff instead.
+type LockSchedule struct {
+	// start_date is a date such as 2026-03-01.
+	StartDate string             `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	Period    LockSchedulePeriod `protobuf:"varint,2,opt,name=period,proto3,enum=optio.lockup.LockSchedulePeriod" json:"period,omitempty"`
+	Count     uint32             `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// cliff_periods is at most count. A cliff of count periods unlocks everything at once.
+	CliffPeriods uint32 `protobuf:"varint,4,opt,name=cliff_periods,json=cliffPeriods,proto3" json:"cliff_periods,omitempty"`
+}
+
+func (m *LockSchedule) Reset()         { *m = LockSchedule{} }
+func (m *LockSchedule) String() string { return proto.CompactTextString(m) }
+func (*LockSchedule) ProtoMessage()    {}
+func (*LockSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d59a785ea6fd3746, []int{3}
+}
+func (m *LockSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockSchedule.Merge(m, src)
+}
+func (m *LockSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockSchedule proto.InternalMessageInfo
+
+func (m *LockSchedule) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *LockSchedule) GetPeriod() LockSchedulePeriod {
+	if m != nil {
+		return m.Period
+	}
+	return LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_UNSPECIFIED
+}
+
+func (m *LockSchedule) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *LockSchedule) GetCliffPeriods() uint32 {
+	if m != nil {
+		return m.CliffPeriods
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("optio.lockup.LockKind", LockKind_name, LockKind_value)
+	proto.RegisterEnum("optio.lockup.LockSchedulePeriod", LockSchedulePeriod_name, LockSchedulePeriod_value)
 	proto.RegisterType((*Lock)(nil), "optio.lockup.Lock")
 	proto.RegisterType((*Locks)(nil), "optio.lockup.Locks")
+	proto.RegisterType((*RollingLock)(nil), "optio.lockup.RollingLock")
+	proto.RegisterType((*LockSchedule)(nil), "optio.lockup.LockSchedule")
 }
 
 func init() { proto.RegisterFile("optio/lockup/lock.proto", fileDescriptor_d59a785ea6fd3746) }
 
 var fileDescriptor_d59a785ea6fd3746 = []byte{
-	// 306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcf, 0x2f, 0x28, 0xc9,
-	0xcc, 0xd7, 0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x2d, 0x00, 0x53, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x3c, 0x60, 0x09, 0x3d, 0x88, 0x84, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x42, 0x1f,
-	0xc4, 0x82, 0xa8, 0x91, 0x92, 0x4c, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x8e, 0x87, 0x48, 0x40, 0x38,
-	0x50, 0x29, 0x39, 0x08, 0x4f, 0x3f, 0x29, 0xb1, 0x38, 0x55, 0xbf, 0xcc, 0x30, 0x29, 0xb5, 0x24,
-	0xd1, 0x50, 0x3f, 0x39, 0x3f, 0x33, 0x0f, 0x2a, 0x2f, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f,
-	0x26, 0x21, 0x42, 0x4a, 0x85, 0x5c, 0x2c, 0x3e, 0xf9, 0xc9, 0xd9, 0x42, 0xf2, 0x5c, 0xdc, 0xa5,
-	0x79, 0x20, 0x7b, 0xe3, 0x53, 0x12, 0x4b, 0x52, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xb8,
-	0x20, 0x42, 0x2e, 0x89, 0x25, 0xa9, 0x42, 0x1e, 0x5c, 0x6c, 0x89, 0xb9, 0xf9, 0xa5, 0x79, 0x25,
-	0x12, 0x4c, 0x20, 0x39, 0x27, 0x83, 0x13, 0xf7, 0xe4, 0x19, 0x6e, 0xdd, 0x93, 0x17, 0x85, 0xd8,
-	0x59, 0x9c, 0x92, 0xad, 0x97, 0x99, 0xaf, 0x9f, 0x9b, 0x58, 0x92, 0xa1, 0xe7, 0x99, 0x57, 0x72,
-	0x69, 0x8b, 0x2e, 0x17, 0xd4, 0x69, 0x9e, 0x79, 0x25, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82,
-	0xea, 0x57, 0x32, 0xe4, 0x62, 0x05, 0x59, 0x59, 0x2c, 0xa4, 0xc1, 0xc5, 0x0a, 0x32, 0xbe, 0x58,
-	0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x48, 0x0f, 0xd9, 0xf7, 0x7a, 0x20, 0x35, 0x41, 0x10,
-	0x05, 0x4e, 0x6e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3,
-	0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x93, 0x9e,
-	0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0xef, 0x0f, 0xd2, 0xee, 0x97, 0x5a, 0x52,
-	0x9e, 0x5f, 0x94, 0xad, 0x0f, 0x09, 0xe2, 0x0a, 0x58, 0x20, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27,
-	0xb1, 0x81, 0x3d, 0x6d, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x4c, 0xd6, 0xd1, 0x81, 0x01,
-	0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xc1, 0x6e, 0x12, 0x51,
+	0x14, 0xe5, 0x95, 0xb6, 0xb1, 0xb7, 0xb4, 0xc1, 0x67, 0x6d, 0xb1, 0xea, 0x30, 0x41, 0x17, 0x84,
+	0xe8, 0x8c, 0xe0, 0xc6, 0xc4, 0x55, 0xcb, 0x4c, 0x65, 0xc2, 0x08, 0x64, 0x68, 0xd3, 0xe0, 0x66,
+	0x32, 0xcc, 0x4c, 0x61, 0x02, 0x33, 0x8f, 0xf0, 0xde, 0xa8, 0x24, 0x7e, 0x84, 0x3f, 0xe0, 0xc6,
+	0x95, 0x4b, 0x13, 0xfd, 0x88, 0x2e, 0x1b, 0x57, 0xc6, 0x45, 0x63, 0x60, 0xe1, 0x6f, 0x98, 0x79,
+	0x0f, 0x62, 0x13, 0x58, 0xba, 0x81, 0xb9, 0xe7, 0x9c, 0x77, 0xef, 0x3d, 0x27, 0xb9, 0x70, 0x40,
+	0x46, 0x2c, 0x20, 0xea, 0x90, 0xb8, 0x83, 0x78, 0xc4, 0xff, 0x94, 0xd1, 0x98, 0x30, 0x82, 0x33,
+	0x9c, 0x50, 0x04, 0x71, 0xb8, 0xd7, 0x23, 0x3d, 0xc2, 0x09, 0x35, 0xf9, 0x12, 0x9a, 0xc3, 0x7b,
+	0x2e, 0xa1, 0x21, 0xa1, 0xb6, 0x20, 0x44, 0x31, 0xa7, 0x24, 0x51, 0xa9, 0x5d, 0x87, 0xfa, 0xea,
+	0xdb, 0x72, 0xd7, 0x67, 0x4e, 0x59, 0x75, 0x49, 0x10, 0xcd, 0xf9, 0xdb, 0x4e, 0x18, 0x44, 0x44,
+	0xe5, 0xbf, 0x02, 0x2a, 0x7c, 0x43, 0xb0, 0x6e, 0x12, 0x77, 0x80, 0xf3, 0xb0, 0x1d, 0x47, 0xc9,
+	0x60, 0xdb, 0x73, 0x98, 0x9f, 0x43, 0x32, 0x2a, 0x6e, 0x59, 0x20, 0x20, 0xcd, 0x61, 0x3e, 0xae,
+	0xc1, 0xa6, 0x13, 0x92, 0x38, 0x62, 0xb9, 0xb5, 0x84, 0x3b, 0x7e, 0x76, 0x79, 0x9d, 0x4f, 0xfd,
+	0xba, 0xce, 0xdf, 0x15, 0x43, 0xa9, 0x37, 0x50, 0x02, 0xa2, 0x86, 0x0e, 0xeb, 0x2b, 0x46, 0xc4,
+	0x7e, 0x7c, 0x7f, 0x0a, 0xf3, 0xdd, 0x8c, 0x88, 0x7d, 0xf9, 0xf3, 0xb5, 0x84, 0xac, 0xf9, 0x7b,
+	0xbc, 0x07, 0x1b, 0x9e, 0x1f, 0x91, 0x30, 0x97, 0xe6, 0x43, 0x44, 0x81, 0x4b, 0xb0, 0x3e, 0x08,
+	0x22, 0x2f, 0xb7, 0x2e, 0xa3, 0xe2, 0x6e, 0x65, 0x5f, 0xb9, 0x19, 0x85, 0x92, 0xac, 0x58, 0x0f,
+	0x22, 0xcf, 0xe2, 0x9a, 0x42, 0x19, 0x36, 0x12, 0x84, 0xe2, 0x22, 0x6c, 0x24, 0x0a, 0x9a, 0x43,
+	0x72, 0xba, 0xb8, 0x5d, 0xc1, 0xcb, 0xaf, 0x2c, 0x21, 0x28, 0x7c, 0x80, 0x6d, 0x8b, 0x0c, 0x87,
+	0x41, 0xd4, 0xe3, 0x76, 0x1f, 0xc1, 0x8e, 0x17, 0x8f, 0x1d, 0x16, 0x90, 0xc8, 0xf6, 0x9c, 0x09,
+	0xe5, 0x86, 0x77, 0xac, 0xcc, 0x02, 0xd4, 0x9c, 0x09, 0xfd, 0x7f, 0x96, 0x0b, 0x9f, 0x11, 0x64,
+	0x92, 0xb9, 0x6d, 0xb7, 0xef, 0x7b, 0xf1, 0xd0, 0xc7, 0x0f, 0x01, 0x28, 0x73, 0xc6, 0xec, 0x66,
+	0xda, 0x5b, 0x1c, 0xe1, 0x61, 0xbf, 0x80, 0xcd, 0x91, 0x3f, 0x0e, 0x88, 0xc7, 0x27, 0xef, 0x56,
+	0xe4, 0x65, 0x63, 0x8b, 0x56, 0x2d, 0xae, 0xb3, 0xe6, 0xfa, 0x24, 0x5c, 0x97, 0xaf, 0x9c, 0xe6,
+	0x86, 0x44, 0x91, 0xd8, 0x75, 0x87, 0xc1, 0xc5, 0x85, 0x2d, 0x54, 0x94, 0xa7, 0xbc, 0x63, 0x65,
+	0x38, 0x28, 0x3a, 0xd0, 0xd2, 0x4b, 0xb8, 0xb5, 0xc8, 0x19, 0x1f, 0xc0, 0x1d, 0xb3, 0x59, 0xad,
+	0xdb, 0x75, 0xa3, 0xa1, 0xd9, 0x9a, 0x6e, 0xea, 0xaf, 0x8e, 0x4e, 0x75, 0x2d, 0x9b, 0xc2, 0xfb,
+	0x80, 0xff, 0x11, 0x7a, 0xbb, 0x6a, 0x35, 0xcf, 0x75, 0x2d, 0x8b, 0x4a, 0x9f, 0x10, 0xe0, 0xe5,
+	0xb5, 0xf0, 0x63, 0x90, 0xb9, 0xbc, 0x5d, 0xad, 0xe9, 0xda, 0x99, 0xa9, 0xdb, 0x2d, 0xdd, 0x32,
+	0x9a, 0x9a, 0x7d, 0xd6, 0x68, 0xb7, 0xf4, 0xaa, 0x71, 0x62, 0xf0, 0xa6, 0x12, 0x1c, 0xae, 0x54,
+	0x69, 0x47, 0x86, 0xd9, 0xc9, 0x22, 0x9c, 0x87, 0xfb, 0x2b, 0xf9, 0x73, 0x5d, 0xaf, 0x9b, 0x9d,
+	0xec, 0x1a, 0x96, 0xe1, 0xc1, 0x4a, 0xc1, 0xeb, 0x66, 0xe3, 0xb4, 0x66, 0x76, 0xb2, 0xe9, 0xe3,
+	0x93, 0xcb, 0xa9, 0x84, 0xae, 0xa6, 0x12, 0xfa, 0x3d, 0x95, 0xd0, 0xc7, 0x99, 0x94, 0xba, 0x9a,
+	0x49, 0xa9, 0x9f, 0x33, 0x29, 0xf5, 0xe6, 0x49, 0x2f, 0x60, 0xfd, 0xb8, 0xab, 0xb8, 0x24, 0x54,
+	0x9b, 0x49, 0xca, 0x0d, 0x9f, 0xbd, 0x23, 0xe3, 0x81, 0x2a, 0xae, 0xf4, 0xfd, 0xe2, 0x4e, 0xd9,
+	0x64, 0xe4, 0xd3, 0xee, 0x26, 0xbf, 0x9b, 0xe7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x33, 0xd5,
+	0x1d, 0x04, 0xc4, 0x03, 0x00, 0x00,
 }
 
 func (m *Lock) Marshal() (dAtA []byte, err error) {
@@ -167,6 +391,18 @@ func (m *Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Kind != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -224,6 +460,89 @@ func (m *Locks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RollingLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollingLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollingLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.DurationDays != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.DurationDays))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CliffPeriods != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.CliffPeriods))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Count != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Period != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLock(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLock(v)
 	base := offset
@@ -247,6 +566,13 @@ func (m *Lock) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovLock(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovLock(uint64(m.Kind))
+	}
 	return n
 }
 
@@ -265,6 +591,42 @@ func (m *Locks) Size() (n int) {
 	return n
 }
 
+func (m *RollingLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DurationDays != 0 {
+		n += 1 + sovLock(uint64(m.DurationDays))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLock(uint64(l))
+	return n
+}
+
+func (m *LockSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovLock(uint64(m.Period))
+	}
+	if m.Count != 0 {
+		n += 1 + sovLock(uint64(m.Count))
+	}
+	if m.CliffPeriods != 0 {
+		n += 1 + sovLock(uint64(m.CliffPeriods))
+	}
+	return n
+}
+
 func sovLock(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -366,6 +728,57 @@ func (m *Lock) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= LockKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -471,6 +884,248 @@ func (m *Locks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RollingLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollingLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollingLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationDays", wireType)
+			}
+			m.DurationDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= LockSchedulePeriod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffPeriods", wireType)
+			}
+			m.CliffPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffPeriods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLock(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
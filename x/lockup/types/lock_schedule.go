@@ -0,0 +1,95 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxLockScheduleCount bounds the number of locks a single schedule expands
+// into, which is a year of daily locks.
+const MaxLockScheduleCount = 366
+
+func NewLockSchedule(startDate string, period LockSchedulePeriod, count uint32, cliffPeriods uint32) LockSchedule {
+	return LockSchedule{
+		StartDate:    startDate,
+		Period:       period,
+		Count:        count,
+		CliffPeriods: cliffPeriods,
+	}
+}
+
+func (s LockSchedule) Validate() error {
+	if _, err := time.Parse(time.DateOnly, s.StartDate); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDate, "invalid schedule start date %s: %s", s.StartDate, err)
+	}
+
+	if _, ok := LockSchedulePeriod_name[int32(s.Period)]; !ok || s.Period == LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_UNSPECIFIED {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid schedule period: %s", s.Period)
+	}
+
+	if s.Count == 0 || s.Count > MaxLockScheduleCount {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "schedule count must be between 1 and %d, got %d", MaxLockScheduleCount, s.Count)
+	}
+
+	if s.CliffPeriods > s.Count {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cliff of %d periods is longer than the schedule of %d periods", s.CliffPeriods, s.Count)
+	}
+
+	return nil
+}
+
+// Locks splits totalAmount into the locks of the schedule, in unlock date
+// order. Every lock holds a positive amount.
+func (s LockSchedule) Locks(totalAmount math.Int) ([]Lock, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	count := math.NewInt(int64(s.Count))
+	if totalAmount.LT(count) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount %s cannot be split into %d locks", totalAmount, s.Count)
+	}
+
+	startDate, err := time.Parse(time.DateOnly, s.StartDate)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidDate, "invalid schedule start date %s: %s", s.StartDate, err)
+	}
+
+	amount := totalAmount.Quo(count)
+	remainder := totalAmount.Sub(amount.Mul(count))
+
+	// the locks of the cliff all unlock at its end
+	first := max(s.CliffPeriods, 1)
+	locks := make([]Lock, 0, s.Count-first+1)
+	locks = append(locks, Lock{
+		UnlockDate: FormatUnlockTime(s.unlockTime(startDate, first)),
+		Amount:     amount.MulRaw(int64(first)),
+	})
+	for i := first + 1; i <= s.Count; i++ {
+		locks = append(locks, Lock{
+			UnlockDate: FormatUnlockTime(s.unlockTime(startDate, i)),
+			Amount:     amount,
+		})
+	}
+	locks[len(locks)-1].Amount = locks[len(locks)-1].Amount.Add(remainder)
+
+	return locks, nil
+}
+
+// unlockTime returns the unlock time of the lock n periods after startDate.
+func (s LockSchedule) unlockTime(startDate time.Time, n uint32) time.Time {
+	switch s.Period {
+	case LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_DAILY:
+		return startDate.AddDate(0, 0, int(n))
+	case LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_WEEKLY:
+		return startDate.AddDate(0, 0, 7*int(n))
+	default:
+		// time.AddDate would roll the 31st over into the next month
+		firstOfMonth := time.Date(startDate.Year(), startDate.Month()+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+		lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+		return firstOfMonth.AddDate(0, 0, min(startDate.Day(), lastDay)-1)
+	}
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockSchedule_Locks(t *testing.T) {
+	lock := func(unlockDate string, amount int64) Lock {
+		return Lock{UnlockDate: unlockDate, Amount: math.NewInt(amount)}
+	}
+
+	tests := []struct {
+		name     string
+		schedule LockSchedule
+		total    int64
+		locks    []Lock
+		err      error
+	}{
+		{
+			name:     "daily",
+			schedule: NewLockSchedule("2026-01-01", LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_DAILY, 3, 0),
+			total:    300,
+			locks:    []Lock{lock("2026-01-02", 100), lock("2026-01-03", 100), lock("2026-01-04", 100)},
+		}, {
+			name:     "weekly remainder goes to the last lock",
+			schedule: NewLockSchedule("2026-01-01", LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_WEEKLY, 3, 0),
+			total:    302,
+			locks:    []Lock{lock("2026-01-08", 100), lock("2026-01-15", 100), lock("2026-01-22", 102)},
+		}, {
+			name:     "monthly stays on the last day of shorter months",
+			schedule: NewLockSchedule("2026-01-31", LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_MONTHLY, 3, 0),
+			total:    300,
+			locks:    []Lock{lock("2026-02-28", 100), lock("2026-03-31", 100), lock("2026-04-30", 100)},
+		}, {
+			name:     "cliff",
+			schedule: NewLockSchedule("2026-01-01", LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_MONTHLY, 4, 2),
+			total:    400,
+			locks:    []Lock{lock("2026-03-01", 200), lock("2026-04-01", 100), lock("2026-05-01", 100)},
+		}, {
+			name:     "cliff of the whole schedule",
+			schedule: NewLockSchedule("2026-01-01", LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_MONTHLY, 12, 12),
+			total:    1201,
+			locks:    []Lock{lock("2027-01-01", 1201)},
+		}, {
+			name:     "amount smaller than count",
+			schedule: NewLockSchedule("2026-01-01", LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_DAILY, 3, 0),
+			total:    2,
+			err:      sdkerrors.ErrInvalidCoins,
+		}, {
+			name:     "cliff longer than schedule",
+			schedule: NewLockSchedule("2026-01-01", LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_DAILY, 3, 4),
+			total:    300,
+			err:      sdkerrors.ErrInvalidRequest,
+		}, {
+			name:     "unspecified period",
+			schedule: NewLockSchedule("2026-01-01", LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_UNSPECIFIED, 3, 0),
+			total:    300,
+			err:      sdkerrors.ErrInvalidRequest,
+		}, {
+			name:     "zero count",
+			schedule: NewLockSchedule("2026-01-01", LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_DAILY, 0, 0),
+			total:    300,
+			err:      sdkerrors.ErrInvalidRequest,
+		}, {
+			name:     "count above max",
+			schedule: NewLockSchedule("2026-01-01", LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_DAILY, MaxLockScheduleCount+1, 0),
+			total:    100_000,
+			err:      sdkerrors.ErrInvalidRequest,
+		}, {
+			name:     "timestamp start date",
+			schedule: NewLockSchedule("2026-01-01T12:00:00Z", LockSchedulePeriod_LOCK_SCHEDULE_PERIOD_DAILY, 3, 0),
+			total:    300,
+			err:      ErrInvalidDate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locks, err := tt.schedule.Locks(math.NewInt(tt.total))
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.locks, locks)
+		})
+	}
+}
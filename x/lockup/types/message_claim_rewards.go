@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+func NewMsgClaimRewards(address string) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Address: address,
+	}
+}
+
+func (msg *MsgClaimRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxDelegateAndLockValidators is the maximum number of validators a
+// MsgDelegateAndLock can delegate to.
+const MaxDelegateAndLockValidators = 10
+
+var _ sdk.Msg = &MsgDelegateAndLock{}
+
+func NewMsgDelegateAndLock(address string, validators []ValidatorWeight, unlockDate string, amount sdk.Coin) *MsgDelegateAndLock {
+	return &MsgDelegateAndLock{
+		Address:    address,
+		Validators: validators,
+		UnlockDate: unlockDate,
+		Amount:     amount,
+	}
+}
+
+func (msg *MsgDelegateAndLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+
+	if err := ValidateValidatorWeights(msg.Validators); err != nil {
+		return err
+	}
+
+	if _, err := ParseUnlockTime(msg.UnlockDate); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date format: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount: %s", msg.Amount.String())
+	}
+
+	return nil
+}
+
+// ValidateValidatorWeights checks that validators holds at most
+// MaxDelegateAndLockValidators distinct validators whose weights sum to 1.
+func ValidateValidatorWeights(validators []ValidatorWeight) error {
+	if len(validators) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one validator is required")
+	}
+
+	if len(validators) > MaxDelegateAndLockValidators {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "too many validators: %d > %d", len(validators), MaxDelegateAndLockValidators)
+	}
+
+	total := math.LegacyZeroDec()
+	seen := make(map[string]bool, len(validators))
+	for _, validator := range validators {
+		if _, err := sdk.ValAddressFromBech32(validator.ValidatorAddress); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %s (%s)", validator.ValidatorAddress, err)
+		}
+
+		if seen[validator.ValidatorAddress] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate validator address %s", validator.ValidatorAddress)
+		}
+		seen[validator.ValidatorAddress] = true
+
+		if validator.Weight.IsNil() || !validator.Weight.IsPositive() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "weight of validator %s must be positive", validator.ValidatorAddress)
+		}
+		total = total.Add(validator.Weight)
+	}
+
+	if !total.Equal(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "validator weights must sum to 1, got %s", total)
+	}
+
+	return nil
+}
+
+// SplitByWeight splits amount between validators by weight. Each part is
+// rounded down and the remainder is added to the last part.
+func SplitByWeight(amount math.Int, validators []ValidatorWeight) []math.Int {
+	parts := make([]math.Int, len(validators))
+	remaining := amount
+	for i, validator := range validators {
+		if i == len(validators)-1 {
+			parts[i] = remaining
+			break
+		}
+		parts[i] = validator.Weight.MulInt(amount).TruncateInt()
+		remaining = remaining.Sub(parts[i])
+	}
+	return parts
+}
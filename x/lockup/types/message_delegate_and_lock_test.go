@@ -0,0 +1,103 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDelegateAndLock_ValidateBasic(t *testing.T) {
+	addr := sample.AccAddress()
+	valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	otherValAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+	amount := sdk.NewCoin(bondDenom, math.NewInt(1000))
+	weight := func(validatorAddress string, weight string) ValidatorWeight {
+		return ValidatorWeight{ValidatorAddress: validatorAddress, Weight: math.LegacyMustNewDecFromStr(weight)}
+	}
+
+	tooMany := make([]ValidatorWeight, MaxDelegateAndLockValidators+1)
+	for i := range tooMany {
+		tooMany[i] = weight(sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String(), "0.1")
+	}
+
+	tests := []struct {
+		name string
+		msg  *MsgDelegateAndLock
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  NewMsgDelegateAndLock("invalid_address", []ValidatorWeight{weight(valAddr, "1")}, "2026-12-01", amount),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no validators",
+			msg:  NewMsgDelegateAndLock(addr, nil, "2026-12-01", amount),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "too many validators",
+			msg:  NewMsgDelegateAndLock(addr, tooMany, "2026-12-01", amount),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid validator",
+			msg:  NewMsgDelegateAndLock(addr, []ValidatorWeight{weight("invalid", "1")}, "2026-12-01", amount),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicate validator",
+			msg:  NewMsgDelegateAndLock(addr, []ValidatorWeight{weight(valAddr, "0.5"), weight(valAddr, "0.5")}, "2026-12-01", amount),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero weight",
+			msg:  NewMsgDelegateAndLock(addr, []ValidatorWeight{weight(valAddr, "1"), weight(otherValAddr, "0")}, "2026-12-01", amount),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "weights do not sum to 1",
+			msg:  NewMsgDelegateAndLock(addr, []ValidatorWeight{weight(valAddr, "0.5"), weight(otherValAddr, "0.4")}, "2026-12-01", amount),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid unlock date",
+			msg:  NewMsgDelegateAndLock(addr, []ValidatorWeight{weight(valAddr, "1")}, "12/01/2026", amount),
+			err:  ErrInvalidDate,
+		}, {
+			name: "zero amount",
+			msg:  NewMsgDelegateAndLock(addr, []ValidatorWeight{weight(valAddr, "1")}, "2026-12-01", sdk.NewCoin(bondDenom, math.ZeroInt())),
+			err:  sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid",
+			msg:  NewMsgDelegateAndLock(addr, []ValidatorWeight{weight(valAddr, "0.75"), weight(otherValAddr, "0.25")}, "2026-12-01", amount),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSplitByWeight(t *testing.T) {
+	validators := []ValidatorWeight{
+		{Weight: math.LegacyMustNewDecFromStr("0.333333333333333333")},
+		{Weight: math.LegacyMustNewDecFromStr("0.333333333333333333")},
+		{Weight: math.LegacyMustNewDecFromStr("0.333333333333333334")},
+	}
+
+	split := func(amount int64) []string {
+		var parts []string
+		for _, part := range SplitByWeight(math.NewInt(amount), validators) {
+			parts = append(parts, part.String())
+		}
+		return parts
+	}
+
+	// the rounding remainder goes to the last validator
+	require.Equal(t, []string{"333", "333", "334"}, split(1000))
+	require.Equal(t, []string{"0", "0", "2"}, split(2))
+}
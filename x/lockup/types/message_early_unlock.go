@@ -0,0 +1,38 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgEarlyUnlock{}
+
+func NewMsgEarlyUnlock(address string, unlockDate string, amount sdk.Coin) *MsgEarlyUnlock {
+	return &MsgEarlyUnlock{
+		Address:    address,
+		UnlockDate: unlockDate,
+		Amount:     amount,
+	}
+}
+
+func (msg *MsgEarlyUnlock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+
+	if msg.UnlockDate == "" {
+		return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date")
+	}
+	_, err = ParseUnlockTime(msg.UnlockDate)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date format: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid early unlock amount: %s", msg.Amount.String())
+	}
+
+	return nil
+}
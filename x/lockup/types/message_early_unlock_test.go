@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgEarlyUnlock_ValidateBasic(t *testing.T) {
+	validAddr := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  MsgEarlyUnlock
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgEarlyUnlock{
+				Address:    "invalid_address",
+				UnlockDate: "2026-12-01",
+				Amount:     sdk.NewCoin(bondDenom, math.NewInt(1000)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty unlock date",
+			msg: MsgEarlyUnlock{
+				Address:    validAddr,
+				UnlockDate: "",
+				Amount:     sdk.NewCoin(bondDenom, math.NewInt(1000)),
+			},
+			err: ErrInvalidDate,
+		},
+		{
+			name: "invalid unlock date format",
+			msg: MsgEarlyUnlock{
+				Address:    validAddr,
+				UnlockDate: "12/01/2026",
+				Amount:     sdk.NewCoin(bondDenom, math.NewInt(1000)),
+			},
+			err: ErrInvalidDate,
+		},
+		{
+			name: "invalid amount - zero",
+			msg: MsgEarlyUnlock{
+				Address:    validAddr,
+				UnlockDate: "2026-12-01",
+				Amount:     sdk.NewCoin(bondDenom, math.NewInt(0)),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "invalid amount - negative",
+			msg: MsgEarlyUnlock{
+				Address:    validAddr,
+				UnlockDate: "2026-12-01",
+				Amount:     sdk.Coin{Denom: bondDenom, Amount: math.NewInt(-1000)},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid early unlock",
+			msg: MsgEarlyUnlock{
+				Address:    validAddr,
+				UnlockDate: "2026-12-01",
+				Amount:     sdk.NewCoin(bondDenom, math.NewInt(1000)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
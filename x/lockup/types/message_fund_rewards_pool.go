@@ -0,0 +1,29 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgFundRewardsPool{}
+
+func NewMsgFundRewardsPool(depositor string, amount sdk.Coins) *MsgFundRewardsPool {
+	return &MsgFundRewardsPool{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgFundRewardsPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address (%s)", err)
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid funding amount: %s", msg.Amount)
+	}
+
+	return nil
+}
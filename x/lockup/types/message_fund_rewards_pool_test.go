@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/OptioNetwork/optio/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFundRewardsPool_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFundRewardsPool
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgFundRewardsPool{
+				Depositor: "invalid_address",
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty amount",
+			msg: MsgFundRewardsPool{
+				Depositor: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid funding",
+			msg: MsgFundRewardsPool{
+				Depositor: sample.AccAddress(),
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
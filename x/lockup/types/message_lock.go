@@ -1,8 +1,6 @@
 package types
 
 import (
-	"time"
-
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -27,7 +25,7 @@ func (msg *MsgLock) ValidateBasic() error {
 	if msg.UnlockDate == "" {
 		return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date")
 	}
-	_, err = time.Parse(time.DateOnly, msg.UnlockDate)
+	_, err = ParseUnlockTime(msg.UnlockDate)
 	if err != nil {
 		return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date format: %s", err)
 	}
@@ -36,5 +34,9 @@ func (msg *MsgLock) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid lock amount: %s", msg.Amount.String())
 	}
 
+	if msg.Escrow && msg.AutoRenew {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "escrowed locks cannot auto-renew")
+	}
+
 	return nil
 }
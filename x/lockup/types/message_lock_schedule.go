@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgLockSchedule{}
+
+func NewMsgLockSchedule(address string, totalAmount sdk.Coin, schedule LockSchedule) *MsgLockSchedule {
+	return &MsgLockSchedule{
+		Address:     address,
+		TotalAmount: totalAmount,
+		Schedule:    schedule,
+	}
+}
+
+func (msg *MsgLockSchedule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+
+	if !msg.TotalAmount.IsValid() || !msg.TotalAmount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid total amount: %s", msg.TotalAmount.String())
+	}
+
+	_, err = msg.Schedule.Locks(msg.TotalAmount.Amount)
+	return err
+}
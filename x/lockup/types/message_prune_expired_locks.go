@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxPruneAddresses is the maximum number of addresses in a MsgPruneExpiredLocks,
+// which bounds the work done by a single message.
+const MaxPruneAddresses = 100
+
+var _ sdk.Msg = &MsgPruneExpiredLocks{}
+
+func NewMsgPruneExpiredLocks(sender string, addresses []string) *MsgPruneExpiredLocks {
+	return &MsgPruneExpiredLocks{
+		Sender:    sender,
+		Addresses: addresses,
+	}
+}
+
+func (msg *MsgPruneExpiredLocks) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if len(msg.Addresses) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one address is required")
+	}
+
+	if len(msg.Addresses) > MaxPruneAddresses {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "too many addresses: %d > %d", len(msg.Addresses), MaxPruneAddresses)
+	}
+
+	seen := make(map[string]bool, len(msg.Addresses))
+	for _, address := range msg.Addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s (%s)", address, err)
+		}
+
+		if seen[address] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate address %s", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
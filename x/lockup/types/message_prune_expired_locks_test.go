@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/OptioNetwork/optio/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgPruneExpiredLocks_ValidateBasic(t *testing.T) {
+	sender := sample.AccAddress()
+	addr := sample.AccAddress()
+
+	tooMany := make([]string, MaxPruneAddresses+1)
+	for i := range tooMany {
+		tooMany[i] = sample.AccAddress()
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgPruneExpiredLocks
+		err  error
+	}{
+		{
+			name: "invalid sender",
+			msg: MsgPruneExpiredLocks{
+				Sender:    "invalid_address",
+				Addresses: []string{addr},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no addresses",
+			msg: MsgPruneExpiredLocks{
+				Sender: sender,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "too many addresses",
+			msg: MsgPruneExpiredLocks{
+				Sender:    sender,
+				Addresses: tooMany,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid address",
+			msg: MsgPruneExpiredLocks{
+				Sender:    sender,
+				Addresses: []string{addr, "invalid_address"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicate address",
+			msg: MsgPruneExpiredLocks{
+				Sender:    sender,
+				Addresses: []string{addr, addr},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgPruneExpiredLocks{
+				Sender:    sender,
+				Addresses: []string{addr, sender},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSendDelegateAndLockSchedule{}
+
+func NewMsgSendDelegateAndLockSchedule(fromAddress string, toAddress string, validatorAddress string, totalAmount sdk.Coin, schedule LockSchedule) *MsgSendDelegateAndLockSchedule {
+	return &MsgSendDelegateAndLockSchedule{
+		FromAddress:      fromAddress,
+		ToAddress:        toAddress,
+		ValidatorAddress: validatorAddress,
+		TotalAmount:      totalAmount,
+		Schedule:         schedule,
+	}
+}
+
+func (msg *MsgSendDelegateAndLockSchedule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid to address (%s)", err)
+	}
+
+	if msg.ValidatorAddress != "" {
+		if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+		}
+	}
+
+	if !msg.TotalAmount.IsValid() || !msg.TotalAmount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid total amount: %s", msg.TotalAmount.String())
+	}
+
+	_, err = msg.Schedule.Locks(msg.TotalAmount.Amount)
+	return err
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetAutoRenew{}
+
+func NewMsgSetAutoRenew(address string, unlockDate string, autoRenew bool) *MsgSetAutoRenew {
+	return &MsgSetAutoRenew{
+		Address:    address,
+		UnlockDate: unlockDate,
+		AutoRenew:  autoRenew,
+	}
+}
+
+func (msg *MsgSetAutoRenew) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+
+	if msg.UnlockDate == "" {
+		return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date")
+	}
+	_, err = ParseUnlockTime(msg.UnlockDate)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date format: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgTokenizeLock{}
+
+func NewMsgTokenizeLock(address string, unlockDate string) *MsgTokenizeLock {
+	return &MsgTokenizeLock{
+		Address:    address,
+		UnlockDate: unlockDate,
+	}
+}
+
+func (msg *MsgTokenizeLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+
+	if msg.UnlockDate == "" {
+		return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date")
+	}
+	_, err = ParseUnlockTime(msg.UnlockDate)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date format: %s", err)
+	}
+
+	return nil
+}
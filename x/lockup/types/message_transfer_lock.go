@@ -0,0 +1,54 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgTransferLock{}
+
+func NewMsgTransferLock(fromAddress string, toAddress string, validatorAddress string, unlockDate string, amount sdk.Coin) *MsgTransferLock {
+	return &MsgTransferLock{
+		FromAddress:      fromAddress,
+		ToAddress:        toAddress,
+		ValidatorAddress: validatorAddress,
+		UnlockDate:       unlockDate,
+		Amount:           amount,
+	}
+}
+
+func (msg *MsgTransferLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fromAddress address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid toAddress address (%s)", err)
+	}
+
+	if msg.FromAddress == msg.ToAddress {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot transfer a lock to the same address")
+	}
+
+	_, err = sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+
+	if msg.UnlockDate == "" {
+		return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date")
+	}
+	_, err = ParseUnlockTime(msg.UnlockDate)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date format: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid transfer amount: %s", msg.Amount.String())
+	}
+
+	return nil
+}
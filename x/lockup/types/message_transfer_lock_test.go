@@ -0,0 +1,110 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/OptioNetwork/optio/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferLock_ValidateBasic(t *testing.T) {
+	fromAddr := sample.AccAddress()
+	toAddr := sample.AccAddress()
+	valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress())).String()
+
+	tests := []struct {
+		name string
+		msg  MsgTransferLock
+		err  error
+	}{
+		{
+			name: "invalid from address",
+			msg: MsgTransferLock{
+				FromAddress:      "invalid_address",
+				ToAddress:        toAddr,
+				ValidatorAddress: valAddr,
+				UnlockDate:       "2026-12-01",
+				Amount:           sdk.NewCoin(bondDenom, math.NewInt(1000)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid to address",
+			msg: MsgTransferLock{
+				FromAddress:      fromAddr,
+				ToAddress:        "invalid_address",
+				ValidatorAddress: valAddr,
+				UnlockDate:       "2026-12-01",
+				Amount:           sdk.NewCoin(bondDenom, math.NewInt(1000)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "same address",
+			msg: MsgTransferLock{
+				FromAddress:      fromAddr,
+				ToAddress:        fromAddr,
+				ValidatorAddress: valAddr,
+				UnlockDate:       "2026-12-01",
+				Amount:           sdk.NewCoin(bondDenom, math.NewInt(1000)),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid validator address",
+			msg: MsgTransferLock{
+				FromAddress:      fromAddr,
+				ToAddress:        toAddr,
+				ValidatorAddress: toAddr,
+				UnlockDate:       "2026-12-01",
+				Amount:           sdk.NewCoin(bondDenom, math.NewInt(1000)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid unlock date format",
+			msg: MsgTransferLock{
+				FromAddress:      fromAddr,
+				ToAddress:        toAddr,
+				ValidatorAddress: valAddr,
+				UnlockDate:       "12/01/2026",
+				Amount:           sdk.NewCoin(bondDenom, math.NewInt(1000)),
+			},
+			err: ErrInvalidDate,
+		},
+		{
+			name: "invalid amount - zero",
+			msg: MsgTransferLock{
+				FromAddress:      fromAddr,
+				ToAddress:        toAddr,
+				ValidatorAddress: valAddr,
+				UnlockDate:       "2026-12-01",
+				Amount:           sdk.NewCoin(bondDenom, math.NewInt(0)),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid transfer",
+			msg: MsgTransferLock{
+				FromAddress:      fromAddr,
+				ToAddress:        toAddr,
+				ValidatorAddress: valAddr,
+				UnlockDate:       "2026-12-01",
+				Amount:           sdk.NewCoin(bondDenom, math.NewInt(1000)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -102,35 +103,116 @@ func (m *MultiSendDelegateAndLockOutput) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// MultiSendDelegateAndLockResult is the lock created for an output of a MsgMultiSendDelegateAndLock.
+type MultiSendDelegateAndLockResult struct {
+	ToAddress  string     `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	UnlockDate string     `protobuf:"bytes,2,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	Amount     types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// kind is the kind of the lock. Outputs of allowed denoms other than the bond denom are escrowed.
+	Kind LockKind `protobuf:"varint,4,opt,name=kind,proto3,enum=optio.lockup.LockKind" json:"kind,omitempty"`
+	// new_shares are the delegation shares the recipient received from the validator. They are zero for escrowed locks.
+	NewShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=new_shares,json=newShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"new_shares"`
+}
+
+func (m *MultiSendDelegateAndLockResult) Reset()         { *m = MultiSendDelegateAndLockResult{} }
+func (m *MultiSendDelegateAndLockResult) String() string { return proto.CompactTextString(m) }
+func (*MultiSendDelegateAndLockResult) ProtoMessage()    {}
+func (*MultiSendDelegateAndLockResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8494412eb9ab7ce8, []int{1}
+}
+func (m *MultiSendDelegateAndLockResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiSendDelegateAndLockResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiSendDelegateAndLockResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiSendDelegateAndLockResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSendDelegateAndLockResult.Merge(m, src)
+}
+func (m *MultiSendDelegateAndLockResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiSendDelegateAndLockResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSendDelegateAndLockResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSendDelegateAndLockResult proto.InternalMessageInfo
+
+func (m *MultiSendDelegateAndLockResult) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MultiSendDelegateAndLockResult) GetUnlockDate() string {
+	if m != nil {
+		return m.UnlockDate
+	}
+	return ""
+}
+
+func (m *MultiSendDelegateAndLockResult) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MultiSendDelegateAndLockResult) GetKind() LockKind {
+	if m != nil {
+		return m.Kind
+	}
+	return LockKind_LOCK_KIND_DELEGATED
+}
+
 func init() {
 	proto.RegisterType((*MultiSendDelegateAndLockOutput)(nil), "optio.lockup.MultiSendDelegateAndLockOutput")
+	proto.RegisterType((*MultiSendDelegateAndLockResult)(nil), "optio.lockup.MultiSendDelegateAndLockResult")
 }
 
 func init() { proto.RegisterFile("optio/lockup/output.proto", fileDescriptor_8494412eb9ab7ce8) }
 
 var fileDescriptor_8494412eb9ab7ce8 = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0xb1, 0x4f, 0xfa, 0x40,
-	0x14, 0xc7, 0xdb, 0xdf, 0x0f, 0x49, 0x38, 0x18, 0xa4, 0x71, 0x00, 0x12, 0x0f, 0x74, 0x22, 0xd1,
-	0xf4, 0x82, 0x0e, 0xce, 0x20, 0x71, 0x52, 0x49, 0x70, 0x73, 0x21, 0xd7, 0xf6, 0xac, 0x17, 0xda,
-	0x7b, 0x4d, 0xfb, 0x0e, 0xf5, 0xbf, 0xf0, 0xcf, 0x62, 0x64, 0x74, 0x32, 0x06, 0x26, 0xff, 0x0b,
-	0xd3, 0xbb, 0xc2, 0xd2, 0xf4, 0x7d, 0x3f, 0x9f, 0xe1, 0xde, 0xfb, 0x92, 0x2e, 0x64, 0x28, 0x81,
-	0x25, 0x10, 0x2e, 0x75, 0xc6, 0x40, 0x63, 0xa6, 0xd1, 0xcf, 0x72, 0x40, 0xf0, 0x5a, 0x06, 0xf9,
-	0x16, 0xf5, 0x4e, 0x62, 0x88, 0xc1, 0x00, 0x56, 0xfe, 0x59, 0xa7, 0xd7, 0x0d, 0xa1, 0x48, 0xa1,
-	0x58, 0x58, 0x60, 0x87, 0x0a, 0x51, 0x3b, 0xb1, 0x80, 0x17, 0x82, 0xad, 0x46, 0x81, 0x40, 0x3e,
-	0x62, 0x21, 0x48, 0x55, 0xf1, 0x36, 0x4f, 0xa5, 0x02, 0x66, 0xbe, 0x36, 0x3a, 0xff, 0x75, 0x09,
-	0x7d, 0xd0, 0x09, 0xca, 0x27, 0xa1, 0xa2, 0xa9, 0x48, 0x44, 0xcc, 0x51, 0x8c, 0x55, 0x74, 0x0f,
-	0xe1, 0x72, 0x66, 0x9e, 0xe6, 0x9d, 0x91, 0xd6, 0x4b, 0x0e, 0xe9, 0x82, 0x47, 0x51, 0x2e, 0x8a,
-	0xa2, 0xe3, 0x0e, 0xdc, 0x61, 0x63, 0xde, 0x2c, 0xb3, 0xb1, 0x8d, 0xbc, 0x53, 0x42, 0x10, 0x0e,
-	0xc2, 0x3f, 0x23, 0x34, 0x10, 0xf6, 0xf8, 0x82, 0xb4, 0x57, 0x3c, 0x91, 0x11, 0x47, 0xc8, 0x0f,
-	0xd6, 0x7f, 0x63, 0x1d, 0x1f, 0xc0, 0x5e, 0xee, 0x93, 0xa6, 0x56, 0xe5, 0x05, 0x16, 0x11, 0x47,
-	0xd1, 0xa9, 0x19, 0x8d, 0xd8, 0x68, 0xca, 0x51, 0x78, 0x37, 0xa4, 0xce, 0x53, 0xd0, 0x0a, 0x3b,
-	0x47, 0x03, 0x77, 0xd8, 0xbc, 0xea, 0xfa, 0xd5, 0x11, 0xca, 0xb5, 0xfd, 0x6a, 0x6d, 0xff, 0x16,
-	0xa4, 0x9a, 0xd4, 0xd6, 0xdf, 0x7d, 0x67, 0x5e, 0xe9, 0x93, 0xbb, 0xf5, 0x96, 0xba, 0x9b, 0x2d,
-	0x75, 0x7f, 0xb6, 0xd4, 0xfd, 0xdc, 0x51, 0x67, 0xb3, 0xa3, 0xce, 0xd7, 0x8e, 0x3a, 0xcf, 0x97,
-	0xb1, 0xc4, 0x57, 0x1d, 0xf8, 0x21, 0xa4, 0x6c, 0x56, 0x56, 0xf0, 0x28, 0xf0, 0x0d, 0xf2, 0x25,
-	0xb3, 0x55, 0xbd, 0xef, 0xcb, 0xc2, 0x8f, 0x4c, 0x14, 0x41, 0xdd, 0x9c, 0xee, 0xfa, 0x2f, 0x00,
-	0x00, 0xff, 0xff, 0xe7, 0xf0, 0x4c, 0x04, 0xc9, 0x01, 0x00, 0x00,
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0x34, 0x54, 0xca, 0xa6, 0x42, 0xd4, 0x42, 0x90, 0x14, 0xe1, 0x94, 0x9e, 0xaa,
+	0x02, 0xbb, 0x6a, 0x91, 0xe0, 0xdc, 0x10, 0x71, 0xa1, 0x50, 0xc9, 0x15, 0x17, 0x2e, 0xd1, 0xc6,
+	0xbb, 0x38, 0x2b, 0xdb, 0x3b, 0x96, 0x77, 0xdc, 0xd0, 0xb7, 0xe0, 0x01, 0x78, 0x00, 0x8e, 0x1c,
+	0x78, 0x88, 0x1e, 0x2b, 0x4e, 0x88, 0x43, 0x84, 0x92, 0x03, 0xe2, 0x2d, 0x90, 0x77, 0x9d, 0x08,
+	0x22, 0x81, 0xd4, 0x8b, 0xed, 0x9d, 0xff, 0xd3, 0xfa, 0x9b, 0xd1, 0x90, 0x1e, 0xe4, 0xa8, 0x80,
+	0xa5, 0x10, 0x25, 0x65, 0xce, 0xa0, 0xc4, 0xbc, 0x44, 0x9a, 0x17, 0x80, 0xe0, 0x6f, 0xd9, 0x88,
+	0xba, 0x68, 0xe7, 0x76, 0x0c, 0x31, 0xd8, 0x80, 0x55, 0x5f, 0x8e, 0xd9, 0xe9, 0x45, 0x60, 0x32,
+	0x30, 0x23, 0x17, 0xb8, 0x43, 0x1d, 0x05, 0xee, 0xc4, 0xc6, 0xdc, 0x48, 0x76, 0x7e, 0x38, 0x96,
+	0xc8, 0x0f, 0x59, 0x04, 0x4a, 0xd7, 0xf9, 0x36, 0xcf, 0x94, 0x06, 0x66, 0x9f, 0x75, 0xe9, 0xee,
+	0x5f, 0x32, 0xd5, 0xcb, 0x05, 0x7b, 0xbf, 0x3c, 0x12, 0xbc, 0x2a, 0x53, 0x54, 0x67, 0x52, 0x8b,
+	0xa1, 0x4c, 0x65, 0xcc, 0x51, 0x1e, 0x6b, 0x71, 0x02, 0x51, 0x72, 0x6a, 0x9d, 0xfd, 0x07, 0x64,
+	0xeb, 0x5d, 0x01, 0xd9, 0x88, 0x0b, 0x51, 0x48, 0x63, 0xba, 0xde, 0xae, 0xb7, 0xdf, 0x0e, 0x3b,
+	0x55, 0xed, 0xd8, 0x95, 0xfc, 0xfb, 0x84, 0x20, 0xac, 0x80, 0xa6, 0x05, 0xda, 0x08, 0xcb, 0xf8,
+	0x21, 0xd9, 0x3e, 0xe7, 0xa9, 0x12, 0x1c, 0xa1, 0x58, 0x51, 0x1b, 0x96, 0xba, 0xb5, 0x0a, 0x96,
+	0x70, 0x9f, 0x74, 0x4a, 0x5d, 0x19, 0x8e, 0x04, 0x47, 0xd9, 0x6d, 0x59, 0x8c, 0xb8, 0xd2, 0x90,
+	0xa3, 0xf4, 0x9f, 0x91, 0x4d, 0x9e, 0x41, 0xa9, 0xb1, 0x7b, 0x63, 0xd7, 0xdb, 0xef, 0x1c, 0xf5,
+	0x68, 0x3d, 0x9d, 0x6a, 0x1e, 0xb4, 0x9e, 0x07, 0x7d, 0x0e, 0x4a, 0x0f, 0x5a, 0x97, 0xb3, 0x7e,
+	0x23, 0xac, 0xf1, 0xbd, 0x8f, 0xcd, 0x7f, 0xf7, 0x1a, 0x4a, 0x53, 0xa6, 0xb8, 0xd6, 0x88, 0xb7,
+	0xde, 0xc8, 0x9a, 0x5b, 0xf3, 0x3f, 0x6e, 0x1b, 0xd7, 0x72, 0xf3, 0x0f, 0x48, 0x2b, 0x51, 0x5a,
+	0xd8, 0x76, 0x6f, 0x1e, 0xdd, 0xa1, 0x7f, 0x6e, 0x08, 0xad, 0x04, 0x5f, 0x2a, 0x2d, 0x42, 0xcb,
+	0xf8, 0x6f, 0x08, 0xd1, 0x72, 0x3a, 0x32, 0x13, 0x5e, 0x48, 0x63, 0x87, 0xd0, 0x1e, 0x3c, 0xad,
+	0x6e, 0xfb, 0x3e, 0xeb, 0xdf, 0x73, 0xff, 0x33, 0x22, 0xa1, 0x0a, 0x58, 0xc6, 0x71, 0x42, 0x4f,
+	0x64, 0xcc, 0xa3, 0x8b, 0xa1, 0x8c, 0xbe, 0x7e, 0x79, 0x4c, 0x6a, 0x9d, 0xa1, 0x8c, 0x3e, 0xfd,
+	0xfc, 0x7c, 0xe0, 0x85, 0x6d, 0x2d, 0xa7, 0x67, 0xf6, 0xa2, 0xc1, 0x8b, 0xcb, 0x79, 0xe0, 0x5d,
+	0xcd, 0x03, 0xef, 0xc7, 0x3c, 0xf0, 0x3e, 0x2c, 0x82, 0xc6, 0xd5, 0x22, 0x68, 0x7c, 0x5b, 0x04,
+	0x8d, 0xb7, 0x8f, 0x62, 0x85, 0x93, 0x72, 0x4c, 0x23, 0xc8, 0xd8, 0x69, 0x25, 0xf6, 0x5a, 0xe2,
+	0x14, 0x8a, 0x84, 0xb9, 0xad, 0x7a, 0xbf, 0xdc, 0x2b, 0xbc, 0xc8, 0xa5, 0x19, 0x6f, 0xda, 0xcd,
+	0x7a, 0xf2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xd8, 0x78, 0x57, 0x01, 0x03, 0x00, 0x00,
 }
 
 func (m *MultiSendDelegateAndLockOutput) Marshal() (dAtA []byte, err error) {
@@ -194,6 +276,68 @@ func (m *MultiSendDelegateAndLockOutput) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MultiSendDelegateAndLockResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiSendDelegateAndLockResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiSendDelegateAndLockResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NewShares.Size()
+		i -= size
+		if _, err := m.NewShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOutput(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Kind != 0 {
+		i = encodeVarintOutput(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOutput(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintOutput(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintOutput(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOutput(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOutput(v)
 	base := offset
@@ -232,6 +376,30 @@ func (m *MultiSendDelegateAndLockOutput) Size() (n int) {
 	return n
 }
 
+func (m *MultiSendDelegateAndLockResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovOutput(uint64(l))
+	}
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovOutput(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovOutput(uint64(l))
+	if m.Kind != 0 {
+		n += 1 + sovOutput(uint64(m.Kind))
+	}
+	l = m.NewShares.Size()
+	n += 1 + l + sovOutput(uint64(l))
+	return n
+}
+
 func sovOutput(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -449,6 +617,206 @@ func (m *MultiSendDelegateAndLockOutput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MultiSendDelegateAndLockResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutput
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiSendDelegateAndLockResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiSendDelegateAndLockResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutput
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutput
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutput
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutput
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= LockKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutput
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutput
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutput
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutput(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOutput
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOutput(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
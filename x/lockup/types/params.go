@@ -0,0 +1,270 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	DefaultMaxLockMonths          uint32 = 24
+	DefaultMinLockAmount                 = math.ZeroInt()
+	DefaultMaxLocksPerAddress     uint32 = 0
+	DefaultAllowedDenoms                 = []string{bondDenom}
+	DefaultEarlyUnlockPenaltyRate        = math.LegacyNewDecWithPrec(25, 2)
+	DefaultPenaltyDestination            = PenaltyDestination_PENALTY_DESTINATION_BURN
+	DefaultVotingPowerMultipliers        = []VotingPowerMultiplier{
+		{MinRemainingDays: 30, Multiplier: math.LegacyNewDecWithPrec(10, 2)},
+		{MinRemainingDays: 90, Multiplier: math.LegacyNewDecWithPrec(25, 2)},
+		{MinRemainingDays: 180, Multiplier: math.LegacyNewDecWithPrec(50, 2)},
+		{MinRemainingDays: 365, Multiplier: math.LegacyNewDecWithPrec(75, 2)},
+		{MinRemainingDays: 730, Multiplier: math.LegacyOneDec()},
+	}
+	DefaultRewardEpochDays          uint32 = 7
+	DefaultLockHistoryRetentionDays uint32 = 365
+	DefaultMaxMultiSendOutputs      uint32 = 1000
+)
+
+const (
+	// MaxRewardEpochDays bounds RewardEpochDays, so payouts happen at least once a year.
+	MaxRewardEpochDays uint32 = 365
+	// MaxLockHistoryRetentionDays bounds LockHistoryRetentionDays to ten years.
+	MaxLockHistoryRetentionDays uint32 = 3650
+	// MaxMultiSendOutputsLimit bounds MaxMultiSendOutputs, keeping a single
+	// MsgMultiSendDelegateAndLock within a reasonable block gas budget.
+	MaxMultiSendOutputsLimit uint32 = 10000
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	maxLockMonths uint32,
+	minLockAmount math.Int,
+	maxLocksPerAddress uint32,
+	allowedDenoms []string,
+	earlyUnlockPenaltyRate math.LegacyDec,
+	penaltyDestination PenaltyDestination,
+	votingPowerMultipliers []VotingPowerMultiplier,
+	rewardEpochDays uint32,
+	lockHistoryRetentionDays uint32,
+	maxMultiSendOutputs uint32,
+) Params {
+	return Params{
+		MaxLockMonths:            maxLockMonths,
+		MinLockAmount:            minLockAmount,
+		MaxLocksPerAddress:       maxLocksPerAddress,
+		AllowedDenoms:            allowedDenoms,
+		EarlyUnlockPenaltyRate:   earlyUnlockPenaltyRate,
+		PenaltyDestination:       penaltyDestination,
+		VotingPowerMultipliers:   votingPowerMultipliers,
+		RewardEpochDays:          rewardEpochDays,
+		LockHistoryRetentionDays: lockHistoryRetentionDays,
+		MaxMultiSendOutputs:      maxMultiSendOutputs,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMaxLockMonths,
+		DefaultMinLockAmount,
+		DefaultMaxLocksPerAddress,
+		DefaultAllowedDenoms,
+		DefaultEarlyUnlockPenaltyRate,
+		DefaultPenaltyDestination,
+		DefaultVotingPowerMultipliers,
+		DefaultRewardEpochDays,
+		DefaultLockHistoryRetentionDays,
+		DefaultMaxMultiSendOutputs,
+	)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateMaxLockMonths(p.MaxLockMonths); err != nil {
+		return err
+	}
+
+	if err := validateMinLockAmount(p.MinLockAmount); err != nil {
+		return err
+	}
+
+	if err := validateAllowedDenoms(p.AllowedDenoms); err != nil {
+		return err
+	}
+
+	if err := validateEarlyUnlockPenaltyRate(p.EarlyUnlockPenaltyRate); err != nil {
+		return err
+	}
+
+	if err := validatePenaltyDestination(p.PenaltyDestination); err != nil {
+		return err
+	}
+
+	if err := validateVotingPowerMultipliers(p.VotingPowerMultipliers); err != nil {
+		return err
+	}
+
+	if err := validateRewardEpochDays(p.RewardEpochDays); err != nil {
+		return err
+	}
+
+	if err := validateLockHistoryRetentionDays(p.LockHistoryRetentionDays); err != nil {
+		return err
+	}
+
+	if err := validateMaxMultiSendOutputs(p.MaxMultiSendOutputs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsDenomAllowed reports whether denom is in the allowed lock denoms.
+func (p Params) IsDenomAllowed(denom string) bool {
+	for _, allowed := range p.AllowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// EarlyUnlockPenalty returns the penalty charged for unlocking amount on
+// blockDay instead of unlockDate. The penalty rate is scaled by the time left
+// until unlockDate relative to the maximum lock duration.
+func (p Params) EarlyUnlockPenalty(amount math.Int, blockDay, unlockDate time.Time) math.Int {
+	if !blockDay.Before(unlockDate) {
+		return math.ZeroInt()
+	}
+
+	remainingDays := int64(unlockDate.Sub(blockDay).Hours() / 24)
+	maxDays := int64(blockDay.AddDate(0, int(p.MaxLockMonths), 0).Sub(blockDay).Hours() / 24)
+	if remainingDays > maxDays {
+		remainingDays = maxDays
+	}
+
+	return p.EarlyUnlockPenaltyRate.MulInt(amount).MulInt64(remainingDays).QuoInt64(maxDays).TruncateInt()
+}
+
+// VotingPowerMultiplier returns the multiplier of the highest curve step that
+// applies to a lock with remainingDays left, or zero if no step applies.
+func (p Params) VotingPowerMultiplier(remainingDays uint32) math.LegacyDec {
+	multiplier := math.LegacyZeroDec()
+	for _, step := range p.VotingPowerMultipliers {
+		if remainingDays < step.MinRemainingDays {
+			break
+		}
+		multiplier = step.Multiplier
+	}
+	return multiplier
+}
+
+// validateMaxLockMonths validates the MaxLockMonths param
+func validateMaxLockMonths(maxLockMonths uint32) error {
+	if maxLockMonths == 0 {
+		return fmt.Errorf("max lock months must be positive")
+	}
+
+	return nil
+}
+
+// validateMinLockAmount validates the MinLockAmount param
+func validateMinLockAmount(minLockAmount math.Int) error {
+	if minLockAmount.IsNil() {
+		return fmt.Errorf("min lock amount cannot be nil")
+	}
+
+	if minLockAmount.IsNegative() {
+		return fmt.Errorf("min lock amount cannot be negative: %s", minLockAmount)
+	}
+
+	return nil
+}
+
+// validateAllowedDenoms validates the AllowedDenoms param
+func validateAllowedDenoms(allowedDenoms []string) error {
+	seen := make(map[string]bool, len(allowedDenoms))
+	for _, denom := range allowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid allowed denom %q: %w", denom, err)
+		}
+
+		if seen[denom] {
+			return fmt.Errorf("duplicate allowed denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// validateEarlyUnlockPenaltyRate validates the EarlyUnlockPenaltyRate param
+func validateEarlyUnlockPenaltyRate(rate math.LegacyDec) error {
+	if rate.IsNil() {
+		return fmt.Errorf("early unlock penalty rate cannot be nil")
+	}
+
+	if rate.IsNegative() || rate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("early unlock penalty rate must be between 0 and 1: %s", rate)
+	}
+
+	return nil
+}
+
+// validatePenaltyDestination validates the PenaltyDestination param
+func validatePenaltyDestination(destination PenaltyDestination) error {
+	if _, ok := PenaltyDestination_name[int32(destination)]; !ok {
+		return fmt.Errorf("invalid penalty destination: %d", destination)
+	}
+
+	return nil
+}
+
+// validateVotingPowerMultipliers validates the VotingPowerMultipliers param
+func validateVotingPowerMultipliers(steps []VotingPowerMultiplier) error {
+	for i, step := range steps {
+		if i > 0 && step.MinRemainingDays <= steps[i-1].MinRemainingDays {
+			return fmt.Errorf("voting power multiplier steps must have strictly increasing min remaining days: %d", step.MinRemainingDays)
+		}
+
+		if step.Multiplier.IsNil() {
+			return fmt.Errorf("voting power multiplier cannot be nil")
+		}
+
+		if step.Multiplier.IsNegative() {
+			return fmt.Errorf("voting power multiplier cannot be negative: %s", step.Multiplier)
+		}
+	}
+
+	return nil
+}
+
+// validateRewardEpochDays validates the RewardEpochDays param. Zero disables payouts.
+func validateRewardEpochDays(days uint32) error {
+	if days > MaxRewardEpochDays {
+		return fmt.Errorf("reward epoch days cannot exceed %d: %d", MaxRewardEpochDays, days)
+	}
+
+	return nil
+}
+
+// validateLockHistoryRetentionDays validates the LockHistoryRetentionDays param.
+// Zero disables lock history.
+func validateLockHistoryRetentionDays(days uint32) error {
+	if days > MaxLockHistoryRetentionDays {
+		return fmt.Errorf("lock history retention days cannot exceed %d: %d", MaxLockHistoryRetentionDays, days)
+	}
+
+	return nil
+}
+
+// validateMaxMultiSendOutputs validates the MaxMultiSendOutputs param.
+func validateMaxMultiSendOutputs(outputs uint32) error {
+	if outputs == 0 || outputs > MaxMultiSendOutputsLimit {
+		return fmt.Errorf("max multi send outputs must be between 1 and %d: %d", MaxMultiSendOutputsLimit, outputs)
+	}
+
+	return nil
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -24,8 +26,66 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PenaltyDestination defines where early unlock penalties are sent.
+type PenaltyDestination int32
+
+const (
+	// PENALTY_DESTINATION_BURN burns the penalty.
+	PenaltyDestination_PENALTY_DESTINATION_BURN PenaltyDestination = 0
+	// PENALTY_DESTINATION_COMMUNITY_POOL sends the penalty to the community pool.
+	PenaltyDestination_PENALTY_DESTINATION_COMMUNITY_POOL PenaltyDestination = 1
+	// PENALTY_DESTINATION_MODULE_ACCOUNT sends the penalty to the lockup_penalties module account, which is kept apart
+	// from the escrowed tokens held by the lockup module account.
+	PenaltyDestination_PENALTY_DESTINATION_MODULE_ACCOUNT PenaltyDestination = 2
+)
+
+var PenaltyDestination_name = map[int32]string{
+	0: "PENALTY_DESTINATION_BURN",
+	1: "PENALTY_DESTINATION_COMMUNITY_POOL",
+	2: "PENALTY_DESTINATION_MODULE_ACCOUNT",
+}
+
+var PenaltyDestination_value = map[string]int32{
+	"PENALTY_DESTINATION_BURN":           0,
+	"PENALTY_DESTINATION_COMMUNITY_POOL": 1,
+	"PENALTY_DESTINATION_MODULE_ACCOUNT": 2,
+}
+
+func (x PenaltyDestination) String() string {
+	return proto.EnumName(PenaltyDestination_name, int32(x))
+}
+
+func (PenaltyDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2b68a8a0f446205c, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
+	// max_lock_months is the number of months after the current block day that an unlock date may be set to.
+	MaxLockMonths uint32 `protobuf:"varint,1,opt,name=max_lock_months,json=maxLockMonths,proto3" json:"max_lock_months,omitempty"`
+	// min_lock_amount is the smallest amount a single lock message can lock.
+	MinLockAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=min_lock_amount,json=minLockAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_lock_amount"`
+	// max_locks_per_address is the maximum number of distinct unlock dates an address can hold. Zero disables the limit.
+	MaxLocksPerAddress uint32 `protobuf:"varint,3,opt,name=max_locks_per_address,json=maxLocksPerAddress,proto3" json:"max_locks_per_address,omitempty"`
+	// allowed_denoms lists the denoms that can be locked. Locks of the bond denom are backed by delegations unless they
+	// are escrowed, locks of any other denom are always escrowed in the lockup module account until they expire.
+	AllowedDenoms []string `protobuf:"bytes,4,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// early_unlock_penalty_rate is the fraction of the unlocked amount charged when a lock with the maximum remaining
+	// duration is unlocked early. The penalty scales linearly with the time remaining until the unlock date.
+	EarlyUnlockPenaltyRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=early_unlock_penalty_rate,json=earlyUnlockPenaltyRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"early_unlock_penalty_rate"`
+	// penalty_destination defines where early unlock penalties are sent.
+	PenaltyDestination PenaltyDestination `protobuf:"varint,6,opt,name=penalty_destination,json=penaltyDestination,proto3,enum=optio.lockup.PenaltyDestination" json:"penalty_destination,omitempty"`
+	// voting_power_multipliers is the duration curve used by the informational LockWeightedTally query. Each step applies
+	// its multiplier to locks with at least min_remaining_days left until unlock. An empty curve disables the lock bonus.
+	VotingPowerMultipliers []VotingPowerMultiplier `protobuf:"bytes,7,rep,name=voting_power_multipliers,json=votingPowerMultipliers,proto3" json:"voting_power_multipliers"`
+	// reward_epoch_days is the number of days between rewards pool payouts. Zero disables payouts.
+	RewardEpochDays uint32 `protobuf:"varint,8,opt,name=reward_epoch_days,json=rewardEpochDays,proto3" json:"reward_epoch_days,omitempty"`
+	// lock_history_retention_days is the number of days lock history entries are kept. Zero disables recording new
+	// entries; entries already recorded are kept until they are pruned.
+	LockHistoryRetentionDays uint32 `protobuf:"varint,9,opt,name=lock_history_retention_days,json=lockHistoryRetentionDays,proto3" json:"lock_history_retention_days,omitempty"`
+	// max_multi_send_outputs is the maximum number of outputs of a single MsgMultiSendDelegateAndLock. It must be between
+	// one and 10000.
+	MaxMultiSendOutputs uint32 `protobuf:"varint,10,opt,name=max_multi_send_outputs,json=maxMultiSendOutputs,proto3" json:"max_multi_send_outputs,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,25 +121,165 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxLockMonths() uint32 {
+	if m != nil {
+		return m.MaxLockMonths
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLocksPerAddress() uint32 {
+	if m != nil {
+		return m.MaxLocksPerAddress
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetPenaltyDestination() PenaltyDestination {
+	if m != nil {
+		return m.PenaltyDestination
+	}
+	return PenaltyDestination_PENALTY_DESTINATION_BURN
+}
+
+func (m *Params) GetVotingPowerMultipliers() []VotingPowerMultiplier {
+	if m != nil {
+		return m.VotingPowerMultipliers
+	}
+	return nil
+}
+
+func (m *Params) GetRewardEpochDays() uint32 {
+	if m != nil {
+		return m.RewardEpochDays
+	}
+	return 0
+}
+
+func (m *Params) GetLockHistoryRetentionDays() uint32 {
+	if m != nil {
+		return m.LockHistoryRetentionDays
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMultiSendOutputs() uint32 {
+	if m != nil {
+		return m.MaxMultiSendOutputs
+	}
+	return 0
+}
+
+// VotingPowerMultiplier is a step of the lock-weighted tally curve.
+type VotingPowerMultiplier struct {
+	// min_remaining_days is the minimum number of days left on a lock for the step to apply.
+	MinRemainingDays uint32 `protobuf:"varint,1,opt,name=min_remaining_days,json=minRemainingDays,proto3" json:"min_remaining_days,omitempty"`
+	// multiplier is the fraction of the locked amount added to the holder's voting power.
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+}
+
+func (m *VotingPowerMultiplier) Reset()         { *m = VotingPowerMultiplier{} }
+func (m *VotingPowerMultiplier) String() string { return proto.CompactTextString(m) }
+func (*VotingPowerMultiplier) ProtoMessage()    {}
+func (*VotingPowerMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b68a8a0f446205c, []int{1}
+}
+func (m *VotingPowerMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingPowerMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingPowerMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingPowerMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPowerMultiplier.Merge(m, src)
+}
+func (m *VotingPowerMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingPowerMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPowerMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingPowerMultiplier proto.InternalMessageInfo
+
+func (m *VotingPowerMultiplier) GetMinRemainingDays() uint32 {
+	if m != nil {
+		return m.MinRemainingDays
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("optio.lockup.PenaltyDestination", PenaltyDestination_name, PenaltyDestination_value)
 	proto.RegisterType((*Params)(nil), "optio.lockup.Params")
+	proto.RegisterType((*VotingPowerMultiplier)(nil), "optio.lockup.VotingPowerMultiplier")
 }
 
 func init() { proto.RegisterFile("optio/lockup/params.proto", fileDescriptor_2b68a8a0f446205c) }
 
 var fileDescriptor_2b68a8a0f446205c = []byte{
-	// 171 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcc, 0x2f, 0x28, 0xc9,
-	0xcc, 0xd7, 0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x2d, 0xd0, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x01, 0x4b, 0xe9, 0x41, 0xa4, 0xa4, 0x04, 0x13, 0x73,
-	0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x81, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9,
-	0x0f, 0x62, 0x41, 0x44, 0x95, 0x34, 0xb8, 0xd8, 0x02, 0xc0, 0xc6, 0x58, 0xc9, 0xbd, 0x58, 0x20,
-	0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x28, 0xc4, 0x92, 0x0a, 0x98, 0x35, 0x10, 0x79, 0x27, 0xb7,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xf7, 0x07, 0xe9, 0xf5, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca,
-	0xd6, 0x47, 0x33, 0xa8, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x6c, 0xb1, 0x31, 0x20, 0x00,
-	0x00, 0xff, 0xff, 0x56, 0x20, 0x14, 0xa2, 0xcc, 0x00, 0x00, 0x00,
+	// 717 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3d, 0x4f, 0x1b, 0x49,
+	0x18, 0xf6, 0xf2, 0x75, 0xe7, 0xb9, 0x33, 0x98, 0xe1, 0x8c, 0x16, 0x38, 0x19, 0x8b, 0xd3, 0x21,
+	0xcb, 0x22, 0x76, 0x02, 0x52, 0x0a, 0xa4, 0x14, 0x36, 0x76, 0x14, 0x4b, 0xb6, 0xd7, 0x59, 0x6c,
+	0x14, 0xd2, 0x8c, 0x86, 0xdd, 0x91, 0xbd, 0xb2, 0x67, 0x66, 0xb3, 0x33, 0xc6, 0x76, 0x9b, 0x32,
+	0x55, 0x7e, 0x42, 0xa4, 0x34, 0x29, 0x29, 0xf2, 0x23, 0x28, 0x51, 0x94, 0x22, 0x4a, 0x81, 0x22,
+	0x28, 0xc8, 0xcf, 0x88, 0x76, 0x66, 0x1d, 0x48, 0x20, 0x4d, 0x1a, 0xcb, 0xf3, 0x3e, 0xcf, 0xfb,
+	0xcc, 0xf3, 0x7e, 0xcc, 0x82, 0x15, 0xee, 0x4b, 0x8f, 0x17, 0xfa, 0xdc, 0xe9, 0x0d, 0xfc, 0x82,
+	0x8f, 0x03, 0x4c, 0x45, 0xde, 0x0f, 0xb8, 0xe4, 0xf0, 0x6f, 0x05, 0xe5, 0x35, 0xb4, 0xba, 0x88,
+	0xa9, 0xc7, 0x78, 0x41, 0xfd, 0x6a, 0xc2, 0xea, 0x8a, 0xc3, 0x05, 0xe5, 0x02, 0xa9, 0x53, 0x41,
+	0x1f, 0x22, 0xe8, 0x9f, 0x0e, 0xef, 0x70, 0x1d, 0x0f, 0xff, 0xe9, 0xe8, 0xc6, 0xc7, 0x59, 0x30,
+	0xd7, 0x54, 0x57, 0xc0, 0x4d, 0xb0, 0x40, 0xf1, 0x08, 0x85, 0xe2, 0x88, 0x72, 0x26, 0xbb, 0xc2,
+	0x34, 0x32, 0x46, 0x36, 0x61, 0x27, 0x28, 0x1e, 0xd5, 0xb8, 0xd3, 0xab, 0xab, 0x20, 0x7c, 0x06,
+	0x16, 0xa8, 0xc7, 0x34, 0x0f, 0x53, 0x3e, 0x60, 0xd2, 0x9c, 0xca, 0x18, 0xd9, 0x78, 0xe9, 0xfe,
+	0xe9, 0xf9, 0x7a, 0xec, 0xf3, 0xf9, 0x7a, 0x4a, 0xdf, 0x2b, 0xdc, 0x5e, 0xde, 0xe3, 0x05, 0x8a,
+	0x65, 0x37, 0x5f, 0x65, 0xf2, 0xc3, 0xfb, 0x7b, 0x20, 0x32, 0x54, 0x65, 0xf2, 0xdd, 0xd5, 0x49,
+	0xce, 0xb0, 0x13, 0xd4, 0x63, 0xa1, 0x72, 0x51, 0xc9, 0xc0, 0x07, 0x20, 0x35, 0x71, 0x20, 0x90,
+	0x4f, 0x02, 0x84, 0x5d, 0x37, 0x20, 0x42, 0x98, 0xd3, 0xca, 0x07, 0x8c, 0x7c, 0x88, 0x26, 0x09,
+	0x8a, 0x1a, 0x81, 0xff, 0x83, 0x79, 0xdc, 0xef, 0xf3, 0x21, 0x71, 0x91, 0x4b, 0x18, 0xa7, 0xc2,
+	0x9c, 0xc9, 0x4c, 0x67, 0xe3, 0x76, 0x22, 0x8a, 0x96, 0x55, 0x10, 0xbe, 0x00, 0x2b, 0x04, 0x07,
+	0xfd, 0x31, 0x1a, 0x30, 0xe5, 0xdb, 0x27, 0x0c, 0xf7, 0xe5, 0x18, 0x05, 0x58, 0x12, 0x73, 0x56,
+	0xb9, 0x7f, 0x18, 0xb9, 0x5f, 0xbb, 0xed, 0xbe, 0x46, 0x3a, 0xd8, 0x19, 0x97, 0x89, 0x73, 0xa3,
+	0x86, 0x32, 0x71, 0x74, 0x0d, 0xcb, 0x4a, 0xb8, 0xad, 0x74, 0x9b, 0x5a, 0xd6, 0xc6, 0x92, 0xc0,
+	0xa7, 0x60, 0x69, 0x72, 0x8b, 0x4b, 0x84, 0xf4, 0x18, 0x96, 0x1e, 0x67, 0xe6, 0x5c, 0xc6, 0xc8,
+	0xce, 0x6f, 0x67, 0xf2, 0x37, 0x27, 0x99, 0x8f, 0xf2, 0xca, 0xd7, 0x3c, 0x1b, 0xfa, 0xb7, 0x62,
+	0xb0, 0x0b, 0xcc, 0x63, 0x2e, 0x3d, 0xd6, 0x41, 0x3e, 0x1f, 0x92, 0x00, 0xd1, 0x41, 0x5f, 0x7a,
+	0x7e, 0xdf, 0x23, 0x81, 0x30, 0xff, 0xc8, 0x4c, 0x67, 0xff, 0xda, 0xfe, 0xef, 0x47, 0xdd, 0x03,
+	0xc5, 0x6e, 0x86, 0xe4, 0xfa, 0x77, 0x6e, 0x29, 0x1e, 0x56, 0x1a, 0x99, 0x3f, 0xbe, 0x8b, 0x21,
+	0x60, 0x0e, 0x2c, 0x06, 0x64, 0x88, 0x03, 0x17, 0x11, 0x9f, 0x3b, 0x5d, 0xe4, 0xe2, 0xb1, 0x30,
+	0xff, 0x54, 0x53, 0x58, 0xd0, 0x40, 0x25, 0x8c, 0x97, 0xf1, 0x58, 0xc0, 0x47, 0x60, 0x4d, 0xf5,
+	0xb4, 0xeb, 0x09, 0xc9, 0x83, 0x31, 0x0a, 0x88, 0x24, 0x2c, 0xf4, 0xab, 0xb3, 0xe2, 0x2a, 0xcb,
+	0x0c, 0x29, 0x4f, 0x34, 0xc3, 0x9e, 0x10, 0x54, 0xfa, 0x0e, 0x58, 0x0e, 0x87, 0xae, 0x6a, 0x41,
+	0x82, 0x30, 0x17, 0xf1, 0x81, 0xf4, 0x07, 0x52, 0x98, 0x40, 0x65, 0x2e, 0x51, 0x3c, 0x52, 0xd6,
+	0xf6, 0x09, 0x73, 0x2d, 0x0d, 0xed, 0xa6, 0xbf, 0xbe, 0x59, 0x37, 0x5e, 0x5d, 0x9d, 0xe4, 0x52,
+	0xfa, 0xb1, 0x8c, 0x26, 0xcf, 0x45, 0xef, 0xf2, 0xc6, 0x5b, 0x03, 0xa4, 0xee, 0x2c, 0x1e, 0x6e,
+	0x01, 0x18, 0x6e, 0x6f, 0x40, 0x28, 0xf6, 0x58, 0xd8, 0x4a, 0x65, 0x52, 0x2f, 0x7a, 0x92, 0x7a,
+	0xcc, 0x9e, 0x00, 0xca, 0xdc, 0x01, 0x00, 0xd7, 0x4d, 0x8e, 0xd6, 0xfc, 0x77, 0x17, 0xe5, 0x86,
+	0xd2, 0xee, 0x4c, 0xe8, 0x3f, 0xf7, 0xd2, 0x00, 0xf0, 0xf6, 0xe8, 0xe1, 0xbf, 0xc0, 0x6c, 0x56,
+	0x1a, 0xc5, 0x5a, 0xeb, 0x10, 0x95, 0x2b, 0xfb, 0xad, 0x6a, 0xa3, 0xd8, 0xaa, 0x5a, 0x0d, 0x54,
+	0x6a, 0xdb, 0x8d, 0x64, 0x0c, 0x6e, 0x82, 0x8d, 0xbb, 0xd0, 0x3d, 0xab, 0x5e, 0x6f, 0x37, 0xaa,
+	0xad, 0x43, 0xd4, 0xb4, 0xac, 0x5a, 0xd2, 0xf8, 0x15, 0xaf, 0x6e, 0x95, 0xdb, 0xb5, 0x0a, 0x2a,
+	0xee, 0xed, 0x59, 0xed, 0x46, 0x2b, 0x39, 0x55, 0x7a, 0x7c, 0x7a, 0x91, 0x36, 0xce, 0x2e, 0xd2,
+	0xc6, 0x97, 0x8b, 0xb4, 0xf1, 0xfa, 0x32, 0x1d, 0x3b, 0xbb, 0x4c, 0xc7, 0x3e, 0x5d, 0xa6, 0x63,
+	0xcf, 0xb7, 0x3a, 0x9e, 0xec, 0x0e, 0x8e, 0xf2, 0x0e, 0xa7, 0x05, 0x2b, 0x6c, 0x73, 0x83, 0xc8,
+	0x21, 0x0f, 0x7a, 0x85, 0x9f, 0x7a, 0x2e, 0xc7, 0x3e, 0x11, 0x47, 0x73, 0xea, 0x83, 0xb2, 0xf3,
+	0x2d, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xc0, 0xe4, 0x5c, 0xbf, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -101,6 +301,73 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxLockMonths != that1.MaxLockMonths {
+		return false
+	}
+	if !this.MinLockAmount.Equal(that1.MinLockAmount) {
+		return false
+	}
+	if this.MaxLocksPerAddress != that1.MaxLocksPerAddress {
+		return false
+	}
+	if len(this.AllowedDenoms) != len(that1.AllowedDenoms) {
+		return false
+	}
+	for i := range this.AllowedDenoms {
+		if this.AllowedDenoms[i] != that1.AllowedDenoms[i] {
+			return false
+		}
+	}
+	if !this.EarlyUnlockPenaltyRate.Equal(that1.EarlyUnlockPenaltyRate) {
+		return false
+	}
+	if this.PenaltyDestination != that1.PenaltyDestination {
+		return false
+	}
+	if len(this.VotingPowerMultipliers) != len(that1.VotingPowerMultipliers) {
+		return false
+	}
+	for i := range this.VotingPowerMultipliers {
+		if !this.VotingPowerMultipliers[i].Equal(&that1.VotingPowerMultipliers[i]) {
+			return false
+		}
+	}
+	if this.RewardEpochDays != that1.RewardEpochDays {
+		return false
+	}
+	if this.LockHistoryRetentionDays != that1.LockHistoryRetentionDays {
+		return false
+	}
+	if this.MaxMultiSendOutputs != that1.MaxMultiSendOutputs {
+		return false
+	}
+	return true
+}
+func (this *VotingPowerMultiplier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VotingPowerMultiplier)
+	if !ok {
+		that2, ok := that.(VotingPowerMultiplier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinRemainingDays != that1.MinRemainingDays {
+		return false
+	}
+	if !this.Multiplier.Equal(that1.Multiplier) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -123,6 +390,117 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxMultiSendOutputs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMultiSendOutputs))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LockHistoryRetentionDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LockHistoryRetentionDays))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RewardEpochDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardEpochDays))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.VotingPowerMultipliers) > 0 {
+		for iNdEx := len(m.VotingPowerMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PenaltyDestination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PenaltyDestination))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.EarlyUnlockPenaltyRate.Size()
+		i -= size
+		if _, err := m.EarlyUnlockPenaltyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxLocksPerAddress != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxLocksPerAddress))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinLockAmount.Size()
+		i -= size
+		if _, err := m.MinLockAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxLockMonths != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxLockMonths))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingPowerMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingPowerMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPowerMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MinRemainingDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinRemainingDays))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -143,6 +521,54 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxLockMonths != 0 {
+		n += 1 + sovParams(uint64(m.MaxLockMonths))
+	}
+	l = m.MinLockAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxLocksPerAddress != 0 {
+		n += 1 + sovParams(uint64(m.MaxLocksPerAddress))
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.EarlyUnlockPenaltyRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.PenaltyDestination != 0 {
+		n += 1 + sovParams(uint64(m.PenaltyDestination))
+	}
+	if len(m.VotingPowerMultipliers) > 0 {
+		for _, e := range m.VotingPowerMultipliers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.RewardEpochDays != 0 {
+		n += 1 + sovParams(uint64(m.RewardEpochDays))
+	}
+	if m.LockHistoryRetentionDays != 0 {
+		n += 1 + sovParams(uint64(m.LockHistoryRetentionDays))
+	}
+	if m.MaxMultiSendOutputs != 0 {
+		n += 1 + sovParams(uint64(m.MaxMultiSendOutputs))
+	}
+	return n
+}
+
+func (m *VotingPowerMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinRemainingDays != 0 {
+		n += 1 + sovParams(uint64(m.MinRemainingDays))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -181,6 +607,357 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLockMonths", wireType)
+			}
+			m.MaxLockMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLockMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLockAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLockAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLocksPerAddress", wireType)
+			}
+			m.MaxLocksPerAddress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLocksPerAddress |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyUnlockPenaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyUnlockPenaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyDestination", wireType)
+			}
+			m.PenaltyDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PenaltyDestination |= PenaltyDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerMultipliers = append(m.VotingPowerMultipliers, VotingPowerMultiplier{})
+			if err := m.VotingPowerMultipliers[len(m.VotingPowerMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpochDays", wireType)
+			}
+			m.RewardEpochDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardEpochDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockHistoryRetentionDays", wireType)
+			}
+			m.LockHistoryRetentionDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockHistoryRetentionDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiSendOutputs", wireType)
+			}
+			m.MaxMultiSendOutputs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMultiSendOutputs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingPowerMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingPowerMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingPowerMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRemainingDays", wireType)
+			}
+			m.MinRemainingDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRemainingDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
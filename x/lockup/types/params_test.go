@@ -0,0 +1,176 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params Params
+		valid  bool
+	}{
+		{
+			name:   "default",
+			params: DefaultParams(),
+			valid:  true,
+		},
+		{
+			name:   "zero max lock months",
+			params: NewParams(0, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 0, 0, 0),
+		},
+		{
+			name:   "negative min lock amount",
+			params: NewParams(24, math.NewInt(-1), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 0, 0, 0),
+		},
+		{
+			name:   "invalid denom",
+			params: NewParams(24, math.ZeroInt(), 0, []string{"!"}, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 0, 0, 0),
+		},
+		{
+			name:   "penalty rate above one",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyNewDec(2), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 0, 0, 0),
+		},
+		{
+			name:   "negative penalty rate",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyNewDec(-1), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 0, 0, 0),
+		},
+		{
+			name:   "unknown penalty destination",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination(42), nil, 0, 0, 0),
+		},
+		{
+			name: "unordered voting power multipliers",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, []VotingPowerMultiplier{
+				{MinRemainingDays: 90, Multiplier: math.LegacyOneDec()},
+				{MinRemainingDays: 30, Multiplier: math.LegacyOneDec()},
+			}, 0, 0, 0),
+		},
+		{
+			name: "negative voting power multiplier",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, []VotingPowerMultiplier{
+				{MinRemainingDays: 30, Multiplier: math.LegacyNewDec(-1)},
+			}, 0, 0, 0),
+		},
+		{
+			name:   "reward payouts disabled",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 0, 365, 1000),
+			valid:  true,
+		},
+		{
+			name:   "yearly reward epoch",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, MaxRewardEpochDays, 365, 1000),
+			valid:  true,
+		},
+		{
+			name:   "reward epoch longer than a year",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, MaxRewardEpochDays+1, 365, 1000),
+		},
+		{
+			name:   "lock history disabled",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 7, 0, 1000),
+			valid:  true,
+		},
+		{
+			name:   "maximum lock history retention",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 7, MaxLockHistoryRetentionDays, 1000),
+			valid:  true,
+		},
+		{
+			name:   "lock history retention above the maximum",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 7, MaxLockHistoryRetentionDays+1, 1000),
+		},
+		{
+			name:   "single multi send output",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 7, 365, 1),
+			valid:  true,
+		},
+		{
+			name:   "maximum multi send outputs",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 7, 365, MaxMultiSendOutputsLimit),
+			valid:  true,
+		},
+		{
+			name:   "zero multi send outputs",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 7, 365, 0),
+		},
+		{
+			name:   "multi send outputs above the limit",
+			params: NewParams(24, math.ZeroInt(), 0, nil, math.LegacyZeroDec(), PenaltyDestination_PENALTY_DESTINATION_BURN, nil, 7, 365, MaxMultiSendOutputsLimit+1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_EarlyUnlockPenalty(t *testing.T) {
+	params := DefaultParams()
+	params.MaxLockMonths = 12
+	params.EarlyUnlockPenaltyRate = math.LegacyNewDecWithPrec(5, 1)
+
+	blockDay := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	amount := math.NewInt(1_000_000)
+
+	tests := []struct {
+		name       string
+		unlockDate time.Time
+		expected   math.Int
+	}{
+		{
+			name:       "maximum remaining duration",
+			unlockDate: blockDay.AddDate(1, 0, 0),
+			expected:   math.NewInt(500_000),
+		},
+		{
+			name:       "half the maximum duration",
+			unlockDate: blockDay.AddDate(0, 0, 365/2),
+			expected:   math.NewInt(249_315),
+		},
+		{
+			name:       "remaining duration above the maximum is capped",
+			unlockDate: blockDay.AddDate(2, 0, 0),
+			expected:   math.NewInt(500_000),
+		},
+		{
+			name:       "already unlocked",
+			unlockDate: blockDay,
+			expected:   math.ZeroInt(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, params.EarlyUnlockPenalty(amount, blockDay, tt.unlockDate))
+		})
+	}
+}
+
+func TestParams_VotingPowerMultiplier(t *testing.T) {
+	params := DefaultParams()
+
+	tests := []struct {
+		remainingDays uint32
+		expected      math.LegacyDec
+	}{
+		{remainingDays: 0, expected: math.LegacyZeroDec()},
+		{remainingDays: 29, expected: math.LegacyZeroDec()},
+		{remainingDays: 30, expected: math.LegacyNewDecWithPrec(10, 2)},
+		{remainingDays: 364, expected: math.LegacyNewDecWithPrec(50, 2)},
+		{remainingDays: 730, expected: math.LegacyOneDec()},
+		{remainingDays: 1000, expected: math.LegacyOneDec()},
+	}
+	for _, tt := range tests {
+		require.True(t, tt.expected.Equal(params.VotingPowerMultiplier(tt.remainingDays)), "remaining days %d", tt.remainingDays)
+	}
+}
@@ -5,9 +5,14 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -31,16 +36,135 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// UnlockScheduleBucket is the period that UnlockSchedule aggregates unlocks by.
+type UnlockScheduleBucket int32
+
+const (
+	// UNLOCK_SCHEDULE_BUCKET_DAY aggregates by unlock date.
+	UnlockScheduleBucket_UNLOCK_SCHEDULE_BUCKET_DAY UnlockScheduleBucket = 0
+	// UNLOCK_SCHEDULE_BUCKET_WEEK aggregates by week, starting on Monday.
+	UnlockScheduleBucket_UNLOCK_SCHEDULE_BUCKET_WEEK UnlockScheduleBucket = 1
+	// UNLOCK_SCHEDULE_BUCKET_MONTH aggregates by calendar month.
+	UnlockScheduleBucket_UNLOCK_SCHEDULE_BUCKET_MONTH UnlockScheduleBucket = 2
+)
+
+var UnlockScheduleBucket_name = map[int32]string{
+	0: "UNLOCK_SCHEDULE_BUCKET_DAY",
+	1: "UNLOCK_SCHEDULE_BUCKET_WEEK",
+	2: "UNLOCK_SCHEDULE_BUCKET_MONTH",
+}
+
+var UnlockScheduleBucket_value = map[string]int32{
+	"UNLOCK_SCHEDULE_BUCKET_DAY":   0,
+	"UNLOCK_SCHEDULE_BUCKET_WEEK":  1,
+	"UNLOCK_SCHEDULE_BUCKET_MONTH": 2,
+}
+
+func (x UnlockScheduleBucket) String() string {
+	return proto.EnumName(UnlockScheduleBucket_name, int32(x))
+}
+
+func (UnlockScheduleBucket) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{0}
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // QueryActiveLocksRequest is request type for the Query/ActiveLocks RPC method.
 type QueryActiveLocksRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// address only returns the locks of this address if set. The locks of a single address are paged by their index
+	// in the results, so page keys of queries with and without an address cannot be mixed.
+	Address string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Filter  LockFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter"`
 }
 
 func (m *QueryActiveLocksRequest) Reset()         { *m = QueryActiveLocksRequest{} }
 func (m *QueryActiveLocksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActiveLocksRequest) ProtoMessage()    {}
 func (*QueryActiveLocksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513e58b3df6d044, []int{0}
+	return fileDescriptor_4513e58b3df6d044, []int{2}
 }
 func (m *QueryActiveLocksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,6 +200,78 @@ func (m *QueryActiveLocksRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryActiveLocksRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryActiveLocksRequest) GetFilter() LockFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return LockFilter{}
+}
+
+// LockFilter narrows the locks returned by the lock queries. Rolling locks are matched by their effective unlock date
+// on the current block day.
+type LockFilter struct {
+	// start_date is the first unlock date included, unbounded if empty.
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// end_date is the last unlock date included, unbounded if empty.
+	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// min_amount is the smallest lock amount included, unbounded if empty.
+	MinAmount *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_amount,json=minAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount,omitempty"`
+}
+
+func (m *LockFilter) Reset()         { *m = LockFilter{} }
+func (m *LockFilter) String() string { return proto.CompactTextString(m) }
+func (*LockFilter) ProtoMessage()    {}
+func (*LockFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{3}
+}
+func (m *LockFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockFilter.Merge(m, src)
+}
+func (m *LockFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockFilter proto.InternalMessageInfo
+
+func (m *LockFilter) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *LockFilter) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
 // QueryActiveLocksResponse is response type for the Query/ActiveLocks RPC method.
 type QueryActiveLocksResponse struct {
 	Locks      []ActiveLockResource `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
@@ -86,7 +282,7 @@ func (m *QueryActiveLocksResponse) Reset()         { *m = QueryActiveLocksRespon
 func (m *QueryActiveLocksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActiveLocksResponse) ProtoMessage()    {}
 func (*QueryActiveLocksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513e58b3df6d044, []int{1}
+	return fileDescriptor_4513e58b3df6d044, []int{4}
 }
 func (m *QueryActiveLocksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -133,13 +329,16 @@ type ActiveLockResource struct {
 	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	UnlockDate string     `protobuf:"bytes,2,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
 	Amount     types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	AutoRenew  bool       `protobuf:"varint,4,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// kind tells delegated locks of the bond denom from escrowed locks, which can be of any allowed denom.
+	Kind LockKind `protobuf:"varint,5,opt,name=kind,proto3,enum=optio.lockup.LockKind" json:"kind,omitempty"`
 }
 
 func (m *ActiveLockResource) Reset()         { *m = ActiveLockResource{} }
 func (m *ActiveLockResource) String() string { return proto.CompactTextString(m) }
 func (*ActiveLockResource) ProtoMessage()    {}
 func (*ActiveLockResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513e58b3df6d044, []int{2}
+	return fileDescriptor_4513e58b3df6d044, []int{5}
 }
 func (m *ActiveLockResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -189,6 +388,20 @@ func (m *ActiveLockResource) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *ActiveLockResource) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
+}
+
+func (m *ActiveLockResource) GetKind() LockKind {
+	if m != nil {
+		return m.Kind
+	}
+	return LockKind_LOCK_KIND_DELEGATED
+}
+
 // QueryTotalLockedAmountRequest is request type for the Query/TotalLockedAmount RPC method.
 type QueryTotalLockedAmountRequest struct {
 }
@@ -197,7 +410,7 @@ func (m *QueryTotalLockedAmountRequest) Reset()         { *m = QueryTotalLockedA
 func (m *QueryTotalLockedAmountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLockedAmountRequest) ProtoMessage()    {}
 func (*QueryTotalLockedAmountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513e58b3df6d044, []int{3}
+	return fileDescriptor_4513e58b3df6d044, []int{6}
 }
 func (m *QueryTotalLockedAmountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,14 +441,17 @@ var xxx_messageInfo_QueryTotalLockedAmountRequest proto.InternalMessageInfo
 
 // QueryTotalLockedAmountResponse is response type for the Query/TotalLockedAmount RPC method.
 type QueryTotalLockedAmountResponse struct {
+	// total_locked is the total amount of the bond denom in delegated locks.
 	TotalLocked types.Coin `protobuf:"bytes,1,opt,name=total_locked,json=totalLocked,proto3" json:"total_locked"`
+	// total_escrowed is the total amount of every denom, including the bond denom, held in escrowed locks.
+	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed"`
 }
 
 func (m *QueryTotalLockedAmountResponse) Reset()         { *m = QueryTotalLockedAmountResponse{} }
 func (m *QueryTotalLockedAmountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLockedAmountResponse) ProtoMessage()    {}
 func (*QueryTotalLockedAmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513e58b3df6d044, []int{4}
+	return fileDescriptor_4513e58b3df6d044, []int{7}
 }
 func (m *QueryTotalLockedAmountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -271,17 +487,26 @@ func (m *QueryTotalLockedAmountResponse) GetTotalLocked() types.Coin {
 	return types.Coin{}
 }
 
+func (m *QueryTotalLockedAmountResponse) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEscrowed
+	}
+	return nil
+}
+
 // QueryAccountLocksRequest is request type for the Query/AccountLocks RPC method.
 type QueryAccountLocksRequest struct {
-	Addresses  string             `protobuf:"bytes,1,opt,name=addresses,proto3" json:"addresses,omitempty"`
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// pagination pages through addresses.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Filter     LockFilter         `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter"`
 }
 
 func (m *QueryAccountLocksRequest) Reset()         { *m = QueryAccountLocksRequest{} }
 func (m *QueryAccountLocksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountLocksRequest) ProtoMessage()    {}
 func (*QueryAccountLocksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513e58b3df6d044, []int{5}
+	return fileDescriptor_4513e58b3df6d044, []int{8}
 }
 func (m *QueryAccountLocksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -310,11 +535,11 @@ func (m *QueryAccountLocksRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAccountLocksRequest proto.InternalMessageInfo
 
-func (m *QueryAccountLocksRequest) GetAddresses() string {
+func (m *QueryAccountLocksRequest) GetAddresses() []string {
 	if m != nil {
 		return m.Addresses
 	}
-	return ""
+	return nil
 }
 
 func (m *QueryAccountLocksRequest) GetPagination() *query.PageRequest {
@@ -324,6 +549,13 @@ func (m *QueryAccountLocksRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAccountLocksRequest) GetFilter() LockFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return LockFilter{}
+}
+
 // QueryAccountLocksResponse is response type for the Query/AccountLocks RPC method.
 type QueryAccountLocksResponse struct {
 	Accounts   []AccountLocksResource `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
@@ -334,7 +566,7 @@ func (m *QueryAccountLocksResponse) Reset()         { *m = QueryAccountLocksResp
 func (m *QueryAccountLocksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountLocksResponse) ProtoMessage()    {}
 func (*QueryAccountLocksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513e58b3df6d044, []int{6}
+	return fileDescriptor_4513e58b3df6d044, []int{9}
 }
 func (m *QueryAccountLocksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +618,7 @@ func (m *AccountLocksResource) Reset()         { *m = AccountLocksResource{} }
 func (m *AccountLocksResource) String() string { return proto.CompactTextString(m) }
 func (*AccountLocksResource) ProtoMessage()    {}
 func (*AccountLocksResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513e58b3df6d044, []int{7}
+	return fileDescriptor_4513e58b3df6d044, []int{10}
 }
 func (m *AccountLocksResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,13 +664,16 @@ func (m *AccountLocksResource) GetLocks() []LockResource {
 type LockResource struct {
 	UnlockDate string     `protobuf:"bytes,1,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
 	Amount     types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	AutoRenew  bool       `protobuf:"varint,4,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// kind tells delegated locks of the bond denom from escrowed locks, which can be of any allowed denom.
+	Kind LockKind `protobuf:"varint,5,opt,name=kind,proto3,enum=optio.lockup.LockKind" json:"kind,omitempty"`
 }
 
 func (m *LockResource) Reset()         { *m = LockResource{} }
 func (m *LockResource) String() string { return proto.CompactTextString(m) }
 func (*LockResource) ProtoMessage()    {}
 func (*LockResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513e58b3df6d044, []int{8}
+	return fileDescriptor_4513e58b3df6d044, []int{11}
 }
 func (m *LockResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,17 +716,32 @@ func (m *LockResource) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *LockResource) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
+}
+
+func (m *LockResource) GetKind() LockKind {
+	if m != nil {
+		return m.Kind
+	}
+	return LockKind_LOCK_KIND_DELEGATED
+}
+
 // QueryLocksRequest is request type for the Query/Locks RPC method.
 type QueryLocksRequest struct {
 	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Filter     LockFilter         `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter"`
 }
 
 func (m *QueryLocksRequest) Reset()         { *m = QueryLocksRequest{} }
 func (m *QueryLocksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLocksRequest) ProtoMessage()    {}
 func (*QueryLocksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513e58b3df6d044, []int{9}
+	return fileDescriptor_4513e58b3df6d044, []int{12}
 }
 func (m *QueryLocksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,6 +784,13 @@ func (m *QueryLocksRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryLocksRequest) GetFilter() LockFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return LockFilter{}
+}
+
 // QueryLocksResponse is response type for the Query/Locks RPC method.
 type QueryLocksResponse struct {
 	Locks      []LockResource      `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
@@ -544,7 +801,7 @@ func (m *QueryLocksResponse) Reset()         { *m = QueryLocksResponse{} }
 func (m *QueryLocksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLocksResponse) ProtoMessage()    {}
 func (*QueryLocksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513e58b3df6d044, []int{10}
+	return fileDescriptor_4513e58b3df6d044, []int{13}
 }
 func (m *QueryLocksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,915 +844,5261 @@ func (m *QueryLocksResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryActiveLocksRequest)(nil), "optio.lockup.QueryActiveLocksRequest")
-	proto.RegisterType((*QueryActiveLocksResponse)(nil), "optio.lockup.QueryActiveLocksResponse")
-	proto.RegisterType((*ActiveLockResource)(nil), "optio.lockup.ActiveLockResource")
-	proto.RegisterType((*QueryTotalLockedAmountRequest)(nil), "optio.lockup.QueryTotalLockedAmountRequest")
-	proto.RegisterType((*QueryTotalLockedAmountResponse)(nil), "optio.lockup.QueryTotalLockedAmountResponse")
-	proto.RegisterType((*QueryAccountLocksRequest)(nil), "optio.lockup.QueryAccountLocksRequest")
-	proto.RegisterType((*QueryAccountLocksResponse)(nil), "optio.lockup.QueryAccountLocksResponse")
-	proto.RegisterType((*AccountLocksResource)(nil), "optio.lockup.AccountLocksResource")
-	proto.RegisterType((*LockResource)(nil), "optio.lockup.LockResource")
-	proto.RegisterType((*QueryLocksRequest)(nil), "optio.lockup.QueryLocksRequest")
-	proto.RegisterType((*QueryLocksResponse)(nil), "optio.lockup.QueryLocksResponse")
+// QueryLockWeightedTallyRequest is request type for the Query/LockWeightedTally RPC method.
+type QueryLockWeightedTallyRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("optio/lockup/query.proto", fileDescriptor_4513e58b3df6d044) }
-
-var fileDescriptor_4513e58b3df6d044 = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xbb, 0x6f, 0x13, 0x4f,
-	0x10, 0xf6, 0x3a, 0x8f, 0xdf, 0x2f, 0x63, 0x37, 0x59, 0x45, 0x8a, 0x63, 0x92, 0xb3, 0xb9, 0x28,
-	0x0f, 0x20, 0xdc, 0x29, 0x41, 0x0a, 0x0d, 0x4d, 0x42, 0x14, 0x1a, 0xc4, 0xc3, 0xa2, 0xa2, 0xb1,
-	0xd6, 0xe7, 0xd5, 0xe5, 0x14, 0xe7, 0xf6, 0xe2, 0xdd, 0x0b, 0x44, 0x08, 0x29, 0x42, 0x14, 0x94,
-	0x48, 0x88, 0x82, 0x92, 0x0e, 0xf1, 0x97, 0xa4, 0x8c, 0x44, 0x43, 0x85, 0x50, 0xc2, 0x1f, 0x82,
-	0x6e, 0x77, 0x2f, 0xbe, 0xf5, 0x23, 0x8e, 0x90, 0x2b, 0xdb, 0x37, 0xdf, 0xcc, 0xf7, 0xcd, 0xcc,
-	0x77, 0x63, 0x28, 0xb1, 0x48, 0x04, 0xcc, 0x6d, 0x31, 0x6f, 0x3f, 0x8e, 0xdc, 0xc3, 0x98, 0xb6,
-	0x8f, 0x9d, 0xa8, 0xcd, 0x04, 0xc3, 0x45, 0x19, 0x71, 0x54, 0xa4, 0x3c, 0xe3, 0x33, 0x9f, 0xc9,
-	0x80, 0x9b, 0x7c, 0x53, 0x98, 0xf2, 0xbc, 0xcf, 0x98, 0xdf, 0xa2, 0x2e, 0x89, 0x02, 0x97, 0x84,
-	0x21, 0x13, 0x44, 0x04, 0x2c, 0xe4, 0x3a, 0x7a, 0xdb, 0x63, 0xfc, 0x80, 0x71, 0xb7, 0x41, 0x38,
-	0x55, 0xa5, 0xdd, 0xa3, 0xf5, 0x06, 0x15, 0x64, 0xdd, 0x8d, 0x88, 0x1f, 0x84, 0x12, 0xac, 0xb1,
-	0x56, 0x16, 0x9b, 0xa2, 0x3c, 0x16, 0xa4, 0xf1, 0x59, 0x43, 0x67, 0xf2, 0xa1, 0x02, 0x36, 0x81,
-	0xd9, 0xe7, 0x49, 0xe9, 0x2d, 0x4f, 0x04, 0x47, 0xf4, 0x31, 0xf3, 0xf6, 0x79, 0x8d, 0x1e, 0xc6,
-	0x94, 0x0b, 0xbc, 0x0b, 0xd0, 0xe1, 0x29, 0xa1, 0x2a, 0x5a, 0x2d, 0x6c, 0x2c, 0x3b, 0x8a, 0xc8,
-	0x49, 0x88, 0x1c, 0xd5, 0xaf, 0xa6, 0x73, 0x9e, 0x11, 0x9f, 0xea, 0xdc, 0x5a, 0x26, 0xd3, 0xfe,
-	0x8a, 0xa0, 0xd4, 0xcb, 0xc1, 0x23, 0x16, 0x72, 0x8a, 0x1f, 0xc0, 0x44, 0xa2, 0x86, 0x97, 0x50,
-	0x75, 0x6c, 0xb5, 0xb0, 0x51, 0x75, 0xb2, 0x63, 0x73, 0x3a, 0x19, 0x35, 0xca, 0x59, 0xdc, 0xf6,
-	0xe8, 0xf6, 0xf8, 0xe9, 0xaf, 0x4a, 0xae, 0xa6, 0x92, 0xf0, 0x23, 0x43, 0x62, 0x5e, 0x4a, 0x5c,
-	0x19, 0x2a, 0x51, 0x51, 0x1b, 0x1a, 0x3f, 0x20, 0xc0, 0xbd, 0x64, 0xb8, 0x04, 0xff, 0x91, 0x66,
-	0xb3, 0x4d, 0x39, 0x97, 0xfd, 0x4f, 0xd5, 0xd2, 0x9f, 0xb8, 0x02, 0x85, 0x38, 0x4c, 0x44, 0xd4,
-	0x9b, 0x44, 0x50, 0x49, 0x3d, 0x55, 0x03, 0xf5, 0x68, 0x87, 0x08, 0x8a, 0xef, 0xc3, 0x24, 0x39,
-	0x60, 0x71, 0x28, 0x4a, 0x63, 0x52, 0xd6, 0x9c, 0x21, 0x2b, 0x15, 0xf4, 0x90, 0x05, 0xa1, 0x6e,
-	0x49, 0xc3, 0xed, 0x0a, 0x2c, 0xc8, 0x69, 0xbd, 0x60, 0x82, 0xb4, 0x12, 0x35, 0xb4, 0xb9, 0x25,
-	0x23, 0x7a, 0xb6, 0x76, 0x13, 0xac, 0x41, 0x00, 0x3d, 0xd4, 0x6d, 0x28, 0x8a, 0x24, 0x58, 0x6f,
-	0xc9, 0xa8, 0xde, 0xdd, 0x50, 0x05, 0x05, 0xd1, 0xa9, 0x68, 0x9f, 0x74, 0xb6, 0xe6, 0x25, 0xc5,
-	0x0d, 0x6b, 0xcc, 0xc3, 0x94, 0x1e, 0x04, 0x4d, 0x27, 0xd3, 0x79, 0xd0, 0x65, 0x9c, 0xfc, 0x3f,
-	0x1b, 0xe7, 0x3b, 0x82, 0xb9, 0x3e, 0x12, 0x74, 0x93, 0x3b, 0xf0, 0x3f, 0x51, 0xcf, 0x53, 0xf3,
-	0xd8, 0xdd, 0xe6, 0x31, 0xb2, 0xb2, 0xf6, 0xb9, 0xcc, 0x1c, 0x9d, 0x83, 0xf6, 0x60, 0xa6, 0x1f,
-	0xe1, 0x15, 0x16, 0xda, 0x4c, 0xad, 0x9f, 0x97, 0xea, 0xcb, 0xa6, 0xfa, 0x81, 0xa6, 0xb7, 0xf7,
-	0xa0, 0x68, 0x98, 0xb4, 0xcb, 0x8a, 0x68, 0x74, 0x56, 0x8c, 0x61, 0x5a, 0xce, 0xdf, 0xd8, 0xfd,
-	0xe0, 0x86, 0x46, 0xb5, 0xf7, 0xcf, 0x08, 0x70, 0x96, 0x57, 0x2f, 0x7c, 0xd3, 0x3c, 0x15, 0xd7,
-	0x9d, 0xd7, 0xc8, 0x56, 0xbc, 0xf1, 0x6d, 0x1c, 0x26, 0xa4, 0x2e, 0x7c, 0x82, 0xa0, 0x90, 0xb9,
-	0x66, 0x78, 0xc9, 0xd4, 0x32, 0xe0, 0xa2, 0x96, 0x97, 0x87, 0xc1, 0x14, 0xa9, 0x6d, 0xbf, 0xfb,
-	0xf1, 0xe7, 0x53, 0x7e, 0x1e, 0x97, 0x5d, 0xe3, 0x6c, 0x13, 0x09, 0xad, 0xab, 0xae, 0xbe, 0x20,
-	0x98, 0xee, 0xb9, 0x00, 0xf8, 0x4e, 0x1f, 0x86, 0x41, 0x87, 0xa4, 0xbc, 0x76, 0x3d, 0xb0, 0x16,
-	0x75, 0x4b, 0x8a, 0x5a, 0xc4, 0x37, 0x4d, 0x51, 0xd9, 0x43, 0x53, 0x57, 0xbe, 0xc1, 0xef, 0x11,
-	0x14, 0xb3, 0x2f, 0x03, 0xee, 0xdf, 0x78, 0xcf, 0x5d, 0x29, 0xaf, 0x0c, 0xc5, 0x69, 0x31, 0x8b,
-	0x52, 0xcc, 0x02, 0xbe, 0xd1, 0x3d, 0x21, 0x89, 0xd5, 0x23, 0x3a, 0x86, 0x09, 0x45, 0x5f, 0xe9,
-	0x53, 0xd6, 0xe0, 0xad, 0x0e, 0x06, 0x68, 0xc2, 0xbb, 0x92, 0x70, 0x05, 0x2f, 0x5d, 0x41, 0xe8,
-	0xbe, 0xd1, 0x6f, 0xc2, 0xdb, 0xed, 0xdd, 0xd3, 0x73, 0x0b, 0x9d, 0x9d, 0x5b, 0xe8, 0xf7, 0xb9,
-	0x85, 0x3e, 0x5e, 0x58, 0xb9, 0xb3, 0x0b, 0x2b, 0xf7, 0xf3, 0xc2, 0xca, 0xbd, 0x5c, 0xf3, 0x03,
-	0xb1, 0x17, 0x37, 0x1c, 0x8f, 0x1d, 0xb8, 0x4f, 0x93, 0x52, 0x4f, 0xa8, 0x78, 0xc5, 0xda, 0xfb,
-	0xba, 0xee, 0xeb, 0xcb, 0xb9, 0x1e, 0x47, 0x94, 0x37, 0x26, 0xe5, 0xbf, 0xf4, 0xbd, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xce, 0xcd, 0x60, 0x1d, 0x68, 0x08, 0x00, 0x00,
+func (m *QueryLockWeightedTallyRequest) Reset()         { *m = QueryLockWeightedTallyRequest{} }
+func (m *QueryLockWeightedTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockWeightedTallyRequest) ProtoMessage()    {}
+func (*QueryLockWeightedTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{14}
+}
+func (m *QueryLockWeightedTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockWeightedTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockWeightedTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockWeightedTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockWeightedTallyRequest.Merge(m, src)
+}
+func (m *QueryLockWeightedTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockWeightedTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockWeightedTallyRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryLockWeightedTallyRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ActiveLocks queries all active locks with an unlock date in the future.
-	ActiveLocks(ctx context.Context, in *QueryActiveLocksRequest, opts ...grpc.CallOption) (*QueryActiveLocksResponse, error)
-	// TotalLockedAmount queries the total amount of tokens locked across all accounts.
-	TotalLockedAmount(ctx context.Context, in *QueryTotalLockedAmountRequest, opts ...grpc.CallOption) (*QueryTotalLockedAmountResponse, error)
-	// AccountLocks queries active locks for multiple addresses.
-	AccountLocks(ctx context.Context, in *QueryAccountLocksRequest, opts ...grpc.CallOption) (*QueryAccountLocksResponse, error)
-	// Locks queries active locks for an address.
-	Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error)
+func (m *QueryLockWeightedTallyRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryLockWeightedTallyResponse is response type for the Query/LockWeightedTally RPC method.
+type QueryLockWeightedTallyResponse struct {
+	Tally v1.TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally"`
+	// passes reports whether the proposal would pass under the lock-weighted tally. It does not affect the outcome.
+	Passes bool `protobuf:"varint,2,opt,name=passes,proto3" json:"passes,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryLockWeightedTallyResponse) Reset()         { *m = QueryLockWeightedTallyResponse{} }
+func (m *QueryLockWeightedTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockWeightedTallyResponse) ProtoMessage()    {}
+func (*QueryLockWeightedTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{15}
 }
-
-func (c *queryClient) ActiveLocks(ctx context.Context, in *QueryActiveLocksRequest, opts ...grpc.CallOption) (*QueryActiveLocksResponse, error) {
-	out := new(QueryActiveLocksResponse)
-	err := c.cc.Invoke(ctx, "/optio.lockup.Query/ActiveLocks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLockWeightedTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockWeightedTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockWeightedTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLockWeightedTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockWeightedTallyResponse.Merge(m, src)
+}
+func (m *QueryLockWeightedTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockWeightedTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockWeightedTallyResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalLockedAmount(ctx context.Context, in *QueryTotalLockedAmountRequest, opts ...grpc.CallOption) (*QueryTotalLockedAmountResponse, error) {
-	out := new(QueryTotalLockedAmountResponse)
-	err := c.cc.Invoke(ctx, "/optio.lockup.Query/TotalLockedAmount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLockWeightedTallyResponse proto.InternalMessageInfo
+
+func (m *QueryLockWeightedTallyResponse) GetTally() v1.TallyResult {
+	if m != nil {
+		return m.Tally
 	}
-	return out, nil
+	return v1.TallyResult{}
 }
 
-func (c *queryClient) AccountLocks(ctx context.Context, in *QueryAccountLocksRequest, opts ...grpc.CallOption) (*QueryAccountLocksResponse, error) {
-	out := new(QueryAccountLocksResponse)
-	err := c.cc.Invoke(ctx, "/optio.lockup.Query/AccountLocks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLockWeightedTallyResponse) GetPasses() bool {
+	if m != nil {
+		return m.Passes
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error) {
-	out := new(QueryLocksResponse)
-	err := c.cc.Invoke(ctx, "/optio.lockup.Query/Locks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRewardsPoolRequest is request type for the Query/RewardsPool RPC method.
+type QueryRewardsPoolRequest struct {
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ActiveLocks queries all active locks with an unlock date in the future.
-	ActiveLocks(context.Context, *QueryActiveLocksRequest) (*QueryActiveLocksResponse, error)
-	// TotalLockedAmount queries the total amount of tokens locked across all accounts.
-	TotalLockedAmount(context.Context, *QueryTotalLockedAmountRequest) (*QueryTotalLockedAmountResponse, error)
-	// AccountLocks queries active locks for multiple addresses.
-	AccountLocks(context.Context, *QueryAccountLocksRequest) (*QueryAccountLocksResponse, error)
-	// Locks queries active locks for an address.
-	Locks(context.Context, *QueryLocksRequest) (*QueryLocksResponse, error)
+func (m *QueryRewardsPoolRequest) Reset()         { *m = QueryRewardsPoolRequest{} }
+func (m *QueryRewardsPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsPoolRequest) ProtoMessage()    {}
+func (*QueryRewardsPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{16}
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryRewardsPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedQueryServer) ActiveLocks(ctx context.Context, req *QueryActiveLocksRequest) (*QueryActiveLocksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActiveLocks not implemented")
+func (m *QueryRewardsPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TotalLockedAmount(ctx context.Context, req *QueryTotalLockedAmountRequest) (*QueryTotalLockedAmountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalLockedAmount not implemented")
+func (m *QueryRewardsPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsPoolRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AccountLocks(ctx context.Context, req *QueryAccountLocksRequest) (*QueryAccountLocksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountLocks not implemented")
+func (m *QueryRewardsPoolRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Locks(ctx context.Context, req *QueryLocksRequest) (*QueryLocksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Locks not implemented")
+func (m *QueryRewardsPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsPoolRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryRewardsPoolRequest proto.InternalMessageInfo
 
-func _Query_ActiveLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActiveLocksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActiveLocks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/optio.lockup.Query/ActiveLocks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActiveLocks(ctx, req.(*QueryActiveLocksRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryRewardsPoolResponse is response type for the Query/RewardsPool RPC method.
+type QueryRewardsPoolResponse struct {
+	// balance is the total balance of the rewards pool module account.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// unallocated is the part of the balance that will be paid out at the next epoch.
+	Unallocated github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unallocated,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unallocated"`
 }
 
-func _Query_TotalLockedAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalLockedAmountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalLockedAmount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/optio.lockup.Query/TotalLockedAmount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalLockedAmount(ctx, req.(*QueryTotalLockedAmountRequest))
+func (m *QueryRewardsPoolResponse) Reset()         { *m = QueryRewardsPoolResponse{} }
+func (m *QueryRewardsPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsPoolResponse) ProtoMessage()    {}
+func (*QueryRewardsPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{17}
+}
+func (m *QueryRewardsPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRewardsPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsPoolResponse.Merge(m, src)
+}
+func (m *QueryRewardsPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsPoolResponse.DiscardUnknown(m)
 }
 
-func _Query_AccountLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountLocksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountLocks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/optio.lockup.Query/AccountLocks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountLocks(ctx, req.(*QueryAccountLocksRequest))
+var xxx_messageInfo_QueryRewardsPoolResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsPoolResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Locks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLocksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Locks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/optio.lockup.Query/Locks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Locks(ctx, req.(*QueryLocksRequest))
+func (m *QueryRewardsPoolResponse) GetUnallocated() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unallocated
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "optio.lockup.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ActiveLocks",
-			Handler:    _Query_ActiveLocks_Handler,
-		},
-		{
-			MethodName: "TotalLockedAmount",
-			Handler:    _Query_TotalLockedAmount_Handler,
-		},
-		{
-			MethodName: "AccountLocks",
-			Handler:    _Query_AccountLocks_Handler,
-		},
-		{
-			MethodName: "Locks",
-			Handler:    _Query_Locks_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "optio/lockup/query.proto",
+// QueryPendingRewardsRequest is request type for the Query/PendingRewards RPC method.
+type QueryPendingRewardsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryActiveLocksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPendingRewardsRequest) Reset()         { *m = QueryPendingRewardsRequest{} }
+func (m *QueryPendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsRequest) ProtoMessage()    {}
+func (*QueryPendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{18}
 }
-
-func (m *QueryActiveLocksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryActiveLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsRequest.Merge(m, src)
+}
+func (m *QueryPendingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryActiveLocksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryActiveLocksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingRewardsResponse is response type for the Query/PendingRewards RPC method.
+type QueryPendingRewardsResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func (m *QueryActiveLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Locks) > 0 {
-		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPendingRewardsResponse) Reset()         { *m = QueryPendingRewardsResponse{} }
+func (m *QueryPendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsResponse) ProtoMessage()    {}
+func (*QueryPendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{19}
+}
+func (m *QueryPendingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsResponse.Merge(m, src)
+}
+func (m *QueryPendingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *ActiveLockResource) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ActiveLockResource) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAccountSummaryRequest is request type for the Query/AccountSummary RPC method.
+type QueryAccountSummaryRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *ActiveLockResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.UnlockDate) > 0 {
-		i -= len(m.UnlockDate)
-		copy(dAtA[i:], m.UnlockDate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnlockDate)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAccountSummaryRequest) Reset()         { *m = QueryAccountSummaryRequest{} }
+func (m *QueryAccountSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountSummaryRequest) ProtoMessage()    {}
+func (*QueryAccountSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{20}
 }
-
-func (m *QueryTotalLockedAmountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryTotalLockedAmountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAccountSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountSummaryRequest.Merge(m, src)
 }
-
-func (m *QueryTotalLockedAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAccountSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountSummaryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTotalLockedAmountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountSummaryRequest proto.InternalMessageInfo
+
+func (m *QueryAccountSummaryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryTotalLockedAmountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAccountSummaryResponse is response type for the Query/AccountSummary RPC method. All amounts are in the bond
+// denom.
+type QueryAccountSummaryResponse struct {
+	// total_locked is the amount in active dated and rolling locks.
+	TotalLocked types.Coin `protobuf:"bytes,1,opt,name=total_locked,json=totalLocked,proto3" json:"total_locked"`
+	// total_delegated is the amount currently delegated.
+	TotalDelegated types.Coin `protobuf:"bytes,2,opt,name=total_delegated,json=totalDelegated,proto3" json:"total_delegated"`
+	// locked_above_delegated is the locked amount not covered by delegations, which has to stay in the balance.
+	LockedAboveDelegated types.Coin `protobuf:"bytes,3,opt,name=locked_above_delegated,json=lockedAboveDelegated,proto3" json:"locked_above_delegated"`
+	// balance is the bank balance.
+	Balance types.Coin `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance"`
+	// spendable_unlocked is the amount that can be sent: the bank spendable balance, capped at the balance minus
+	// locked_above_delegated.
+	SpendableUnlocked types.Coin `protobuf:"bytes,5,opt,name=spendable_unlocked,json=spendableUnlocked,proto3" json:"spendable_unlocked"`
+	// next_unlock_date is the earliest unlock date of the dated locks, empty if there are none. Rolling locks never
+	// unlock.
+	NextUnlockDate string `protobuf:"bytes,6,opt,name=next_unlock_date,json=nextUnlockDate,proto3" json:"next_unlock_date,omitempty"`
+	// next_unlock_amount is the amount that unlocks on next_unlock_date.
+	NextUnlockAmount types.Coin `protobuf:"bytes,7,opt,name=next_unlock_amount,json=nextUnlockAmount,proto3" json:"next_unlock_amount"`
+	// lock_count is the number of active dated and rolling locks.
+	LockCount uint32 `protobuf:"varint,8,opt,name=lock_count,json=lockCount,proto3" json:"lock_count,omitempty"`
+	// total_escrowed is the amount of every denom held in the escrowed locks of the address, including the bond denom.
+	// Escrowed locks are not part of the other fields.
+	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed"`
+}
+
+func (m *QueryAccountSummaryResponse) Reset()         { *m = QueryAccountSummaryResponse{} }
+func (m *QueryAccountSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountSummaryResponse) ProtoMessage()    {}
+func (*QueryAccountSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{21}
+}
+func (m *QueryAccountSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryTotalLockedAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TotalLocked.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAccountSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAccountSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountSummaryResponse.Merge(m, src)
+}
+func (m *QueryAccountSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountSummaryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccountLocksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryAccountSummaryResponse) GetTotalLocked() types.Coin {
+	if m != nil {
+		return m.TotalLocked
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryAccountLocksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAccountSummaryResponse) GetTotalDelegated() types.Coin {
+	if m != nil {
+		return m.TotalDelegated
+	}
+	return types.Coin{}
 }
 
-func (m *QueryAccountLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryAccountSummaryResponse) GetLockedAboveDelegated() types.Coin {
+	if m != nil {
+		return m.LockedAboveDelegated
 	}
-	if len(m.Addresses) > 0 {
-		i -= len(m.Addresses)
-		copy(dAtA[i:], m.Addresses)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses)))
-		i--
-		dAtA[i] = 0xa
+	return types.Coin{}
+}
+
+func (m *QueryAccountSummaryResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryAccountLocksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountSummaryResponse) GetSpendableUnlocked() types.Coin {
+	if m != nil {
+		return m.SpendableUnlocked
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryAccountLocksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAccountSummaryResponse) GetNextUnlockDate() string {
+	if m != nil {
+		return m.NextUnlockDate
+	}
+	return ""
 }
 
-func (m *QueryAccountLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryAccountSummaryResponse) GetNextUnlockAmount() types.Coin {
+	if m != nil {
+		return m.NextUnlockAmount
 	}
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return types.Coin{}
+}
+
+func (m *QueryAccountSummaryResponse) GetLockCount() uint32 {
+	if m != nil {
+		return m.LockCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AccountLocksResource) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountSummaryResponse) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEscrowed
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AccountLocksResource) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLockHistoryRequest is request type for the Query/LockHistory RPC method.
+type QueryLockHistoryRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *AccountLocksResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Locks) > 0 {
-		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryLockHistoryRequest) Reset()         { *m = QueryLockHistoryRequest{} }
+func (m *QueryLockHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockHistoryRequest) ProtoMessage()    {}
+func (*QueryLockHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{22}
+}
+func (m *QueryLockHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryLockHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockHistoryRequest.Merge(m, src)
+}
+func (m *QueryLockHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryLockHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *LockResource) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLockHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LockResource) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLockHistoryResponse is response type for the Query/LockHistory RPC method.
+type QueryLockHistoryResponse struct {
+	Entries    []LockHistoryEntry  `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *LockResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryLockHistoryResponse) Reset()         { *m = QueryLockHistoryResponse{} }
+func (m *QueryLockHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockHistoryResponse) ProtoMessage()    {}
+func (*QueryLockHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{23}
+}
+func (m *QueryLockHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.UnlockDate) > 0 {
-		i -= len(m.UnlockDate)
-		copy(dAtA[i:], m.UnlockDate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnlockDate)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryLockHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockHistoryResponse.Merge(m, src)
+}
+func (m *QueryLockHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryLockHistoryResponse) GetEntries() []LockHistoryEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryLocksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLockHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLocksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUnlockScheduleRequest is request type for the Query/UnlockSchedule RPC method.
+type QueryUnlockScheduleRequest struct {
+	// start_date is the first unlock date included, defaulting to the day after the current block day.
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// end_date is the last unlock date included, unbounded if empty.
+	EndDate string               `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Bucket  UnlockScheduleBucket `protobuf:"varint,3,opt,name=bucket,proto3,enum=optio.lockup.UnlockScheduleBucket" json:"bucket,omitempty"`
+	// pagination limits the number of buckets returned.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryUnlockScheduleRequest) Reset()         { *m = QueryUnlockScheduleRequest{} }
+func (m *QueryUnlockScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockScheduleRequest) ProtoMessage()    {}
+func (*QueryUnlockScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{24}
+}
+func (m *QueryUnlockScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryUnlockScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockScheduleRequest.Merge(m, src)
+}
+func (m *QueryUnlockScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlockScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryUnlockScheduleRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryLocksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnlockScheduleRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryLocksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnlockScheduleRequest) GetBucket() UnlockScheduleBucket {
+	if m != nil {
+		return m.Bucket
+	}
+	return UnlockScheduleBucket_UNLOCK_SCHEDULE_BUCKET_DAY
 }
 
-func (m *QueryLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryUnlockScheduleRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.Locks) > 0 {
-		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return nil
+}
+
+// QueryUnlockScheduleResponse is response type for the Query/UnlockSchedule RPC method. Rolling locks never unlock
+// and are not included.
+type QueryUnlockScheduleResponse struct {
+	Entries    []UnlockScheduleEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnlockScheduleResponse) Reset()         { *m = QueryUnlockScheduleResponse{} }
+func (m *QueryUnlockScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockScheduleResponse) ProtoMessage()    {}
+func (*QueryUnlockScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{25}
+}
+func (m *QueryUnlockScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryUnlockScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockScheduleResponse.Merge(m, src)
+}
+func (m *QueryUnlockScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockScheduleResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryUnlockScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryUnlockScheduleResponse) GetEntries() []UnlockScheduleEntry {
+	if m != nil {
+		return m.Entries
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryActiveLocksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryUnlockScheduleResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryActiveLocksResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Locks) > 0 {
-		for _, e := range m.Locks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type UnlockScheduleEntry struct {
+	// date is the first day of the bucket.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// amount is the amount of the bond denom unlocking from delegated locks in the bucket.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// address_count is the number of distinct addresses with delegated or escrowed locks unlocking in the bucket.
+	AddressCount uint32 `protobuf:"varint,3,opt,name=address_count,json=addressCount,proto3" json:"address_count,omitempty"`
+	// escrowed is the amount of every denom, including the bond denom, released from escrowed locks in the bucket.
+	Escrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed"`
+}
+
+func (m *UnlockScheduleEntry) Reset()         { *m = UnlockScheduleEntry{} }
+func (m *UnlockScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*UnlockScheduleEntry) ProtoMessage()    {}
+func (*UnlockScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513e58b3df6d044, []int{26}
+}
+func (m *UnlockScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *UnlockScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockScheduleEntry.Merge(m, src)
+}
+func (m *UnlockScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockScheduleEntry.DiscardUnknown(m)
 }
 
-func (m *ActiveLockResource) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.UnlockDate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_UnlockScheduleEntry proto.InternalMessageInfo
+
+func (m *UnlockScheduleEntry) GetDate() string {
+	if m != nil {
+		return m.Date
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryTotalLockedAmountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnlockScheduleEntry) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	return n
+	return types.Coin{}
 }
 
-func (m *QueryTotalLockedAmountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnlockScheduleEntry) GetAddressCount() uint32 {
+	if m != nil {
+		return m.AddressCount
 	}
-	var l int
-	_ = l
-	l = m.TotalLocked.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryAccountLocksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnlockScheduleEntry) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrowed
 	}
-	var l int
-	_ = l
-	l = len(m.Addresses)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("optio.lockup.UnlockScheduleBucket", UnlockScheduleBucket_name, UnlockScheduleBucket_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "optio.lockup.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "optio.lockup.QueryParamsResponse")
+	proto.RegisterType((*QueryActiveLocksRequest)(nil), "optio.lockup.QueryActiveLocksRequest")
+	proto.RegisterType((*LockFilter)(nil), "optio.lockup.LockFilter")
+	proto.RegisterType((*QueryActiveLocksResponse)(nil), "optio.lockup.QueryActiveLocksResponse")
+	proto.RegisterType((*ActiveLockResource)(nil), "optio.lockup.ActiveLockResource")
+	proto.RegisterType((*QueryTotalLockedAmountRequest)(nil), "optio.lockup.QueryTotalLockedAmountRequest")
+	proto.RegisterType((*QueryTotalLockedAmountResponse)(nil), "optio.lockup.QueryTotalLockedAmountResponse")
+	proto.RegisterType((*QueryAccountLocksRequest)(nil), "optio.lockup.QueryAccountLocksRequest")
+	proto.RegisterType((*QueryAccountLocksResponse)(nil), "optio.lockup.QueryAccountLocksResponse")
+	proto.RegisterType((*AccountLocksResource)(nil), "optio.lockup.AccountLocksResource")
+	proto.RegisterType((*LockResource)(nil), "optio.lockup.LockResource")
+	proto.RegisterType((*QueryLocksRequest)(nil), "optio.lockup.QueryLocksRequest")
+	proto.RegisterType((*QueryLocksResponse)(nil), "optio.lockup.QueryLocksResponse")
+	proto.RegisterType((*QueryLockWeightedTallyRequest)(nil), "optio.lockup.QueryLockWeightedTallyRequest")
+	proto.RegisterType((*QueryLockWeightedTallyResponse)(nil), "optio.lockup.QueryLockWeightedTallyResponse")
+	proto.RegisterType((*QueryRewardsPoolRequest)(nil), "optio.lockup.QueryRewardsPoolRequest")
+	proto.RegisterType((*QueryRewardsPoolResponse)(nil), "optio.lockup.QueryRewardsPoolResponse")
+	proto.RegisterType((*QueryPendingRewardsRequest)(nil), "optio.lockup.QueryPendingRewardsRequest")
+	proto.RegisterType((*QueryPendingRewardsResponse)(nil), "optio.lockup.QueryPendingRewardsResponse")
+	proto.RegisterType((*QueryAccountSummaryRequest)(nil), "optio.lockup.QueryAccountSummaryRequest")
+	proto.RegisterType((*QueryAccountSummaryResponse)(nil), "optio.lockup.QueryAccountSummaryResponse")
+	proto.RegisterType((*QueryLockHistoryRequest)(nil), "optio.lockup.QueryLockHistoryRequest")
+	proto.RegisterType((*QueryLockHistoryResponse)(nil), "optio.lockup.QueryLockHistoryResponse")
+	proto.RegisterType((*QueryUnlockScheduleRequest)(nil), "optio.lockup.QueryUnlockScheduleRequest")
+	proto.RegisterType((*QueryUnlockScheduleResponse)(nil), "optio.lockup.QueryUnlockScheduleResponse")
+	proto.RegisterType((*UnlockScheduleEntry)(nil), "optio.lockup.UnlockScheduleEntry")
+}
+
+func init() { proto.RegisterFile("optio/lockup/query.proto", fileDescriptor_4513e58b3df6d044) }
+
+var fileDescriptor_4513e58b3df6d044 = []byte{
+	// 1766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1b, 0xcf, 0xb8, 0xce, 0x87, 0xc7, 0x69, 0xde, 0x64, 0x9a, 0x37, 0x75, 0xdc, 0xc4, 0x76, 0xb7,
+	0x6f, 0x1b, 0x37, 0x6d, 0xbd, 0x6f, 0xf2, 0xea, 0x4d, 0x05, 0x42, 0x88, 0x7c, 0x95, 0x94, 0xb4,
+	0x69, 0xd9, 0x26, 0xaa, 0xe0, 0xb2, 0x5a, 0x7b, 0x07, 0x67, 0xf1, 0x7a, 0xc7, 0xdd, 0x5d, 0x27,
+	0x8d, 0xaa, 0x4a, 0x08, 0x71, 0x01, 0x71, 0xa8, 0x40, 0x20, 0x71, 0x41, 0x42, 0x1c, 0x80, 0x4a,
+	0x48, 0x48, 0x70, 0xe0, 0x4f, 0xe8, 0xb1, 0xa2, 0x17, 0x54, 0x89, 0x82, 0x5a, 0x24, 0xae, 0x9c,
+	0x39, 0xa1, 0x9d, 0x79, 0xd6, 0xde, 0xb5, 0xd7, 0x89, 0x5b, 0xd2, 0xc2, 0xc5, 0x1f, 0xf3, 0x7c,
+	0xfd, 0xe6, 0xf9, 0x9a, 0x67, 0x06, 0xa7, 0x58, 0xcd, 0x35, 0x98, 0x6c, 0xb2, 0x52, 0xa5, 0x5e,
+	0x93, 0xaf, 0xd5, 0xa9, 0xbd, 0x53, 0xa8, 0xd9, 0xcc, 0x65, 0x64, 0x90, 0x53, 0x0a, 0x82, 0x92,
+	0x1e, 0xd1, 0xaa, 0x86, 0xc5, 0x64, 0xfe, 0x29, 0x18, 0xd2, 0xa3, 0x65, 0x56, 0x66, 0xfc, 0xa7,
+	0xec, 0xfd, 0x82, 0xd5, 0x89, 0x32, 0x63, 0x65, 0x93, 0xca, 0x5a, 0xcd, 0x90, 0x35, 0xcb, 0x62,
+	0xae, 0xe6, 0x1a, 0xcc, 0x72, 0x80, 0x3a, 0x5d, 0x62, 0x4e, 0x95, 0x39, 0x72, 0x51, 0x73, 0xa8,
+	0xb0, 0x26, 0x6f, 0xcd, 0x14, 0xa9, 0xab, 0xcd, 0xc8, 0x35, 0xad, 0x6c, 0x58, 0x9c, 0x19, 0x78,
+	0xc7, 0x05, 0xaf, 0x2a, 0x4c, 0x88, 0x3f, 0x40, 0xca, 0x04, 0xd5, 0xf8, 0x0a, 0x4a, 0xcc, 0xf0,
+	0x45, 0x0f, 0x03, 0xbd, 0xcc, 0xb6, 0xe4, 0xad, 0x19, 0xef, 0x0b, 0x08, 0xe9, 0xd0, 0x76, 0x37,
+	0x0d, 0xc7, 0x65, 0xfe, 0x86, 0xd3, 0x87, 0x43, 0x34, 0xef, 0xcb, 0x07, 0x12, 0x22, 0xd4, 0x34,
+	0x5b, 0xab, 0x02, 0x10, 0x69, 0x14, 0x93, 0x57, 0xbd, 0x5d, 0x5c, 0xe6, 0x8b, 0x0a, 0xbd, 0x56,
+	0xa7, 0x8e, 0x2b, 0xad, 0xe1, 0x43, 0xa1, 0x55, 0xa7, 0xc6, 0x2c, 0x87, 0x92, 0xb3, 0xb8, 0x4f,
+	0x08, 0xa7, 0x50, 0x0e, 0xe5, 0x93, 0xb3, 0xa3, 0x85, 0xa0, 0x8b, 0x0b, 0x82, 0x7b, 0x21, 0x71,
+	0xe7, 0x41, 0xb6, 0xe7, 0xcb, 0xdf, 0xbe, 0x99, 0x46, 0x0a, 0xb0, 0x4b, 0xdf, 0x22, 0x7c, 0x98,
+	0x2b, 0x9c, 0x2f, 0xb9, 0xc6, 0x16, 0xbd, 0xc0, 0x4a, 0x15, 0xdf, 0x16, 0x39, 0x87, 0x71, 0xd3,
+	0x73, 0xa0, 0xf8, 0x44, 0x01, 0xbc, 0xe5, 0xf9, 0xa7, 0x20, 0x82, 0x0a, 0x5e, 0x2a, 0x5c, 0xd6,
+	0xca, 0x14, 0x64, 0x95, 0x80, 0x24, 0x49, 0xe1, 0x7e, 0x4d, 0xd7, 0x6d, 0xea, 0x38, 0xa9, 0x58,
+	0x0e, 0xe5, 0x13, 0x8a, 0xff, 0x97, 0xcc, 0xe1, 0xbe, 0x37, 0x0c, 0xd3, 0xa5, 0x76, 0xea, 0x00,
+	0xd7, 0x9e, 0x0a, 0xc3, 0xf6, 0xd0, 0x9c, 0xe3, 0xf4, 0x85, 0xb8, 0x07, 0x5d, 0x01, 0x6e, 0xe9,
+	0x03, 0x84, 0x71, 0x93, 0x48, 0x26, 0x31, 0x76, 0x5c, 0xcd, 0x76, 0x55, 0x5d, 0x73, 0x29, 0x07,
+	0x9a, 0x50, 0x12, 0x7c, 0x65, 0x49, 0x73, 0x29, 0x19, 0xc7, 0x03, 0xd4, 0xd2, 0x05, 0x11, 0x00,
+	0x50, 0x4b, 0xe7, 0xa4, 0x57, 0x30, 0xae, 0x1a, 0x96, 0xaa, 0x55, 0x59, 0xdd, 0x72, 0x39, 0x88,
+	0xc4, 0xc2, 0xa9, 0x3b, 0x0f, 0xb2, 0xe8, 0xfe, 0x83, 0xec, 0xbf, 0xc5, 0x4e, 0x1d, 0xbd, 0x52,
+	0x30, 0x98, 0x5c, 0xd5, 0xdc, 0xcd, 0xc2, 0x79, 0xcb, 0xfd, 0xe1, 0xbb, 0x33, 0x18, 0x5c, 0x70,
+	0xde, 0x72, 0x95, 0x44, 0xd5, 0xb0, 0xe6, 0xb9, 0xb4, 0xf4, 0x19, 0xc2, 0xa9, 0x76, 0x57, 0x42,
+	0x80, 0x5e, 0xc0, 0xbd, 0xde, 0xa6, 0xbc, 0xf8, 0x1c, 0xc8, 0x27, 0x67, 0x73, 0xe1, 0x8d, 0x36,
+	0x25, 0x14, 0xea, 0xb0, 0xba, 0x5d, 0xa2, 0xb0, 0x61, 0x21, 0x44, 0x5e, 0x0e, 0x45, 0x22, 0xc6,
+	0x7d, 0x35, 0xb5, 0x67, 0x24, 0x84, 0xe9, 0x60, 0x28, 0xa4, 0x7b, 0x08, 0x93, 0x76, 0x63, 0xc1,
+	0x08, 0xa1, 0x70, 0x84, 0xb2, 0x38, 0x59, 0xb7, 0x3c, 0x10, 0x41, 0xf7, 0x61, 0xb1, 0xc4, 0x3d,
+	0x78, 0x16, 0xf7, 0x05, 0xbc, 0x97, 0x9c, 0x1d, 0x0f, 0xc1, 0xf2, 0x01, 0x2d, 0x32, 0xc3, 0xf2,
+	0x63, 0x28, 0xd8, 0xbd, 0xa0, 0x69, 0x75, 0x97, 0xa9, 0x36, 0xb5, 0xe8, 0x76, 0x2a, 0x9e, 0x43,
+	0xf9, 0x01, 0x25, 0xe1, 0xad, 0x28, 0xde, 0x02, 0x99, 0xc6, 0xf1, 0x8a, 0x61, 0xe9, 0xa9, 0xde,
+	0x1c, 0xca, 0x0f, 0xcd, 0x8e, 0xb5, 0x27, 0xc6, 0xaa, 0x61, 0xe9, 0x0a, 0xe7, 0x91, 0xb2, 0x78,
+	0x92, 0x3b, 0x7e, 0x9d, 0xb9, 0x9a, 0xe9, 0xd1, 0xa8, 0x2e, 0x62, 0xe2, 0x57, 0xcd, 0x4f, 0x08,
+	0x67, 0x3a, 0x71, 0x40, 0x80, 0x16, 0xf0, 0xa0, 0xeb, 0x11, 0x55, 0x93, 0x53, 0x21, 0xdd, 0xf7,
+	0xdc, 0x4d, 0xd2, 0x6d, 0x6a, 0x24, 0xdb, 0x78, 0x48, 0xe8, 0xa0, 0x4e, 0xc9, 0x66, 0xdb, 0x54,
+	0x4f, 0xc5, 0x78, 0xb4, 0x77, 0xd1, 0xf2, 0x7f, 0x4f, 0xcb, 0xed, 0x9f, 0xb3, 0xf9, 0xb2, 0xe1,
+	0x6e, 0xd6, 0x8b, 0x85, 0x12, 0xab, 0x42, 0x3f, 0x82, 0xaf, 0x33, 0x8e, 0x5e, 0x91, 0xdd, 0x9d,
+	0x1a, 0x75, 0xb8, 0x80, 0x23, 0xca, 0xf7, 0x20, 0xb7, 0xb3, 0x0c, 0x66, 0xa4, 0xef, 0x9b, 0xa9,
+	0x57, 0xf2, 0x76, 0x15, 0x2a, 0xe3, 0x09, 0x9c, 0x80, 0x68, 0x52, 0x91, 0x7e, 0x09, 0xa5, 0xb9,
+	0xd0, 0x52, 0xe4, 0xb1, 0x27, 0x2e, 0xf2, 0x27, 0x2d, 0xe5, 0xdb, 0x08, 0x8f, 0x47, 0x40, 0x87,
+	0xa8, 0x2c, 0xe1, 0x01, 0x4d, 0xac, 0xfb, 0x95, 0x23, 0xb5, 0x56, 0x4e, 0x48, 0x2a, 0x58, 0x3b,
+	0x0d, 0xc9, 0xfd, 0x2b, 0x9f, 0x4d, 0x3c, 0x1a, 0x65, 0x70, 0x97, 0xfa, 0x99, 0xf3, 0xeb, 0x5e,
+	0x64, 0x42, 0xba, 0xdd, 0x2b, 0x91, 0x15, 0xef, 0xf5, 0xe5, 0xc1, 0x50, 0x89, 0xb6, 0x14, 0x22,
+	0xfa, 0x47, 0x16, 0xe2, 0xd7, 0x08, 0x8f, 0xf0, 0x60, 0x86, 0x12, 0xb0, 0xb3, 0x77, 0xfe, 0xee,
+	0xe4, 0xfb, 0x08, 0xc1, 0x21, 0x1b, 0xce, 0xba, 0xb9, 0x70, 0xb3, 0xee, 0x36, 0x68, 0xfb, 0x97,
+	0x67, 0x2f, 0x41, 0x43, 0xf3, 0x4c, 0x5d, 0xa5, 0x46, 0x79, 0xd3, 0xa5, 0xfa, 0xba, 0x66, 0x9a,
+	0x3b, 0xbe, 0x4b, 0xb3, 0x38, 0x59, 0xb3, 0x59, 0x8d, 0x39, 0x9a, 0xa9, 0x1a, 0xa2, 0x59, 0xc5,
+	0x15, 0xec, 0x2f, 0x9d, 0xd7, 0xa5, 0x1a, 0x34, 0xbc, 0x08, 0x0d, 0xcd, 0x4d, 0xba, 0xde, 0x02,
+	0x74, 0xba, 0xb4, 0x8f, 0xd3, 0x9b, 0x68, 0xb6, 0x66, 0x0a, 0x3e, 0x73, 0xdd, 0x74, 0xfd, 0x4d,
+	0x72, 0x76, 0x32, 0xe6, 0x8d, 0x1a, 0xbc, 0x97, 0xc4, 0x78, 0xaa, 0xc0, 0x3f, 0x69, 0x1c, 0x06,
+	0x09, 0x85, 0x6e, 0x6b, 0xb6, 0xee, 0x5c, 0x66, 0xcc, 0xf4, 0xdb, 0xef, 0x1f, 0x7e, 0x7b, 0x0a,
+	0xd1, 0x00, 0xc7, 0x9b, 0xb8, 0xbf, 0xa8, 0x99, 0x9a, 0x55, 0xa2, 0xe0, 0xee, 0xfd, 0xef, 0x96,
+	0xbe, 0x01, 0x62, 0x7b, 0x45, 0xa4, 0x99, 0x26, 0x2b, 0x69, 0xee, 0x53, 0xec, 0xce, 0x41, 0x23,
+	0xd2, 0x1c, 0x4e, 0x8b, 0x89, 0x8d, 0x5a, 0xba, 0x61, 0x95, 0xc1, 0x05, 0x7b, 0xd6, 0x86, 0xf4,
+	0x2e, 0xc2, 0x47, 0x22, 0x05, 0x9b, 0x7e, 0xb3, 0xc5, 0xd2, 0xd3, 0xf3, 0x1b, 0x18, 0x68, 0xec,
+	0x01, 0x9a, 0xdf, 0x95, 0x7a, 0xb5, 0xaa, 0xd9, 0x3b, 0x7b, 0xef, 0xe1, 0xd3, 0x5e, 0xd8, 0x43,
+	0xab, 0xe0, 0x3e, 0x1e, 0xba, 0x2b, 0xf8, 0x5f, 0x42, 0x87, 0x4e, 0x4d, 0x5a, 0x86, 0xb8, 0x76,
+	0xa5, 0x46, 0x1c, 0xd6, 0x4b, 0xbe, 0x18, 0xd9, 0xc0, 0x63, 0x02, 0x87, 0xaa, 0x15, 0xd9, 0x16,
+	0x0d, 0x28, 0xec, 0xb2, 0xa3, 0x8e, 0x0a, 0xf1, 0x79, 0x4f, 0xba, 0xa9, 0xf6, 0xb9, 0x66, 0x82,
+	0xc7, 0xbb, 0xd3, 0xd3, 0xc8, 0xd7, 0x35, 0x4c, 0x9c, 0x1a, 0xb5, 0x74, 0xad, 0x68, 0x52, 0x55,
+	0xf4, 0x7a, 0x2a, 0x3a, 0x71, 0x17, 0x5a, 0x46, 0x1a, 0xa2, 0x1b, 0x20, 0x49, 0xf2, 0x78, 0xd8,
+	0xa2, 0xd7, 0x5d, 0x35, 0x78, 0x92, 0xf4, 0xf1, 0x90, 0x0d, 0x79, 0xeb, 0x1b, 0xcd, 0xd3, 0xe4,
+	0x22, 0x26, 0x41, 0x4e, 0x38, 0x59, 0xfa, 0xbb, 0xb3, 0x3c, 0xdc, 0x54, 0x36, 0xdf, 0x38, 0x63,
+	0xb8, 0x1e, 0x9e, 0x05, 0xa9, 0x81, 0x1c, 0xca, 0x1f, 0x54, 0x12, 0xde, 0xca, 0x22, 0x27, 0xb7,
+	0x0f, 0x4e, 0x89, 0x67, 0x33, 0x38, 0xdd, 0x80, 0xa6, 0xe5, 0xe5, 0xd2, 0x8a, 0xb8, 0xb2, 0x3d,
+	0xb3, 0x53, 0x4b, 0xfa, 0xdc, 0x6f, 0x8b, 0x21, 0xeb, 0x50, 0x1a, 0x2f, 0xe2, 0x7e, 0x6a, 0xb9,
+	0xb6, 0x41, 0xfd, 0xf2, 0xce, 0xb4, 0x9f, 0x42, 0x20, 0xb3, 0x6c, 0xb9, 0xf6, 0x8e, 0x9f, 0x3a,
+	0x20, 0xb4, 0x7f, 0x67, 0xd1, 0x7d, 0x04, 0xc5, 0x2f, 0x02, 0x7a, 0xa5, 0xb4, 0x49, 0xf5, 0xba,
+	0xe9, 0x6f, 0xe8, 0x2f, 0xdc, 0xbd, 0x9e, 0xc7, 0x7d, 0xc5, 0x7a, 0xa9, 0x42, 0xc5, 0xc0, 0x32,
+	0xd4, 0x3a, 0xd9, 0x85, 0xcd, 0x2d, 0x70, 0x4e, 0x05, 0x24, 0x5a, 0x42, 0x10, 0x7f, 0xe2, 0x10,
+	0x7c, 0xe5, 0x37, 0xd9, 0xd6, 0xcd, 0x41, 0x14, 0xe6, 0x5b, 0xa3, 0x70, 0x74, 0x37, 0x90, 0x4f,
+	0x37, 0x10, 0xbf, 0x23, 0x7c, 0x28, 0xc2, 0x1e, 0x21, 0x38, 0x1e, 0xf0, 0x3d, 0xff, 0x1d, 0x18,
+	0x06, 0x63, 0x8f, 0x37, 0x0c, 0x1e, 0xc3, 0x07, 0x21, 0xcd, 0xa1, 0x56, 0x0f, 0xf0, 0x5a, 0x1d,
+	0x84, 0x45, 0x51, 0xae, 0x65, 0x3c, 0xd0, 0x28, 0xd4, 0xf8, 0x5e, 0x85, 0xfa, 0xdf, 0xc7, 0x2d,
+	0x54, 0xa5, 0xa1, 0x7c, 0x7a, 0x07, 0x8f, 0x46, 0xa5, 0x01, 0xc9, 0xe0, 0xf4, 0xc6, 0xda, 0x85,
+	0x4b, 0x8b, 0xab, 0xea, 0x95, 0xc5, 0x95, 0xe5, 0xa5, 0x8d, 0x0b, 0xcb, 0xea, 0xc2, 0xc6, 0xe2,
+	0xea, 0xf2, 0xba, 0xba, 0x34, 0xff, 0xda, 0x70, 0x0f, 0xc9, 0xe2, 0x23, 0x1d, 0xe8, 0x57, 0x97,
+	0x97, 0x57, 0x87, 0x11, 0xc9, 0xe1, 0x89, 0x0e, 0x0c, 0x17, 0x2f, 0xad, 0xad, 0xaf, 0x0c, 0xc7,
+	0x66, 0x6f, 0x0d, 0xe2, 0x5e, 0x9e, 0x19, 0xa4, 0x82, 0xfb, 0xc4, 0xfb, 0x09, 0x69, 0xb9, 0xb5,
+	0xb7, 0x3f, 0xcf, 0xa4, 0x8f, 0xee, 0xc2, 0x21, 0x42, 0x2a, 0x4d, 0xbc, 0x7d, 0xef, 0xd7, 0x0f,
+	0x63, 0x63, 0x64, 0x54, 0x8e, 0x78, 0xfb, 0x21, 0x6f, 0x21, 0x9c, 0x0c, 0xbc, 0x1f, 0x90, 0xe3,
+	0x11, 0x0a, 0xdb, 0x9f, 0x6a, 0xd2, 0x27, 0xf6, 0x62, 0x03, 0xe3, 0x12, 0x37, 0x3e, 0x41, 0xd2,
+	0x61, 0xe3, 0x1a, 0x67, 0x55, 0xc5, 0x14, 0xfb, 0x09, 0xc2, 0x23, 0x6d, 0xf7, 0x64, 0x72, 0x2a,
+	0xc2, 0x42, 0xa7, 0xfb, 0x76, 0xfa, 0x74, 0x77, 0xcc, 0x00, 0xea, 0x24, 0x07, 0x75, 0x8c, 0x1c,
+	0x0d, 0x83, 0x0a, 0x4e, 0x06, 0x70, 0x00, 0x91, 0x77, 0x10, 0x1e, 0x0c, 0xde, 0xc0, 0x48, 0xf4,
+	0xc6, 0xdb, 0x2e, 0xc1, 0xe9, 0xa9, 0x3d, 0xf9, 0x00, 0xcc, 0x31, 0x0e, 0x66, 0x92, 0x1c, 0x69,
+	0xf5, 0x10, 0xe7, 0x05, 0x17, 0xed, 0xe0, 0x5e, 0x61, 0x3e, 0x1b, 0xa1, 0x36, 0x64, 0x37, 0xd7,
+	0x99, 0x01, 0x0c, 0x9e, 0xe1, 0x06, 0xa7, 0xc8, 0xf1, 0x5d, 0x0c, 0xca, 0x37, 0xa0, 0xfe, 0x6e,
+	0x92, 0x2f, 0x10, 0x1e, 0x69, 0x1b, 0xea, 0x23, 0xa3, 0xd3, 0xe9, 0xf2, 0x10, 0x19, 0x9d, 0x8e,
+	0xf7, 0x04, 0xe9, 0x2c, 0xc7, 0x37, 0x43, 0x64, 0xb9, 0xed, 0x11, 0x53, 0xdd, 0x06, 0x09, 0x95,
+	0x5f, 0x0d, 0xe4, 0x1b, 0x81, 0x3b, 0xc9, 0x4d, 0x9e, 0xca, 0x81, 0x81, 0x3f, 0x32, 0x95, 0xdb,
+	0x2f, 0x0b, 0x91, 0xa9, 0x1c, 0x71, 0x6f, 0xe8, 0x94, 0xca, 0x30, 0xb2, 0xaa, 0x35, 0xcf, 0xe4,
+	0xc7, 0x08, 0x0f, 0x85, 0xc7, 0x67, 0x92, 0x8f, 0xaa, 0xd0, 0xa8, 0xd1, 0x3c, 0x7d, 0xb2, 0x0b,
+	0x4e, 0xc0, 0x22, 0x73, 0x2c, 0x27, 0xc9, 0x54, 0x4b, 0x4d, 0x0b, 0x6e, 0x15, 0x30, 0x05, 0xa2,
+	0xe8, 0x01, 0x0b, 0xcf, 0xc4, 0x91, 0xc0, 0x22, 0xe7, 0xed, 0x48, 0x60, 0xd1, 0x03, 0x76, 0x27,
+	0x60, 0x7e, 0x72, 0x39, 0x82, 0x3d, 0x00, 0xec, 0x3d, 0x84, 0x93, 0x81, 0xd1, 0x22, 0x32, 0x68,
+	0xed, 0xc3, 0x52, 0x64, 0xd0, 0x22, 0xa6, 0x1a, 0xe9, 0x34, 0xc7, 0x73, 0x82, 0xfc, 0x27, 0x22,
+	0x99, 0xe0, 0xc9, 0x3c, 0x00, 0xe6, 0x7d, 0x84, 0x87, 0xc2, 0xfd, 0x3f, 0xd2, 0x4b, 0x91, 0x83,
+	0x49, 0xa4, 0x97, 0xa2, 0x4f, 0x79, 0xe9, 0x38, 0x47, 0x95, 0x25, 0x93, 0x61, 0x54, 0x30, 0xfb,
+	0x3a, 0xfe, 0xd9, 0x73, 0xee, 0xce, 0xc3, 0x0c, 0xba, 0xfb, 0x30, 0x83, 0x7e, 0x79, 0x98, 0x41,
+	0xb7, 0x1e, 0x65, 0x7a, 0xee, 0x3e, 0xca, 0xf4, 0xfc, 0xf8, 0x28, 0xd3, 0xf3, 0xfa, 0xe9, 0xc0,
+	0xd9, 0x76, 0xc9, 0x53, 0xb1, 0x46, 0xdd, 0x6d, 0x66, 0x57, 0x40, 0xdf, 0xf5, 0x46, 0x4b, 0xf3,
+	0x4e, 0xb9, 0x62, 0x1f, 0x7f, 0xe0, 0xff, 0xdf, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x8f,
+	0xbd, 0xe8, 0x21, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ActiveLocks queries all active locks with an unlock date in the future: delegated dated locks, followed by
+	// escrowed locks and rolling locks.
+	ActiveLocks(ctx context.Context, in *QueryActiveLocksRequest, opts ...grpc.CallOption) (*QueryActiveLocksResponse, error)
+	// TotalLockedAmount queries the total amount of tokens locked across all accounts.
+	TotalLockedAmount(ctx context.Context, in *QueryTotalLockedAmountRequest, opts ...grpc.CallOption) (*QueryTotalLockedAmountResponse, error)
+	// AccountLocks queries active locks for multiple addresses.
+	AccountLocks(ctx context.Context, in *QueryAccountLocksRequest, opts ...grpc.CallOption) (*QueryAccountLocksResponse, error)
+	// Locks queries active locks for an address.
+	Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error)
+	// LockWeightedTally queries the current tally of a proposal in its voting period with voting power boosted by
+	// active locks. It is informational: x/gov still decides proposals with its own staked tally.
+	LockWeightedTally(ctx context.Context, in *QueryLockWeightedTallyRequest, opts ...grpc.CallOption) (*QueryLockWeightedTallyResponse, error)
+	// RewardsPool queries the balance of the lock rewards pool.
+	RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error)
+	// PendingRewards queries the unclaimed rewards pool payouts of an address.
+	PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error)
+	// AccountSummary queries the locked, delegated and sendable amounts of an address.
+	AccountSummary(ctx context.Context, in *QueryAccountSummaryRequest, opts ...grpc.CallOption) (*QueryAccountSummaryResponse, error)
+	// LockHistory queries the recorded lock changes of an address, oldest first.
+	LockHistory(ctx context.Context, in *QueryLockHistoryRequest, opts ...grpc.CallOption) (*QueryLockHistoryResponse, error)
+	// UnlockSchedule queries the amounts of dated and escrowed locks that unlock chain-wide, aggregated by day, week
+	// or month.
+	UnlockSchedule(ctx context.Context, in *QueryUnlockScheduleRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ActiveLocks(ctx context.Context, in *QueryActiveLocksRequest, opts ...grpc.CallOption) (*QueryActiveLocksResponse, error) {
+	out := new(QueryActiveLocksResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Query/ActiveLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAccountLocksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) TotalLockedAmount(ctx context.Context, in *QueryTotalLockedAmountRequest, opts ...grpc.CallOption) (*QueryTotalLockedAmountResponse, error) {
+	out := new(QueryTotalLockedAmountResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Query/TotalLockedAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) AccountLocks(ctx context.Context, in *QueryAccountLocksRequest, opts ...grpc.CallOption) (*QueryAccountLocksResponse, error) {
+	out := new(QueryAccountLocksResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Query/AccountLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error) {
+	out := new(QueryLocksResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Query/Locks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *AccountLocksResource) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) LockWeightedTally(ctx context.Context, in *QueryLockWeightedTallyRequest, opts ...grpc.CallOption) (*QueryLockWeightedTallyResponse, error) {
+	out := new(QueryLockWeightedTallyResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Query/LockWeightedTally", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error) {
+	out := new(QueryRewardsPoolResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Query/RewardsPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Locks) > 0 {
-		for _, e := range m.Locks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error) {
+	out := new(QueryPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Query/PendingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *LockResource) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AccountSummary(ctx context.Context, in *QueryAccountSummaryRequest, opts ...grpc.CallOption) (*QueryAccountSummaryResponse, error) {
+	out := new(QueryAccountSummaryResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Query/AccountSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.UnlockDate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) LockHistory(ctx context.Context, in *QueryLockHistoryRequest, opts ...grpc.CallOption) (*QueryLockHistoryResponse, error) {
+	out := new(QueryLockHistoryResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Query/LockHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryLocksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) UnlockSchedule(ctx context.Context, in *QueryUnlockScheduleRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleResponse, error) {
+	out := new(QueryUnlockScheduleResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Query/UnlockSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ActiveLocks queries all active locks with an unlock date in the future: delegated dated locks, followed by
+	// escrowed locks and rolling locks.
+	ActiveLocks(context.Context, *QueryActiveLocksRequest) (*QueryActiveLocksResponse, error)
+	// TotalLockedAmount queries the total amount of tokens locked across all accounts.
+	TotalLockedAmount(context.Context, *QueryTotalLockedAmountRequest) (*QueryTotalLockedAmountResponse, error)
+	// AccountLocks queries active locks for multiple addresses.
+	AccountLocks(context.Context, *QueryAccountLocksRequest) (*QueryAccountLocksResponse, error)
+	// Locks queries active locks for an address.
+	Locks(context.Context, *QueryLocksRequest) (*QueryLocksResponse, error)
+	// LockWeightedTally queries the current tally of a proposal in its voting period with voting power boosted by
+	// active locks. It is informational: x/gov still decides proposals with its own staked tally.
+	LockWeightedTally(context.Context, *QueryLockWeightedTallyRequest) (*QueryLockWeightedTallyResponse, error)
+	// RewardsPool queries the balance of the lock rewards pool.
+	RewardsPool(context.Context, *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error)
+	// PendingRewards queries the unclaimed rewards pool payouts of an address.
+	PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error)
+	// AccountSummary queries the locked, delegated and sendable amounts of an address.
+	AccountSummary(context.Context, *QueryAccountSummaryRequest) (*QueryAccountSummaryResponse, error)
+	// LockHistory queries the recorded lock changes of an address, oldest first.
+	LockHistory(context.Context, *QueryLockHistoryRequest) (*QueryLockHistoryResponse, error)
+	// UnlockSchedule queries the amounts of dated and escrowed locks that unlock chain-wide, aggregated by day, week
+	// or month.
+	UnlockSchedule(context.Context, *QueryUnlockScheduleRequest) (*QueryUnlockScheduleResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ActiveLocks(ctx context.Context, req *QueryActiveLocksRequest) (*QueryActiveLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveLocks not implemented")
+}
+func (*UnimplementedQueryServer) TotalLockedAmount(ctx context.Context, req *QueryTotalLockedAmountRequest) (*QueryTotalLockedAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLockedAmount not implemented")
+}
+func (*UnimplementedQueryServer) AccountLocks(ctx context.Context, req *QueryAccountLocksRequest) (*QueryAccountLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountLocks not implemented")
+}
+func (*UnimplementedQueryServer) Locks(ctx context.Context, req *QueryLocksRequest) (*QueryLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Locks not implemented")
+}
+func (*UnimplementedQueryServer) LockWeightedTally(ctx context.Context, req *QueryLockWeightedTallyRequest) (*QueryLockWeightedTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockWeightedTally not implemented")
+}
+func (*UnimplementedQueryServer) RewardsPool(ctx context.Context, req *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsPool not implemented")
+}
+func (*UnimplementedQueryServer) PendingRewards(ctx context.Context, req *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (*UnimplementedQueryServer) AccountSummary(ctx context.Context, req *QueryAccountSummaryRequest) (*QueryAccountSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountSummary not implemented")
+}
+func (*UnimplementedQueryServer) LockHistory(ctx context.Context, req *QueryLockHistoryRequest) (*QueryLockHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockHistory not implemented")
+}
+func (*UnimplementedQueryServer) UnlockSchedule(ctx context.Context, req *QueryUnlockScheduleRequest) (*QueryUnlockScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockSchedule not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLocksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ActiveLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Locks) > 0 {
-		for _, e := range m.Locks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Query/ActiveLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveLocks(ctx, req.(*QueryActiveLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLockedAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLockedAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLockedAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Query/TotalLockedAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLockedAmount(ctx, req.(*QueryTotalLockedAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Query/AccountLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountLocks(ctx, req.(*QueryAccountLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Locks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Locks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Query/Locks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Locks(ctx, req.(*QueryLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockWeightedTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockWeightedTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockWeightedTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Query/LockWeightedTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockWeightedTally(ctx, req.(*QueryLockWeightedTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Query/RewardsPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsPool(ctx, req.(*QueryRewardsPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Query/PendingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRewards(ctx, req.(*QueryPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Query/AccountSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountSummary(ctx, req.(*QueryAccountSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Query/LockHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockHistory(ctx, req.(*QueryLockHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnlockSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnlockScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnlockSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Query/UnlockSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnlockSchedule(ctx, req.(*QueryUnlockScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "optio.lockup.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ActiveLocks",
+			Handler:    _Query_ActiveLocks_Handler,
+		},
+		{
+			MethodName: "TotalLockedAmount",
+			Handler:    _Query_TotalLockedAmount_Handler,
+		},
+		{
+			MethodName: "AccountLocks",
+			Handler:    _Query_AccountLocks_Handler,
+		},
+		{
+			MethodName: "Locks",
+			Handler:    _Query_Locks_Handler,
+		},
+		{
+			MethodName: "LockWeightedTally",
+			Handler:    _Query_LockWeightedTally_Handler,
+		},
+		{
+			MethodName: "RewardsPool",
+			Handler:    _Query_RewardsPool_Handler,
+		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _Query_PendingRewards_Handler,
+		},
+		{
+			MethodName: "AccountSummary",
+			Handler:    _Query_AccountSummary_Handler,
+		},
+		{
+			MethodName: "LockHistory",
+			Handler:    _Query_LockHistory_Handler,
+		},
+		{
+			MethodName: "UnlockSchedule",
+			Handler:    _Query_UnlockSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "optio/lockup/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveLocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveLocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinAmount != nil {
+		{
+			size := m.MinAmount.Size()
+			i -= size
+			if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EndDate) > 0 {
+		i -= len(m.EndDate)
+		copy(dAtA[i:], m.EndDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EndDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActiveLockResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveLockResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveLockResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLockedAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLockedAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLockedAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLockedAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLockedAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLockedAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalEscrowed) > 0 {
+		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEscrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.TotalLocked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountLocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountLocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountLocksResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountLocksResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountLocksResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockWeightedTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockWeightedTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockWeightedTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockWeightedTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockWeightedTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockWeightedTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Passes {
+		i--
+		if m.Passes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unallocated) > 0 {
+		for iNdEx := len(m.Unallocated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unallocated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalEscrowed) > 0 {
+		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEscrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.LockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.NextUnlockAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.NextUnlockDate) > 0 {
+		i -= len(m.NextUnlockDate)
+		copy(dAtA[i:], m.NextUnlockDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NextUnlockDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.SpendableUnlocked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.LockedAboveDelegated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TotalDelegated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TotalLocked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Bucket != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EndDate) > 0 {
+		i -= len(m.EndDate)
+		copy(dAtA[i:], m.EndDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EndDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnlockScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AddressCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AddressCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryActiveLocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Filter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LockFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EndDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinAmount != nil {
+		l = m.MinAmount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ActiveLockResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AutoRenew {
+		n += 2
+	}
+	if m.Kind != 0 {
+		n += 1 + sovQuery(uint64(m.Kind))
+	}
+	return n
+}
+
+func (m *QueryTotalLockedAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalLockedAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalLocked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TotalEscrowed) > 0 {
+		for _, e := range m.TotalEscrowed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountLocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Filter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountLocksResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AutoRenew {
+		n += 2
+	}
+	if m.Kind != 0 {
+		n += 1 + sovQuery(uint64(m.Kind))
+	}
+	return n
+}
+
+func (m *QueryLocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Filter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockWeightedTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryLockWeightedTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Passes {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRewardsPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardsPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unallocated) > 0 {
+		for _, e := range m.Unallocated {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalLocked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalDelegated.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockedAboveDelegated.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpendableUnlocked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.NextUnlockDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.NextUnlockAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LockCount != 0 {
+		n += 1 + sovQuery(uint64(m.LockCount))
+	}
+	if len(m.TotalEscrowed) > 0 {
+		for _, e := range m.TotalEscrowed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLockHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnlockScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EndDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Bucket != 0 {
+		n += 1 + sovQuery(uint64(m.Bucket))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnlockScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnlockScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AddressCount != 0 {
+		n += 1 + sovQuery(uint64(m.AddressCount))
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveLocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveLocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MinAmount = &v
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveLocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveLocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, ActiveLockResource{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveLockResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveLockResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveLockResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= LockKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalLockedAmountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalLockedAmountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalLockedAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalLockedAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalLockedAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalLockedAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEscrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalEscrowed = append(m.TotalEscrowed, types.Coin{})
+			if err := m.TotalEscrowed[len(m.TotalEscrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountLocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountLocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountLocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountLocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, AccountLocksResource{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountLocksResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountLocksResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountLocksResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, LockResource{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= LockKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, LockResource{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockWeightedTallyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockWeightedTallyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockWeightedTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockWeightedTallyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockWeightedTallyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockWeightedTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passes = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unallocated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unallocated = append(m.Unallocated, types.Coin{})
+			if err := m.Unallocated[len(m.Unallocated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryActiveLocksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1518,15 +6121,15 @@ func (m *QueryActiveLocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveLocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1553,10 +6156,8 @@ func (m *QueryActiveLocksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1581,7 +6182,7 @@ func (m *QueryActiveLocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActiveLocksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountSummaryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1604,51 +6205,17 @@ func (m *QueryActiveLocksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveLocksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountSummaryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Locks = append(m.Locks, ActiveLockResource{})
-			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1658,27 +6225,23 @@ func (m *QueryActiveLocksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1701,7 +6264,7 @@ func (m *QueryActiveLocksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveLockResource) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountSummaryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1724,17 +6287,17 @@ func (m *ActiveLockResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveLockResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountSummaryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveLockResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1744,29 +6307,30 @@ func (m *ActiveLockResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1776,27 +6340,28 @@ func (m *ActiveLockResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalDelegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAboveDelegated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1823,113 +6388,163 @@ func (m *ActiveLockResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LockedAboveDelegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendableUnlocked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalLockedAmountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.SpendableUnlocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.NextUnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnlockAmount", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalLockedAmountRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalLockedAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalLockedAmountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.NextUnlockAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalLockedAmountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalLockedAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEscrowed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1956,7 +6571,8 @@ func (m *QueryTotalLockedAmountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalEscrowed = append(m.TotalEscrowed, types.Coin{})
+			if err := m.TotalEscrowed[len(m.TotalEscrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1981,7 +6597,7 @@ func (m *QueryTotalLockedAmountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountLocksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLockHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2004,15 +6620,15 @@ func (m *QueryAccountLocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountLocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2040,7 +6656,7 @@ func (m *QueryAccountLocksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2099,7 +6715,7 @@ func (m *QueryAccountLocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountLocksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLockHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2122,15 +6738,15 @@ func (m *QueryAccountLocksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountLocksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2157,8 +6773,8 @@ func (m *QueryAccountLocksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, AccountLocksResource{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, LockHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2219,7 +6835,7 @@ func (m *QueryAccountLocksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountLocksResource) Unmarshal(dAtA []byte) error {
+func (m *QueryUnlockScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2242,15 +6858,15 @@ func (m *AccountLocksResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountLocksResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnlockScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountLocksResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnlockScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2278,11 +6894,62 @@ func (m *AccountLocksResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.StartDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			m.Bucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bucket |= UnlockScheduleBucket(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2309,8 +6976,10 @@ func (m *AccountLocksResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locks = append(m.Locks, LockResource{})
-			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2335,7 +7004,7 @@ func (m *AccountLocksResource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockResource) Unmarshal(dAtA []byte) error {
+func (m *QueryUnlockScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2358,17 +7027,17 @@ func (m *LockResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnlockScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnlockScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2378,27 +7047,29 @@ func (m *LockResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, UnlockScheduleEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2425,7 +7096,10 @@ func (m *LockResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2450,7 +7124,7 @@ func (m *LockResource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLocksRequest) Unmarshal(dAtA []byte) error {
+func (m *UnlockScheduleEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2473,15 +7147,15 @@ func (m *QueryLocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnlockScheduleEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnlockScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2509,11 +7183,11 @@ func (m *QueryLocksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Date = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2540,68 +7214,15 @@ func (m *QueryLocksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLocksResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLocksResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressCount", wireType)
 			}
-			var msglen int
+			m.AddressCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2611,29 +7232,14 @@ func (m *QueryLocksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AddressCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Locks = append(m.Locks, LockResource{})
-			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2660,10 +7266,8 @@ func (m *QueryLocksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Escrowed = append(m.Escrowed, types.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
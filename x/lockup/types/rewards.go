@@ -0,0 +1,93 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRewardWeight returns a reward weight without any locks.
+func NewRewardWeight() RewardWeight {
+	return RewardWeight{
+		Amount:     math.ZeroInt(),
+		UnlockDays: math.ZeroInt(),
+		Rolling:    math.ZeroInt(),
+	}
+}
+
+// AddDatedLock adds a dated lock of amount unlocking at unlockDay, counted in
+// days since the Unix epoch.
+func (w RewardWeight) AddDatedLock(amount math.Int, unlockDay int64) RewardWeight {
+	w.Amount = w.Amount.Add(amount)
+	w.UnlockDays = w.UnlockDays.Add(amount.MulRaw(unlockDay))
+	return w
+}
+
+// AddRollingLock adds a rolling lock of amount over durationDays.
+func (w RewardWeight) AddRollingLock(amount math.Int, durationDays uint32) RewardWeight {
+	w.Rolling = w.Rolling.Add(amount.MulRaw(int64(durationDays)))
+	return w
+}
+
+// Add returns the sum of both weights.
+func (w RewardWeight) Add(other RewardWeight) RewardWeight {
+	return RewardWeight{
+		Amount:     w.Amount.Add(other.Amount),
+		UnlockDays: w.UnlockDays.Add(other.UnlockDays),
+		Rolling:    w.Rolling.Add(other.Rolling),
+	}
+}
+
+// Sub returns the difference of both weights.
+func (w RewardWeight) Sub(other RewardWeight) RewardWeight {
+	return RewardWeight{
+		Amount:     w.Amount.Sub(other.Amount),
+		UnlockDays: w.UnlockDays.Sub(other.UnlockDays),
+		Rolling:    w.Rolling.Sub(other.Rolling),
+	}
+}
+
+// IsZero reports whether the weight holds no locks.
+func (w RewardWeight) IsZero() bool {
+	return w.Amount.IsZero() && w.UnlockDays.IsZero() && w.Rolling.IsZero()
+}
+
+// On returns the weight on day, counted in days since the Unix epoch: the
+// amount of every lock times the days left until it unlocks.
+func (w RewardWeight) On(day int64) math.Int {
+	return w.UnlockDays.Sub(w.Amount.MulRaw(day)).Add(w.Rolling)
+}
+
+// Add returns the index after an epoch on day paying perWeight rewards per
+// unit of weight.
+func (i RewardIndex) Add(perWeight sdk.DecCoins, day int64) RewardIndex {
+	perWeightDay := sdk.NewDecCoins()
+	for _, coin := range perWeight {
+		perWeightDay = perWeightDay.Add(sdk.NewDecCoinFromDec(coin.Denom, coin.Amount.MulInt64(day)))
+	}
+
+	return RewardIndex{
+		PerWeight:    i.PerWeight.Add(perWeight...),
+		PerWeightDay: i.PerWeightDay.Add(perWeightDay...),
+	}
+}
+
+// Equal reports whether both indexes are the same.
+func (i RewardIndex) Equal(other RewardIndex) bool {
+	return i.PerWeight.Equal(other.PerWeight) && i.PerWeightDay.Equal(other.PerWeightDay)
+}
+
+// RewardsSince returns the rewards earned by weight from the epochs paid
+// between since and the index. Fractions of a token are truncated.
+func (i RewardIndex) RewardsSince(since RewardIndex, weight RewardWeight) sdk.Coins {
+	rewards := sdk.NewCoins()
+	for _, coin := range i.PerWeight {
+		perWeight := coin.Amount.Sub(since.PerWeight.AmountOf(coin.Denom))
+		perWeightDay := i.PerWeightDay.AmountOf(coin.Denom).Sub(since.PerWeightDay.AmountOf(coin.Denom))
+
+		amount := perWeight.MulInt(weight.UnlockDays.Add(weight.Rolling)).Sub(perWeightDay.MulInt(weight.Amount)).TruncateInt()
+		if amount.IsPositive() {
+			rewards = rewards.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return rewards
+}
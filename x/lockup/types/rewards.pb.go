@@ -0,0 +1,1102 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: optio/lockup/rewards.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingRewards defines the rewards pool payouts allocated to an address that have not been claimed yet.
+type PendingRewards struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *PendingRewards) Reset()         { *m = PendingRewards{} }
+func (m *PendingRewards) String() string { return proto.CompactTextString(m) }
+func (*PendingRewards) ProtoMessage()    {}
+func (*PendingRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_905dd13b32ada116, []int{0}
+}
+func (m *PendingRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRewards.Merge(m, src)
+}
+func (m *PendingRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRewards proto.InternalMessageInfo
+
+func (m *PendingRewards) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PendingRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// RewardIndex defines the cumulative rewards paid per unit of lock weight.
+// Lock weight is the locked amount times the days left until the lock unlocks,
+// so the rewards of a dated lock are settled from both sums: the rewards per
+// weight times its unlock day, less the rewards per weight times the epoch day.
+type RewardIndex struct {
+	// per_weight is the sum of the rewards per unit of weight of every epoch.
+	PerWeight github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=per_weight,json=perWeight,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"per_weight"`
+	// per_weight_day is the sum of the rewards per unit of weight of every epoch
+	// times the epoch day, counted in days since the Unix epoch.
+	PerWeightDay github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=per_weight_day,json=perWeightDay,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"per_weight_day"`
+}
+
+func (m *RewardIndex) Reset()         { *m = RewardIndex{} }
+func (m *RewardIndex) String() string { return proto.CompactTextString(m) }
+func (*RewardIndex) ProtoMessage()    {}
+func (*RewardIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_905dd13b32ada116, []int{1}
+}
+func (m *RewardIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardIndex.Merge(m, src)
+}
+func (m *RewardIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardIndex proto.InternalMessageInfo
+
+func (m *RewardIndex) GetPerWeight() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.PerWeight
+	}
+	return nil
+}
+
+func (m *RewardIndex) GetPerWeightDay() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.PerWeightDay
+	}
+	return nil
+}
+
+// RewardWeight defines the sums the reward weight of a set of locks is computed
+// from on any day: unlock_days - amount * day + rolling.
+type RewardWeight struct {
+	// amount is the sum of the amounts of the dated locks.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// unlock_days is the sum of the amount of every dated lock times its unlock
+	// day, counted in days since the Unix epoch.
+	UnlockDays cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=unlock_days,json=unlockDays,proto3,customtype=cosmossdk.io/math.Int" json:"unlock_days"`
+	// rolling is the sum of the amount of every rolling lock times its duration.
+	Rolling cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=rolling,proto3,customtype=cosmossdk.io/math.Int" json:"rolling"`
+}
+
+func (m *RewardWeight) Reset()         { *m = RewardWeight{} }
+func (m *RewardWeight) String() string { return proto.CompactTextString(m) }
+func (*RewardWeight) ProtoMessage()    {}
+func (*RewardWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_905dd13b32ada116, []int{2}
+}
+func (m *RewardWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardWeight.Merge(m, src)
+}
+func (m *RewardWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardWeight proto.InternalMessageInfo
+
+// OutstandingRewards defines the rewards allocated by past epochs that have not
+// been claimed yet, settled or not.
+type OutstandingRewards struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *OutstandingRewards) Reset()         { *m = OutstandingRewards{} }
+func (m *OutstandingRewards) String() string { return proto.CompactTextString(m) }
+func (*OutstandingRewards) ProtoMessage()    {}
+func (*OutstandingRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_905dd13b32ada116, []int{3}
+}
+func (m *OutstandingRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutstandingRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutstandingRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutstandingRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutstandingRewards.Merge(m, src)
+}
+func (m *OutstandingRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutstandingRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutstandingRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutstandingRewards proto.InternalMessageInfo
+
+func (m *OutstandingRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PendingRewards)(nil), "optio.lockup.PendingRewards")
+	proto.RegisterType((*RewardIndex)(nil), "optio.lockup.RewardIndex")
+	proto.RegisterType((*RewardWeight)(nil), "optio.lockup.RewardWeight")
+	proto.RegisterType((*OutstandingRewards)(nil), "optio.lockup.OutstandingRewards")
+}
+
+func init() { proto.RegisterFile("optio/lockup/rewards.proto", fileDescriptor_905dd13b32ada116) }
+
+var fileDescriptor_905dd13b32ada116 = []byte{
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x2d, 0x34, 0x74, 0x12, 0x0a, 0x2e, 0x0a, 0xdb, 0x20, 0x9b, 0x92, 0x53, 0xb0,
+	0x76, 0x86, 0x2a, 0x82, 0xe7, 0x34, 0x0a, 0x01, 0xb1, 0x92, 0x8b, 0xe0, 0x25, 0x4c, 0x76, 0x86,
+	0xcd, 0x98, 0xec, 0xbc, 0x65, 0x66, 0xd6, 0x34, 0xe0, 0x41, 0xfc, 0x04, 0x9e, 0xfc, 0x0c, 0xe2,
+	0xc9, 0x83, 0x1f, 0xa2, 0xc7, 0xe2, 0x49, 0x3c, 0x54, 0x49, 0x0e, 0xfa, 0x31, 0x64, 0x67, 0x26,
+	0x5a, 0xc1, 0x43, 0x7b, 0xe8, 0x65, 0x77, 0xdf, 0xbe, 0xfd, 0xbf, 0xff, 0x6f, 0xf6, 0xbd, 0x87,
+	0x5b, 0x50, 0x58, 0x09, 0x74, 0x06, 0xe9, 0xb4, 0x2c, 0xa8, 0x16, 0x73, 0xa6, 0xb9, 0x21, 0x85,
+	0x06, 0x0b, 0x51, 0xd3, 0xe5, 0x88, 0xcf, 0xb5, 0x6e, 0xb0, 0x5c, 0x2a, 0xa0, 0xee, 0xea, 0x3f,
+	0x68, 0xed, 0xa6, 0x60, 0x72, 0x30, 0x23, 0x17, 0x51, 0x1f, 0x84, 0x54, 0xe2, 0x23, 0x3a, 0x66,
+	0x46, 0xd0, 0x57, 0x87, 0x63, 0x61, 0xd9, 0x21, 0x4d, 0x41, 0xaa, 0x90, 0xbf, 0x99, 0x41, 0x06,
+	0x5e, 0x57, 0x3d, 0xf9, 0xb7, 0x9d, 0xf7, 0x08, 0xef, 0x3c, 0x13, 0x8a, 0x4b, 0x95, 0x0d, 0x3d,
+	0x4a, 0x14, 0xe3, 0x3a, 0xe3, 0x5c, 0x0b, 0x63, 0x62, 0xb4, 0x87, 0xba, 0xdb, 0xc3, 0x75, 0x18,
+	0xbd, 0xc4, 0xf5, 0xc0, 0x1b, 0x6f, 0xec, 0x6d, 0x76, 0x1b, 0xf7, 0x76, 0x49, 0x40, 0xa8, 0x4c,
+	0x49, 0x30, 0x25, 0x47, 0x20, 0x55, 0xef, 0xc1, 0xe9, 0x79, 0xbb, 0xf6, 0xf1, 0x7b, 0xbb, 0x9b,
+	0x49, 0x3b, 0x29, 0xc7, 0x24, 0x85, 0x3c, 0xf0, 0x86, 0xdb, 0x81, 0xe1, 0x53, 0x6a, 0x17, 0x85,
+	0x30, 0x4e, 0x60, 0x3e, 0xfc, 0xfc, 0x74, 0x07, 0x0d, 0xd7, 0x06, 0x9d, 0xb7, 0x1b, 0xb8, 0xe1,
+	0x89, 0x06, 0x8a, 0x8b, 0x93, 0xa8, 0xc4, 0xb8, 0x10, 0x7a, 0x34, 0x17, 0x32, 0x9b, 0xd8, 0x18,
+	0x39, 0xfb, 0xdb, 0xff, 0xb5, 0xef, 0x8b, 0xd4, 0x11, 0x3c, 0x0c, 0x04, 0xfb, 0x97, 0x20, 0x08,
+	0x9a, 0x00, 0xb1, 0x5d, 0x08, 0xfd, 0xdc, 0x19, 0x45, 0xaf, 0xf1, 0xce, 0x5f, 0xdb, 0x11, 0x67,
+	0x8b, 0x70, 0xf2, 0xeb, 0xb2, 0x6e, 0xfe, 0xb1, 0xee, 0xb3, 0x45, 0xe7, 0x17, 0xc2, 0x4d, 0xff,
+	0x13, 0x02, 0xce, 0x11, 0xde, 0x62, 0x39, 0x94, 0xca, 0xfa, 0xd6, 0xf4, 0xf6, 0x2b, 0xa3, 0x6f,
+	0xe7, 0xed, 0x5b, 0xbe, 0xac, 0xe1, 0x53, 0x22, 0x81, 0xe6, 0xcc, 0x4e, 0xc8, 0x40, 0xd9, 0x2f,
+	0x9f, 0x0f, 0x70, 0xc0, 0x1c, 0x28, 0x3b, 0x0c, 0xd2, 0xe8, 0x09, 0x6e, 0x94, 0xaa, 0x9a, 0xb1,
+	0xea, 0x3c, 0x55, 0x2b, 0xaf, 0x5c, 0x09, 0x7b, 0x7d, 0x9f, 0x2d, 0x4c, 0xf4, 0x08, 0xd7, 0x35,
+	0xcc, 0x66, 0x52, 0x65, 0xf1, 0xe6, 0xd5, 0x2b, 0xad, 0xb5, 0x9d, 0x37, 0x08, 0x47, 0xc7, 0xa5,
+	0x35, 0x96, 0xfd, 0x33, 0x8c, 0x17, 0x46, 0x0e, 0x5d, 0xf3, 0xc8, 0xf5, 0x1e, 0x9f, 0x2e, 0x13,
+	0x74, 0xb6, 0x4c, 0xd0, 0x8f, 0x65, 0x82, 0xde, 0xad, 0x92, 0xda, 0xd9, 0x2a, 0xa9, 0x7d, 0x5d,
+	0x25, 0xb5, 0x17, 0x77, 0x2f, 0x54, 0x3c, 0xae, 0x56, 0xf4, 0xa9, 0xb0, 0x73, 0xd0, 0x53, 0xea,
+	0x77, 0xf9, 0x64, 0xbd, 0xcd, 0xae, 0xf6, 0x78, 0xcb, 0xad, 0xd6, 0xfd, 0xdf, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0xf3, 0x0b, 0xa5, 0xc3, 0xea, 0x03, 0x00, 0x00,
+}
+
+func (m *PendingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PerWeightDay) > 0 {
+		for iNdEx := len(m.PerWeightDay) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerWeightDay[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PerWeight) > 0 {
+		for iNdEx := len(m.PerWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rolling.Size()
+		i -= size
+		if _, err := m.Rolling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.UnlockDays.Size()
+		i -= size
+		if _, err := m.UnlockDays.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OutstandingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewards(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewards(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PerWeight) > 0 {
+		for _, e := range m.PerWeight {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.PerWeightDay) > 0 {
+		for _, e := range m.PerWeightDay {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	l = m.UnlockDays.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	l = m.Rolling.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *OutstandingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRewards(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewards(x uint64) (n int) {
+	return sovRewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerWeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerWeight = append(m.PerWeight, types.DecCoin{})
+			if err := m.PerWeight[len(m.PerWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerWeightDay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerWeightDay = append(m.PerWeightDay, types.DecCoin{})
+			if err := m.PerWeightDay[len(m.PerWeightDay)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDays", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnlockDays.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rolling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rolling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutstandingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutstandingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewards(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewards
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewards
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewards
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewards        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewards          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewards = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -32,17 +34,119 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the module parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{0}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{1}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 type MsgLock struct {
-	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	UnlockDate string     `protobuf:"bytes,2,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
-	Amount     types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// unlock_date is a date or an RFC3339 timestamp in UTC, as in Lock. Auto-renewing locks take a date.
+	UnlockDate string `protobuf:"bytes,2,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	// amount is either of the bond denom, which must be covered by delegations unless escrow is set, or of another
+	// allowed denom, which is always escrowed.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// auto_renew keeps the lock at the same distance from the current block day until it is turned off.
+	AutoRenew bool `protobuf:"varint,4,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// escrow moves amount into the lockup module account until the lock expires instead of requiring delegations.
+	// Escrowed locks cannot auto-renew.
+	Escrow bool `protobuf:"varint,5,opt,name=escrow,proto3" json:"escrow,omitempty"`
 }
 
 func (m *MsgLock) Reset()         { *m = MsgLock{} }
 func (m *MsgLock) String() string { return proto.CompactTextString(m) }
 func (*MsgLock) ProtoMessage()    {}
 func (*MsgLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6000163095da8e34, []int{0}
+	return fileDescriptor_6000163095da8e34, []int{2}
 }
 func (m *MsgLock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -92,6 +196,20 @@ func (m *MsgLock) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgLock) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
+}
+
+func (m *MsgLock) GetEscrow() bool {
+	if m != nil {
+		return m.Escrow
+	}
+	return false
+}
+
 type MsgLockResponse struct {
 }
 
@@ -99,7 +217,7 @@ func (m *MsgLockResponse) Reset()         { *m = MsgLockResponse{} }
 func (m *MsgLockResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLockResponse) ProtoMessage()    {}
 func (*MsgLockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6000163095da8e34, []int{1}
+	return fileDescriptor_6000163095da8e34, []int{3}
 }
 func (m *MsgLockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,7 +255,7 @@ func (m *MsgExtend) Reset()         { *m = MsgExtend{} }
 func (m *MsgExtend) String() string { return proto.CompactTextString(m) }
 func (*MsgExtend) ProtoMessage()    {}
 func (*MsgExtend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6000163095da8e34, []int{2}
+	return fileDescriptor_6000163095da8e34, []int{4}
 }
 func (m *MsgExtend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,7 +305,7 @@ func (m *MsgExtendResponse) Reset()         { *m = MsgExtendResponse{} }
 func (m *MsgExtendResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExtendResponse) ProtoMessage()    {}
 func (*MsgExtendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6000163095da8e34, []int{3}
+	return fileDescriptor_6000163095da8e34, []int{5}
 }
 func (m *MsgExtendResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -217,8 +335,10 @@ func (m *MsgExtendResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgExtendResponse proto.InternalMessageInfo
 
 type MsgSendDelegateAndLock struct {
-	FromAddress      string     `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
-	ToAddress        string     `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// validator_address is the validator to delegate to. It must be empty for denoms other than the bond denom, which
+	// are escrowed instead of delegated.
 	ValidatorAddress string     `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	UnlockDate       string     `protobuf:"bytes,4,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
 	Amount           types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
@@ -228,7 +348,7 @@ func (m *MsgSendDelegateAndLock) Reset()         { *m = MsgSendDelegateAndLock{}
 func (m *MsgSendDelegateAndLock) String() string { return proto.CompactTextString(m) }
 func (*MsgSendDelegateAndLock) ProtoMessage()    {}
 func (*MsgSendDelegateAndLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6000163095da8e34, []int{4}
+	return fileDescriptor_6000163095da8e34, []int{6}
 }
 func (m *MsgSendDelegateAndLock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,7 +419,7 @@ func (m *MsgSendDelegateAndLockResponse) Reset()         { *m = MsgSendDelegateA
 func (m *MsgSendDelegateAndLockResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendDelegateAndLockResponse) ProtoMessage()    {}
 func (*MsgSendDelegateAndLockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6000163095da8e34, []int{5}
+	return fileDescriptor_6000163095da8e34, []int{7}
 }
 func (m *MsgSendDelegateAndLockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -338,7 +458,7 @@ func (m *MsgMultiSendDelegateAndLock) Reset()         { *m = MsgMultiSendDelegat
 func (m *MsgMultiSendDelegateAndLock) String() string { return proto.CompactTextString(m) }
 func (*MsgMultiSendDelegateAndLock) ProtoMessage()    {}
 func (*MsgMultiSendDelegateAndLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6000163095da8e34, []int{6}
+	return fileDescriptor_6000163095da8e34, []int{8}
 }
 func (m *MsgMultiSendDelegateAndLock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,13 +509,15 @@ func (m *MsgMultiSendDelegateAndLock) GetOutputs() []*MultiSendDelegateAndLockOu
 }
 
 type MsgMultiSendDelegateAndLockResponse struct {
+	// results holds the lock created for each output, in output order.
+	Results []MultiSendDelegateAndLockResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
 func (m *MsgMultiSendDelegateAndLockResponse) Reset()         { *m = MsgMultiSendDelegateAndLockResponse{} }
 func (m *MsgMultiSendDelegateAndLockResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMultiSendDelegateAndLockResponse) ProtoMessage()    {}
 func (*MsgMultiSendDelegateAndLockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6000163095da8e34, []int{7}
+	return fileDescriptor_6000163095da8e34, []int{9}
 }
 func (m *MsgMultiSendDelegateAndLockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,697 +546,5644 @@ func (m *MsgMultiSendDelegateAndLockResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMultiSendDelegateAndLockResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgLock)(nil), "optio.lockup.MsgLock")
-	proto.RegisterType((*MsgLockResponse)(nil), "optio.lockup.MsgLockResponse")
-	proto.RegisterType((*MsgExtend)(nil), "optio.lockup.MsgExtend")
-	proto.RegisterType((*MsgExtendResponse)(nil), "optio.lockup.MsgExtendResponse")
-	proto.RegisterType((*MsgSendDelegateAndLock)(nil), "optio.lockup.MsgSendDelegateAndLock")
-	proto.RegisterType((*MsgSendDelegateAndLockResponse)(nil), "optio.lockup.MsgSendDelegateAndLockResponse")
-	proto.RegisterType((*MsgMultiSendDelegateAndLock)(nil), "optio.lockup.MsgMultiSendDelegateAndLock")
-	proto.RegisterType((*MsgMultiSendDelegateAndLockResponse)(nil), "optio.lockup.MsgMultiSendDelegateAndLockResponse")
+func (m *MsgMultiSendDelegateAndLockResponse) GetResults() []MultiSendDelegateAndLockResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("optio/lockup/tx.proto", fileDescriptor_6000163095da8e34) }
-
-var fileDescriptor_6000163095da8e34 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0x8e, 0x93, 0xfe, 0x51, 0x27, 0x91, 0x7e, 0xbf, 0xb8, 0x94, 0xba, 0x86, 0xba, 0x21, 0x80,
-	0x54, 0x4a, 0x65, 0x2b, 0xe5, 0x50, 0x09, 0x71, 0x49, 0x68, 0x7b, 0xc2, 0x54, 0x0a, 0x37, 0x2e,
-	0x91, 0x13, 0x2f, 0xc6, 0x8a, 0xed, 0xb1, 0xb2, 0xeb, 0x10, 0x6e, 0x88, 0x2b, 0x17, 0x1e, 0xa5,
-	0x8f, 0xd1, 0x63, 0x8f, 0x9c, 0x00, 0x25, 0x87, 0x3e, 0x00, 0x3c, 0x00, 0xb2, 0x77, 0x6d, 0x35,
-	0x8d, 0x13, 0x05, 0x2e, 0x89, 0x77, 0xbe, 0x6f, 0x66, 0xbe, 0x99, 0x6f, 0xb5, 0xb0, 0x85, 0x21,
-	0x73, 0xd1, 0xf0, 0xb0, 0xd7, 0x8f, 0x42, 0x83, 0x8d, 0xf4, 0x70, 0x80, 0x0c, 0xe5, 0x4a, 0x12,
-	0xd6, 0x79, 0x58, 0xad, 0x5a, 0xbe, 0x1b, 0xa0, 0x91, 0xfc, 0x72, 0x82, 0xba, 0xdd, 0x43, 0xea,
-	0x23, 0x35, 0x7c, 0xea, 0x18, 0xc3, 0x46, 0xfc, 0x27, 0x00, 0x4d, 0x00, 0x5d, 0x8b, 0x12, 0x63,
-	0xd8, 0xe8, 0x12, 0x66, 0x35, 0x8c, 0x1e, 0xba, 0x81, 0xc0, 0x77, 0x38, 0xde, 0x49, 0x4e, 0x06,
-	0x3f, 0x08, 0xe8, 0x8e, 0x83, 0x0e, 0xf2, 0x78, 0xfc, 0x25, 0xa2, 0xf7, 0xa7, 0x14, 0x92, 0x11,
-	0x23, 0x01, 0x75, 0x31, 0x2b, 0x37, 0x85, 0x62, 0xc4, 0xc2, 0x88, 0x71, 0xa8, 0xfe, 0x45, 0x82,
-	0x75, 0x93, 0x3a, 0xaf, 0xb0, 0xd7, 0x97, 0x15, 0x58, 0xb7, 0x6c, 0x7b, 0x40, 0x28, 0x55, 0xa4,
-	0x9a, 0xb4, 0xbf, 0xd1, 0x4e, 0x8f, 0xf2, 0x1e, 0x94, 0xa3, 0x20, 0x4e, 0xef, 0xd8, 0x16, 0x23,
-	0x4a, 0x31, 0x41, 0x81, 0x87, 0x4e, 0x2c, 0x46, 0xe4, 0x63, 0x58, 0xb3, 0x7c, 0x8c, 0x02, 0xa6,
-	0x94, 0x6a, 0xd2, 0x7e, 0xf9, 0x68, 0x47, 0x17, 0xa2, 0xe3, 0x09, 0x75, 0x31, 0xa1, 0xfe, 0x12,
-	0xdd, 0xa0, 0xb5, 0x72, 0xf9, 0x7d, 0xaf, 0xd0, 0x16, 0xf4, 0xe7, 0x95, 0xcf, 0xd7, 0x17, 0x07,
-	0x69, 0x9f, 0x7a, 0x15, 0xfe, 0x13, 0x62, 0xda, 0x84, 0x86, 0x18, 0x50, 0x52, 0xf7, 0x60, 0xc3,
-	0xa4, 0xce, 0x69, 0x3c, 0x91, 0xbd, 0x40, 0xe1, 0x31, 0x40, 0x36, 0x35, 0x55, 0x8a, 0xb5, 0xd2,
-	0x7e, 0xf9, 0x68, 0x5b, 0xbf, 0x69, 0x90, 0x7e, 0x9a, 0xe2, 0xed, 0x1b, 0xd4, 0x5b, 0x02, 0x36,
-	0xa1, 0x9a, 0x75, 0xcb, 0x24, 0xfc, 0x96, 0xe0, 0xae, 0x49, 0x9d, 0x37, 0x24, 0xb0, 0x4f, 0x88,
-	0x47, 0x1c, 0x8b, 0x91, 0x66, 0x60, 0x27, 0x2b, 0x7b, 0x00, 0x95, 0x77, 0x03, 0xf4, 0x3b, 0xd3,
-	0xaa, 0xca, 0x71, 0xac, 0x29, 0x94, 0xed, 0x02, 0x30, 0xcc, 0x08, 0x7c, 0x75, 0x1b, 0x0c, 0x53,
-	0xf8, 0x29, 0x54, 0x87, 0x96, 0xe7, 0xda, 0x16, 0xc3, 0x41, 0xc6, 0x2a, 0x25, 0xac, 0xff, 0x33,
-	0xa0, 0x99, 0xef, 0xc3, 0xca, 0x02, 0x1f, 0x56, 0xff, 0xce, 0x87, 0x6a, 0xbc, 0x86, 0xa9, 0x59,
-	0xea, 0x35, 0xd0, 0xf2, 0xa7, 0xce, 0x16, 0xf3, 0x43, 0x82, 0x7b, 0x26, 0x75, 0xcc, 0xc8, 0x63,
-	0xee, 0x3f, 0x6e, 0xa7, 0x05, 0x15, 0x86, 0xcc, 0xf2, 0x3a, 0x42, 0x76, 0x71, 0x39, 0xd9, 0xe5,
-	0x24, 0xa9, 0x99, 0xe4, 0xc8, 0x67, 0xb0, 0xce, 0xef, 0x74, 0xbc, 0xb8, 0xd8, 0xf8, 0xc3, 0x69,
-	0xe3, 0xe7, 0xe9, 0x3b, 0x4f, 0x92, 0xda, 0x69, 0x72, 0xde, 0x0e, 0x1e, 0xc3, 0xc3, 0x05, 0x03,
-	0xa6, 0x8b, 0x38, 0xfa, 0x55, 0x84, 0x92, 0x49, 0x1d, 0xf9, 0x05, 0xac, 0x24, 0x83, 0x6f, 0xdd,
-	0x12, 0xc0, 0xef, 0xb4, 0xba, 0x9b, 0x1b, 0x4e, 0xab, 0xc8, 0x2d, 0x58, 0x13, 0xf7, 0x7c, 0x7b,
-	0x86, 0xc8, 0x01, 0x75, 0x6f, 0x0e, 0x90, 0xd5, 0x70, 0x61, 0x33, 0xcf, 0x89, 0x47, 0x33, 0x79,
-	0x39, 0x2c, 0xf5, 0x70, 0x19, 0x56, 0xd6, 0x6a, 0x04, 0xca, 0x5c, 0xe7, 0x9f, 0xcc, 0x54, 0x9a,
-	0x47, 0x55, 0x1b, 0x4b, 0x53, 0xd3, 0xce, 0xea, 0xea, 0xa7, 0xeb, 0x8b, 0x03, 0xa9, 0x75, 0x76,
-	0x39, 0xd6, 0xa4, 0xab, 0xb1, 0x26, 0xfd, 0x1c, 0x6b, 0xd2, 0xd7, 0x89, 0x56, 0xb8, 0x9a, 0x68,
-	0x85, 0x6f, 0x13, 0xad, 0xf0, 0xf6, 0xd0, 0x71, 0xd9, 0xfb, 0xa8, 0xab, 0xf7, 0xd0, 0x37, 0xce,
-	0xe3, 0xea, 0xaf, 0x09, 0xfb, 0x80, 0x83, 0xbe, 0xc1, 0x1f, 0xc2, 0x51, 0xf6, 0x94, 0x7f, 0x0c,
-	0x09, 0xed, 0xae, 0x25, 0x4f, 0xe1, 0xb3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xca, 0x15, 0x60,
-	0x1e, 0xe7, 0x05, 0x00, 0x00,
+type MsgEarlyUnlock struct {
+	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	UnlockDate string     `protobuf:"bytes,2,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	Amount     types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Lock locks tokens for a specific address.
-	Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error)
-	// Extend extends the lock duration for a specific address.
-	Extend(ctx context.Context, in *MsgExtend, opts ...grpc.CallOption) (*MsgExtendResponse, error)
-	// SendDelegateAndLock sends tokens to a specific address, delegates them to a validator, and locks them until a specified unlock date.
-	SendDelegateAndLock(ctx context.Context, in *MsgSendDelegateAndLock, opts ...grpc.CallOption) (*MsgSendDelegateAndLockResponse, error)
-	// MultiSendDelegateAndLock sends tokens to multiple addresses, delegates them to a validator, and locks them until specified unlock dates.
-	MultiSendDelegateAndLock(ctx context.Context, in *MsgMultiSendDelegateAndLock, opts ...grpc.CallOption) (*MsgMultiSendDelegateAndLockResponse, error)
+func (m *MsgEarlyUnlock) Reset()         { *m = MsgEarlyUnlock{} }
+func (m *MsgEarlyUnlock) String() string { return proto.CompactTextString(m) }
+func (*MsgEarlyUnlock) ProtoMessage()    {}
+func (*MsgEarlyUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{10}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgEarlyUnlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgEarlyUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEarlyUnlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEarlyUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEarlyUnlock.Merge(m, src)
+}
+func (m *MsgEarlyUnlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEarlyUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEarlyUnlock.DiscardUnknown(m)
 }
 
-func (c *msgClient) Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error) {
-	out := new(MsgLockResponse)
-	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/Lock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgEarlyUnlock proto.InternalMessageInfo
+
+func (m *MsgEarlyUnlock) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Extend(ctx context.Context, in *MsgExtend, opts ...grpc.CallOption) (*MsgExtendResponse, error) {
-	out := new(MsgExtendResponse)
-	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/Extend", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEarlyUnlock) GetUnlockDate() string {
+	if m != nil {
+		return m.UnlockDate
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SendDelegateAndLock(ctx context.Context, in *MsgSendDelegateAndLock, opts ...grpc.CallOption) (*MsgSendDelegateAndLockResponse, error) {
-	out := new(MsgSendDelegateAndLockResponse)
-	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/SendDelegateAndLock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEarlyUnlock) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) MultiSendDelegateAndLock(ctx context.Context, in *MsgMultiSendDelegateAndLock, opts ...grpc.CallOption) (*MsgMultiSendDelegateAndLockResponse, error) {
-	out := new(MsgMultiSendDelegateAndLockResponse)
-	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/MultiSendDelegateAndLock", in, out, opts...)
-	if err != nil {
-		return nil, err
+type MsgEarlyUnlockResponse struct {
+	Penalty types.Coin `protobuf:"bytes,1,opt,name=penalty,proto3" json:"penalty"`
+}
+
+func (m *MsgEarlyUnlockResponse) Reset()         { *m = MsgEarlyUnlockResponse{} }
+func (m *MsgEarlyUnlockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEarlyUnlockResponse) ProtoMessage()    {}
+func (*MsgEarlyUnlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{11}
+}
+func (m *MsgEarlyUnlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEarlyUnlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEarlyUnlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgEarlyUnlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEarlyUnlockResponse.Merge(m, src)
+}
+func (m *MsgEarlyUnlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEarlyUnlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEarlyUnlockResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Lock locks tokens for a specific address.
-	Lock(context.Context, *MsgLock) (*MsgLockResponse, error)
-	// Extend extends the lock duration for a specific address.
-	Extend(context.Context, *MsgExtend) (*MsgExtendResponse, error)
-	// SendDelegateAndLock sends tokens to a specific address, delegates them to a validator, and locks them until a specified unlock date.
-	SendDelegateAndLock(context.Context, *MsgSendDelegateAndLock) (*MsgSendDelegateAndLockResponse, error)
-	// MultiSendDelegateAndLock sends tokens to multiple addresses, delegates them to a validator, and locks them until specified unlock dates.
-	MultiSendDelegateAndLock(context.Context, *MsgMultiSendDelegateAndLock) (*MsgMultiSendDelegateAndLockResponse, error)
+var xxx_messageInfo_MsgEarlyUnlockResponse proto.InternalMessageInfo
+
+func (m *MsgEarlyUnlockResponse) GetPenalty() types.Coin {
+	if m != nil {
+		return m.Penalty
+	}
+	return types.Coin{}
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgTransferLock struct {
+	FromAddress      string     `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress        string     `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	ValidatorAddress string     `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	UnlockDate       string     `protobuf:"bytes,4,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	Amount           types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
 }
 
-func (*UnimplementedMsgServer) Lock(ctx context.Context, req *MsgLock) (*MsgLockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+func (m *MsgTransferLock) Reset()         { *m = MsgTransferLock{} }
+func (m *MsgTransferLock) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLock) ProtoMessage()    {}
+func (*MsgTransferLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{12}
 }
-func (*UnimplementedMsgServer) Extend(ctx context.Context, req *MsgExtend) (*MsgExtendResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Extend not implemented")
+func (m *MsgTransferLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SendDelegateAndLock(ctx context.Context, req *MsgSendDelegateAndLock) (*MsgSendDelegateAndLockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendDelegateAndLock not implemented")
+func (m *MsgTransferLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) MultiSendDelegateAndLock(ctx context.Context, req *MsgMultiSendDelegateAndLock) (*MsgMultiSendDelegateAndLockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiSendDelegateAndLock not implemented")
+func (m *MsgTransferLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLock.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgTransferLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLock.DiscardUnknown(m)
 }
 
-func _Msg_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLock)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Lock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/optio.lockup.Msg/Lock",
+var xxx_messageInfo_MsgTransferLock proto.InternalMessageInfo
+
+func (m *MsgTransferLock) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Lock(ctx, req.(*MsgLock))
+	return ""
+}
+
+func (m *MsgTransferLock) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Extend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExtend)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Extend(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/optio.lockup.Msg/Extend",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Extend(ctx, req.(*MsgExtend))
+func (m *MsgTransferLock) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SendDelegateAndLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendDelegateAndLock)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendDelegateAndLock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/optio.lockup.Msg/SendDelegateAndLock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendDelegateAndLock(ctx, req.(*MsgSendDelegateAndLock))
+func (m *MsgTransferLock) GetUnlockDate() string {
+	if m != nil {
+		return m.UnlockDate
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_MultiSendDelegateAndLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMultiSendDelegateAndLock)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MultiSendDelegateAndLock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/optio.lockup.Msg/MultiSendDelegateAndLock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MultiSendDelegateAndLock(ctx, req.(*MsgMultiSendDelegateAndLock))
+func (m *MsgTransferLock) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "optio.lockup.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Lock",
-			Handler:    _Msg_Lock_Handler,
-		},
-		{
-			MethodName: "Extend",
-			Handler:    _Msg_Extend_Handler,
-		},
-		{
-			MethodName: "SendDelegateAndLock",
-			Handler:    _Msg_SendDelegateAndLock_Handler,
-		},
-		{
-			MethodName: "MultiSendDelegateAndLock",
-			Handler:    _Msg_MultiSendDelegateAndLock_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "optio/lockup/tx.proto",
+type MsgTransferLockResponse struct {
 }
 
-func (m *MsgLock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferLockResponse) Reset()         { *m = MsgTransferLockResponse{} }
+func (m *MsgTransferLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLockResponse) ProtoMessage()    {}
+func (*MsgTransferLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{13}
+}
+func (m *MsgTransferLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgTransferLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLockResponse.Merge(m, src)
+}
+func (m *MsgTransferLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLockResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTransferLockResponse proto.InternalMessageInfo
+
+type MsgSetAutoRenew struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// unlock_date is the current unlock date of the lock. For an auto-renewing lock this is the block day plus its duration.
+	UnlockDate string `protobuf:"bytes,2,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	AutoRenew  bool   `protobuf:"varint,3,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
 }
 
-func (m *MsgLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetAutoRenew) Reset()         { *m = MsgSetAutoRenew{} }
+func (m *MsgSetAutoRenew) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRenew) ProtoMessage()    {}
+func (*MsgSetAutoRenew) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{14}
+}
+func (m *MsgSetAutoRenew) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRenew) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRenew.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.UnlockDate) > 0 {
-		i -= len(m.UnlockDate)
-		copy(dAtA[i:], m.UnlockDate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UnlockDate)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetAutoRenew) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRenew.Merge(m, src)
+}
+func (m *MsgSetAutoRenew) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRenew) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRenew.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRenew proto.InternalMessageInfo
+
+func (m *MsgSetAutoRenew) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgLockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoRenew) GetUnlockDate() string {
+	if m != nil {
+		return m.UnlockDate
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgLockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetAutoRenew) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
 }
 
-func (m *MsgLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetAutoRenewResponse struct {
 }
 
-func (m *MsgExtend) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoRenewResponse) Reset()         { *m = MsgSetAutoRenewResponse{} }
+func (m *MsgSetAutoRenewResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRenewResponse) ProtoMessage()    {}
+func (*MsgSetAutoRenewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{15}
+}
+func (m *MsgSetAutoRenewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRenewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRenewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetAutoRenewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRenewResponse.Merge(m, src)
+}
+func (m *MsgSetAutoRenewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRenewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRenewResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExtend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetAutoRenewResponse proto.InternalMessageInfo
+
+type MsgFundRewardsPool struct {
+	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *MsgExtend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Extensions) > 0 {
-		for iNdEx := len(m.Extensions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Extensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgFundRewardsPool) Reset()         { *m = MsgFundRewardsPool{} }
+func (m *MsgFundRewardsPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardsPool) ProtoMessage()    {}
+func (*MsgFundRewardsPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{16}
+}
+func (m *MsgFundRewardsPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardsPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardsPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgFundRewardsPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardsPool.Merge(m, src)
+}
+func (m *MsgFundRewardsPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardsPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardsPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundRewardsPool proto.InternalMessageInfo
+
+func (m *MsgFundRewardsPool) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgExtendResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFundRewardsPool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgExtendResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgFundRewardsPoolResponse struct {
 }
 
-func (m *MsgExtendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgFundRewardsPoolResponse) Reset()         { *m = MsgFundRewardsPoolResponse{} }
+func (m *MsgFundRewardsPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardsPoolResponse) ProtoMessage()    {}
+func (*MsgFundRewardsPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{17}
 }
-
-func (m *MsgSendDelegateAndLock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFundRewardsPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardsPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardsPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgFundRewardsPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardsPoolResponse.Merge(m, src)
+}
+func (m *MsgFundRewardsPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardsPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardsPoolResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSendDelegateAndLock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgFundRewardsPoolResponse proto.InternalMessageInfo
+
+type MsgClaimRewards struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *MsgSendDelegateAndLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
+func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewards) ProtoMessage()    {}
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{18}
+}
+func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.UnlockDate) > 0 {
-		i -= len(m.UnlockDate)
-		copy(dAtA[i:], m.UnlockDate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UnlockDate)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClaimRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewards.Merge(m, src)
+}
+func (m *MsgClaimRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewards.DiscardUnknown(m)
 }
 
-func (m *MsgSendDelegateAndLockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimRewards proto.InternalMessageInfo
+
+func (m *MsgClaimRewards) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSendDelegateAndLockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgClaimRewardsResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func (m *MsgSendDelegateAndLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse{} }
+func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{19}
+}
+func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiSendDelegateAndLock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgMultiSendDelegateAndLock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgTokenizeLock struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	UnlockDate string `protobuf:"bytes,2,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
 }
 
-func (m *MsgMultiSendDelegateAndLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Outputs) > 0 {
-		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size, err := m.TotalAmount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgTokenizeLock) Reset()         { *m = MsgTokenizeLock{} }
+func (m *MsgTokenizeLock) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeLock) ProtoMessage()    {}
+func (*MsgTokenizeLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{20}
+}
+func (m *MsgTokenizeLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenizeLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenizeLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTokenizeLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeLock.Merge(m, src)
+}
+func (m *MsgTokenizeLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenizeLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeLock.DiscardUnknown(m)
 }
 
-func (m *MsgMultiSendDelegateAndLockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTokenizeLock proto.InternalMessageInfo
+
+func (m *MsgTokenizeLock) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMultiSendDelegateAndLockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTokenizeLock) GetUnlockDate() string {
+	if m != nil {
+		return m.UnlockDate
+	}
+	return ""
 }
 
-func (m *MsgMultiSendDelegateAndLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgTokenizeLockResponse struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftId   string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgTokenizeLockResponse) Reset()         { *m = MsgTokenizeLockResponse{} }
+func (m *MsgTokenizeLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeLockResponse) ProtoMessage()    {}
+func (*MsgTokenizeLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{21}
 }
-func (m *MsgLock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.UnlockDate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTokenizeLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenizeLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenizeLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgTokenizeLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeLockResponse.Merge(m, src)
+}
+func (m *MsgTokenizeLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenizeLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeLockResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgTokenizeLockResponse proto.InternalMessageInfo
+
+func (m *MsgTokenizeLockResponse) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgExtend) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTokenizeLockResponse) GetNftId() string {
+	if m != nil {
+		return m.NftId
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+type MsgPruneExpiredLocks struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// addresses are the addresses to remove expired locks from, at most MaxPruneAddresses of them.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *MsgPruneExpiredLocks) Reset()         { *m = MsgPruneExpiredLocks{} }
+func (m *MsgPruneExpiredLocks) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneExpiredLocks) ProtoMessage()    {}
+func (*MsgPruneExpiredLocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{22}
+}
+func (m *MsgPruneExpiredLocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneExpiredLocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneExpiredLocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Extensions) > 0 {
-		for _, e := range m.Extensions {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgPruneExpiredLocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneExpiredLocks.Merge(m, src)
+}
+func (m *MsgPruneExpiredLocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneExpiredLocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneExpiredLocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneExpiredLocks proto.InternalMessageInfo
+
+func (m *MsgPruneExpiredLocks) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgPruneExpiredLocks) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type MsgPruneExpiredLocksResponse struct {
+}
+
+func (m *MsgPruneExpiredLocksResponse) Reset()         { *m = MsgPruneExpiredLocksResponse{} }
+func (m *MsgPruneExpiredLocksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneExpiredLocksResponse) ProtoMessage()    {}
+func (*MsgPruneExpiredLocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{23}
+}
+func (m *MsgPruneExpiredLocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneExpiredLocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneExpiredLocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgPruneExpiredLocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneExpiredLocksResponse.Merge(m, src)
+}
+func (m *MsgPruneExpiredLocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneExpiredLocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneExpiredLocksResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExtendResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgPruneExpiredLocksResponse proto.InternalMessageInfo
+
+type MsgLockSchedule struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// total_amount is split across the locks of the schedule. Each lock is subject to the same checks as MsgLock.
+	TotalAmount types.Coin   `protobuf:"bytes,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount"`
+	Schedule    LockSchedule `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule"`
+	// escrow escrows the locks of the bond denom, as in MsgLock.
+	Escrow bool `protobuf:"varint,4,opt,name=escrow,proto3" json:"escrow,omitempty"`
+}
+
+func (m *MsgLockSchedule) Reset()         { *m = MsgLockSchedule{} }
+func (m *MsgLockSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgLockSchedule) ProtoMessage()    {}
+func (*MsgLockSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{24}
+}
+func (m *MsgLockSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgLockSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockSchedule.Merge(m, src)
+}
+func (m *MsgLockSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockSchedule.DiscardUnknown(m)
 }
 
-func (m *MsgSendDelegateAndLock) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgLockSchedule proto.InternalMessageInfo
+
+func (m *MsgLockSchedule) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	var l int
-	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgLockSchedule) GetTotalAmount() types.Coin {
+	if m != nil {
+		return m.TotalAmount
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return types.Coin{}
+}
+
+func (m *MsgLockSchedule) GetSchedule() LockSchedule {
+	if m != nil {
+		return m.Schedule
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return LockSchedule{}
+}
+
+func (m *MsgLockSchedule) GetEscrow() bool {
+	if m != nil {
+		return m.Escrow
 	}
-	l = len(m.UnlockDate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return false
+}
+
+type MsgLockScheduleResponse struct {
+	// locks are the locks added by the schedule, in unlock date order.
+	Locks []Lock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+}
+
+func (m *MsgLockScheduleResponse) Reset()         { *m = MsgLockScheduleResponse{} }
+func (m *MsgLockScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockScheduleResponse) ProtoMessage()    {}
+func (*MsgLockScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{25}
+}
+func (m *MsgLockScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgLockScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockScheduleResponse.Merge(m, src)
+}
+func (m *MsgLockScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSendDelegateAndLockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgLockScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgLockScheduleResponse) GetLocks() []Lock {
+	if m != nil {
+		return m.Locks
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgMultiSendDelegateAndLock) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgSendDelegateAndLockSchedule struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// validator_address is the validator to delegate to, as in MsgSendDelegateAndLock.
+	ValidatorAddress string       `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	TotalAmount      types.Coin   `protobuf:"bytes,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount"`
+	Schedule         LockSchedule `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *MsgSendDelegateAndLockSchedule) Reset()         { *m = MsgSendDelegateAndLockSchedule{} }
+func (m *MsgSendDelegateAndLockSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgSendDelegateAndLockSchedule) ProtoMessage()    {}
+func (*MsgSendDelegateAndLockSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{26}
+}
+func (m *MsgSendDelegateAndLockSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendDelegateAndLockSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendDelegateAndLockSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSendDelegateAndLockSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendDelegateAndLockSchedule.Merge(m, src)
+}
+func (m *MsgSendDelegateAndLockSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendDelegateAndLockSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendDelegateAndLockSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendDelegateAndLockSchedule proto.InternalMessageInfo
+
+func (m *MsgSendDelegateAndLockSchedule) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	l = m.TotalAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Outputs) > 0 {
-		for _, e := range m.Outputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSendDelegateAndLockSchedule) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgSendDelegateAndLockSchedule) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSendDelegateAndLockSchedule) GetTotalAmount() types.Coin {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSendDelegateAndLockSchedule) GetSchedule() LockSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return LockSchedule{}
+}
+
+type MsgSendDelegateAndLockScheduleResponse struct {
+	// locks are the locks added to to_address by the schedule, in unlock date order.
+	Locks []Lock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+}
+
+func (m *MsgSendDelegateAndLockScheduleResponse) Reset() {
+	*m = MsgSendDelegateAndLockScheduleResponse{}
+}
+func (m *MsgSendDelegateAndLockScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendDelegateAndLockScheduleResponse) ProtoMessage()    {}
+func (*MsgSendDelegateAndLockScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{27}
+}
+func (m *MsgSendDelegateAndLockScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendDelegateAndLockScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendDelegateAndLockScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSendDelegateAndLockScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendDelegateAndLockScheduleResponse.Merge(m, src)
+}
+func (m *MsgSendDelegateAndLockScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendDelegateAndLockScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendDelegateAndLockScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiSendDelegateAndLockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSendDelegateAndLockScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgSendDelegateAndLockScheduleResponse) GetLocks() []Lock {
+	if m != nil {
+		return m.Locks
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// ValidatorWeight is a validator and the share of an amount delegated to it.
+type ValidatorWeight struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// weight is the share of the amount, above 0. The weights of a message sum to 1.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ValidatorWeight) Reset()         { *m = ValidatorWeight{} }
+func (m *ValidatorWeight) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWeight) ProtoMessage()    {}
+func (*ValidatorWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{28}
+}
+func (m *ValidatorWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWeight.Merge(m, src)
+}
+func (m *ValidatorWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorWeight proto.InternalMessageInfo
+
+func (m *ValidatorWeight) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+type MsgDelegateAndLock struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// validators split amount by weight, with any rounding remainder going to the last validator.
+	Validators []ValidatorWeight `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+	// unlock_date is a date or an RFC3339 timestamp in UTC, as in Lock.
+	UnlockDate string `protobuf:"bytes,3,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	// amount is of the bond denom.
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgDelegateAndLock) Reset()         { *m = MsgDelegateAndLock{} }
+func (m *MsgDelegateAndLock) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateAndLock) ProtoMessage()    {}
+func (*MsgDelegateAndLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{29}
+}
+func (m *MsgDelegateAndLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateAndLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateAndLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateAndLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateAndLock.Merge(m, src)
+}
+func (m *MsgDelegateAndLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateAndLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateAndLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateAndLock proto.InternalMessageInfo
+
+func (m *MsgDelegateAndLock) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgDelegateAndLock) GetValidators() []ValidatorWeight {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *MsgDelegateAndLock) GetUnlockDate() string {
+	if m != nil {
+		return m.UnlockDate
+	}
+	return ""
+}
+
+func (m *MsgDelegateAndLock) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+type MsgDelegateAndLockResponse struct {
+}
+
+func (m *MsgDelegateAndLockResponse) Reset()         { *m = MsgDelegateAndLockResponse{} }
+func (m *MsgDelegateAndLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateAndLockResponse) ProtoMessage()    {}
+func (*MsgDelegateAndLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6000163095da8e34, []int{30}
+}
+func (m *MsgDelegateAndLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateAndLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateAndLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateAndLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateAndLockResponse.Merge(m, src)
+}
+func (m *MsgDelegateAndLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateAndLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateAndLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateAndLockResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "optio.lockup.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "optio.lockup.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgLock)(nil), "optio.lockup.MsgLock")
+	proto.RegisterType((*MsgLockResponse)(nil), "optio.lockup.MsgLockResponse")
+	proto.RegisterType((*MsgExtend)(nil), "optio.lockup.MsgExtend")
+	proto.RegisterType((*MsgExtendResponse)(nil), "optio.lockup.MsgExtendResponse")
+	proto.RegisterType((*MsgSendDelegateAndLock)(nil), "optio.lockup.MsgSendDelegateAndLock")
+	proto.RegisterType((*MsgSendDelegateAndLockResponse)(nil), "optio.lockup.MsgSendDelegateAndLockResponse")
+	proto.RegisterType((*MsgMultiSendDelegateAndLock)(nil), "optio.lockup.MsgMultiSendDelegateAndLock")
+	proto.RegisterType((*MsgMultiSendDelegateAndLockResponse)(nil), "optio.lockup.MsgMultiSendDelegateAndLockResponse")
+	proto.RegisterType((*MsgEarlyUnlock)(nil), "optio.lockup.MsgEarlyUnlock")
+	proto.RegisterType((*MsgEarlyUnlockResponse)(nil), "optio.lockup.MsgEarlyUnlockResponse")
+	proto.RegisterType((*MsgTransferLock)(nil), "optio.lockup.MsgTransferLock")
+	proto.RegisterType((*MsgTransferLockResponse)(nil), "optio.lockup.MsgTransferLockResponse")
+	proto.RegisterType((*MsgSetAutoRenew)(nil), "optio.lockup.MsgSetAutoRenew")
+	proto.RegisterType((*MsgSetAutoRenewResponse)(nil), "optio.lockup.MsgSetAutoRenewResponse")
+	proto.RegisterType((*MsgFundRewardsPool)(nil), "optio.lockup.MsgFundRewardsPool")
+	proto.RegisterType((*MsgFundRewardsPoolResponse)(nil), "optio.lockup.MsgFundRewardsPoolResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "optio.lockup.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "optio.lockup.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgTokenizeLock)(nil), "optio.lockup.MsgTokenizeLock")
+	proto.RegisterType((*MsgTokenizeLockResponse)(nil), "optio.lockup.MsgTokenizeLockResponse")
+	proto.RegisterType((*MsgPruneExpiredLocks)(nil), "optio.lockup.MsgPruneExpiredLocks")
+	proto.RegisterType((*MsgPruneExpiredLocksResponse)(nil), "optio.lockup.MsgPruneExpiredLocksResponse")
+	proto.RegisterType((*MsgLockSchedule)(nil), "optio.lockup.MsgLockSchedule")
+	proto.RegisterType((*MsgLockScheduleResponse)(nil), "optio.lockup.MsgLockScheduleResponse")
+	proto.RegisterType((*MsgSendDelegateAndLockSchedule)(nil), "optio.lockup.MsgSendDelegateAndLockSchedule")
+	proto.RegisterType((*MsgSendDelegateAndLockScheduleResponse)(nil), "optio.lockup.MsgSendDelegateAndLockScheduleResponse")
+	proto.RegisterType((*ValidatorWeight)(nil), "optio.lockup.ValidatorWeight")
+	proto.RegisterType((*MsgDelegateAndLock)(nil), "optio.lockup.MsgDelegateAndLock")
+	proto.RegisterType((*MsgDelegateAndLockResponse)(nil), "optio.lockup.MsgDelegateAndLockResponse")
+}
+
+func init() { proto.RegisterFile("optio/lockup/tx.proto", fileDescriptor_6000163095da8e34) }
+
+var fileDescriptor_6000163095da8e34 = []byte{
+	// 1457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x6f, 0x1b, 0xd5,
+	0x17, 0xcf, 0x34, 0x4e, 0x52, 0x1f, 0x47, 0xed, 0x3f, 0xd3, 0xb4, 0x71, 0xa6, 0x89, 0x93, 0xff,
+	0x50, 0x90, 0x09, 0xad, 0xad, 0x14, 0x68, 0xd5, 0xa8, 0x9b, 0xb8, 0x69, 0xa5, 0x8a, 0xba, 0x2d,
+	0x4e, 0xcb, 0xa3, 0x12, 0x8a, 0x26, 0x9e, 0x9b, 0xc9, 0x90, 0xf1, 0x5c, 0x6b, 0xee, 0x9d, 0x26,
+	0x61, 0x05, 0x48, 0x6c, 0x58, 0x20, 0xc4, 0x67, 0x60, 0x81, 0x10, 0x8b, 0x2e, 0xca, 0x17, 0x60,
+	0xd5, 0x65, 0x55, 0x36, 0x08, 0xa4, 0x16, 0xb5, 0x8b, 0xae, 0x58, 0x20, 0xf1, 0x01, 0xd0, 0x7d,
+	0xcc, 0xcd, 0xbc, 0xec, 0x24, 0x4d, 0x05, 0x12, 0x9b, 0x4c, 0xe6, 0x9e, 0x73, 0xcf, 0xfd, 0x9d,
+	0xdf, 0x79, 0xdc, 0x33, 0x86, 0xe3, 0xb8, 0x4b, 0x5d, 0x5c, 0xf7, 0x70, 0x7b, 0x23, 0xec, 0xd6,
+	0xe9, 0x56, 0xad, 0x1b, 0x60, 0x8a, 0xf5, 0x51, 0xbe, 0x5c, 0x13, 0xcb, 0xc6, 0x98, 0xd5, 0x71,
+	0x7d, 0x5c, 0xe7, 0x7f, 0x85, 0x82, 0x31, 0xd1, 0xc6, 0xa4, 0x83, 0x49, 0xbd, 0x43, 0x9c, 0xfa,
+	0xdd, 0x79, 0xf6, 0x90, 0x82, 0x8a, 0x14, 0xac, 0x5a, 0x04, 0xd5, 0xef, 0xce, 0xaf, 0x22, 0x6a,
+	0xcd, 0xd7, 0xdb, 0xd8, 0xf5, 0xa5, 0x7c, 0x52, 0xc8, 0x57, 0xf8, 0x5b, 0x5d, 0xbc, 0x48, 0xd1,
+	0xb8, 0x83, 0x1d, 0x2c, 0xd6, 0xd9, 0x7f, 0x72, 0x75, 0x2a, 0x81, 0x10, 0x6d, 0x51, 0xe4, 0x13,
+	0x17, 0x47, 0xe6, 0x26, 0x12, 0x52, 0xf6, 0x88, 0xce, 0x49, 0x08, 0x70, 0x48, 0xbb, 0x21, 0xcd,
+	0x15, 0x75, 0xad, 0xc0, 0xea, 0x48, 0x08, 0xe6, 0x8f, 0x1a, 0x1c, 0x6d, 0x12, 0xe7, 0x76, 0xd7,
+	0xb6, 0x28, 0xba, 0xc9, 0x25, 0xfa, 0x39, 0x28, 0x5a, 0x21, 0x5d, 0xc7, 0x81, 0x4b, 0xb7, 0xcb,
+	0xda, 0xac, 0x56, 0x2d, 0x36, 0xca, 0x8f, 0xee, 0x9f, 0x19, 0x97, 0xd8, 0x17, 0x6d, 0x3b, 0x40,
+	0x84, 0x2c, 0xd3, 0xc0, 0xf5, 0x9d, 0xd6, 0x8e, 0xaa, 0x7e, 0x1e, 0x86, 0x85, 0xed, 0xf2, 0xa1,
+	0x59, 0xad, 0x5a, 0x3a, 0x3b, 0x5e, 0x8b, 0x93, 0x5a, 0x13, 0xd6, 0x1b, 0xc5, 0x07, 0x8f, 0x67,
+	0x06, 0xbe, 0x7b, 0x7e, 0x6f, 0x4e, 0x6b, 0x49, 0xf5, 0x85, 0xf9, 0xcf, 0x9f, 0xdf, 0x9b, 0xdb,
+	0x31, 0xf4, 0xe5, 0xf3, 0x7b, 0x73, 0x15, 0x01, 0x79, 0x2b, 0x02, 0x9d, 0xc2, 0x68, 0x4e, 0xc2,
+	0x44, 0x6a, 0xa9, 0x85, 0x48, 0x17, 0xfb, 0x04, 0x99, 0x3f, 0x69, 0x30, 0xd2, 0x24, 0xce, 0x35,
+	0xdc, 0xde, 0xd0, 0xcb, 0x30, 0x62, 0x09, 0xb8, 0xc2, 0x91, 0x56, 0xf4, 0xaa, 0xcf, 0x40, 0x29,
+	0xf4, 0x99, 0xf1, 0x15, 0x66, 0x82, 0x23, 0x2e, 0xb6, 0x40, 0x2c, 0x2d, 0x59, 0x14, 0x31, 0x6f,
+	0xac, 0x0e, 0x0e, 0x7d, 0x5a, 0x1e, 0xe4, 0xde, 0x4c, 0xd6, 0xa4, 0xff, 0x2c, 0xd0, 0x35, 0x19,
+	0xe8, 0xda, 0x25, 0xec, 0xfa, 0x8d, 0x02, 0x73, 0xa9, 0x25, 0xd5, 0xf5, 0x69, 0x00, 0x2b, 0xa4,
+	0x78, 0x25, 0x40, 0x3e, 0xda, 0x2c, 0x17, 0x66, 0xb5, 0xea, 0x61, 0xce, 0x12, 0x6e, 0xb1, 0x05,
+	0xfd, 0x04, 0x0c, 0x23, 0xd2, 0x0e, 0xf0, 0x66, 0x79, 0x88, 0x8b, 0xe4, 0xdb, 0xc2, 0x28, 0x23,
+	0x21, 0x82, 0x67, 0x8e, 0xf1, 0xb0, 0x30, 0x1f, 0x94, 0x5f, 0x1e, 0x14, 0x9b, 0xc4, 0xb9, 0xcc,
+	0xf2, 0xc1, 0xee, 0xe3, 0xd8, 0x79, 0x00, 0x95, 0x33, 0x2c, 0x12, 0x83, 0xd5, 0xd2, 0xd9, 0x89,
+	0x64, 0x24, 0x2e, 0x47, 0xf2, 0x56, 0x4c, 0x35, 0x05, 0xe0, 0x18, 0x8c, 0xa9, 0xd3, 0x14, 0x84,
+	0xbf, 0x34, 0x38, 0xd1, 0x24, 0xce, 0x32, 0xf2, 0xed, 0x25, 0xe4, 0x21, 0xc7, 0xa2, 0x68, 0xd1,
+	0xb7, 0x39, 0xd3, 0xff, 0x87, 0xd1, 0xb5, 0x00, 0x77, 0x56, 0x92, 0xa8, 0x4a, 0x6c, 0x4d, 0x26,
+	0x0c, 0x23, 0x86, 0x62, 0xa5, 0x20, 0x18, 0x2f, 0x52, 0x1c, 0x89, 0xdf, 0x80, 0xb1, 0xbb, 0x96,
+	0xe7, 0xda, 0x16, 0xc5, 0x81, 0xd2, 0x1a, 0xe4, 0x5a, 0xff, 0x53, 0x82, 0xc5, 0xfc, 0xf0, 0x15,
+	0xfa, 0x84, 0x6f, 0x68, 0x5f, 0xe1, 0x5b, 0x18, 0x63, 0x34, 0x24, 0x7c, 0x31, 0x67, 0xa1, 0x92,
+	0xef, 0xb5, 0x22, 0xe6, 0x89, 0x06, 0x27, 0x9b, 0xc4, 0x69, 0x86, 0x1e, 0x75, 0x5f, 0x90, 0x9d,
+	0x06, 0x8c, 0x52, 0x4c, 0x2d, 0x6f, 0x45, 0xc2, 0x3e, 0xb4, 0x37, 0xd8, 0x25, 0xbe, 0x69, 0x51,
+	0xa4, 0xde, 0x15, 0x18, 0x11, 0x85, 0xcf, 0x88, 0x63, 0x81, 0x3f, 0x9d, 0x0c, 0x7c, 0x2f, 0x7c,
+	0x37, 0xf8, 0xa6, 0x56, 0xb4, 0x39, 0x8f, 0x03, 0x02, 0xaf, 0xf4, 0x71, 0x30, 0x22, 0x42, 0xbf,
+	0x06, 0x23, 0x01, 0x22, 0xa1, 0x47, 0x99, 0x8f, 0xfb, 0x40, 0xd0, 0xe2, 0x9b, 0xa4, 0x4f, 0x91,
+	0x09, 0xf3, 0x1b, 0x0d, 0x8e, 0xb0, 0x2c, 0xb4, 0x02, 0x6f, 0xfb, 0x36, 0x8f, 0xed, 0xbf, 0x51,
+	0xd1, 0xa9, 0xca, 0x58, 0xe6, 0x35, 0x10, 0xc3, 0xa4, 0x9c, 0xbf, 0x00, 0x23, 0x5d, 0xe4, 0x5b,
+	0x9e, 0x6c, 0x9b, 0x7b, 0x38, 0x21, 0xd2, 0x37, 0xff, 0x10, 0x7d, 0xf8, 0x56, 0x60, 0xf9, 0x64,
+	0x0d, 0x05, 0xff, 0xf9, 0x92, 0x12, 0xfd, 0x3b, 0xee, 0xae, 0xaa, 0xa5, 0x6d, 0xce, 0xc4, 0x32,
+	0xa2, 0x8b, 0xaa, 0x67, 0x1e, 0x20, 0xe8, 0xc9, 0x6e, 0x3c, 0x98, 0xea, 0xc6, 0xa9, 0xd0, 0x0a,
+	0x54, 0xf1, 0xa3, 0x15, 0xaa, 0x1f, 0x34, 0xd0, 0x9b, 0xc4, 0xb9, 0x12, 0xb2, 0x76, 0xb8, 0x69,
+	0x05, 0x36, 0xb9, 0x89, 0xb1, 0xa7, 0x4f, 0x41, 0xd1, 0x46, 0x5d, 0x4c, 0x5c, 0x8a, 0x03, 0x89,
+	0x6d, 0x67, 0x41, 0x5f, 0x57, 0x8c, 0x89, 0x3e, 0xdc, 0x87, 0xb1, 0xb7, 0x19, 0x63, 0xdf, 0x3f,
+	0x99, 0xa9, 0x3a, 0x2e, 0x5d, 0x0f, 0x57, 0x6b, 0x6d, 0xdc, 0x91, 0xc3, 0x82, 0x7c, 0x9c, 0x21,
+	0xf6, 0x46, 0x9d, 0x6e, 0x77, 0x11, 0xe1, 0x1b, 0x88, 0xbc, 0x42, 0x25, 0xc5, 0x47, 0xf8, 0x15,
+	0xaa, 0x4e, 0x36, 0xa7, 0xc0, 0xc8, 0xa2, 0x55, 0xce, 0x5c, 0xe0, 0x14, 0x5f, 0xf2, 0x2c, 0xb7,
+	0x23, 0xc5, 0xbd, 0x29, 0x4e, 0x51, 0xf4, 0x85, 0xc6, 0x39, 0x8a, 0xef, 0x55, 0xf9, 0xff, 0x31,
+	0x2b, 0x7e, 0xbe, 0x24, 0x8b, 0xff, 0xe5, 0xfb, 0x1b, 0x1d, 0x60, 0xde, 0x11, 0xf5, 0x82, 0x37,
+	0x90, 0xef, 0x7e, 0x82, 0x0e, 0x78, 0xd9, 0xa7, 0x7c, 0x7c, 0x47, 0x24, 0x67, 0xcc, 0xb6, 0x72,
+	0x71, 0x12, 0x0e, 0xb7, 0x3d, 0x8b, 0x90, 0x15, 0xd7, 0x8e, 0x0e, 0xe1, 0xef, 0x57, 0x6d, 0xfd,
+	0x38, 0x0c, 0xfb, 0x6b, 0x94, 0x09, 0x84, 0xfd, 0x21, 0x7f, 0x8d, 0x5e, 0xb5, 0xcd, 0x0f, 0x61,
+	0xbc, 0x49, 0x9c, 0x9b, 0x41, 0xe8, 0xa3, 0xcb, 0x5b, 0x5d, 0x37, 0x40, 0xbc, 0xdf, 0x11, 0x36,
+	0x07, 0x10, 0xe4, 0xdb, 0x28, 0x4a, 0x1b, 0xf9, 0xc6, 0x32, 0x4a, 0xe2, 0x40, 0xe2, 0xfa, 0x2e,
+	0xb6, 0x76, 0x16, 0x16, 0x4a, 0x0c, 0xa8, 0x54, 0x35, 0x2b, 0x30, 0x95, 0x67, 0x5a, 0x85, 0xf9,
+	0x67, 0x4d, 0x4d, 0x11, 0xcb, 0xed, 0x75, 0x64, 0x87, 0x1e, 0xea, 0x43, 0xd2, 0xcb, 0xb8, 0x80,
+	0x2e, 0xc2, 0x61, 0x22, 0x4f, 0x92, 0x4d, 0xd6, 0x48, 0xf6, 0xff, 0x38, 0x16, 0x69, 0x40, 0xed,
+	0x88, 0x8d, 0x46, 0x85, 0x3e, 0xa3, 0xd1, 0x55, 0x1e, 0x9d, 0xb8, 0x21, 0x15, 0x9d, 0x1a, 0x0c,
+	0xb1, 0x73, 0xa2, 0xf4, 0xd3, 0xb3, 0x67, 0xcb, 0x33, 0x85, 0x9a, 0xf9, 0xed, 0xa1, 0x5e, 0x37,
+	0xbb, 0xe2, 0xeb, 0x1f, 0x6e, 0xc2, 0xe9, 0x20, 0x14, 0x0e, 0x18, 0x84, 0xa1, 0xfd, 0x06, 0x21,
+	0xaf, 0x59, 0x7f, 0x00, 0xaf, 0xf5, 0x67, 0xe9, 0x85, 0x03, 0xf0, 0x95, 0x06, 0x47, 0xdf, 0x8b,
+	0x38, 0x78, 0x1f, 0xb9, 0xce, 0x3a, 0xcd, 0xe7, 0x4b, 0xeb, 0xc1, 0xd7, 0x75, 0x18, 0xde, 0xe4,
+	0xdb, 0x04, 0xef, 0x8d, 0x73, 0xcc, 0xfa, 0xaf, 0x8f, 0x67, 0x4e, 0x0a, 0xc2, 0x88, 0xbd, 0x51,
+	0x73, 0x71, 0xbd, 0x63, 0xd1, 0xf5, 0xda, 0x35, 0xe4, 0x58, 0xed, 0xed, 0x25, 0xd4, 0x7e, 0x74,
+	0xff, 0x0c, 0x48, 0x3e, 0x97, 0x50, 0x5b, 0xf6, 0x51, 0x61, 0xc5, 0xfc, 0x4d, 0xb4, 0xf9, 0xf4,
+	0xfc, 0xd6, 0xbb, 0x6a, 0x2e, 0x01, 0x28, 0x50, 0xd1, 0xb8, 0x3d, 0x9d, 0x74, 0x3b, 0xe5, 0xa0,
+	0x64, 0x20, 0xb6, 0x2d, 0xdd, 0x9f, 0x06, 0xfb, 0x5c, 0xbd, 0x85, 0x83, 0x8c, 0x2e, 0xe2, 0x56,
+	0xe8, 0x31, 0xbb, 0x9d, 0xfd, 0x13, 0x60, 0xb0, 0x49, 0x1c, 0xfd, 0x16, 0x8c, 0x26, 0xbe, 0x07,
+	0x53, 0xee, 0xa4, 0xbe, 0xbb, 0x8c, 0x57, 0xfb, 0x8a, 0x55, 0x6a, 0x5c, 0x84, 0x02, 0xa7, 0xf2,
+	0x78, 0x46, 0x9d, 0x2d, 0x1b, 0xd3, 0xb9, 0xcb, 0x6a, 0x77, 0x03, 0x86, 0xe5, 0x97, 0xcf, 0x44,
+	0x46, 0x51, 0x08, 0x8c, 0x99, 0x1e, 0x02, 0x65, 0xc3, 0x85, 0x63, 0x79, 0xb3, 0xf9, 0xa9, 0xcc,
+	0xbe, 0x1c, 0x2d, 0xe3, 0xf4, 0x5e, 0xb4, 0xd4, 0x51, 0x5b, 0x50, 0xee, 0xf9, 0x2d, 0xf0, 0x7a,
+	0xc6, 0x52, 0x2f, 0x55, 0x63, 0x7e, 0xcf, 0xaa, 0xea, 0xe4, 0x77, 0xa1, 0x14, 0x1f, 0x97, 0xa7,
+	0xb2, 0xa4, 0xec, 0x48, 0x8d, 0x53, 0xfd, 0xa4, 0xca, 0xe4, 0x2d, 0x18, 0x4d, 0xcc, 0xa5, 0xd9,
+	0x50, 0xc5, 0xc5, 0x39, 0xf9, 0x90, 0x37, 0xe6, 0x31, 0xab, 0x89, 0x19, 0x6f, 0x3a, 0x87, 0xe0,
+	0x1d, 0x71, 0x8e, 0xd5, 0xbc, 0x31, 0x4d, 0xff, 0x08, 0x8e, 0xa6, 0x47, 0xb4, 0xd9, 0xcc, 0xce,
+	0x94, 0x86, 0x51, 0xdd, 0x4d, 0x23, 0x0e, 0x3a, 0x31, 0x35, 0x65, 0x41, 0xc7, 0xc5, 0x39, 0xa0,
+	0x73, 0xe7, 0x26, 0x46, 0x70, 0x7c, 0x90, 0xc9, 0x21, 0x38, 0x26, 0xce, 0x23, 0x38, 0x6f, 0x54,
+	0x69, 0xc3, 0x58, 0x76, 0xea, 0x30, 0x33, 0x7b, 0x33, 0x3a, 0xc6, 0xdc, 0xee, 0x3a, 0x71, 0xe8,
+	0x89, 0xeb, 0x32, 0xbf, 0x8c, 0x23, 0x71, 0x0e, 0xf4, 0xdc, 0x6b, 0xe4, 0x33, 0x0d, 0x4e, 0xf6,
+	0xbb, 0x94, 0xf7, 0x54, 0x8c, 0xea, 0xd0, 0xb7, 0xf6, 0xa3, 0x1d, 0xcf, 0xa4, 0x74, 0xe5, 0x66,
+	0x33, 0x29, 0x5d, 0xb0, 0xd5, 0xdd, 0x34, 0x22, 0xf3, 0xc6, 0xd0, 0xa7, 0xec, 0xde, 0x69, 0x5c,
+	0x79, 0xf0, 0xb4, 0xa2, 0x3d, 0x7c, 0x5a, 0xd1, 0x7e, 0x7f, 0x5a, 0xd1, 0xbe, 0x7e, 0x56, 0x19,
+	0x78, 0xf8, 0xac, 0x32, 0xf0, 0xcb, 0xb3, 0xca, 0xc0, 0x9d, 0xd3, 0xb1, 0xc1, 0xf8, 0x06, 0x33,
+	0x7a, 0x1d, 0xd1, 0x4d, 0x1c, 0x6c, 0xd4, 0x53, 0xbf, 0x8c, 0xf1, 0x11, 0x79, 0x75, 0x98, 0xff,
+	0x9c, 0xf7, 0xe6, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x4c, 0x88, 0xcb, 0xdf, 0x14, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// Lock locks tokens for a specific address.
+	Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error)
+	// Extend extends the lock duration for a specific address.
+	Extend(ctx context.Context, in *MsgExtend, opts ...grpc.CallOption) (*MsgExtendResponse, error)
+	// SendDelegateAndLock sends tokens to a specific address, delegates them to a validator, and locks them until a specified unlock date.
+	SendDelegateAndLock(ctx context.Context, in *MsgSendDelegateAndLock, opts ...grpc.CallOption) (*MsgSendDelegateAndLockResponse, error)
+	// MultiSendDelegateAndLock sends tokens to multiple addresses, delegates them to a validator, and locks them until specified unlock dates.
+	MultiSendDelegateAndLock(ctx context.Context, in *MsgMultiSendDelegateAndLock, opts ...grpc.CallOption) (*MsgMultiSendDelegateAndLockResponse, error)
+	// EarlyUnlock releases part or all of a lock before its unlock date in exchange for a penalty. The penalty is unbonded
+	// from the delegations backing the lock, so no liquid balance is needed.
+	EarlyUnlock(ctx context.Context, in *MsgEarlyUnlock, opts ...grpc.CallOption) (*MsgEarlyUnlockResponse, error)
+	// TransferLock moves part or all of a lock, together with the delegation backing it, to another address.
+	TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error)
+	// SetAutoRenew turns auto-renewal of a lock on or off.
+	SetAutoRenew(ctx context.Context, in *MsgSetAutoRenew, opts ...grpc.CallOption) (*MsgSetAutoRenewResponse, error)
+	// FundRewardsPool deposits tokens into the lock rewards pool.
+	FundRewardsPool(ctx context.Context, in *MsgFundRewardsPool, opts ...grpc.CallOption) (*MsgFundRewardsPoolResponse, error)
+	// ClaimRewards pays out the rewards pool payouts allocated to an address.
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	// TokenizeLock mints an x/nft token that represents a lock. Sending the token transfers the lock and its delegation.
+	TokenizeLock(ctx context.Context, in *MsgTokenizeLock, opts ...grpc.CallOption) (*MsgTokenizeLockResponse, error)
+	// PruneExpiredLocks removes the expired locks of the given addresses. Any account can submit it.
+	PruneExpiredLocks(ctx context.Context, in *MsgPruneExpiredLocks, opts ...grpc.CallOption) (*MsgPruneExpiredLocksResponse, error)
+	// LockSchedule expands a lock schedule into dated locks.
+	LockSchedule(ctx context.Context, in *MsgLockSchedule, opts ...grpc.CallOption) (*MsgLockScheduleResponse, error)
+	// SendDelegateAndLockSchedule sends tokens to an address, delegates them to a validator, and locks them according
+	// to a lock schedule.
+	SendDelegateAndLockSchedule(ctx context.Context, in *MsgSendDelegateAndLockSchedule, opts ...grpc.CallOption) (*MsgSendDelegateAndLockScheduleResponse, error)
+	// DelegateAndLock delegates liquid tokens of an address to one or more validators and locks them in one step.
+	DelegateAndLock(ctx context.Context, in *MsgDelegateAndLock, opts ...grpc.CallOption) (*MsgDelegateAndLockResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error) {
+	out := new(MsgLockResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Extend(ctx context.Context, in *MsgExtend, opts ...grpc.CallOption) (*MsgExtendResponse, error) {
+	out := new(MsgExtendResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/Extend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendDelegateAndLock(ctx context.Context, in *MsgSendDelegateAndLock, opts ...grpc.CallOption) (*MsgSendDelegateAndLockResponse, error) {
+	out := new(MsgSendDelegateAndLockResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/SendDelegateAndLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MultiSendDelegateAndLock(ctx context.Context, in *MsgMultiSendDelegateAndLock, opts ...grpc.CallOption) (*MsgMultiSendDelegateAndLockResponse, error) {
+	out := new(MsgMultiSendDelegateAndLockResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/MultiSendDelegateAndLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EarlyUnlock(ctx context.Context, in *MsgEarlyUnlock, opts ...grpc.CallOption) (*MsgEarlyUnlockResponse, error) {
+	out := new(MsgEarlyUnlockResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/EarlyUnlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error) {
+	out := new(MsgTransferLockResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/TransferLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoRenew(ctx context.Context, in *MsgSetAutoRenew, opts ...grpc.CallOption) (*MsgSetAutoRenewResponse, error) {
+	out := new(MsgSetAutoRenewResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/SetAutoRenew", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundRewardsPool(ctx context.Context, in *MsgFundRewardsPool, opts ...grpc.CallOption) (*MsgFundRewardsPoolResponse, error) {
+	out := new(MsgFundRewardsPoolResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/FundRewardsPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TokenizeLock(ctx context.Context, in *MsgTokenizeLock, opts ...grpc.CallOption) (*MsgTokenizeLockResponse, error) {
+	out := new(MsgTokenizeLockResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/TokenizeLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PruneExpiredLocks(ctx context.Context, in *MsgPruneExpiredLocks, opts ...grpc.CallOption) (*MsgPruneExpiredLocksResponse, error) {
+	out := new(MsgPruneExpiredLocksResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/PruneExpiredLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockSchedule(ctx context.Context, in *MsgLockSchedule, opts ...grpc.CallOption) (*MsgLockScheduleResponse, error) {
+	out := new(MsgLockScheduleResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/LockSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendDelegateAndLockSchedule(ctx context.Context, in *MsgSendDelegateAndLockSchedule, opts ...grpc.CallOption) (*MsgSendDelegateAndLockScheduleResponse, error) {
+	out := new(MsgSendDelegateAndLockScheduleResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/SendDelegateAndLockSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateAndLock(ctx context.Context, in *MsgDelegateAndLock, opts ...grpc.CallOption) (*MsgDelegateAndLockResponse, error) {
+	out := new(MsgDelegateAndLockResponse)
+	err := c.cc.Invoke(ctx, "/optio.lockup.Msg/DelegateAndLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// Lock locks tokens for a specific address.
+	Lock(context.Context, *MsgLock) (*MsgLockResponse, error)
+	// Extend extends the lock duration for a specific address.
+	Extend(context.Context, *MsgExtend) (*MsgExtendResponse, error)
+	// SendDelegateAndLock sends tokens to a specific address, delegates them to a validator, and locks them until a specified unlock date.
+	SendDelegateAndLock(context.Context, *MsgSendDelegateAndLock) (*MsgSendDelegateAndLockResponse, error)
+	// MultiSendDelegateAndLock sends tokens to multiple addresses, delegates them to a validator, and locks them until specified unlock dates.
+	MultiSendDelegateAndLock(context.Context, *MsgMultiSendDelegateAndLock) (*MsgMultiSendDelegateAndLockResponse, error)
+	// EarlyUnlock releases part or all of a lock before its unlock date in exchange for a penalty. The penalty is unbonded
+	// from the delegations backing the lock, so no liquid balance is needed.
+	EarlyUnlock(context.Context, *MsgEarlyUnlock) (*MsgEarlyUnlockResponse, error)
+	// TransferLock moves part or all of a lock, together with the delegation backing it, to another address.
+	TransferLock(context.Context, *MsgTransferLock) (*MsgTransferLockResponse, error)
+	// SetAutoRenew turns auto-renewal of a lock on or off.
+	SetAutoRenew(context.Context, *MsgSetAutoRenew) (*MsgSetAutoRenewResponse, error)
+	// FundRewardsPool deposits tokens into the lock rewards pool.
+	FundRewardsPool(context.Context, *MsgFundRewardsPool) (*MsgFundRewardsPoolResponse, error)
+	// ClaimRewards pays out the rewards pool payouts allocated to an address.
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	// TokenizeLock mints an x/nft token that represents a lock. Sending the token transfers the lock and its delegation.
+	TokenizeLock(context.Context, *MsgTokenizeLock) (*MsgTokenizeLockResponse, error)
+	// PruneExpiredLocks removes the expired locks of the given addresses. Any account can submit it.
+	PruneExpiredLocks(context.Context, *MsgPruneExpiredLocks) (*MsgPruneExpiredLocksResponse, error)
+	// LockSchedule expands a lock schedule into dated locks.
+	LockSchedule(context.Context, *MsgLockSchedule) (*MsgLockScheduleResponse, error)
+	// SendDelegateAndLockSchedule sends tokens to an address, delegates them to a validator, and locks them according
+	// to a lock schedule.
+	SendDelegateAndLockSchedule(context.Context, *MsgSendDelegateAndLockSchedule) (*MsgSendDelegateAndLockScheduleResponse, error)
+	// DelegateAndLock delegates liquid tokens of an address to one or more validators and locks them in one step.
+	DelegateAndLock(context.Context, *MsgDelegateAndLock) (*MsgDelegateAndLockResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) Lock(ctx context.Context, req *MsgLock) (*MsgLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (*UnimplementedMsgServer) Extend(ctx context.Context, req *MsgExtend) (*MsgExtendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Extend not implemented")
+}
+func (*UnimplementedMsgServer) SendDelegateAndLock(ctx context.Context, req *MsgSendDelegateAndLock) (*MsgSendDelegateAndLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDelegateAndLock not implemented")
+}
+func (*UnimplementedMsgServer) MultiSendDelegateAndLock(ctx context.Context, req *MsgMultiSendDelegateAndLock) (*MsgMultiSendDelegateAndLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSendDelegateAndLock not implemented")
+}
+func (*UnimplementedMsgServer) EarlyUnlock(ctx context.Context, req *MsgEarlyUnlock) (*MsgEarlyUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarlyUnlock not implemented")
+}
+func (*UnimplementedMsgServer) TransferLock(ctx context.Context, req *MsgTransferLock) (*MsgTransferLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLock not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoRenew(ctx context.Context, req *MsgSetAutoRenew) (*MsgSetAutoRenewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoRenew not implemented")
+}
+func (*UnimplementedMsgServer) FundRewardsPool(ctx context.Context, req *MsgFundRewardsPool) (*MsgFundRewardsPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundRewardsPool not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (*UnimplementedMsgServer) TokenizeLock(ctx context.Context, req *MsgTokenizeLock) (*MsgTokenizeLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeLock not implemented")
+}
+func (*UnimplementedMsgServer) PruneExpiredLocks(ctx context.Context, req *MsgPruneExpiredLocks) (*MsgPruneExpiredLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneExpiredLocks not implemented")
+}
+func (*UnimplementedMsgServer) LockSchedule(ctx context.Context, req *MsgLockSchedule) (*MsgLockScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockSchedule not implemented")
+}
+func (*UnimplementedMsgServer) SendDelegateAndLockSchedule(ctx context.Context, req *MsgSendDelegateAndLockSchedule) (*MsgSendDelegateAndLockScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDelegateAndLockSchedule not implemented")
+}
+func (*UnimplementedMsgServer) DelegateAndLock(ctx context.Context, req *MsgDelegateAndLock) (*MsgDelegateAndLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateAndLock not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Lock(ctx, req.(*MsgLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Extend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Extend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/Extend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Extend(ctx, req.(*MsgExtend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendDelegateAndLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendDelegateAndLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendDelegateAndLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/SendDelegateAndLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendDelegateAndLock(ctx, req.(*MsgSendDelegateAndLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MultiSendDelegateAndLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiSendDelegateAndLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiSendDelegateAndLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/MultiSendDelegateAndLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiSendDelegateAndLock(ctx, req.(*MsgMultiSendDelegateAndLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EarlyUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEarlyUnlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EarlyUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/EarlyUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EarlyUnlock(ctx, req.(*MsgEarlyUnlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/TransferLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferLock(ctx, req.(*MsgTransferLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRenew)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoRenew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/SetAutoRenew",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoRenew(ctx, req.(*MsgSetAutoRenew))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundRewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundRewardsPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundRewardsPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/FundRewardsPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundRewardsPool(ctx, req.(*MsgFundRewardsPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TokenizeLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenizeLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TokenizeLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/TokenizeLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TokenizeLock(ctx, req.(*MsgTokenizeLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PruneExpiredLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneExpiredLocks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneExpiredLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/PruneExpiredLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneExpiredLocks(ctx, req.(*MsgPruneExpiredLocks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/LockSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockSchedule(ctx, req.(*MsgLockSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendDelegateAndLockSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendDelegateAndLockSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendDelegateAndLockSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/SendDelegateAndLockSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendDelegateAndLockSchedule(ctx, req.(*MsgSendDelegateAndLockSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateAndLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateAndLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateAndLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optio.lockup.Msg/DelegateAndLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateAndLock(ctx, req.(*MsgDelegateAndLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "optio.lockup.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _Msg_Lock_Handler,
+		},
+		{
+			MethodName: "Extend",
+			Handler:    _Msg_Extend_Handler,
+		},
+		{
+			MethodName: "SendDelegateAndLock",
+			Handler:    _Msg_SendDelegateAndLock_Handler,
+		},
+		{
+			MethodName: "MultiSendDelegateAndLock",
+			Handler:    _Msg_MultiSendDelegateAndLock_Handler,
+		},
+		{
+			MethodName: "EarlyUnlock",
+			Handler:    _Msg_EarlyUnlock_Handler,
+		},
+		{
+			MethodName: "TransferLock",
+			Handler:    _Msg_TransferLock_Handler,
+		},
+		{
+			MethodName: "SetAutoRenew",
+			Handler:    _Msg_SetAutoRenew_Handler,
+		},
+		{
+			MethodName: "FundRewardsPool",
+			Handler:    _Msg_FundRewardsPool_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "TokenizeLock",
+			Handler:    _Msg_TokenizeLock_Handler,
+		},
+		{
+			MethodName: "PruneExpiredLocks",
+			Handler:    _Msg_PruneExpiredLocks_Handler,
+		},
+		{
+			MethodName: "LockSchedule",
+			Handler:    _Msg_LockSchedule_Handler,
+		},
+		{
+			MethodName: "SendDelegateAndLockSchedule",
+			Handler:    _Msg_SendDelegateAndLockSchedule_Handler,
+		},
+		{
+			MethodName: "DelegateAndLock",
+			Handler:    _Msg_DelegateAndLock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "optio/lockup/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Escrow {
+		i--
+		if m.Escrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Extensions) > 0 {
+		for iNdEx := len(m.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Extensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendDelegateAndLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendDelegateAndLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendDelegateAndLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendDelegateAndLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendDelegateAndLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendDelegateAndLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiSendDelegateAndLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendDelegateAndLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendDelegateAndLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.TotalAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiSendDelegateAndLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendDelegateAndLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendDelegateAndLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEarlyUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEarlyUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEarlyUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEarlyUnlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEarlyUnlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEarlyUnlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Penalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoRenew) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoRenew) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRenew) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoRenewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoRenewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRenewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardsPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardsPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardsPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardsPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardsPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenizeLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenizeLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenizeLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenizeLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneExpiredLocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneExpiredLocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneExpiredLocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneExpiredLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneExpiredLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneExpiredLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Escrow {
+		i--
+		if m.Escrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TotalAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendDelegateAndLockSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendDelegateAndLockSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendDelegateAndLockSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TotalAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendDelegateAndLockScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendDelegateAndLockScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendDelegateAndLockScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateAndLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateAndLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateAndLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateAndLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateAndLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateAndLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AutoRenew {
+		n += 2
+	}
+	if m.Escrow {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExtend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Extensions) > 0 {
+		for _, e := range m.Extensions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExtendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendDelegateAndLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSendDelegateAndLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiSendDelegateAndLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiSendDelegateAndLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgEarlyUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgEarlyUnlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Penalty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoRenew) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AutoRenew {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoRenewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundRewardsPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundRewardsPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTokenizeLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenizeLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneExpiredLocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPruneExpiredLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLockSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Escrow {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgLockScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendDelegateAndLockSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSendDelegateAndLockScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateAndLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateAndLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Escrow = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extensions = append(m.Extensions, &Extension{})
+			if err := m.Extensions[len(m.Extensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendDelegateAndLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendDelegateAndLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendDelegateAndLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendDelegateAndLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendDelegateAndLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendDelegateAndLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSendDelegateAndLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendDelegateAndLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendDelegateAndLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, &MultiSendDelegateAndLockOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSendDelegateAndLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendDelegateAndLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendDelegateAndLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, MultiSendDelegateAndLockResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEarlyUnlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEarlyUnlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEarlyUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEarlyUnlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEarlyUnlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEarlyUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoRenew) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoRenew: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoRenew: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoRenewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoRenewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoRenewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardsPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardsPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardsPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgLock) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenizeLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1137,10 +6206,10 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenizeLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenizeLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1207,11 +6276,61 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 			}
 			m.UnlockDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenizeLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenizeLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenizeLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1221,24 +6340,169 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneExpiredLocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneExpiredLocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneExpiredLocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1261,7 +6525,7 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneExpiredLocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1284,10 +6548,10 @@ func (m *MsgLockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneExpiredLocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneExpiredLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1311,7 +6575,7 @@ func (m *MsgLockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtend) Unmarshal(dAtA []byte) error {
+func (m *MsgLockSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1334,10 +6598,10 @@ func (m *MsgExtend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1374,7 +6638,7 @@ func (m *MsgExtend) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1401,11 +6665,63 @@ func (m *MsgExtend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extensions = append(m.Extensions, &Extension{})
-			if err := m.Extensions[len(m.Extensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Escrow = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1427,7 +6743,7 @@ func (m *MsgExtend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLockScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1450,12 +6766,46 @@ func (m *MsgExtendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1477,7 +6827,7 @@ func (m *MsgExtendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendDelegateAndLock) Unmarshal(dAtA []byte) error {
+func (m *MsgSendDelegateAndLockSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1500,10 +6850,10 @@ func (m *MsgSendDelegateAndLock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendDelegateAndLock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendDelegateAndLockSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendDelegateAndLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendDelegateAndLockSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1604,9 +6954,9 @@ func (m *MsgSendDelegateAndLock) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1616,27 +6966,28 @@ func (m *MsgSendDelegateAndLock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1663,7 +7014,7 @@ func (m *MsgSendDelegateAndLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1688,7 +7039,7 @@ func (m *MsgSendDelegateAndLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendDelegateAndLockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSendDelegateAndLockScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1711,12 +7062,46 @@ func (m *MsgSendDelegateAndLockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendDelegateAndLockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendDelegateAndLockScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendDelegateAndLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendDelegateAndLockScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1738,7 +7123,7 @@ func (m *MsgSendDelegateAndLockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSendDelegateAndLock) Unmarshal(dAtA []byte) error {
+func (m *ValidatorWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1761,15 +7146,15 @@ func (m *MsgMultiSendDelegateAndLock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSendDelegateAndLock: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSendDelegateAndLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1797,11 +7182,127 @@ func (m *MsgMultiSendDelegateAndLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateAndLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateAndLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateAndLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1828,13 +7329,46 @@ func (m *MsgMultiSendDelegateAndLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, ValidatorWeight{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1861,8 +7395,7 @@ func (m *MsgMultiSendDelegateAndLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Outputs = append(m.Outputs, &MultiSendDelegateAndLockOutput{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1887,7 +7420,7 @@ func (m *MsgMultiSendDelegateAndLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSendDelegateAndLockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateAndLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1910,10 +7443,10 @@ func (m *MsgMultiSendDelegateAndLockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSendDelegateAndLockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateAndLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSendDelegateAndLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateAndLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: